@@ -1,14 +1,18 @@
 module github.com/mithrandie/csvq
 
 require (
+	github.com/klauspost/compress v1.18.0
 	github.com/mitchellh/go-homedir v1.0.0
 	github.com/mithrandie/go-file/v2 v2.0.2
 	github.com/mithrandie/go-text v1.3.3
 	github.com/mithrandie/readline-csvq v1.1.1
 	github.com/mithrandie/ternary v1.1.0
+	github.com/ulikunitz/xz v0.5.12
 	github.com/urfave/cli v1.20.0
 	golang.org/x/crypto v0.0.0-20181112202954-3d3f9f413869
 	golang.org/x/sys v0.0.0-20191029155521-f43be2a4598c
 )
 
-go 1.11
+require golang.org/x/text v0.3.3 // indirect
+
+go 1.22
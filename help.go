@@ -30,9 +30,9 @@ PARAMETERS:
    Timezone
        Local | UTC
    Import Format
-       CSV | TSV | FIXED | JSON | LTSV
+       CSV | TSV | FIXED | JSON | LTSV | JSONL | PARQUET | XLSX | YAML | XML
    Export Format
-       CSV | TSV | FIXED | JSON | LTSV | GFM | ORG | TEXT
+       CSV | TSV | FIXED | JSON | LTSV | JSONL | XLSX | YAML | XML | SQL | HTML | GFM | ORG | TEXT
    Import Character Encodings
        AUTO | UTF8 | UTF8M | UTF16 | UTF16BE | UTF16LE | UTF16BEM | UTF16LEM | SJIS
    Export Character Encodings
@@ -40,7 +40,9 @@ PARAMETERS:
    Line Break
        CRLF | CR | LF
    JSON Escape Type
-       BACKSLASH | HEX | HEXALL{{end}}{{if .Copyright}}
+       BACKSLASH | HEX | HEXALL
+   SQL Dialect
+       ANSI | MYSQL | POSTGRESQL | SQLITE{{end}}{{if .Copyright}}
 
 COPYRIGHT:
    {{.Copyright}}{{end}}
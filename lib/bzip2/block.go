@@ -0,0 +1,455 @@
+package bzip2
+
+const (
+	runA = 0
+	runB = 1
+
+	// groupSize is the number of symbols coded with the same Huffman table.
+	groupSize = 50
+
+	maxGroups = 6
+
+	// maxCodeLen is the maximum length of Huffman codes. The format allows up to 20 bits,
+	// and codes are limited to 17 bits as the reference implementation does.
+	maxCodeLen = 17
+
+	refinementIterations = 4
+)
+
+// transform applies the Burrows-Wheeler transform to data.
+// It returns the last column of the sorted rotations and the row of the original data.
+func transform(data []byte) ([]byte, int) {
+	n := len(data)
+	rotations := sortRotations(data)
+
+	last := make([]byte, n)
+	origPtr := 0
+	for i, p := range rotations {
+		if p == 0 {
+			origPtr = i
+			last[i] = data[n-1]
+		} else {
+			last[i] = data[p-1]
+		}
+	}
+	return last, origPtr
+}
+
+// sortRotations returns the starting positions of the rotations of data in sorted order.
+// The rotations are sorted by prefix doubling with counting sorts, so the order of the rotations
+// is decided by their first 2^k bytes in the k-th pass.
+func sortRotations(data []byte) []int32 {
+	n := len(data)
+	p := make([]int32, n)
+	c := make([]int32, n)
+	pn := make([]int32, n)
+	cn := make([]int32, n)
+	cnt := make([]int32, 256)
+	if len(cnt) < n {
+		cnt = make([]int32, n)
+	}
+
+	for _, b := range data {
+		cnt[b]++
+	}
+	for i := 1; i < 256; i++ {
+		cnt[i] += cnt[i-1]
+	}
+	for i := n - 1; 0 <= i; i-- {
+		cnt[data[i]]--
+		p[cnt[data[i]]] = int32(i)
+	}
+	classes := int32(1)
+	for i := 1; i < n; i++ {
+		if data[p[i]] != data[p[i-1]] {
+			classes++
+		}
+		c[p[i]] = classes - 1
+	}
+
+	for h := 1; h < n && int(classes) < n; h <<= 1 {
+		// The rotations are already sorted by the second half, so sorting them stably by the first half
+		// sorts them by the whole.
+		for i := 0; i < n; i++ {
+			pn[i] = p[i] - int32(h)
+			if pn[i] < 0 {
+				pn[i] += int32(n)
+			}
+		}
+
+		for i := int32(0); i < classes; i++ {
+			cnt[i] = 0
+		}
+		for i := 0; i < n; i++ {
+			cnt[c[pn[i]]]++
+		}
+		for i := int32(1); i < classes; i++ {
+			cnt[i] += cnt[i-1]
+		}
+		for i := n - 1; 0 <= i; i-- {
+			cnt[c[pn[i]]]--
+			p[cnt[c[pn[i]]]] = pn[i]
+		}
+
+		cn[p[0]] = 0
+		classes = 1
+		for i := 1; i < n; i++ {
+			if c[p[i]] != c[p[i-1]] || c[(int(p[i])+h)%n] != c[(int(p[i-1])+h)%n] {
+				classes++
+			}
+			cn[p[i]] = classes - 1
+		}
+		c, cn = cn, c
+	}
+	return p
+}
+
+// encodeBlock writes the transformed data of a block after the origin pointer.
+func encodeBlock(bw *bitWriter, last []byte) {
+	var inUse [256]bool
+	for _, b := range last {
+		inUse[b] = true
+	}
+
+	var seq [256]byte
+	nInUse := 0
+	for i := 0; i < 256; i++ {
+		if inUse[i] {
+			seq[i] = byte(nInUse)
+			nInUse++
+		}
+	}
+	alphaSize := nInUse + 2
+
+	writeSymbolMap(bw, inUse)
+
+	symbols, freq := moveToFront(last, seq, nInUse)
+	lengths, selectors := buildCodeTables(symbols, freq, alphaSize)
+
+	bw.writeBits(3, uint64(len(lengths)))
+	bw.writeBits(15, uint64(len(selectors)))
+
+	var order [maxGroups]byte
+	for i := range order {
+		order[i] = byte(i)
+	}
+	for _, s := range selectors {
+		j := 0
+		for order[j] != s {
+			j++
+		}
+		copy(order[1:j+1], order[:j])
+		order[0] = s
+		for ; 0 < j; j-- {
+			bw.writeBits(1, 1)
+		}
+		bw.writeBits(1, 0)
+	}
+
+	codes := make([][]uint32, len(lengths))
+	for t, ls := range lengths {
+		codes[t] = assignCodes(ls)
+
+		cur := ls[0]
+		bw.writeBits(5, uint64(cur))
+		for _, l := range ls {
+			for ; cur < l; cur++ {
+				bw.writeBits(2, 2)
+			}
+			for ; l < cur; cur-- {
+				bw.writeBits(2, 3)
+			}
+			bw.writeBits(1, 0)
+		}
+	}
+
+	for i, s := range selectors {
+		end := (i + 1) * groupSize
+		if len(symbols) < end {
+			end = len(symbols)
+		}
+		for _, v := range symbols[i*groupSize : end] {
+			bw.writeBits(uint(lengths[s][v]), uint64(codes[s][v]))
+		}
+	}
+}
+
+// writeSymbolMap writes the bytes used in the block as a bitmap of 16 ranges of 16 bytes.
+func writeSymbolMap(bw *bitWriter, inUse [256]bool) {
+	var ranges uint64
+	for i := 0; i < 16; i++ {
+		for j := 0; j < 16; j++ {
+			if inUse[i*16+j] {
+				ranges |= 1 << uint(15-i)
+				break
+			}
+		}
+	}
+	bw.writeBits(16, ranges)
+
+	for i := 0; i < 16; i++ {
+		if ranges&(1<<uint(15-i)) == 0 {
+			continue
+		}
+		var bits uint64
+		for j := 0; j < 16; j++ {
+			if inUse[i*16+j] {
+				bits |= 1 << uint(15-j)
+			}
+		}
+		bw.writeBits(16, bits)
+	}
+}
+
+// moveToFront encodes the bytes with the move-to-front transform and the run-length encoding of zeros.
+// The runs of zeros are written in bijective base 2 with the symbols RUNA and RUNB, other values are
+// shifted by one, and the end of the block is marked with the last symbol of the alphabet.
+func moveToFront(last []byte, seq [256]byte, nInUse int) ([]uint16, []int) {
+	eob := uint16(nInUse + 1)
+	freq := make([]int, nInUse+2)
+	symbols := make([]uint16, 0, len(last)+1)
+
+	var order [256]byte
+	for i := 0; i < nInUse; i++ {
+		order[i] = byte(i)
+	}
+
+	zeros := 0
+	writeZeros := func() {
+		zeros--
+		for {
+			s := uint16(runA)
+			if zeros&1 != 0 {
+				s = runB
+			}
+			symbols = append(symbols, s)
+			freq[s]++
+			if zeros < 2 {
+				break
+			}
+			zeros = (zeros - 2) / 2
+		}
+		zeros = 0
+	}
+
+	for _, b := range last {
+		s := seq[b]
+		j := 0
+		for order[j] != s {
+			j++
+		}
+		if j == 0 {
+			zeros++
+			continue
+		}
+
+		if 0 < zeros {
+			writeZeros()
+		}
+		copy(order[1:j+1], order[:j])
+		order[0] = s
+
+		symbols = append(symbols, uint16(j+1))
+		freq[j+1]++
+	}
+	if 0 < zeros {
+		writeZeros()
+	}
+
+	symbols = append(symbols, eob)
+	freq[eob]++
+	return symbols, freq
+}
+
+// buildCodeTables decides the code lengths of the Huffman tables and the table used for each group of symbols.
+// The tables are first made from ranges of the alphabet with similar total frequencies,
+// and then refined by making each table from the groups that are coded best with it.
+func buildCodeTables(symbols []uint16, freq []int, alphaSize int) ([][]uint8, []byte) {
+	var nGroups int
+	switch n := len(symbols); {
+	case n < 200:
+		nGroups = 2
+	case n < 600:
+		nGroups = 3
+	case n < 1200:
+		nGroups = 4
+	case n < 2400:
+		nGroups = 5
+	default:
+		nGroups = 6
+	}
+
+	lengths := make([][]uint8, nGroups)
+	remaining := len(symbols)
+	start := 0
+	for part := nGroups; 0 < part; part-- {
+		target := remaining / part
+		end := start - 1
+		sum := 0
+		for sum < target && end < alphaSize-1 {
+			end++
+			sum += freq[end]
+		}
+		if start < end && part != nGroups && part != 1 && (nGroups-part)%2 == 1 {
+			sum -= freq[end]
+			end--
+		}
+
+		ls := make([]uint8, alphaSize)
+		for v := range ls {
+			if v < start || end < v {
+				ls[v] = 15
+			}
+		}
+		lengths[part-1] = ls
+
+		start = end + 1
+		remaining -= sum
+	}
+
+	nSelectors := (len(symbols) + groupSize - 1) / groupSize
+	selectors := make([]byte, nSelectors)
+	tableFreq := make([][]int, nGroups)
+	for t := range tableFreq {
+		tableFreq[t] = make([]int, alphaSize)
+	}
+
+	for iter := 0; iter < refinementIterations; iter++ {
+		for t := range tableFreq {
+			for v := range tableFreq[t] {
+				tableFreq[t][v] = 0
+			}
+		}
+
+		for i := range selectors {
+			end := (i + 1) * groupSize
+			if len(symbols) < end {
+				end = len(symbols)
+			}
+			group := symbols[i*groupSize : end]
+
+			best := 0
+			bestCost := -1
+			for t, ls := range lengths {
+				cost := 0
+				for _, v := range group {
+					cost += int(ls[v])
+				}
+				if bestCost < 0 || cost < bestCost {
+					best = t
+					bestCost = cost
+				}
+			}
+
+			selectors[i] = byte(best)
+			for _, v := range group {
+				tableFreq[best][v]++
+			}
+		}
+
+		for t := range lengths {
+			lengths[t] = codeLengths(tableFreq[t], maxCodeLen)
+		}
+	}
+	return lengths, selectors
+}
+
+// codeLengths returns the lengths of the Huffman codes for the frequencies.
+// Every symbol is given a code even if it does not appear. If a code is longer than maxLen,
+// the frequencies are flattened and the codes are made again.
+func codeLengths(freq []int, maxLen int) []uint8 {
+	weights := make([]int, len(freq))
+	for i, f := range freq {
+		weights[i] = f
+		if weights[i] < 1 {
+			weights[i] = 1
+		}
+	}
+
+	for {
+		lengths, longest := huffmanCodeLengths(weights)
+		if longest <= maxLen {
+			return lengths
+		}
+		for i := range weights {
+			weights[i] = 1 + weights[i]/2
+		}
+	}
+}
+
+// huffmanCodeLengths builds the Huffman tree of the weights and returns the depths of the leaves
+// and the maximum depth. The tree is built by merging the two lightest nodes taken from
+// the leaves sorted by weight and the merged nodes, which are made in ascending order of weight.
+func huffmanCodeLengths(weights []int) ([]uint8, int) {
+	n := len(weights)
+	nodeWeights := make([]int, n, 2*n-1)
+	parents := make([]int, n, 2*n-1)
+	copy(nodeWeights, weights)
+	for i := range parents {
+		parents[i] = -1
+	}
+
+	leaves := make([]int, n)
+	for i := range leaves {
+		leaves[i] = i
+	}
+	// Insertion sort is sufficient for the alphabet of at most 258 symbols.
+	for i := 1; i < n; i++ {
+		for j := i; 0 < j && weights[leaves[j]] < weights[leaves[j-1]]; j-- {
+			leaves[j], leaves[j-1] = leaves[j-1], leaves[j]
+		}
+	}
+
+	merged := make([]int, 0, n-1)
+	li, mi := 0, 0
+	lightest := func() int {
+		if li < len(leaves) && (len(merged) <= mi || nodeWeights[leaves[li]] <= nodeWeights[merged[mi]]) {
+			li++
+			return leaves[li-1]
+		}
+		mi++
+		return merged[mi-1]
+	}
+
+	for i := 1; i < n; i++ {
+		a := lightest()
+		b := lightest()
+		node := len(nodeWeights)
+		nodeWeights = append(nodeWeights, nodeWeights[a]+nodeWeights[b])
+		parents = append(parents, -1)
+		parents[a] = node
+		parents[b] = node
+		merged = append(merged, node)
+	}
+
+	lengths := make([]uint8, n)
+	longest := 0
+	for i := 0; i < n; i++ {
+		depth := 0
+		for p := parents[i]; 0 <= p; p = parents[p] {
+			depth++
+		}
+		lengths[i] = uint8(depth)
+		if longest < depth {
+			longest = depth
+		}
+	}
+	return lengths, longest
+}
+
+// assignCodes returns the canonical Huffman codes for the lengths.
+// Shorter codes come first, and codes of the same length are assigned in the order of the symbols.
+func assignCodes(lengths []uint8) []uint32 {
+	codes := make([]uint32, len(lengths))
+	code := uint32(0)
+	for l := uint8(1); l <= maxCodeLen; l++ {
+		for v, vl := range lengths {
+			if vl == l {
+				codes[v] = code
+				code++
+			}
+		}
+		code <<= 1
+	}
+	return codes
+}
@@ -0,0 +1,228 @@
+// Package bzip2 implements a writer of the bzip2 compressed format.
+//
+// The standard library only provides a reader of the format, so the data is compressed
+// by this package and decompressed by compress/bzip2.
+package bzip2
+
+import (
+	"errors"
+	"io"
+)
+
+// blockSizeLevel is the level written in the stream header, which indicates that
+// the blocks are at most 900k bytes long.
+const blockSizeLevel = 9
+
+// maxBlockLen is the maximum length of a block after the initial run-length encoding.
+const maxBlockLen = blockSizeLevel*100000 - 19
+
+// maxRunLen is the maximum number of bytes encoded in a run of the initial run-length encoding.
+const maxRunLen = 255
+
+const (
+	blockMagic1       = 0x314159
+	blockMagic2       = 0x265359
+	endOfStreamMagic1 = 0x177245
+	endOfStreamMagic2 = 0x385090
+)
+
+var errClosed = errors.New("bzip2: writer is closed")
+
+// Writer compresses the data written to it in the bzip2 format.
+// The writer must be closed to write the data that are not yet written and the end of the stream.
+type Writer struct {
+	bw *bitWriter
+
+	block     []byte
+	blockCRC  uint32
+	streamCRC uint32
+
+	// runByte and runLen hold the run of identical bytes that is not yet encoded into the block.
+	runByte byte
+	runLen  int
+
+	headerWritten bool
+	closed        bool
+}
+
+// NewWriter returns a writer that writes the compressed data to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		bw:       newBitWriter(w),
+		block:    make([]byte, 0, maxBlockLen),
+		blockCRC: 0xffffffff,
+	}
+}
+
+func (w *Writer) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, errClosed
+	}
+	if w.bw.err != nil {
+		return 0, w.bw.err
+	}
+
+	for _, b := range p {
+		if 0 < w.runLen && b == w.runByte && w.runLen < maxRunLen {
+			w.runLen++
+			continue
+		}
+		if err := w.flushRun(); err != nil {
+			return 0, err
+		}
+		w.runByte = b
+		w.runLen = 1
+	}
+	return len(p), nil
+}
+
+// Close writes the remaining data and the end of the stream. The underlying writer is not closed.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	if err := w.flushRun(); err != nil {
+		return err
+	}
+	if err := w.writeBlock(); err != nil {
+		return err
+	}
+	w.writeHeader()
+
+	w.bw.writeBits(24, endOfStreamMagic1)
+	w.bw.writeBits(24, endOfStreamMagic2)
+	w.bw.writeBits(32, uint64(w.streamCRC))
+	return w.bw.close()
+}
+
+// flushRun encodes the run of identical bytes into the block.
+// Four or more bytes are encoded as four bytes followed by the number of the rest.
+func (w *Writer) flushRun() error {
+	if w.runLen < 1 {
+		return nil
+	}
+
+	if maxBlockLen < len(w.block)+5 {
+		if err := w.writeBlock(); err != nil {
+			return err
+		}
+	}
+
+	for i := 0; i < w.runLen; i++ {
+		w.blockCRC = updateCRC(w.blockCRC, w.runByte)
+	}
+	if w.runLen < 4 {
+		for i := 0; i < w.runLen; i++ {
+			w.block = append(w.block, w.runByte)
+		}
+	} else {
+		w.block = append(w.block, w.runByte, w.runByte, w.runByte, w.runByte, byte(w.runLen-4))
+	}
+	w.runLen = 0
+	return nil
+}
+
+func (w *Writer) writeHeader() {
+	if w.headerWritten {
+		return
+	}
+	w.bw.writeBits(8, 'B')
+	w.bw.writeBits(8, 'Z')
+	w.bw.writeBits(8, 'h')
+	w.bw.writeBits(8, '0'+blockSizeLevel)
+	w.headerWritten = true
+}
+
+func (w *Writer) writeBlock() error {
+	if len(w.block) < 1 {
+		return nil
+	}
+	w.writeHeader()
+
+	crc := ^w.blockCRC
+	w.streamCRC = (w.streamCRC<<1 | w.streamCRC>>31) ^ crc
+
+	w.bw.writeBits(24, blockMagic1)
+	w.bw.writeBits(24, blockMagic2)
+	w.bw.writeBits(32, uint64(crc))
+	// The block is not randomized.
+	w.bw.writeBits(1, 0)
+
+	last, origPtr := transform(w.block)
+	w.bw.writeBits(24, uint64(origPtr))
+	encodeBlock(w.bw, last)
+
+	w.block = w.block[:0]
+	w.blockCRC = 0xffffffff
+	return w.bw.flush()
+}
+
+// bitWriter writes bits from the most significant bit.
+type bitWriter struct {
+	w    io.Writer
+	buf  []byte
+	bits uint64
+	n    uint
+	err  error
+}
+
+func newBitWriter(w io.Writer) *bitWriter {
+	return &bitWriter{
+		w: w,
+	}
+}
+
+// writeBits writes the lower n bits of v. n must be 32 or less.
+func (bw *bitWriter) writeBits(n uint, v uint64) {
+	bw.bits = bw.bits<<n | v&(1<<n-1)
+	bw.n += n
+	for 8 <= bw.n {
+		bw.n -= 8
+		bw.buf = append(bw.buf, byte(bw.bits>>bw.n))
+	}
+	bw.bits &= 1<<bw.n - 1
+}
+
+// flush writes the whole bytes to the underlying writer.
+func (bw *bitWriter) flush() error {
+	if bw.err != nil {
+		return bw.err
+	}
+	if 0 < len(bw.buf) {
+		_, bw.err = bw.w.Write(bw.buf)
+		bw.buf = bw.buf[:0]
+	}
+	return bw.err
+}
+
+// close pads the last byte with zeros and writes it.
+func (bw *bitWriter) close() error {
+	if 0 < bw.n {
+		bw.writeBits(8-bw.n, 0)
+	}
+	return bw.flush()
+}
+
+var crcTable = func() [256]uint32 {
+	var table [256]uint32
+	for i := range table {
+		c := uint32(i) << 24
+		for j := 0; j < 8; j++ {
+			if c&0x80000000 != 0 {
+				c = c<<1 ^ 0x04c11db7
+			} else {
+				c <<= 1
+			}
+		}
+		table[i] = c
+	}
+	return table
+}()
+
+// updateCRC updates the checksum with b. The checksum of bzip2 is calculated from the most significant bit,
+// unlike the IEEE checksum in hash/crc32.
+func updateCRC(crc uint32, b byte) uint32 {
+	return crc<<8 ^ crcTable[byte(crc>>24)^b]
+}
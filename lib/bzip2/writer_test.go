@@ -0,0 +1,111 @@
+package bzip2
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func randomBytes(n int, alphabet int) []byte {
+	r := rand.New(rand.NewSource(1))
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(r.Intn(alphabet))
+	}
+	return b
+}
+
+var writerTests = []struct {
+	Name string
+	Data []byte
+}{
+	{
+		Name: "Empty",
+		Data: []byte{},
+	},
+	{
+		Name: "Single Byte",
+		Data: []byte("a"),
+	},
+	{
+		Name: "Text",
+		Data: []byte("column1,column2\n1,str1\n2,str2\n3,str3\n"),
+	},
+	{
+		Name: "Runs",
+		Data: []byte("abbbcccc" + strings.Repeat("d", 5) + strings.Repeat("e", 255) + strings.Repeat("f", 259) + strings.Repeat("g", 1000) + "h"),
+	},
+	{
+		Name: "Periodic",
+		Data: []byte(strings.Repeat("abc", 10000)),
+	},
+	{
+		Name: "All Bytes",
+		Data: func() []byte {
+			b := make([]byte, 0, 256*3)
+			for i := 0; i < 3; i++ {
+				for j := 0; j < 256; j++ {
+					b = append(b, byte(j))
+				}
+			}
+			return b
+		}(),
+	},
+	{
+		Name: "Random",
+		Data: randomBytes(100000, 256),
+	},
+	{
+		Name: "Multiple Blocks",
+		Data: randomBytes(2*maxBlockLen+1000, 16),
+	},
+	{
+		Name: "Multiple Blocks of Runs",
+		Data: bytes.Repeat([]byte("x"), 4*maxBlockLen),
+	},
+}
+
+func TestWriter(t *testing.T) {
+	for _, v := range writerTests {
+		buf := &bytes.Buffer{}
+		w := NewWriter(buf)
+		// Written in pieces so that runs are split across calls.
+		for i := 0; i < len(v.Data); i += 100003 {
+			end := i + 100003
+			if len(v.Data) < end {
+				end = len(v.Data)
+			}
+			if _, err := w.Write(v.Data[i:end]); err != nil {
+				t.Fatalf("%s: unexpected error %q", v.Name, err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		result, err := ioutil.ReadAll(bzip2.NewReader(bytes.NewReader(buf.Bytes())))
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if !bytes.Equal(result, v.Data) {
+			t.Errorf("%s: decompressed data does not match the data written", v.Name)
+		}
+	}
+}
+
+func TestWriter_Closed(t *testing.T) {
+	w := NewWriter(&bytes.Buffer{})
+	_ = w.Close()
+
+	if _, err := w.Write([]byte("a")); err == nil {
+		t.Errorf("no error, want error %q", errClosed)
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("unexpected error %q", err)
+	}
+}
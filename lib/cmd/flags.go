@@ -21,6 +21,10 @@ const (
 	RuntimeInformationSign  = "@#"
 )
 const DelimitAutomatically = "SPACES"
+const DetectDelimiterAutomatically = "AUTO"
+
+// AutoDelimiter is the delimiter that represents that the delimiter is detected from the contents of files.
+const AutoDelimiter rune = 0
 
 const (
 	RepositoryFlag               = "REPOSITORY"
@@ -29,6 +33,8 @@ const (
 	AnsiQuotesFlag               = "ANSI_QUOTES"
 	StrictEqualFlag              = "STRICT_EQUAL"
 	WaitTimeoutFlag              = "WAIT_TIMEOUT"
+	KeepVersionsFlag             = "KEEP_VERSIONS"
+	MaxVersionsFlag              = "MAX_VERSIONS"
 	ImportFormatFlag             = "IMPORT_FORMAT"
 	DelimiterFlag                = "DELIMITER"
 	DelimiterPositionsFlag       = "DELIMITER_POSITIONS"
@@ -36,6 +42,14 @@ const (
 	EncodingFlag                 = "ENCODING"
 	NoHeaderFlag                 = "NO_HEADER"
 	WithoutNullFlag              = "WITHOUT_NULL"
+	GlobUnionByNameFlag          = "GLOB_UNION_BY_NAME"
+	ColumnTypesFlag              = "COLUMN_TYPES"
+	QuoteCharFlag                = "QUOTE_CHAR"
+	EscapeStyleFlag              = "ESCAPE_STYLE"
+	CommentPrefixFlag            = "COMMENT_PREFIX"
+	SkipLinesFlag                = "SKIP_LINES"
+	TrimSpaceFlag                = "TRIM_SPACE"
+	OnParseErrorFlag             = "ON_PARSE_ERROR"
 	StripEndingLineBreakFlag     = "STRIP_ENDING_LINE_BREAK"
 	FormatFlag                   = "FORMAT"
 	ExportEncodingFlag           = "WRITE_ENCODING"
@@ -46,6 +60,11 @@ const (
 	EncloseAllFlag               = "ENCLOSE_ALL"
 	JsonEscapeFlag               = "JSON_ESCAPE"
 	PrettyPrintFlag              = "PRETTY_PRINT"
+	XmlRootElementFlag           = "XML_ROOT_ELEMENT"
+	XmlRowElementFlag            = "XML_ROW_ELEMENT"
+	SqlTableNameFlag             = "SQL_TABLE_NAME"
+	SqlDialectFlag               = "SQL_DIALECT"
+	HtmlStandaloneFlag           = "HTML_STANDALONE"
 	EastAsianEncodingFlag        = "EAST_ASIAN_ENCODING"
 	CountDiacriticalSignFlag     = "COUNT_DIACRITICAL_SIGN"
 	CountFormatCodeFlag          = "COUNT_FORMAT_CODE"
@@ -63,6 +82,8 @@ var FlagList = []string{
 	AnsiQuotesFlag,
 	StrictEqualFlag,
 	WaitTimeoutFlag,
+	KeepVersionsFlag,
+	MaxVersionsFlag,
 	ImportFormatFlag,
 	DelimiterFlag,
 	DelimiterPositionsFlag,
@@ -70,6 +91,14 @@ var FlagList = []string{
 	EncodingFlag,
 	NoHeaderFlag,
 	WithoutNullFlag,
+	GlobUnionByNameFlag,
+	ColumnTypesFlag,
+	QuoteCharFlag,
+	EscapeStyleFlag,
+	CommentPrefixFlag,
+	SkipLinesFlag,
+	TrimSpaceFlag,
+	OnParseErrorFlag,
 	StripEndingLineBreakFlag,
 	FormatFlag,
 	ExportEncodingFlag,
@@ -80,6 +109,11 @@ var FlagList = []string{
 	EncloseAllFlag,
 	JsonEscapeFlag,
 	PrettyPrintFlag,
+	XmlRootElementFlag,
+	XmlRowElementFlag,
+	SqlTableNameFlag,
+	SqlDialectFlag,
+	HtmlStandaloneFlag,
 	EastAsianEncodingFlag,
 	CountDiacriticalSignFlag,
 	CountFormatCodeFlag,
@@ -99,20 +133,34 @@ const (
 	FIXED
 	JSON
 	LTSV
+	JSONL
+	PARQUET
+	XLSX
+	YAML
+	XML
+	SQL
+	HTML
 	GFM
 	ORG
 	TEXT
 )
 
 var FormatLiteral = map[Format]string{
-	CSV:   "CSV",
-	TSV:   "TSV",
-	FIXED: "FIXED",
-	JSON:  "JSON",
-	LTSV:  "LTSV",
-	GFM:   "GFM",
-	ORG:   "ORG",
-	TEXT:  "TEXT",
+	CSV:     "CSV",
+	TSV:     "TSV",
+	FIXED:   "FIXED",
+	JSON:    "JSON",
+	LTSV:    "LTSV",
+	JSONL:   "JSONL",
+	PARQUET: "PARQUET",
+	XLSX:    "XLSX",
+	YAML:    "YAML",
+	XML:     "XML",
+	SQL:     "SQL",
+	HTML:    "HTML",
+	GFM:     "GFM",
+	ORG:     "ORG",
+	TEXT:    "TEXT",
 }
 
 func (f Format) String() string {
@@ -125,6 +173,11 @@ var ImportFormats = []Format{
 	FIXED,
 	JSON,
 	LTSV,
+	JSONL,
+	PARQUET,
+	XLSX,
+	YAML,
+	XML,
 }
 
 var JsonEscapeTypeLiteral = map[txjson.EscapeType]string{
@@ -137,14 +190,77 @@ func JsonEscapeTypeToString(escapeType txjson.EscapeType) string {
 	return JsonEscapeTypeLiteral[escapeType]
 }
 
+type SqlDialect int
+
+const (
+	AnsiSql SqlDialect = iota
+	MySql
+	PostgreSql
+	SQLite
+)
+
+var SqlDialectLiteral = map[SqlDialect]string{
+	AnsiSql:    "ANSI",
+	MySql:      "MYSQL",
+	PostgreSql: "POSTGRESQL",
+	SQLite:     "SQLITE",
+}
+
+func (d SqlDialect) String() string {
+	return SqlDialectLiteral[d]
+}
+
+type EscapeStyle int
+
+const (
+	DoubleQuoteEscape EscapeStyle = iota
+	BackslashEscape
+)
+
+var EscapeStyleLiteral = map[EscapeStyle]string{
+	DoubleQuoteEscape: "DOUBLE",
+	BackslashEscape:   "BACKSLASH",
+}
+
+func (s EscapeStyle) String() string {
+	return EscapeStyleLiteral[s]
+}
+
+type ParseErrorMode int
+
+const (
+	FailOnParseError ParseErrorMode = iota
+	SkipOnParseError
+	QuarantineOnParseError
+)
+
+var ParseErrorModeLiteral = map[ParseErrorMode]string{
+	FailOnParseError:       "FAIL",
+	SkipOnParseError:       "SKIP",
+	QuarantineOnParseError: "QUARANTINE",
+}
+
+func (m ParseErrorMode) String() string {
+	return ParseErrorModeLiteral[m]
+}
+
 const (
 	CsvExt      = ".csv"
 	TsvExt      = ".tsv"
 	JsonExt     = ".json"
 	LtsvExt     = ".ltsv"
+	JsonlExt    = ".jsonl"
+	NdjsonExt   = ".ndjson"
+	ParquetExt  = ".parquet"
+	XlsxExt     = ".xlsx"
+	YamlExt     = ".yaml"
+	YmlExt      = ".yml"
+	XmlExt      = ".xml"
 	GfmExt      = ".md"
 	OrgExt      = ".org"
 	SqlExt      = ".sql"
+	HtmlExt     = ".html"
+	HtmExt      = ".htm"
 	CsvqProcExt = ".cql"
 	TextExt     = ".txt"
 )
@@ -155,9 +271,19 @@ type ImportOptions struct {
 	DelimiterPositions []int
 	SingleLine         bool
 	JsonQuery          string
+	SheetName          string
+	RowPath            string
 	Encoding           text.Encoding
 	NoHeader           bool
 	WithoutNull        bool
+	GlobUnionByName    bool
+	ColumnTypes        []ColumnTypeHint
+	QuoteChar          rune
+	EscapeStyle        EscapeStyle
+	CommentPrefix      string
+	SkipLines          int
+	TrimSpace          bool
+	OnParseError       ParseErrorMode
 }
 
 func (ops ImportOptions) Copy() ImportOptions {
@@ -167,8 +293,15 @@ func (ops ImportOptions) Copy() ImportOptions {
 		copy(dp, ops.DelimiterPositions)
 	}
 
+	var ct []ColumnTypeHint
+	if ops.ColumnTypes != nil {
+		ct = make([]ColumnTypeHint, len(ops.ColumnTypes))
+		copy(ct, ops.ColumnTypes)
+	}
+
 	ret := ops
 	ret.DelimiterPositions = dp
+	ret.ColumnTypes = ct
 	return ret
 }
 
@@ -179,9 +312,19 @@ func NewImportOptions() ImportOptions {
 		DelimiterPositions: nil,
 		SingleLine:         false,
 		JsonQuery:          "",
+		SheetName:          "",
+		RowPath:            "",
 		Encoding:           text.AUTO,
 		NoHeader:           false,
 		WithoutNull:        false,
+		GlobUnionByName:    false,
+		ColumnTypes:        nil,
+		QuoteChar:          '"',
+		EscapeStyle:        DoubleQuoteEscape,
+		CommentPrefix:      "",
+		SkipLines:          0,
+		TrimSpace:          false,
+		OnParseError:       FailOnParseError,
 	}
 }
 
@@ -195,8 +338,18 @@ type ExportOptions struct {
 	WithoutHeader        bool
 	LineBreak            text.LineBreak
 	EncloseAll           bool
+	QuoteChar            rune
+	EscapeStyle          EscapeStyle
+	CommentPrefix        string
+	TrimSpace            bool
+	LeadingLines         []string
 	JsonEscape           txjson.EscapeType
 	PrettyPrint          bool
+	XmlRootElement       string
+	XmlRowElement        string
+	SqlTableName         string
+	SqlDialect           SqlDialect
+	HtmlStandalone       bool
 
 	// For Calculation of String Width
 	EastAsianEncoding    bool
@@ -213,8 +366,15 @@ func (ops ExportOptions) Copy() ExportOptions {
 		copy(dp, ops.DelimiterPositions)
 	}
 
+	var ll []string
+	if ops.LeadingLines != nil {
+		ll = make([]string, len(ops.LeadingLines))
+		copy(ll, ops.LeadingLines)
+	}
+
 	ret := ops
 	ret.DelimiterPositions = dp
+	ret.LeadingLines = ll
 	return ret
 }
 
@@ -229,8 +389,18 @@ func NewExportOptions() ExportOptions {
 		WithoutHeader:        false,
 		LineBreak:            text.LF,
 		EncloseAll:           false,
+		QuoteChar:            '"',
+		EscapeStyle:          DoubleQuoteEscape,
+		CommentPrefix:        "",
+		TrimSpace:            false,
+		LeadingLines:         nil,
 		JsonEscape:           txjson.Backslash,
 		PrettyPrint:          false,
+		XmlRootElement:       "root",
+		XmlRowElement:        "row",
+		SqlTableName:         "result",
+		SqlDialect:           AnsiSql,
+		HtmlStandalone:       false,
 		EastAsianEncoding:    false,
 		CountDiacriticalSign: false,
 		CountFormatCode:      false,
@@ -248,6 +418,10 @@ type Flags struct {
 
 	WaitTimeout float64
 
+	// For Versioning
+	KeepVersions bool
+	MaxVersions  int
+
 	// For Import
 	ImportOptions ImportOptions
 
@@ -287,6 +461,8 @@ func NewFlags(env *Environment) *Flags {
 		AnsiQuotes:     false,
 		StrictEqual:    false,
 		WaitTimeout:    10,
+		KeepVersions:   false,
+		MaxVersions:    0,
 		ImportOptions:  NewImportOptions(),
 		ExportOptions:  NewExportOptions(),
 		Quiet:          false,
@@ -368,19 +544,35 @@ func (f *Flags) SetWaitTimeout(t float64) {
 	return
 }
 
+func (f *Flags) SetKeepVersions(b bool) {
+	f.KeepVersions = b
+}
+
+func (f *Flags) SetMaxVersions(i int64) {
+	if i < 0 {
+		i = 0
+	}
+	f.MaxVersions = int(i)
+}
+
 func (f *Flags) SetImportFormat(s string) error {
+	if strings.EqualFold(s, PARQUET.String()) {
+		f.ImportOptions.Format = PARQUET
+		return nil
+	}
+
 	fm, _, err := ParseFormat(s, f.ExportOptions.JsonEscape)
 	if err != nil {
-		return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV")
+		return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV|JSONL|PARQUET|XLSX|YAML|XML")
 	}
 
 	switch fm {
-	case CSV, TSV, FIXED, JSON, LTSV:
+	case CSV, TSV, FIXED, JSON, LTSV, JSONL, XLSX, YAML, XML:
 		f.ImportOptions.Format = fm
 		return nil
 	}
 
-	return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV")
+	return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV|JSONL|PARQUET|XLSX|YAML|XML")
 }
 
 func (f *Flags) SetDelimiter(s string) error {
@@ -388,6 +580,11 @@ func (f *Flags) SetDelimiter(s string) error {
 		return nil
 	}
 
+	if strings.EqualFold(DetectDelimiterAutomatically, s) {
+		f.ImportOptions.Delimiter = AutoDelimiter
+		return nil
+	}
+
 	delimiter, err := ParseDelimiter(s)
 	if err != nil {
 		return err
@@ -437,6 +634,77 @@ func (f *Flags) SetWithoutNull(b bool) {
 	f.ImportOptions.WithoutNull = b
 }
 
+func (f *Flags) SetGlobUnionByName(b bool) {
+	f.ImportOptions.GlobUnionByName = b
+}
+
+func (f *Flags) SetColumnTypes(s string) error {
+	hints, err := ParseColumnTypes(s)
+	if err != nil {
+		return err
+	}
+
+	f.ImportOptions.ColumnTypes = hints
+	return nil
+}
+
+func (f *Flags) SetQuoteChar(s string) error {
+	if len(s) < 1 {
+		return nil
+	}
+
+	quote, err := ParseQuoteChar(s)
+	if err != nil {
+		return err
+	}
+
+	f.ImportOptions.QuoteChar = quote
+	return nil
+}
+
+func (f *Flags) SetEscapeStyle(s string) error {
+	if len(s) < 1 {
+		return nil
+	}
+
+	style, err := ParseEscapeStyle(s)
+	if err != nil {
+		return err
+	}
+
+	f.ImportOptions.EscapeStyle = style
+	return nil
+}
+
+func (f *Flags) SetCommentPrefix(s string) {
+	f.ImportOptions.CommentPrefix = s
+}
+
+func (f *Flags) SetSkipLines(i int64) {
+	if i < 0 {
+		i = 0
+	}
+	f.ImportOptions.SkipLines = int(i)
+}
+
+func (f *Flags) SetTrimSpace(b bool) {
+	f.ImportOptions.TrimSpace = b
+}
+
+func (f *Flags) SetOnParseError(s string) error {
+	if len(s) < 1 {
+		return nil
+	}
+
+	mode, err := ParseParseErrorMode(s)
+	if err != nil {
+		return err
+	}
+
+	f.ImportOptions.OnParseError = mode
+	return nil
+}
+
 func (f *Flags) SetFormat(s string, outfile string) error {
 	var fm Format
 	var escape txjson.EscapeType
@@ -453,6 +721,18 @@ func (f *Flags) SetFormat(s string, outfile string) error {
 			fm = JSON
 		case LtsvExt:
 			fm = LTSV
+		case JsonlExt, NdjsonExt:
+			fm = JSONL
+		case XlsxExt:
+			fm = XLSX
+		case YamlExt, YmlExt:
+			fm = YAML
+		case XmlExt:
+			fm = XML
+		case SqlExt:
+			fm = SQL
+		case HtmlExt, HtmExt:
+			fm = HTML
 		case GfmExt:
 			fm = GFM
 		case OrgExt:
@@ -547,6 +827,50 @@ func (f *Flags) SetPrettyPrint(b bool) {
 	f.ExportOptions.PrettyPrint = b
 }
 
+func (f *Flags) SetXmlRootElement(s string) error {
+	name, err := ParseXmlElementName(s)
+	if err != nil {
+		return err
+	}
+
+	f.ExportOptions.XmlRootElement = name
+	return nil
+}
+
+func (f *Flags) SetXmlRowElement(s string) error {
+	name, err := ParseXmlElementName(s)
+	if err != nil {
+		return err
+	}
+
+	f.ExportOptions.XmlRowElement = name
+	return nil
+}
+
+func (f *Flags) SetSqlTableName(s string) error {
+	s = TrimSpace(s)
+	if len(s) < 1 {
+		return errors.New("sql table name must not be empty")
+	}
+
+	f.ExportOptions.SqlTableName = s
+	return nil
+}
+
+func (f *Flags) SetSqlDialect(s string) error {
+	dialect, err := ParseSqlDialect(s)
+	if err != nil {
+		return err
+	}
+
+	f.ExportOptions.SqlDialect = dialect
+	return nil
+}
+
+func (f *Flags) SetHtmlStandalone(b bool) {
+	f.ExportOptions.HtmlStandalone = b
+}
+
 func (f *Flags) SetStripEndingLineBreak(b bool) {
 	f.ExportOptions.StripEndingLineBreak = b
 }
@@ -165,6 +165,29 @@ func TestFlags_SetWaitTimeout(t *testing.T) {
 	}
 }
 
+func TestFlags_SetKeepVersions(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetKeepVersions(true)
+	if !flags.KeepVersions {
+		t.Errorf("keep_versions = %t, expect to set %t", flags.KeepVersions, true)
+	}
+}
+
+func TestFlags_SetMaxVersions(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetMaxVersions(5)
+	if flags.MaxVersions != 5 {
+		t.Errorf("max versions = %d, expect to set %d", flags.MaxVersions, 5)
+	}
+
+	flags.SetMaxVersions(-1)
+	if flags.MaxVersions != 0 {
+		t.Errorf("max versions = %d, expect to set %d", flags.MaxVersions, 0)
+	}
+}
+
 func TestFlags_SetImportFormat(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -178,7 +201,32 @@ func TestFlags_SetImportFormat(t *testing.T) {
 		t.Errorf("importFormat = %s, expect to set %s for empty string", flags.ImportOptions.Format, JSON)
 	}
 
-	expectErr := "import format must be one of CSV|TSV|FIXED|JSON|LTSV"
+	_ = flags.SetImportFormat("jsonl")
+	if flags.ImportOptions.Format != JSONL {
+		t.Errorf("importFormat = %s, expect to set %s for %s", flags.ImportOptions.Format, JSONL, "jsonl")
+	}
+
+	_ = flags.SetImportFormat("parquet")
+	if flags.ImportOptions.Format != PARQUET {
+		t.Errorf("importFormat = %s, expect to set %s for %s", flags.ImportOptions.Format, PARQUET, "parquet")
+	}
+
+	_ = flags.SetImportFormat("xlsx")
+	if flags.ImportOptions.Format != XLSX {
+		t.Errorf("importFormat = %s, expect to set %s for %s", flags.ImportOptions.Format, XLSX, "xlsx")
+	}
+
+	_ = flags.SetImportFormat("yaml")
+	if flags.ImportOptions.Format != YAML {
+		t.Errorf("importFormat = %s, expect to set %s for %s", flags.ImportOptions.Format, YAML, "yaml")
+	}
+
+	_ = flags.SetImportFormat("xml")
+	if flags.ImportOptions.Format != XML {
+		t.Errorf("importFormat = %s, expect to set %s for %s", flags.ImportOptions.Format, XML, "xml")
+	}
+
+	expectErr := "import format must be one of CSV|TSV|FIXED|JSON|LTSV|JSONL|PARQUET|XLSX|YAML|XML"
 	err := flags.SetImportFormat("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -207,6 +255,11 @@ func TestFlags_SetDelimiter(t *testing.T) {
 		t.Errorf("delimiter = %q, expect to set %q for %q", flags.ImportOptions.Delimiter, "\t", "\t")
 	}
 
+	_ = flags.SetDelimiter("auto")
+	if flags.ImportOptions.Delimiter != AutoDelimiter {
+		t.Errorf("delimiter = %q, expect to set %q for %q", flags.ImportOptions.Delimiter, AutoDelimiter, "auto")
+	}
+
 	expectErr := "delimiter must be one character"
 	err := flags.SetDelimiter("[a]")
 	if err == nil {
@@ -316,6 +369,131 @@ func TestFlags_SetWithoutNull(t *testing.T) {
 	}
 }
 
+func TestFlags_SetGlobUnionByName(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetGlobUnionByName(true)
+	if !flags.ImportOptions.GlobUnionByName {
+		t.Errorf("glob-union-by-name = %t, expect to set %t", flags.ImportOptions.GlobUnionByName, true)
+	}
+}
+
+func TestFlags_SetColumnTypes(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetColumnTypes("id:integer, price:FLOAT")
+	expect := []ColumnTypeHint{{Column: "id", Type: "INTEGER"}, {Column: "price", Type: "FLOAT"}}
+	if !reflect.DeepEqual(flags.ImportOptions.ColumnTypes, expect) {
+		t.Errorf("column-types = %v, expect to set %v", flags.ImportOptions.ColumnTypes, expect)
+	}
+
+	_ = flags.SetColumnTypes("")
+	if flags.ImportOptions.ColumnTypes != nil {
+		t.Errorf("column-types = %v, expect to set %v", flags.ImportOptions.ColumnTypes, nil)
+	}
+
+	expectErr := "column type must be one of STRING|INTEGER|FLOAT|BOOLEAN|DATETIME"
+	err := flags.SetColumnTypes("id:number")
+	if err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q", err.Error(), expectErr)
+	}
+}
+
+func TestFlags_SetQuoteChar(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetQuoteChar("")
+	if flags.ImportOptions.QuoteChar != '"' {
+		t.Errorf("quote-char = %q, expect to set %q for %q", flags.ImportOptions.QuoteChar, '"', "")
+	}
+
+	_ = flags.SetQuoteChar("'")
+	if flags.ImportOptions.QuoteChar != '\'' {
+		t.Errorf("quote-char = %q, expect to set %q for %q", flags.ImportOptions.QuoteChar, '\'', "'")
+	}
+
+	expectErr := "quote character must be one character"
+	err := flags.SetQuoteChar("''")
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, "''")
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, "''")
+	}
+}
+
+func TestFlags_SetEscapeStyle(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetEscapeStyle("backslash")
+	if flags.ImportOptions.EscapeStyle != BackslashEscape {
+		t.Errorf("escape-style = %s, expect to set %s", flags.ImportOptions.EscapeStyle, BackslashEscape)
+	}
+
+	expectErr := "escape style must be one of DOUBLE|BACKSLASH"
+	err := flags.SetEscapeStyle("single")
+	if err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q", err.Error(), expectErr)
+	}
+}
+
+func TestFlags_SetCommentPrefix(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetCommentPrefix("#")
+	if flags.ImportOptions.CommentPrefix != "#" {
+		t.Errorf("comment-prefix = %q, expect to set %q", flags.ImportOptions.CommentPrefix, "#")
+	}
+}
+
+func TestFlags_SetSkipLines(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetSkipLines(2)
+	if flags.ImportOptions.SkipLines != 2 {
+		t.Errorf("skip-lines = %d, expect to set %d", flags.ImportOptions.SkipLines, 2)
+	}
+
+	flags.SetSkipLines(-1)
+	if flags.ImportOptions.SkipLines != 0 {
+		t.Errorf("skip-lines = %d, expect to set %d", flags.ImportOptions.SkipLines, 0)
+	}
+}
+
+func TestFlags_SetTrimSpace(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetTrimSpace(true)
+	if !flags.ImportOptions.TrimSpace {
+		t.Errorf("trim-space = %t, expect to set %t", flags.ImportOptions.TrimSpace, true)
+	}
+}
+
+func TestFlags_SetOnParseError(t *testing.T) {
+	flags := NewFlags(nil)
+
+	_ = flags.SetOnParseError("")
+	if flags.ImportOptions.OnParseError != FailOnParseError {
+		t.Errorf("on-parse-error = %s, expect to set %s for empty string", flags.ImportOptions.OnParseError, FailOnParseError)
+	}
+
+	_ = flags.SetOnParseError("quarantine")
+	if flags.ImportOptions.OnParseError != QuarantineOnParseError {
+		t.Errorf("on-parse-error = %s, expect to set %s", flags.ImportOptions.OnParseError, QuarantineOnParseError)
+	}
+
+	expectErr := "on-parse-error must be one of FAIL|SKIP|QUARANTINE"
+	err := flags.SetOnParseError("ignore")
+	if err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q", err.Error(), expectErr)
+	}
+}
+
 func TestFlags_SetFormat(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -344,6 +522,41 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, LTSV, "foo.ltsv")
 	}
 
+	_ = flags.SetFormat("", "foo.jsonl")
+	if flags.ExportOptions.Format != JSONL {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, JSONL, "foo.jsonl")
+	}
+
+	_ = flags.SetFormat("", "foo.xlsx")
+	if flags.ExportOptions.Format != XLSX {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, XLSX, "foo.xlsx")
+	}
+
+	_ = flags.SetFormat("", "foo.yaml")
+	if flags.ExportOptions.Format != YAML {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, YAML, "foo.yaml")
+	}
+
+	_ = flags.SetFormat("", "foo.yml")
+	if flags.ExportOptions.Format != YAML {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, YAML, "foo.yml")
+	}
+
+	_ = flags.SetFormat("", "foo.xml")
+	if flags.ExportOptions.Format != XML {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, XML, "foo.xml")
+	}
+
+	_ = flags.SetFormat("", "foo.sql")
+	if flags.ExportOptions.Format != SQL {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, SQL, "foo.sql")
+	}
+
+	_ = flags.SetFormat("", "foo.htm")
+	if flags.ExportOptions.Format != HTML {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, HTML, "foo.htm")
+	}
+
 	_ = flags.SetFormat("", "foo.md")
 	if flags.ExportOptions.Format != GFM {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, GFM, "foo.md")
@@ -395,6 +608,36 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("json escape type = %v, expect to set %v for %s", flags.ExportOptions.JsonEscape, json.AllWithHexDigits, "jsonh")
 	}
 
+	_ = flags.SetFormat("jsonl", "")
+	if flags.ExportOptions.Format != JSONL {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, JSONL, "jsonl")
+	}
+
+	_ = flags.SetFormat("xlsx", "")
+	if flags.ExportOptions.Format != XLSX {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, XLSX, "xlsx")
+	}
+
+	_ = flags.SetFormat("yaml", "")
+	if flags.ExportOptions.Format != YAML {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, YAML, "yaml")
+	}
+
+	_ = flags.SetFormat("xml", "")
+	if flags.ExportOptions.Format != XML {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, XML, "xml")
+	}
+
+	_ = flags.SetFormat("sql", "")
+	if flags.ExportOptions.Format != SQL {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, SQL, "sql")
+	}
+
+	_ = flags.SetFormat("html", "")
+	if flags.ExportOptions.Format != HTML {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, HTML, "html")
+	}
+
 	_ = flags.SetFormat("gfm", "")
 	if flags.ExportOptions.Format != GFM {
 		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, GFM, "gfm")
@@ -410,7 +653,7 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, TEXT, "text")
 	}
 
-	expectErr := "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT"
+	expectErr := "format must be one of CSV|TSV|FIXED|JSON|LTSV|JSONL|XLSX|YAML|XML|SQL|HTML|GFM|ORG|TEXT"
 	err := flags.SetFormat("error", "")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -576,6 +819,97 @@ func TestFlags_SetPrettyPrint(t *testing.T) {
 	}
 }
 
+func TestFlags_SetXmlRootElement(t *testing.T) {
+	flags := NewFlags(nil)
+
+	s := " items "
+	_ = flags.SetXmlRootElement(s)
+	if flags.ExportOptions.XmlRootElement != "items" {
+		t.Errorf("xml-root-element = %q, expect to set %q", flags.ExportOptions.XmlRootElement, "items")
+	}
+
+	s = "1items"
+	expectErr := "\"1items\" is not a valid xml element name"
+	err := flags.SetXmlRootElement(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
+func TestFlags_SetXmlRowElement(t *testing.T) {
+	flags := NewFlags(nil)
+
+	s := "item"
+	_ = flags.SetXmlRowElement(s)
+	if flags.ExportOptions.XmlRowElement != "item" {
+		t.Errorf("xml-row-element = %q, expect to set %q", flags.ExportOptions.XmlRowElement, "item")
+	}
+
+	s = ""
+	expectErr := "xml element name must not be empty"
+	err := flags.SetXmlRowElement(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
+func TestFlags_SetSqlTableName(t *testing.T) {
+	flags := NewFlags(nil)
+
+	s := " users "
+	_ = flags.SetSqlTableName(s)
+	if flags.ExportOptions.SqlTableName != "users" {
+		t.Errorf("sql-table-name = %q, expect to set %q", flags.ExportOptions.SqlTableName, "users")
+	}
+
+	s = " "
+	expectErr := "sql table name must not be empty"
+	err := flags.SetSqlTableName(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %q", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %q", err.Error(), expectErr, s)
+	}
+}
+
+func TestFlags_SetSqlDialect(t *testing.T) {
+	flags := NewFlags(nil)
+
+	s := "postgresql"
+	_ = flags.SetSqlDialect(s)
+	if flags.ExportOptions.SqlDialect != PostgreSql {
+		t.Errorf("sql-dialect = %s, expect to set %s for %s", flags.ExportOptions.SqlDialect, PostgreSql, s)
+	}
+
+	s = "SQLite"
+	_ = flags.SetSqlDialect(s)
+	if flags.ExportOptions.SqlDialect != SQLite {
+		t.Errorf("sql-dialect = %s, expect to set %s for %s", flags.ExportOptions.SqlDialect, SQLite, s)
+	}
+
+	s = "oracle"
+	expectErr := "sql dialect must be one of ANSI|MYSQL|POSTGRESQL|SQLITE"
+	err := flags.SetSqlDialect(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
+func TestFlags_SetHtmlStandalone(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetHtmlStandalone(true)
+	if !flags.ExportOptions.HtmlStandalone {
+		t.Errorf("html-standalone = %t, expect to set %t", flags.ExportOptions.HtmlStandalone, true)
+	}
+}
+
 func TestFlags_SetStripEndingLineBreak(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -310,6 +310,14 @@ func ParseDelimiter(s string) (rune, error) {
 	return r[0], nil
 }
 
+func ParseQuoteChar(s string) (rune, error) {
+	r := []rune(s)
+	if len(r) != 1 {
+		return 0, errors.New("quote character must be one character")
+	}
+	return r[0], nil
+}
+
 func ParseDelimiterPositions(s string) ([]int, bool, error) {
 	s = UnescapeString(s, '\'')
 	var delimiterPositions []int = nil
@@ -341,6 +349,18 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = JSON
 	case "LTSV":
 		fm = LTSV
+	case "JSONL":
+		fm = JSONL
+	case "XLSX":
+		fm = XLSX
+	case "YAML":
+		fm = YAML
+	case "XML":
+		fm = XML
+	case "SQL":
+		fm = SQL
+	case "HTML":
+		fm = HTML
 	case "GFM":
 		fm = GFM
 	case "ORG":
@@ -354,7 +374,7 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = JSON
 		et = txjson.AllWithHexDigits
 	default:
-		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT")
+		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|LTSV|JSONL|XLSX|YAML|XML|SQL|HTML|GFM|ORG|TEXT")
 	}
 	return fm, et, nil
 }
@@ -374,6 +394,118 @@ func ParseJsonEscapeType(s string) (txjson.EscapeType, error) {
 	return escape, nil
 }
 
+func ParseEscapeStyle(s string) (EscapeStyle, error) {
+	var style EscapeStyle
+	switch strings.ToUpper(TrimSpace(s)) {
+	case "DOUBLE":
+		style = DoubleQuoteEscape
+	case "BACKSLASH":
+		style = BackslashEscape
+	default:
+		return style, errors.New("escape style must be one of DOUBLE|BACKSLASH")
+	}
+	return style, nil
+}
+
+func ParseParseErrorMode(s string) (ParseErrorMode, error) {
+	var mode ParseErrorMode
+	switch strings.ToUpper(TrimSpace(s)) {
+	case "FAIL":
+		mode = FailOnParseError
+	case "SKIP":
+		mode = SkipOnParseError
+	case "QUARANTINE":
+		mode = QuarantineOnParseError
+	default:
+		return mode, errors.New("on-parse-error must be one of FAIL|SKIP|QUARANTINE")
+	}
+	return mode, nil
+}
+
+func ParseSqlDialect(s string) (SqlDialect, error) {
+	var dialect SqlDialect
+	switch strings.ToUpper(TrimSpace(s)) {
+	case "ANSI":
+		dialect = AnsiSql
+	case "MYSQL":
+		dialect = MySql
+	case "POSTGRESQL":
+		dialect = PostgreSql
+	case "SQLITE":
+		dialect = SQLite
+	default:
+		return dialect, errors.New("sql dialect must be one of ANSI|MYSQL|POSTGRESQL|SQLITE")
+	}
+	return dialect, nil
+}
+
+func ParseXmlElementName(s string) (string, error) {
+	s = TrimSpace(s)
+	for i, r := range s {
+		if r == '_' || unicode.IsLetter(r) || (0 < i && (r == '-' || r == '.' || unicode.IsDigit(r))) {
+			continue
+		}
+		return s, errors.New(fmt.Sprintf("%q is not a valid xml element name", s))
+	}
+	if len(s) < 1 {
+		return s, errors.New("xml element name must not be empty")
+	}
+	return s, nil
+}
+
+// ColumnTypeNames is the list of the types that can be specified for columns.
+var ColumnTypeNames = []string{"STRING", "INTEGER", "FLOAT", "BOOLEAN", "DATETIME"}
+
+// ColumnTypeHint is a type to which the fields in a column are converted when a table is loaded.
+type ColumnTypeHint struct {
+	Column string
+	Type   string
+}
+
+// ParseColumnTypes parses a comma-separated list of column type hints in the form of "column:type".
+func ParseColumnTypes(s string) ([]ColumnTypeHint, error) {
+	s = TrimSpace(s)
+	if len(s) < 1 {
+		return nil, nil
+	}
+
+	items := strings.Split(s, ",")
+	hints := make([]ColumnTypeHint, 0, len(items))
+	for _, item := range items {
+		idx := strings.LastIndex(item, ":")
+		if idx < 0 {
+			return nil, errors.New(fmt.Sprintf("column type %q must be in the form of column:type", TrimSpace(item)))
+		}
+
+		column := TrimSpace(item[:idx])
+		typ := strings.ToUpper(TrimSpace(item[idx+1:]))
+		if len(column) < 1 {
+			return nil, errors.New(fmt.Sprintf("column type %q must be in the form of column:type", TrimSpace(item)))
+		}
+		valid := false
+		for _, name := range ColumnTypeNames {
+			if typ == name {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, errors.New(fmt.Sprintf("column type must be one of %s", strings.Join(ColumnTypeNames, "|")))
+		}
+		hints = append(hints, ColumnTypeHint{Column: column, Type: typ})
+	}
+	return hints, nil
+}
+
+// FormatColumnTypes returns the string representation of the column type hints that ParseColumnTypes can parse.
+func FormatColumnTypes(hints []ColumnTypeHint) string {
+	items := make([]string, len(hints))
+	for i := range hints {
+		items[i] = hints[i].Column + ":" + hints[i].Type
+	}
+	return strings.Join(items, ",")
+}
+
 func AppendStrIfNotExist(list []string, elem string) []string {
 	if len(elem) < 1 {
 		return list
@@ -312,6 +312,66 @@ func TestParseDelimiterPositions(t *testing.T) {
 	}
 }
 
+var parseColumnTypesTests = []struct {
+	S      string
+	Result []ColumnTypeHint
+	Error  string
+}{
+	{
+		S:      "id:integer,price: float ,created:Datetime",
+		Result: []ColumnTypeHint{{Column: "id", Type: "INTEGER"}, {Column: "price", Type: "FLOAT"}, {Column: "created", Type: "DATETIME"}},
+	},
+	{
+		S:      "a:b:string",
+		Result: []ColumnTypeHint{{Column: "a:b", Type: "STRING"}},
+	},
+	{
+		S:      "",
+		Result: nil,
+	},
+	{
+		S:     "id",
+		Error: "column type \"id\" must be in the form of column:type",
+	},
+	{
+		S:     ":integer",
+		Error: "column type \":integer\" must be in the form of column:type",
+	},
+	{
+		S:     "id:number",
+		Error: "column type must be one of STRING|INTEGER|FLOAT|BOOLEAN|DATETIME",
+	},
+}
+
+func TestParseColumnTypes(t *testing.T) {
+	for _, v := range parseColumnTypesTests {
+		result, err := ParseColumnTypes(v.S)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.S)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.S)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.S)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %v, want %v for %q", result, v.Result, v.S)
+		}
+	}
+}
+
+func TestFormatColumnTypes(t *testing.T) {
+	hints := []ColumnTypeHint{{Column: "id", Type: "INTEGER"}, {Column: "price", Type: "FLOAT"}}
+	expect := "id:INTEGER,price:FLOAT"
+	if s := FormatColumnTypes(hints); s != expect {
+		t.Errorf("result = %q, want %q", s, expect)
+	}
+}
+
 var unescapeStringBenchString = "fo\\o\\a\\b\\f\\n\\r\\t\\v\\\\\\\\'\\\"bar\\"
 var unescapeStringBenchString2 = "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz"
 
@@ -0,0 +1,138 @@
+package driver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+var (
+	errConnClosed               = errors.New("connection is already closed")
+	errTxAlreadyStarted         = errors.New("transaction has already been started")
+	errIsolationLevel           = errors.New("isolation levels are not supported")
+	errReadOnlyTx               = errors.New("read-only transactions are not supported")
+	errLastInsertIdNotSupported = errors.New("LastInsertId is not supported")
+)
+
+type Conn struct {
+	proc *query.Processor
+	inTx bool
+}
+
+func NewConn(ctx context.Context, dsn *DSN) (*Conn, error) {
+	session := query.NewSession()
+	session.SetStdout(query.NewDiscard())
+	session.SetStderr(query.NewDiscard())
+
+	tx, err := query.NewTransaction(ctx, file.DefaultWaitTimeout, file.DefaultRetryDelay, session)
+	if err != nil {
+		return nil, err
+	}
+	tx.AutoCommit = true
+
+	if err = tx.SetFlag(cmd.RepositoryFlag, dsn.Repository); err != nil {
+		return nil, err
+	}
+	if 0 < len(dsn.Timezone) {
+		if err = tx.SetFlag(cmd.TimezoneFlag, dsn.Timezone); err != nil {
+			return nil, err
+		}
+	}
+	if 0 < len(dsn.DatetimeFormat) {
+		_ = tx.SetFlag(cmd.DatetimeFormatFlag, dsn.DatetimeFormat)
+	}
+	if dsn.AnsiQuotes {
+		_ = tx.SetFlag(cmd.AnsiQuotesFlag, true)
+	}
+	if dsn.StrictEqual {
+		_ = tx.SetFlag(cmd.StrictEqualFlag, true)
+	}
+	if dsn.waitTimeoutIsSet {
+		_ = tx.SetFlag(cmd.WaitTimeoutFlag, dsn.WaitTimeout)
+	}
+	_ = tx.SetFlag(cmd.QuietFlag, true)
+
+	if err = tx.RecoverJournals(); err != nil {
+		return nil, err
+	}
+
+	return &Conn{
+		proc: query.NewProcessor(tx),
+	}, nil
+}
+
+func (c *Conn) Prepare(queryString string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), queryString)
+}
+
+func (c *Conn) PrepareContext(_ context.Context, queryString string) (driver.Stmt, error) {
+	if c.proc == nil {
+		return nil, errConnClosed
+	}
+	return NewStmt(c.proc, queryString)
+}
+
+func (c *Conn) ExecContext(ctx context.Context, queryString string, args []driver.NamedValue) (driver.Result, error) {
+	stmt, err := c.PrepareContext(ctx, queryString)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = stmt.Close() }()
+
+	return stmt.(*Stmt).ExecContext(ctx, args)
+}
+
+func (c *Conn) QueryContext(ctx context.Context, queryString string, args []driver.NamedValue) (driver.Rows, error) {
+	stmt, err := c.PrepareContext(ctx, queryString)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = stmt.Close() }()
+
+	return stmt.(*Stmt).QueryContext(ctx, args)
+}
+
+func (c *Conn) Close() error {
+	if c.proc == nil {
+		return errConnClosed
+	}
+
+	err := c.proc.AutoRollback()
+	if e := c.proc.ReleaseResourcesWithErrors(); e != nil && err == nil {
+		err = e
+	}
+	c.proc = nil
+	return err
+}
+
+func (c *Conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *Conn) BeginTx(_ context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if c.proc == nil {
+		return nil, errConnClosed
+	}
+	if c.inTx {
+		return nil, errTxAlreadyStarted
+	}
+	if opts.Isolation != driver.IsolationLevel(0) {
+		return nil, errIsolationLevel
+	}
+	if opts.ReadOnly {
+		return nil, errReadOnlyTx
+	}
+
+	c.inTx = true
+	c.proc.Tx.AutoCommit = false
+	return &Tx{conn: c}, nil
+}
+
+func (c *Conn) endTx() {
+	c.inTx = false
+	c.proc.Tx.AutoCommit = true
+}
@@ -0,0 +1,59 @@
+// Package driver provides a database/sql driver for csvq.
+//
+// The driver is registered with the name "csvq".
+//
+//	db, err := sql.Open("csvq", "/path/to/repository")
+//
+// Each connection has its own session and transaction.
+// Statements are committed automatically unless they are executed in a transaction started by Begin.
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+)
+
+const DriverName = "csvq"
+
+func init() {
+	sql.Register(DriverName, &Driver{})
+}
+
+type Driver struct{}
+
+func (d *Driver) Open(dsn string) (driver.Conn, error) {
+	c, err := d.OpenConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return c.Connect(context.Background())
+}
+
+func (d *Driver) OpenConnector(dsn string) (driver.Connector, error) {
+	parsed, err := ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return NewConnector(d, parsed), nil
+}
+
+type Connector struct {
+	driver *Driver
+	dsn    *DSN
+}
+
+func NewConnector(d *Driver, dsn *DSN) *Connector {
+	return &Connector{
+		driver: d,
+		dsn:    dsn,
+	}
+}
+
+func (c *Connector) Connect(ctx context.Context) (driver.Conn, error) {
+	return NewConn(ctx, c.dsn)
+}
+
+func (c *Connector) Driver() driver.Driver {
+	return c.driver
+}
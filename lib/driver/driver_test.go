@@ -0,0 +1,227 @@
+package driver
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+)
+
+var driverQueryTests = []struct {
+	Name        string
+	Query       string
+	Args        []interface{}
+	Columns     []string
+	ColumnTypes []string
+	Result      [][]interface{}
+	Error       string
+}{
+	{
+		Name:        "Select Query",
+		Query:       "SELECT column1, column2 FROM table1 WHERE column1 > ?",
+		Args:        []interface{}{1},
+		Columns:     []string{"column1", "column2"},
+		ColumnTypes: []string{StringType, StringType},
+		Result: [][]interface{}{
+			{"2", "str2"},
+			{"3", "str3"},
+		},
+	},
+	{
+		Name:        "Select Query with Named Placeholder",
+		Query:       "SELECT column2 FROM table1 WHERE column1 = :id",
+		Args:        []interface{}{sql.Named("id", 2)},
+		Columns:     []string{"column2"},
+		ColumnTypes: []string{StringType},
+		Result: [][]interface{}{
+			{"str2"},
+		},
+	},
+	{
+		Name:        "Select Query with Joined Table",
+		Query:       "SELECT t1.column2, t2.column4 FROM table1 t1 JOIN table2 t2 ON t1.column1 = t2.column3",
+		Columns:     []string{"column2", "column4"},
+		ColumnTypes: []string{StringType, StringType},
+		Result: [][]interface{}{
+			{"str2", "str22"},
+			{"str3", "str33"},
+		},
+	},
+	{
+		Name:        "Column Types",
+		Query:       "SELECT 1 AS i, 1.5 AS f, TRUE AS t, NULL AS n, ? AS s",
+		Args:        []interface{}{"str"},
+		Columns:     []string{"i", "f", "t", "n", "s"},
+		ColumnTypes: []string{IntegerType, FloatType, TernaryType, NullType, StringType},
+		Result: [][]interface{}{
+			{int64(1), 1.5, true, nil, "str"},
+		},
+	},
+	{
+		Name:  "Query Error",
+		Query: "SELECT notexist FROM table1",
+		Error: "[L:1 C:8] field notexist does not exist",
+	},
+	{
+		Name:  "Syntax Error",
+		Query: "SELECT FROM table1",
+		Error: "[L:1 C:8] syntax error: unexpected token \"FROM\"",
+	},
+}
+
+func TestDriver_Query(t *testing.T) {
+	db, err := sql.Open(DriverName, TestDir)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	for _, v := range driverQueryTests {
+		rows, err := db.Query(v.Query, v.Args...)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			_ = rows.Close()
+			continue
+		}
+
+		columns, _ := rows.Columns()
+		if !reflect.DeepEqual(columns, v.Columns) {
+			t.Errorf("%s: columns = %v, want %v", v.Name, columns, v.Columns)
+		}
+
+		columnTypes, _ := rows.ColumnTypes()
+		typeNames := make([]string, len(columnTypes))
+		for i, ct := range columnTypes {
+			typeNames[i] = ct.DatabaseTypeName()
+		}
+		if !reflect.DeepEqual(typeNames, v.ColumnTypes) {
+			t.Errorf("%s: column types = %v, want %v", v.Name, typeNames, v.ColumnTypes)
+		}
+
+		result := make([][]interface{}, 0, len(v.Result))
+		for rows.Next() {
+			values := make([]interface{}, len(columns))
+			ptrs := make([]interface{}, len(columns))
+			for i := range values {
+				ptrs[i] = &values[i]
+			}
+			if err := rows.Scan(ptrs...); err != nil {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			}
+			result = append(result, values)
+		}
+		_ = rows.Close()
+
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestDriver_MultipleResultSets(t *testing.T) {
+	db, err := sql.Open(DriverName, TestDir)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	rows, err := db.Query("SELECT 1; SELECT 'a', 'b';")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var i int64
+	if !rows.Next() {
+		t.Fatalf("no record in the first result set")
+	}
+	_ = rows.Scan(&i)
+	if i != 1 {
+		t.Errorf("value = %d, want %d", i, 1)
+	}
+
+	if !rows.NextResultSet() {
+		t.Fatalf("no second result set")
+	}
+	var s1, s2 string
+	if !rows.Next() {
+		t.Fatalf("no record in the second result set")
+	}
+	_ = rows.Scan(&s1, &s2)
+	if s1 != "a" || s2 != "b" {
+		t.Errorf("values = %q, %q, want %q, %q", s1, s2, "a", "b")
+	}
+
+	if rows.NextResultSet() {
+		t.Errorf("unexpected result set")
+	}
+}
+
+func TestDriver_Exec(t *testing.T) {
+	db, err := sql.Open(DriverName, TestDir)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	countRecords := func(table string) int64 {
+		var cnt int64
+		if err := db.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&cnt); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		return cnt
+	}
+
+	result, err := db.Exec("INSERT INTO update_table VALUES (?, ?)", 4, "str4")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if cnt, _ := result.RowsAffected(); cnt != 1 {
+		t.Errorf("rows affected = %d, want %d", cnt, 1)
+	}
+	if _, err := result.LastInsertId(); err == nil {
+		t.Errorf("no error, want error for LastInsertId")
+	}
+	if cnt := countRecords("update_table"); cnt != 4 {
+		t.Errorf("record count = %d, want %d", cnt, 4)
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	result, err = tx.Exec("DELETE FROM update_table WHERE column1 < :num", sql.Named("num", 3))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if cnt, _ := result.RowsAffected(); cnt != 2 {
+		t.Errorf("rows affected = %d, want %d", cnt, 2)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if cnt := countRecords("update_table"); cnt != 2 {
+		t.Errorf("record count = %d, want %d", cnt, 2)
+	}
+
+	tx, err = db.Begin()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err = tx.Exec("DELETE FROM rollback_table"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err = tx.Rollback(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if cnt := countRecords("rollback_table"); cnt != 3 {
+		t.Errorf("record count = %d, want %d", cnt, 3)
+	}
+}
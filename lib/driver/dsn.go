@@ -0,0 +1,122 @@
+package driver
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	dsnTimezone       = "timezone"
+	dsnDatetimeFormat = "datetimeformat"
+	dsnAnsiQuotes     = "ansiquotes"
+	dsnStrictEqual    = "strictequal"
+	dsnWaitTimeout    = "waittimeout"
+)
+
+// DSN represents a data source name.
+//
+// The format of a data source name is as follows.
+//
+//	/path/to/repository?Timezone=UTC&DatetimeFormat=%Y-%m-%d&AnsiQuotes=true&StrictEqual=true&WaitTimeout=10
+//
+// The repository is the directory where the tables are placed,
+// and the parameters overwrite the flags of the same names.
+type DSN struct {
+	Repository     string
+	Timezone       string
+	DatetimeFormat string
+	AnsiQuotes     bool
+	StrictEqual    bool
+	WaitTimeout    float64
+
+	waitTimeoutIsSet bool
+}
+
+func ParseDSN(s string) (*DSN, error) {
+	dsn := &DSN{}
+
+	repository := s
+	params := ""
+	if i := strings.IndexByte(s, '?'); -1 < i {
+		repository = s[:i]
+		params = s[i+1:]
+	}
+	dsn.Repository = repository
+
+	if len(params) < 1 {
+		return dsn, nil
+	}
+
+	for _, param := range strings.Split(params, "&") {
+		if len(param) < 1 {
+			continue
+		}
+
+		key := param
+		val := ""
+		if i := strings.IndexByte(param, '='); -1 < i {
+			key = param[:i]
+			val = param[i+1:]
+		}
+		if s, err := url.QueryUnescape(val); err == nil {
+			val = s
+		}
+
+		switch strings.ToLower(key) {
+		case dsnTimezone:
+			dsn.Timezone = val
+		case dsnDatetimeFormat:
+			dsn.DatetimeFormat = val
+		case dsnAnsiQuotes:
+			b, err := strconv.ParseBool(val)
+			if err != nil {
+				return nil, errors.New(fmt.Sprintf("dsn parameter %s must be a boolean value", key))
+			}
+			dsn.AnsiQuotes = b
+		case dsnStrictEqual:
+			b, err := strconv.ParseBool(val)
+			if err != nil {
+				return nil, errors.New(fmt.Sprintf("dsn parameter %s must be a boolean value", key))
+			}
+			dsn.StrictEqual = b
+		case dsnWaitTimeout:
+			f, err := strconv.ParseFloat(val, 64)
+			if err != nil {
+				return nil, errors.New(fmt.Sprintf("dsn parameter %s must be a number", key))
+			}
+			dsn.WaitTimeout = f
+			dsn.waitTimeoutIsSet = true
+		default:
+			return nil, errors.New(fmt.Sprintf("dsn parameter %s is not supported", key))
+		}
+	}
+
+	return dsn, nil
+}
+
+func (dsn *DSN) String() string {
+	params := make([]string, 0, 5)
+	if 0 < len(dsn.Timezone) {
+		params = append(params, "Timezone="+url.QueryEscape(dsn.Timezone))
+	}
+	if 0 < len(dsn.DatetimeFormat) {
+		params = append(params, "DatetimeFormat="+url.QueryEscape(dsn.DatetimeFormat))
+	}
+	if dsn.AnsiQuotes {
+		params = append(params, "AnsiQuotes=true")
+	}
+	if dsn.StrictEqual {
+		params = append(params, "StrictEqual=true")
+	}
+	if dsn.waitTimeoutIsSet {
+		params = append(params, "WaitTimeout="+strconv.FormatFloat(dsn.WaitTimeout, 'f', -1, 64))
+	}
+
+	if len(params) < 1 {
+		return dsn.Repository
+	}
+	return dsn.Repository + "?" + strings.Join(params, "&")
+}
@@ -0,0 +1,105 @@
+package driver
+
+import (
+	"reflect"
+	"testing"
+)
+
+var parseDSNTests = []struct {
+	Name   string
+	DSN    string
+	Result *DSN
+	Error  string
+}{
+	{
+		Name: "Repository Only",
+		DSN:  "/path/to/repository",
+		Result: &DSN{
+			Repository: "/path/to/repository",
+		},
+	},
+	{
+		Name:   "Empty",
+		DSN:    "",
+		Result: &DSN{},
+	},
+	{
+		Name: "With Parameters",
+		DSN:  "/path/to/repository?Timezone=UTC&DatetimeFormat=%Y-%m-%d&ansiquotes=true&StrictEqual=1&WaitTimeout=5.5",
+		Result: &DSN{
+			Repository:       "/path/to/repository",
+			Timezone:         "UTC",
+			DatetimeFormat:   "%Y-%m-%d",
+			AnsiQuotes:       true,
+			StrictEqual:      true,
+			WaitTimeout:      5.5,
+			waitTimeoutIsSet: true,
+		},
+	},
+	{
+		Name: "Escaped Parameter",
+		DSN:  "/path/to/repository?DatetimeFormat=%25Y%2F%25m%2F%25d+%25H",
+		Result: &DSN{
+			Repository:     "/path/to/repository",
+			DatetimeFormat: "%Y/%m/%d %H",
+		},
+	},
+	{
+		Name:  "Invalid Boolean Parameter",
+		DSN:   "/path/to/repository?AnsiQuotes=abc",
+		Error: "dsn parameter AnsiQuotes must be a boolean value",
+	},
+	{
+		Name:  "Invalid Number Parameter",
+		DSN:   "/path/to/repository?WaitTimeout=abc",
+		Error: "dsn parameter WaitTimeout must be a number",
+	},
+	{
+		Name:  "Unsupported Parameter",
+		DSN:   "/path/to/repository?Format=JSON",
+		Error: "dsn parameter Format is not supported",
+	},
+}
+
+func TestParseDSN(t *testing.T) {
+	for _, v := range parseDSNTests {
+		result, err := ParseDSN(v.DSN)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %#v, want %#v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestDSN_String(t *testing.T) {
+	dsn := &DSN{
+		Repository:       "/path/to/repository",
+		Timezone:         "UTC",
+		DatetimeFormat:   "%Y/%m/%d",
+		StrictEqual:      true,
+		WaitTimeout:      0,
+		waitTimeoutIsSet: true,
+	}
+	expect := "/path/to/repository?Timezone=UTC&DatetimeFormat=%25Y%2F%25m%2F%25d&StrictEqual=true&WaitTimeout=0"
+
+	s := dsn.String()
+	if s != expect {
+		t.Errorf("string = %q, want %q", s, expect)
+	}
+
+	parsed, _ := ParseDSN(s)
+	if !reflect.DeepEqual(parsed, dsn) {
+		t.Errorf("parsed = %#v, want %#v", parsed, dsn)
+	}
+}
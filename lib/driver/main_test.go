@@ -0,0 +1,67 @@
+package driver
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var TestDir = filepath.Join(os.TempDir(), "csvq_driver_test")
+var TestDataDir string
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	defer teardown()
+
+	setup()
+	return m.Run()
+}
+
+func setup() {
+	if _, err := os.Stat(TestDir); err == nil {
+		_ = os.RemoveAll(TestDir)
+	}
+
+	wdir, _ := os.Getwd()
+	TestDataDir = filepath.Join(wdir, "..", "..", "testdata", "csv")
+
+	if _, err := os.Stat(TestDir); os.IsNotExist(err) {
+		_ = os.Mkdir(TestDir, 0755)
+	}
+
+	_ = copyfile(filepath.Join(TestDir, "table1.csv"), filepath.Join(TestDataDir, "table1.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table2.csv"), filepath.Join(TestDataDir, "table2.csv"))
+	_ = copyfile(filepath.Join(TestDir, "update_table.csv"), filepath.Join(TestDataDir, "table1.csv"))
+	_ = copyfile(filepath.Join(TestDir, "rollback_table.csv"), filepath.Join(TestDataDir, "table1.csv"))
+}
+
+func teardown() {
+	if _, err := os.Stat(TestDir); err == nil {
+		_ = os.RemoveAll(TestDir)
+	}
+}
+
+func copyfile(dstfile string, srcfile string) error {
+	src, err := os.Open(srcfile)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = src.Close() }()
+
+	dst, err := os.Create(dstfile)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = dst.Close() }()
+
+	_, err = io.Copy(dst, src)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package driver
+
+type Result struct {
+	affectedRows int64
+}
+
+func NewResult(affectedRows int64) *Result {
+	return &Result{
+		affectedRows: affectedRows,
+	}
+}
+
+func (r *Result) LastInsertId() (int64, error) {
+	return 0, errLastInsertIdNotSupported
+}
+
+func (r *Result) RowsAffected() (int64, error) {
+	return r.affectedRows, nil
+}
@@ -0,0 +1,133 @@
+package driver
+
+import (
+	"database/sql/driver"
+	"io"
+	"reflect"
+
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+type Rows struct {
+	views     []*query.View
+	viewIdx   int
+	recordIdx int
+
+	columnTypes []string
+}
+
+func NewRows(views []*query.View) *Rows {
+	return &Rows{
+		views: views,
+	}
+}
+
+func (r *Rows) view() *query.View {
+	if len(r.views) <= r.viewIdx {
+		return nil
+	}
+	return r.views[r.viewIdx]
+}
+
+func (r *Rows) Columns() []string {
+	view := r.view()
+	if view == nil {
+		return []string{}
+	}
+
+	columns := make([]string, view.FieldLen())
+	for i := range view.Header {
+		columns[i] = view.Header[i].Column
+	}
+	return columns
+}
+
+func (r *Rows) Close() error {
+	r.views = nil
+	r.viewIdx = 0
+	r.recordIdx = 0
+	r.columnTypes = nil
+	return nil
+}
+
+func (r *Rows) Next(dest []driver.Value) error {
+	view := r.view()
+	if view == nil || view.RecordLen() <= r.recordIdx {
+		return io.EOF
+	}
+
+	record := view.RecordSet[r.recordIdx]
+	for i := range dest {
+		dest[i] = ConvertToDriverValue(record[i][0])
+	}
+	r.recordIdx++
+	return nil
+}
+
+func (r *Rows) HasNextResultSet() bool {
+	return r.viewIdx < len(r.views)-1
+}
+
+func (r *Rows) NextResultSet() error {
+	if !r.HasNextResultSet() {
+		return io.EOF
+	}
+
+	r.viewIdx++
+	r.recordIdx = 0
+	r.columnTypes = nil
+	return nil
+}
+
+// ColumnTypeDatabaseTypeName returns the type of the values in the column.
+// If the column contains values of different types, then an empty string is returned.
+// If all the values in the column are NULL, then "NULL" is returned.
+func (r *Rows) ColumnTypeDatabaseTypeName(index int) string {
+	if r.columnTypes == nil {
+		r.columnTypes = r.detectColumnTypes()
+	}
+	if index < 0 || len(r.columnTypes) <= index {
+		return ""
+	}
+	return r.columnTypes[index]
+}
+
+func (r *Rows) ColumnTypeScanType(index int) reflect.Type {
+	return scanType(r.ColumnTypeDatabaseTypeName(index))
+}
+
+func (r *Rows) ColumnTypeNullable(_ int) (nullable bool, ok bool) {
+	return true, true
+}
+
+func (r *Rows) detectColumnTypes() []string {
+	view := r.view()
+	if view == nil {
+		return []string{}
+	}
+
+	types := make([]string, view.FieldLen())
+	for i := range types {
+		types[i] = NullType
+	}
+
+	for _, record := range view.RecordSet {
+		for i := range types {
+			if types[i] == "" {
+				continue
+			}
+
+			t := databaseTypeName(record[i][0])
+			if t == NullType || t == types[i] {
+				continue
+			}
+
+			if types[i] == NullType {
+				types[i] = t
+			} else {
+				types[i] = ""
+			}
+		}
+	}
+	return types
+}
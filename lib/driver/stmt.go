@@ -0,0 +1,117 @@
+package driver
+
+import (
+	"context"
+	"database/sql/driver"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+const statementNamePrefix = "__csvq_driver_stmt_"
+
+var statementSequence uint64
+
+type Stmt struct {
+	proc     *query.Processor
+	name     parser.Identifier
+	prepared *query.PreparedStatement
+}
+
+func NewStmt(proc *query.Processor, queryString string) (*Stmt, error) {
+	statements, holderNum, err := parser.Parse(queryString, "", proc.Tx.Flags.DatetimeFormat, true, proc.Tx.Flags.AnsiQuotes)
+	if err != nil {
+		return nil, query.NewSyntaxError(err.(*parser.SyntaxError))
+	}
+
+	name := parser.Identifier{Literal: statementNamePrefix + strconv.FormatUint(atomic.AddUint64(&statementSequence, 1), 10)}
+	prepared := &query.PreparedStatement{
+		Name:            name.Literal,
+		StatementString: queryString,
+		Statements:      statements,
+		HolderNumber:    holderNum,
+	}
+	proc.Tx.PreparedStatements.Store(name.Literal, prepared)
+
+	return &Stmt{
+		proc:     proc,
+		name:     name,
+		prepared: prepared,
+	}, nil
+}
+
+func (stmt *Stmt) Close() error {
+	return stmt.proc.Tx.PreparedStatements.Dispose(parser.DisposeStatement{Name: stmt.name})
+}
+
+func (stmt *Stmt) NumInput() int {
+	return stmt.prepared.HolderNumber
+}
+
+func (stmt *Stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return stmt.ExecContext(context.Background(), namedValues(args))
+}
+
+func (stmt *Stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	if err := stmt.execute(ctx, args); err != nil {
+		return nil, err
+	}
+	return NewResult(int64(stmt.proc.Tx.AffectedRows)), nil
+}
+
+func (stmt *Stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return stmt.QueryContext(context.Background(), namedValues(args))
+}
+
+func (stmt *Stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	if err := stmt.execute(ctx, args); err != nil {
+		return nil, err
+	}
+	return NewRows(stmt.proc.Tx.SelectedViews), nil
+}
+
+func (stmt *Stmt) execute(ctx context.Context, args []driver.NamedValue) error {
+	values, err := replaceValues(args)
+	if err != nil {
+		return err
+	}
+
+	ctx = query.ContextForPreparedStatement(query.ContextForStoringResults(ctx), query.NewReplaceValues(values))
+
+	if _, err = stmt.proc.Execute(ctx, stmt.prepared.Statements); err != nil {
+		if stmt.proc.Tx.AutoCommit {
+			_ = stmt.proc.AutoRollback()
+		}
+		return err
+	}
+	return nil
+}
+
+func namedValues(args []driver.Value) []driver.NamedValue {
+	values := make([]driver.NamedValue, len(args))
+	for i := range args {
+		values[i] = driver.NamedValue{
+			Ordinal: i + 1,
+			Value:   args[i],
+		}
+	}
+	return values
+}
+
+func replaceValues(args []driver.NamedValue) ([]parser.ReplaceValue, error) {
+	values := make([]parser.ReplaceValue, len(args))
+	for i := range args {
+		expr, err := ConvertToQueryExpression(args[i].Value)
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = parser.ReplaceValue{
+			Value: expr,
+			Name:  parser.Identifier{Literal: args[i].Name},
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,27 @@
+package driver
+
+import (
+	"context"
+)
+
+type Tx struct {
+	conn *Conn
+}
+
+func (tx *Tx) Commit() error {
+	if tx.conn.proc == nil {
+		return errConnClosed
+	}
+	defer tx.conn.endTx()
+
+	return tx.conn.proc.Tx.Commit(context.Background(), tx.conn.proc.ReferenceScope, nil)
+}
+
+func (tx *Tx) Rollback() error {
+	if tx.conn.proc == nil {
+		return errConnClosed
+	}
+	defer tx.conn.endTx()
+
+	return tx.conn.proc.Tx.Rollback(tx.conn.proc.ReferenceScope, nil)
+}
@@ -0,0 +1,112 @@
+package driver
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const (
+	StringType   = "STRING"
+	IntegerType  = "INTEGER"
+	FloatType    = "FLOAT"
+	BooleanType  = "BOOLEAN"
+	TernaryType  = "TERNARY"
+	DatetimeType = "DATETIME"
+	NullType     = "NULL"
+)
+
+var (
+	scanTypeString   = reflect.TypeOf("")
+	scanTypeInteger  = reflect.TypeOf(int64(0))
+	scanTypeFloat    = reflect.TypeOf(float64(0))
+	scanTypeBoolean  = reflect.TypeOf(false)
+	scanTypeDatetime = reflect.TypeOf(time.Time{})
+	scanTypeAny      = reflect.TypeOf((*interface{})(nil)).Elem()
+)
+
+// ConvertToQueryExpression converts an argument passed to a statement
+// to a value that replaces a placeholder.
+func ConvertToQueryExpression(v driver.Value) (parser.QueryExpression, error) {
+	switch v := v.(type) {
+	case nil:
+		return parser.NewNullValue(), nil
+	case string:
+		return parser.NewStringValue(v), nil
+	case []byte:
+		return parser.NewStringValue(string(v)), nil
+	case int64:
+		return parser.NewIntegerValue(v), nil
+	case float64:
+		return parser.NewFloatValue(v), nil
+	case bool:
+		return parser.NewTernaryValue(ternary.ConvertFromBool(v)), nil
+	case time.Time:
+		return parser.NewDatetimeValue(v), nil
+	}
+	return nil, errors.New(fmt.Sprintf("type %T is not supported as an argument", v))
+}
+
+// ConvertToDriverValue converts a value in a result set to a value of a type that database/sql can handle.
+// A ternary value UNKNOWN is converted to nil.
+func ConvertToDriverValue(p value.Primary) driver.Value {
+	switch v := p.(type) {
+	case *value.String:
+		return v.Raw()
+	case *value.Integer:
+		return v.Raw()
+	case *value.Float:
+		return v.Raw()
+	case *value.Boolean:
+		return v.Raw()
+	case *value.Ternary:
+		if v.Ternary() == ternary.UNKNOWN {
+			return nil
+		}
+		return v.Ternary().ParseBool()
+	case *value.Datetime:
+		return v.Raw()
+	}
+	return nil
+}
+
+func databaseTypeName(p value.Primary) string {
+	switch p.(type) {
+	case *value.String:
+		return StringType
+	case *value.Integer:
+		return IntegerType
+	case *value.Float:
+		return FloatType
+	case *value.Boolean:
+		return BooleanType
+	case *value.Ternary:
+		return TernaryType
+	case *value.Datetime:
+		return DatetimeType
+	}
+	return NullType
+}
+
+func scanType(typeName string) reflect.Type {
+	switch typeName {
+	case StringType:
+		return scanTypeString
+	case IntegerType:
+		return scanTypeInteger
+	case FloatType:
+		return scanTypeFloat
+	case BooleanType, TernaryType:
+		return scanTypeBoolean
+	case DatetimeType:
+		return scanTypeDatetime
+	}
+	return scanTypeAny
+}
@@ -0,0 +1,120 @@
+package driver
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+var convertToQueryExpressionTests = []struct {
+	Value  driver.Value
+	Result parser.QueryExpression
+	Error  string
+}{
+	{
+		Value:  nil,
+		Result: parser.NewNullValue(),
+	},
+	{
+		Value:  "str",
+		Result: parser.NewStringValue("str"),
+	},
+	{
+		Value:  []byte("str"),
+		Result: parser.NewStringValue("str"),
+	},
+	{
+		Value:  int64(1),
+		Result: parser.NewIntegerValue(1),
+	},
+	{
+		Value:  1.5,
+		Result: parser.NewFloatValue(1.5),
+	},
+	{
+		Value:  true,
+		Result: parser.NewTernaryValue(ternary.TRUE),
+	},
+	{
+		Value:  time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC),
+		Result: parser.NewDatetimeValue(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+	},
+	{
+		Value: struct{}{},
+		Error: "type struct {} is not supported as an argument",
+	},
+}
+
+func TestConvertToQueryExpression(t *testing.T) {
+	for _, v := range convertToQueryExpressionTests {
+		result, err := ConvertToQueryExpression(v.Value)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %#v", err, v.Value)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %#v", err.Error(), v.Error, v.Value)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %#v", v.Error, v.Value)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %#v, want %#v for %#v", result, v.Result, v.Value)
+		}
+	}
+}
+
+var convertToDriverValueTests = []struct {
+	Value  value.Primary
+	Result driver.Value
+}{
+	{
+		Value:  value.NewString("str"),
+		Result: "str",
+	},
+	{
+		Value:  value.NewInteger(1),
+		Result: int64(1),
+	},
+	{
+		Value:  value.NewFloat(1.5),
+		Result: 1.5,
+	},
+	{
+		Value:  value.NewBoolean(true),
+		Result: true,
+	},
+	{
+		Value:  value.NewTernary(ternary.FALSE),
+		Result: false,
+	},
+	{
+		Value:  value.NewTernary(ternary.UNKNOWN),
+		Result: nil,
+	},
+	{
+		Value:  value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+		Result: time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC),
+	},
+	{
+		Value:  value.NewNull(),
+		Result: nil,
+	},
+}
+
+func TestConvertToDriverValue(t *testing.T) {
+	for _, v := range convertToDriverValueTests {
+		result := ConvertToDriverValue(v.Value)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %#v, want %#v for %s", result, v.Result, v.Value)
+		}
+	}
+}
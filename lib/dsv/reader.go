@@ -0,0 +1,435 @@
+// Package dsv reads and writes delimiter-separated values in dialects that the csv package of go-text
+// does not support, such as other quote characters, backslash escapes, comment lines and leading lines
+// to be skipped.
+package dsv
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+)
+
+// Dialect represents the format of delimiter-separated values.
+type Dialect struct {
+	Delimiter     rune
+	Quote         rune
+	Escape        cmd.EscapeStyle
+	CommentPrefix string
+	TrimSpace     bool
+}
+
+// NewDialect returns the dialect that is equivalent to the csv package of go-text.
+func NewDialect(delimiter rune) Dialect {
+	return Dialect{
+		Delimiter: delimiter,
+		Quote:     '"',
+		Escape:    cmd.DoubleQuoteEscape,
+	}
+}
+
+// IsStandard reports whether the dialect can be read and written by the csv package of go-text.
+func (d Dialect) IsStandard() bool {
+	return d.Quote == '"' && d.Escape == cmd.DoubleQuoteEscape && len(d.CommentPrefix) < 1 && !d.TrimSpace
+}
+
+func (d Dialect) validate() error {
+	if d.Quote == d.Delimiter {
+		return errors.New("quote character must be different from the delimiter")
+	}
+	if d.Escape == cmd.BackslashEscape && (d.Quote == '\\' || d.Delimiter == '\\') {
+		return errors.New("backslash cannot be used as a quote character or a delimiter with backslash escapes")
+	}
+	return nil
+}
+
+func (d Dialect) isSpace(r rune) bool {
+	return r != d.Delimiter && (r == ' ' || r == '\t')
+}
+
+// ParseError is returned when a record cannot be parsed.
+// The reader discards the rest of the line on which the error is found,
+// so that the following records can still be read.
+type ParseError struct {
+	Line    int
+	Column  int
+	Message string
+
+	// RecordLine is the line on which the record starts.
+	RecordLine int
+	// Record is the text of the record without the line break at the end.
+	Record string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+type Reader struct {
+	Dialect
+	WithoutNull bool
+
+	// SkipLines is the number of lines to be skipped at the beginning.
+	SkipLines int
+
+	reader    *bufio.Reader
+	line      int
+	column    int
+	lineEnded bool
+
+	recordLine int
+	rawRecord  strings.Builder
+
+	recordBuf     bytes.Buffer
+	fieldStartPos []int
+	fieldQuoted   []bool
+
+	FieldsPerRecord int
+
+	DetectedLineBreak text.LineBreak
+	EnclosedAll       bool
+
+	// SkippedLines holds the lines skipped at the beginning without line breaks.
+	SkippedLines []string
+	skipped      bool
+}
+
+func NewReader(r io.Reader, enc text.Encoding, dialect Dialect) (*Reader, error) {
+	if err := dialect.validate(); err != nil {
+		return nil, err
+	}
+
+	decoder, err := text.GetTransformDecoder(r, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{
+		Dialect:       dialect,
+		reader:        bufio.NewReader(decoder),
+		line:          1,
+		fieldStartPos: make([]int, 0, 40),
+		fieldQuoted:   make([]bool, 0, 40),
+		EnclosedAll:   true,
+	}, nil
+}
+
+func (r *Reader) newError(s string) error {
+	return r.newErrorAt(r.line, r.column, s)
+}
+
+// newErrorAt returns a ParseError after discarding the rest of the current line.
+func (r *Reader) newErrorAt(line int, column int, s string) error {
+	if !r.lineEnded {
+		if _, _, err := r.readLine(); err != nil {
+			return err
+		}
+	}
+
+	return &ParseError{
+		Line:       line,
+		Column:     column,
+		Message:    s,
+		RecordLine: r.recordLine,
+		Record:     strings.TrimSuffix(r.rawRecord.String(), "\n"),
+	}
+}
+
+func (r *Reader) ReadHeader() ([]string, error) {
+	record, err := r.parseRecord(true)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]string, len(record))
+	for i, v := range record {
+		header[i] = string(v)
+	}
+	return header, nil
+}
+
+func (r *Reader) Read() ([]text.RawText, error) {
+	return r.parseRecord(r.WithoutNull)
+}
+
+func (r *Reader) readRune() (rune, text.LineBreak, error) {
+	ch, _, err := r.reader.ReadRune()
+	// Columns are counted from 1, and the end of the input is at the column next to the last character.
+	r.column++
+	if err != nil {
+		r.lineEnded = true
+		return ch, "", err
+	}
+
+	var lineBreak text.LineBreak
+	switch ch {
+	case '\r':
+		if next, _, err := r.reader.ReadRune(); err == nil {
+			if next == '\n' {
+				lineBreak = text.CRLF
+			} else {
+				_ = r.reader.UnreadRune()
+				lineBreak = text.CR
+			}
+		} else {
+			lineBreak = text.CR
+		}
+		ch = '\n'
+	case '\n':
+		lineBreak = text.LF
+	}
+	if ch == '\n' {
+		r.line++
+		r.column = 0
+	}
+	r.lineEnded = ch == '\n'
+	r.rawRecord.WriteRune(ch)
+	return ch, lineBreak, nil
+}
+
+func (r *Reader) readLine() (string, bool, error) {
+	var buf strings.Builder
+	for {
+		ch, lineBreak, err := r.readRune()
+		if err != nil {
+			if err == io.EOF {
+				return buf.String(), 0 < buf.Len(), nil
+			}
+			return buf.String(), false, err
+		}
+		if ch == '\n' {
+			r.setLineBreak(lineBreak)
+			return buf.String(), true, nil
+		}
+		buf.WriteRune(ch)
+	}
+}
+
+func (r *Reader) setLineBreak(lineBreak text.LineBreak) {
+	if r.DetectedLineBreak == "" {
+		r.DetectedLineBreak = lineBreak
+	}
+}
+
+func (r *Reader) skipLeadingLines() error {
+	r.skipped = true
+	for i := 0; i < r.SkipLines; i++ {
+		line, ok, err := r.readLine()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		r.SkippedLines = append(r.SkippedLines, line)
+	}
+	return nil
+}
+
+// skipComments skips the lines that start with the comment prefix.
+func (r *Reader) skipComments() error {
+	if len(r.CommentPrefix) < 1 {
+		return nil
+	}
+
+	for {
+		b, err := r.reader.Peek(len(r.CommentPrefix))
+		if err != nil || string(b) != r.CommentPrefix {
+			return nil
+		}
+		if _, _, err = r.readLine(); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *Reader) parseRecord(withoutNull bool) ([]text.RawText, error) {
+	if !r.skipped {
+		if err := r.skipLeadingLines(); err != nil {
+			return nil, err
+		}
+	}
+
+	r.recordBuf.Reset()
+	r.fieldStartPos = r.fieldStartPos[:0]
+	r.fieldQuoted = r.fieldQuoted[:0]
+
+	for {
+		if len(r.fieldStartPos) < 1 {
+			if err := r.skipComments(); err != nil {
+				return nil, err
+			}
+			r.recordLine = r.line
+			r.rawRecord.Reset()
+		}
+
+		fieldPosition := r.recordBuf.Len()
+		quoted, eol, err := r.parseField()
+		if err != nil {
+			if err != io.EOF {
+				return nil, err
+			}
+			if len(r.fieldStartPos) < 1 && r.recordBuf.Len() < 1 && !quoted {
+				return nil, io.EOF
+			}
+		}
+
+		if eol && len(r.fieldStartPos) < 1 && r.recordBuf.Len() < 1 && !quoted {
+			continue
+		}
+
+		r.fieldStartPos = append(r.fieldStartPos, fieldPosition)
+		r.fieldQuoted = append(r.fieldQuoted, quoted)
+
+		if eol {
+			break
+		}
+		if 0 < r.FieldsPerRecord && r.FieldsPerRecord <= len(r.fieldStartPos) {
+			return nil, r.newError("wrong number of fields in line")
+		}
+	}
+
+	if r.FieldsPerRecord < 1 {
+		r.FieldsPerRecord = len(r.fieldStartPos)
+	} else if len(r.fieldStartPos) < r.FieldsPerRecord {
+		line := r.line
+		if r.column == 0 {
+			line--
+		}
+		return nil, r.newErrorAt(line, r.column, "wrong number of fields in line")
+	}
+
+	record := make([]text.RawText, r.FieldsPerRecord)
+	recordStr := make([]byte, r.recordBuf.Len())
+	copy(recordStr, r.recordBuf.Bytes())
+	for i, pos := range r.fieldStartPos {
+		endPos := r.recordBuf.Len()
+		if i < len(r.fieldStartPos)-1 {
+			endPos = r.fieldStartPos[i+1]
+		}
+
+		if pos == endPos && !r.fieldQuoted[i] {
+			if withoutNull {
+				record[i] = text.RawText{}
+			}
+		} else {
+			record[i] = recordStr[pos:endPos]
+		}
+	}
+	return record, nil
+}
+
+// parseField reads a field and writes its contents to the record buffer.
+// It returns whether the field is quoted and whether the field is the last one in the record.
+func (r *Reader) parseField() (bool, bool, error) {
+	startPos := r.recordBuf.Len()
+	quoted := false
+	closed := false
+	trailingSpaces := 0
+
+	for {
+		ch, lineBreak, err := r.readRune()
+		if err != nil {
+			if err == io.EOF && quoted && !closed {
+				return quoted, true, r.newError(fmt.Sprintf("extraneous %c in field", r.Quote))
+			}
+			if err == io.EOF {
+				r.recordBuf.Truncate(r.recordBuf.Len() - trailingSpaces)
+			}
+			return quoted, true, err
+		}
+
+		if quoted && !closed {
+			switch {
+			case ch == r.Quote:
+				if r.Escape == cmd.DoubleQuoteEscape {
+					if next, _, err := r.reader.ReadRune(); err == nil {
+						if next == r.Quote {
+							r.column++
+							r.recordBuf.WriteRune(ch)
+							continue
+						}
+						_ = r.reader.UnreadRune()
+					}
+				}
+				closed = true
+			case ch == '\\' && r.Escape == cmd.BackslashEscape:
+				if err = r.writeEscapedRune(); err != nil {
+					return quoted, true, err
+				}
+			case ch == '\n':
+				r.recordBuf.WriteString(lineBreak.Value())
+			default:
+				r.recordBuf.WriteRune(ch)
+			}
+			continue
+		}
+
+		switch {
+		case ch == '\n':
+			r.setLineBreak(lineBreak)
+			r.recordBuf.Truncate(r.recordBuf.Len() - trailingSpaces)
+			return quoted, true, nil
+		case ch == r.Delimiter:
+			r.recordBuf.Truncate(r.recordBuf.Len() - trailingSpaces)
+			return quoted, false, nil
+		case closed:
+			if r.TrimSpace && r.isSpace(ch) {
+				continue
+			}
+			r.column--
+			return quoted, true, r.newError(fmt.Sprintf("unexpected %c in field", r.Quote))
+		case ch == r.Quote && r.recordBuf.Len() == startPos:
+			quoted = true
+		case r.TrimSpace && r.isSpace(ch):
+			if r.recordBuf.Len() == startPos {
+				continue
+			}
+			trailingSpaces++
+			r.recordBuf.WriteRune(ch)
+		case ch == '\\' && r.Escape == cmd.BackslashEscape:
+			trailingSpaces = 0
+			if err = r.writeEscapedRune(); err != nil {
+				return quoted, true, err
+			}
+		default:
+			trailingSpaces = 0
+			if r.EnclosedAll && unicode.IsLetter(ch) {
+				r.EnclosedAll = false
+			}
+			r.recordBuf.WriteRune(ch)
+		}
+	}
+}
+
+func (r *Reader) writeEscapedRune() error {
+	ch, _, err := r.reader.ReadRune()
+	r.column++
+	if err != nil {
+		if err == io.EOF {
+			return r.newError("extraneous \\ in field")
+		}
+		return err
+	}
+
+	switch ch {
+	case 'n':
+		r.recordBuf.WriteByte('\n')
+	case 'r':
+		r.recordBuf.WriteByte('\r')
+	case 't':
+		r.recordBuf.WriteByte('\t')
+	case '0':
+		r.recordBuf.WriteByte(0)
+	default:
+		r.recordBuf.WriteRune(ch)
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+package dsv
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
+)
+
+var readerReadTests = []struct {
+	Name         string
+	Input        string
+	Dialect      Dialect
+	SkipLines    int
+	WithoutNull  bool
+	Header       []string
+	Records      [][]text.RawText
+	SkippedLines []string
+	LineBreak    text.LineBreak
+	EnclosedAll  bool
+	Error        string
+}{
+	{
+		Name:    "Single Quote",
+		Input:   "id,name\n1,'a,''b'''\n2,\n",
+		Dialect: Dialect{Delimiter: ',', Quote: '\''},
+		Header:  []string{"id", "name"},
+		Records: [][]text.RawText{
+			{text.RawText("1"), text.RawText("a,'b'")},
+			{text.RawText("2"), nil},
+		},
+		LineBreak: text.LF,
+	},
+	{
+		Name:    "Backslash Escape",
+		Input:   "id,name\r\n1,\"a\\\"b\\\\c\"\r\n2,d\\,e\\n\r\n",
+		Dialect: Dialect{Delimiter: ',', Quote: '"', Escape: cmd.BackslashEscape},
+		Header:  []string{"id", "name"},
+		Records: [][]text.RawText{
+			{text.RawText("1"), text.RawText("a\"b\\c")},
+			{text.RawText("2"), text.RawText("d,e\n")},
+		},
+		LineBreak: text.CRLF,
+	},
+	{
+		Name:         "Comments and Skipped Lines",
+		Input:        "Sales Report\nGenerated: 2026-10-16\n# comment\nid;name\n1;a\n\n# comment\n2;b",
+		Dialect:      Dialect{Delimiter: ';', Quote: '"', CommentPrefix: "#"},
+		SkipLines:    2,
+		Header:       []string{"id", "name"},
+		Records:      [][]text.RawText{{text.RawText("1"), text.RawText("a")}, {text.RawText("2"), text.RawText("b")}},
+		SkippedLines: []string{"Sales Report", "Generated: 2026-10-16"},
+		LineBreak:    text.LF,
+	},
+	{
+		Name:    "Trim Space",
+		Input:   "id , name\n 1 ,  \" a \"  \n2,\tb c\t\n",
+		Dialect: Dialect{Delimiter: ',', Quote: '"', TrimSpace: true},
+		Header:  []string{"id", "name"},
+		Records: [][]text.RawText{
+			{text.RawText("1"), text.RawText(" a ")},
+			{text.RawText("2"), text.RawText("b c")},
+		},
+		LineBreak: text.LF,
+	},
+	{
+		Name:        "Enclosed All",
+		Input:       "'id','name'\n'1','a'\n",
+		Dialect:     Dialect{Delimiter: ',', Quote: '\''},
+		WithoutNull: true,
+		Header:      []string{"id", "name"},
+		Records:     [][]text.RawText{{text.RawText("1"), text.RawText("a")}},
+		LineBreak:   text.LF,
+		EnclosedAll: true,
+	},
+	{
+		Name:    "Unexpected Quote Error",
+		Input:   "id,name\n1,'a'b\n",
+		Dialect: Dialect{Delimiter: ',', Quote: '\''},
+		Header:  []string{"id", "name"},
+		Error:   "line 2, column 5: unexpected ' in field",
+	},
+	{
+		Name:    "Extraneous Quote Error",
+		Input:   "id,name\n1,'a\n",
+		Dialect: Dialect{Delimiter: ',', Quote: '\''},
+		Header:  []string{"id", "name"},
+		Error:   "line 3, column 1: extraneous ' in field",
+	},
+	{
+		Name:    "Wrong Number of Fields Error",
+		Input:   "id,name\n1,a,b\n",
+		Dialect: Dialect{Delimiter: ',', Quote: '\''},
+		Header:  []string{"id", "name"},
+		Error:   "line 2, column 4: wrong number of fields in line",
+	},
+	{
+		Name:    "Same Quote and Delimiter Error",
+		Input:   "id,name\n",
+		Dialect: Dialect{Delimiter: ',', Quote: ','},
+		Error:   "quote character must be different from the delimiter",
+	},
+}
+
+func TestReader_Read(t *testing.T) {
+	for _, v := range readerReadTests {
+		r, err := NewReader(strings.NewReader(v.Input), text.UTF8, v.Dialect)
+		if err == nil {
+			r.SkipLines = v.SkipLines
+			r.WithoutNull = v.WithoutNull
+
+			var header []string
+			if header, err = r.ReadHeader(); err == nil {
+				if !reflect.DeepEqual(header, v.Header) {
+					t.Errorf("%s: header = %q, want %q", v.Name, header, v.Header)
+				}
+
+				records := make([][]text.RawText, 0, len(v.Records))
+				for {
+					var record []text.RawText
+					record, err = r.Read()
+					if err != nil {
+						break
+					}
+					records = append(records, record)
+				}
+				if err == io.EOF {
+					err = nil
+					if !reflect.DeepEqual(records, v.Records) {
+						t.Errorf("%s: records = %q, want %q", v.Name, records, v.Records)
+					}
+				}
+			}
+		}
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(r.SkippedLines, v.SkippedLines) {
+			t.Errorf("%s: skipped lines = %q, want %q", v.Name, r.SkippedLines, v.SkippedLines)
+		}
+		if r.DetectedLineBreak != v.LineBreak {
+			t.Errorf("%s: line break = %q, want %q", v.Name, r.DetectedLineBreak, v.LineBreak)
+		}
+		if r.EnclosedAll != v.EnclosedAll {
+			t.Errorf("%s: enclosed all = %t, want %t", v.Name, r.EnclosedAll, v.EnclosedAll)
+		}
+	}
+}
+
+var readerReadAfterParseErrorTests = []struct {
+	Name    string
+	Input   string
+	Dialect Dialect
+	Records [][]text.RawText
+	Errors  []*ParseError
+}{
+	{
+		Name:    "Skip Malformed Records",
+		Input:   "id,name\n1,a\n2,b,c\n3,'d'e\n4\n5,'f\ng'\n6,h",
+		Dialect: Dialect{Delimiter: ',', Quote: '\''},
+		Records: [][]text.RawText{
+			{text.RawText("1"), text.RawText("a")},
+			{text.RawText("5"), text.RawText("f\ng")},
+			{text.RawText("6"), text.RawText("h")},
+		},
+		Errors: []*ParseError{
+			{Line: 3, Column: 4, Message: "wrong number of fields in line", RecordLine: 3, Record: "2,b,c"},
+			{Line: 4, Column: 5, Message: "unexpected ' in field", RecordLine: 4, Record: "3,'d'e"},
+			{Line: 5, Column: 0, Message: "wrong number of fields in line", RecordLine: 5, Record: "4"},
+		},
+	},
+	{
+		Name:    "Extraneous Quote at the End",
+		Input:   "id,name\n1,a\n2,'b\n",
+		Dialect: Dialect{Delimiter: ',', Quote: '\''},
+		Records: [][]text.RawText{
+			{text.RawText("1"), text.RawText("a")},
+		},
+		Errors: []*ParseError{
+			{Line: 4, Column: 1, Message: "extraneous ' in field", RecordLine: 3, Record: "2,'b"},
+		},
+	},
+}
+
+func TestReader_ReadAfterParseError(t *testing.T) {
+	for _, v := range readerReadAfterParseErrorTests {
+		r, err := NewReader(strings.NewReader(v.Input), text.UTF8, v.Dialect)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if _, err = r.ReadHeader(); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		records := make([][]text.RawText, 0, len(v.Records))
+		errs := make([]*ParseError, 0, len(v.Errors))
+		for {
+			record, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				e, ok := err.(*ParseError)
+				if !ok {
+					t.Errorf("%s: unexpected error %q", v.Name, err)
+					break
+				}
+				errs = append(errs, e)
+				continue
+			}
+			records = append(records, record)
+		}
+
+		if !reflect.DeepEqual(records, v.Records) {
+			t.Errorf("%s: records = %q, want %q", v.Name, records, v.Records)
+		}
+		if !reflect.DeepEqual(errs, v.Errors) {
+			t.Errorf("%s: errors = %#v, want %#v", v.Name, errs, v.Errors)
+		}
+	}
+}
+
+var readerErrorPositionTests = []string{
+	"a,b\n1,2\n3\n5,6\n",
+	"a,b\n1,2\n3,4,5\n5,6\n",
+	"a,b\n1,2,\n",
+	"a,b\r\n1\r\n",
+	"a,b\n1,2\n\"3\n",
+	"a,b\n1,2\n\"3",
+	"a,b\n1,\"\"\"\n",
+	"a,b\n1,2\n3,\"x\"y\n",
+	"a,b\n\"ab\"c,4\n",
+	"a,b\n1,\"a\nb\"c\n",
+	"a,b\n1,\"語\"x\n",
+}
+
+// The standard dialect is read by the csv package of go-text unless malformed records are skipped,
+// so the errors must point at the same positions as go-text.
+func TestReader_ErrorPosition(t *testing.T) {
+	for _, input := range readerErrorPositionTests {
+		expect, _ := csv.NewReader(strings.NewReader(input), text.UTF8)
+		r, _ := NewReader(strings.NewReader(input), text.UTF8, NewDialect(','))
+		_, _ = expect.ReadHeader()
+		_, _ = r.ReadHeader()
+
+		var expectErr, err error
+		for expectErr == nil {
+			_, expectErr = expect.Read()
+		}
+		for err == nil {
+			_, err = r.Read()
+		}
+
+		if err.Error() != expectErr.Error() {
+			t.Errorf("error %q, want error %q for %q", err, expectErr, input)
+		}
+	}
+}
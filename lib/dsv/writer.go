@@ -0,0 +1,124 @@
+package dsv
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
+)
+
+type Writer struct {
+	Dialect
+
+	writer    *bufio.Writer
+	lineBreak string
+	appended  bool
+}
+
+func NewWriter(w io.Writer, lineBreak text.LineBreak, enc text.Encoding, dialect Dialect) (*Writer, error) {
+	if err := dialect.validate(); err != nil {
+		return nil, err
+	}
+
+	writer, err := text.GetTransformWriter(w, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Writer{
+		Dialect:   dialect,
+		writer:    bufio.NewWriter(writer),
+		lineBreak: lineBreak.Value(),
+	}, nil
+}
+
+// WriteLine writes a line as it is, such as a line that has been skipped when the file was read.
+func (e *Writer) WriteLine(s string) error {
+	if err := e.writeLineBreak(); err != nil {
+		return err
+	}
+	_, err := e.writer.WriteString(s)
+	return err
+}
+
+func (e *Writer) writeLineBreak() error {
+	if e.appended {
+		if _, err := e.writer.WriteString(e.lineBreak); err != nil {
+			return err
+		}
+	} else {
+		e.appended = true
+	}
+	return nil
+}
+
+func (e *Writer) Write(record []csv.Field) error {
+	if err := e.writeLineBreak(); err != nil {
+		return err
+	}
+
+	for i := range record {
+		if 0 < i {
+			if _, err := e.writer.WriteRune(e.Delimiter); err != nil {
+				return err
+			}
+		}
+
+		if !record[i].Quote && !e.needsQuote(record[i].Contents, i == 0) {
+			if _, err := e.writer.WriteString(record[i].Contents); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := e.writer.WriteRune(e.Quote); err != nil {
+			return err
+		}
+		for _, r := range record[i].Contents {
+			switch {
+			case r == e.Quote && e.Escape == cmd.DoubleQuoteEscape:
+				_, _ = e.writer.WriteRune(e.Quote)
+			case (r == e.Quote || r == '\\') && e.Escape == cmd.BackslashEscape:
+				_, _ = e.writer.WriteRune('\\')
+			}
+			if _, err := e.writer.WriteRune(r); err != nil {
+				return err
+			}
+		}
+		if _, err := e.writer.WriteRune(e.Quote); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Writer) Flush() error {
+	return e.writer.Flush()
+}
+
+// needsQuote reports whether the contents must be quoted so that they are read as they are.
+func (e *Writer) needsQuote(s string, first bool) bool {
+	if len(s) < 1 {
+		return false
+	}
+	if strings.ContainsAny(s, string([]rune{e.Delimiter, e.Quote, '\r', '\n'})) {
+		return true
+	}
+	if e.Escape == cmd.BackslashEscape && strings.ContainsRune(s, '\\') {
+		return true
+	}
+	if first && 0 < len(e.CommentPrefix) && strings.HasPrefix(s, e.CommentPrefix) {
+		return true
+	}
+	if e.TrimSpace {
+		r := []rune(s)
+		if e.isSpace(r[0]) || e.isSpace(r[len(r)-1]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package dsv
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
+)
+
+var writerWriteTests = []struct {
+	Name         string
+	Dialect      Dialect
+	LeadingLines []string
+	Records      [][]csv.Field
+	Expect       string
+}{
+	{
+		Name:    "Single Quote",
+		Dialect: Dialect{Delimiter: ',', Quote: '\''},
+		Records: [][]csv.Field{
+			{csv.NewField("id", false), csv.NewField("name", false)},
+			{csv.NewField("1", false), csv.NewField("a,'b'", false)},
+			{csv.NewField("2", false), csv.NewField("c", true)},
+		},
+		Expect: "id,name\n" +
+			"1,'a,''b'''\n" +
+			"2,'c'",
+	},
+	{
+		Name:    "Backslash Escape",
+		Dialect: Dialect{Delimiter: ',', Quote: '"', Escape: cmd.BackslashEscape},
+		Records: [][]csv.Field{
+			{csv.NewField("1", false), csv.NewField("a\"b\\c", false)},
+		},
+		Expect: "1,\"a\\\"b\\\\c\"",
+	},
+	{
+		Name:         "Leading Lines and Comment Prefix",
+		Dialect:      Dialect{Delimiter: ';', Quote: '"', CommentPrefix: "#"},
+		LeadingLines: []string{"Sales Report"},
+		Records: [][]csv.Field{
+			{csv.NewField("id", false), csv.NewField("name", false)},
+			{csv.NewField("#1", false), csv.NewField("#a", false)},
+		},
+		Expect: "Sales Report\n" +
+			"id;name\n" +
+			"\"#1\";#a",
+	},
+	{
+		Name:    "Trim Space",
+		Dialect: Dialect{Delimiter: ',', Quote: '"', TrimSpace: true},
+		Records: [][]csv.Field{
+			{csv.NewField(" 1", false), csv.NewField("a b", false), csv.NewField("c ", false)},
+		},
+		Expect: "\" 1\",a b,\"c \"",
+	},
+}
+
+func TestWriter_Write(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	for _, v := range writerWriteTests {
+		buf.Reset()
+
+		w, err := NewWriter(buf, text.LF, text.UTF8, v.Dialect)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		for _, line := range v.LeadingLines {
+			if err = w.WriteLine(line); err != nil {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			}
+		}
+		for _, record := range v.Records {
+			if err = w.Write(record); err != nil {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			}
+		}
+		if err = w.Flush(); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+		}
+
+		if buf.String() != v.Expect {
+			t.Errorf("%s: result = %q, expect = %q", v.Name, buf.String(), v.Expect)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package file
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+
+	bzip2w "github.com/mithrandie/csvq/lib/bzip2"
+)
+
+type Compression int
+
+const (
+	NoCompression Compression = iota
+	Gzip
+	Bzip2
+	Xz
+	Zstd
+)
+
+var CompressionLiteral = map[Compression]string{
+	NoCompression: "NONE",
+	Gzip:          "GZIP",
+	Bzip2:         "BZIP2",
+	Xz:            "XZ",
+	Zstd:          "ZSTD",
+}
+
+func (c Compression) String() string {
+	return CompressionLiteral[c]
+}
+
+const (
+	GzipExt  = ".gz"
+	Bzip2Ext = ".bz2"
+	XzExt    = ".xz"
+	ZstdExt  = ".zst"
+)
+
+var CompressionExtList = []string{
+	GzipExt,
+	Bzip2Ext,
+	XzExt,
+	ZstdExt,
+}
+
+var (
+	gzipMagic  = []byte{0x1f, 0x8b, 0x08}
+	bzip2Magic = []byte{0x42, 0x5a, 0x68}
+	xzMagic    = []byte{0xfd, 0x37, 0x7a, 0x58, 0x5a, 0x00}
+	zstdMagic  = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+	bzip2BlockMagic = []byte{0x31, 0x41, 0x59, 0x26, 0x53, 0x59}
+)
+
+const compressionMagicLen = 10
+
+// CompressionFromExt returns the compression type indicated by the extension of path.
+func CompressionFromExt(path string) Compression {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case GzipExt:
+		return Gzip
+	case Bzip2Ext:
+		return Bzip2
+	case XzExt:
+		return Xz
+	case ZstdExt:
+		return Zstd
+	}
+	return NoCompression
+}
+
+// TrimCompressionExt removes the compression extension from path.
+// The returned path is used to determine the format of the compressed data.
+func TrimCompressionExt(path string) string {
+	if CompressionFromExt(path) == NoCompression {
+		return path
+	}
+	return path[:len(path)-len(filepath.Ext(path))]
+}
+
+// DetectCompression reads the magic bytes at the beginning of r and returns the compression type.
+// The read position of r is restored to the beginning.
+func DetectCompression(r io.ReadSeeker) (Compression, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return NoCompression, err
+	}
+
+	buf := make([]byte, compressionMagicLen)
+	n, err := io.ReadFull(r, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return NoCompression, err
+	}
+	buf = buf[:n]
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return NoCompression, err
+	}
+
+	switch {
+	case bytes.HasPrefix(buf, gzipMagic):
+		return Gzip, nil
+	case bytes.HasPrefix(buf, xzMagic):
+		return Xz, nil
+	case bytes.HasPrefix(buf, zstdMagic):
+		return Zstd, nil
+	case bytes.HasPrefix(buf, bzip2Magic) && len(buf) == compressionMagicLen &&
+		'1' <= buf[3] && buf[3] <= '9' && bytes.Equal(buf[4:], bzip2BlockMagic):
+		return Bzip2, nil
+	}
+	return NoCompression, nil
+}
+
+// NewDecompressionReader returns a reader that decompresses data read from r.
+func NewDecompressionReader(r io.Reader, c Compression) (io.ReadCloser, error) {
+	switch c {
+	case Gzip:
+		return gzip.NewReader(r)
+	case Bzip2:
+		return ioutil.NopCloser(bzip2.NewReader(r)), nil
+	case Xz:
+		xr, err := xz.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.NopCloser(xr), nil
+	case Zstd:
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return zr.IOReadCloser(), nil
+	}
+	return ioutil.NopCloser(r), nil
+}
+
+// DecompressionReader reads the decompressed data of src as a stream without holding the whole data in memory.
+// Seeking backward restarts the decompression from the beginning of src, and seeking forward skips
+// the decompressed data. Seeking relative to the end is not supported.
+type DecompressionReader struct {
+	src         io.ReadSeeker
+	compression Compression
+	r           io.ReadCloser
+	pos         int64
+}
+
+// NewDecompressionReadSeeker returns a DecompressionReader that reads the data of src compressed in c.
+func NewDecompressionReadSeeker(src io.ReadSeeker, c Compression) (*DecompressionReader, error) {
+	d := &DecompressionReader{
+		src:         src,
+		compression: c,
+	}
+	if err := d.reset(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *DecompressionReader) reset() error {
+	if err := d.Close(); err != nil {
+		return err
+	}
+	if _, err := d.src.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	r, err := NewDecompressionReader(d.src, d.compression)
+	if err != nil {
+		return err
+	}
+	d.r = r
+	d.pos = 0
+	return nil
+}
+
+func (d *DecompressionReader) Read(p []byte) (int, error) {
+	n, err := d.r.Read(p)
+	d.pos += int64(n)
+	return n, err
+}
+
+func (d *DecompressionReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += d.pos
+	default:
+		return d.pos, errors.New("seeking relative to the end of decompressed data is not supported")
+	}
+	if offset < 0 {
+		return d.pos, errors.New("negative position")
+	}
+
+	if offset < d.pos {
+		if err := d.reset(); err != nil {
+			return d.pos, err
+		}
+	}
+	if d.pos < offset {
+		if _, err := io.CopyN(ioutil.Discard, d, offset-d.pos); err != nil && err != io.EOF {
+			return d.pos, err
+		}
+	}
+	return d.pos, nil
+}
+
+// Close releases the decompressor. The source is not closed.
+func (d *DecompressionReader) Close() error {
+	if d.r == nil {
+		return nil
+	}
+	err := d.r.Close()
+	d.r = nil
+	return err
+}
+
+// NewCompressionWriter returns a writer that compresses data written to w.
+// The writer must be closed to flush the compressed data.
+func NewCompressionWriter(w io.Writer, c Compression) (io.WriteCloser, error) {
+	switch c {
+	case Gzip:
+		return gzip.NewWriter(w), nil
+	case Bzip2:
+		return bzip2w.NewWriter(w), nil
+	case Xz:
+		return xz.NewWriter(w)
+	case Zstd:
+		return zstd.NewWriter(w)
+	}
+	return nopWriteCloser{w}, nil
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
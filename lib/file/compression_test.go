@@ -0,0 +1,222 @@
+package file
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+var compressionFromExtTests = []struct {
+	Path   string
+	Result Compression
+	Trim   string
+}{
+	{
+		Path:   "/path/to/file.csv.gz",
+		Result: Gzip,
+		Trim:   "/path/to/file.csv",
+	},
+	{
+		Path:   "/path/to/file.csv.BZ2",
+		Result: Bzip2,
+		Trim:   "/path/to/file.csv",
+	},
+	{
+		Path:   "file.json.xz",
+		Result: Xz,
+		Trim:   "file.json",
+	},
+	{
+		Path:   "file.ltsv.zst",
+		Result: Zstd,
+		Trim:   "file.ltsv",
+	},
+	{
+		Path:   "file.csv",
+		Result: NoCompression,
+		Trim:   "file.csv",
+	},
+}
+
+func TestCompressionFromExt(t *testing.T) {
+	for _, v := range compressionFromExtTests {
+		result := CompressionFromExt(v.Path)
+		if result != v.Result {
+			t.Errorf("result = %s, want %s for %q", result, v.Result, v.Path)
+		}
+		trimmed := TrimCompressionExt(v.Path)
+		if trimmed != v.Trim {
+			t.Errorf("trimmed path = %q, want %q for %q", trimmed, v.Trim, v.Path)
+		}
+	}
+}
+
+var detectCompressionTests = []struct {
+	Name   string
+	Data   []byte
+	Result Compression
+}{
+	{
+		Name:   "Gzip",
+		Data:   []byte{0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff},
+		Result: Gzip,
+	},
+	{
+		Name:   "Bzip2",
+		Data:   []byte("BZh91AY&SY"),
+		Result: Bzip2,
+	},
+	{
+		Name:   "Text Beginning with Bzip2 Magic",
+		Data:   []byte("BZh,column2\n"),
+		Result: NoCompression,
+	},
+	{
+		Name:   "Xz",
+		Data:   []byte{0xfd, 0x37, 0x7a, 0x58, 0x5a, 0x00, 0x00, 0x04},
+		Result: Xz,
+	},
+	{
+		Name:   "Zstd",
+		Data:   []byte{0x28, 0xb5, 0x2f, 0xfd, 0x04, 0x00},
+		Result: Zstd,
+	},
+	{
+		Name:   "Plain Text",
+		Data:   []byte("c1,c2\n"),
+		Result: NoCompression,
+	},
+	{
+		Name:   "Empty",
+		Data:   []byte{},
+		Result: NoCompression,
+	},
+}
+
+func TestDetectCompression(t *testing.T) {
+	for _, v := range detectCompressionTests {
+		r := bytes.NewReader(v.Data)
+		result, err := DetectCompression(r)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if result != v.Result {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+		if r.Len() != len(v.Data) {
+			t.Errorf("%s: read position is not restored", v.Name)
+		}
+	}
+}
+
+var compressionWriterTests = []struct {
+	Compression Compression
+	Error       string
+}{
+	{
+		Compression: NoCompression,
+	},
+	{
+		Compression: Gzip,
+	},
+	{
+		Compression: Xz,
+	},
+	{
+		Compression: Zstd,
+	},
+	{
+		Compression: Bzip2,
+	},
+}
+
+func TestNewCompressionWriter(t *testing.T) {
+	data := "column1,column2\n1,str1\n"
+
+	for _, v := range compressionWriterTests {
+		buf := &bytes.Buffer{}
+		w, err := NewCompressionWriter(buf, v.Compression)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Compression, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Compression, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Compression, v.Error)
+			continue
+		}
+
+		_, _ = w.Write([]byte(data))
+		if err = w.Close(); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Compression, err)
+			continue
+		}
+
+		compressed := bytes.NewReader(buf.Bytes())
+		c, _ := DetectCompression(compressed)
+		if c != v.Compression {
+			t.Errorf("%s: detected compression = %s", v.Compression, c)
+		}
+
+		r, err := NewDecompressionReader(compressed, v.Compression)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Compression, err)
+			continue
+		}
+		result, err := ioutil.ReadAll(r)
+		_ = r.Close()
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Compression, err)
+			continue
+		}
+		if string(result) != data {
+			t.Errorf("%s: result = %q, want %q", v.Compression, string(result), data)
+		}
+	}
+}
+
+func TestDecompressionReader(t *testing.T) {
+	data := "column1,column2\n1,str1\n2,str2\n"
+
+	for _, c := range []Compression{Gzip, Bzip2, Xz, Zstd} {
+		buf := &bytes.Buffer{}
+		w, _ := NewCompressionWriter(buf, c)
+		_, _ = w.Write([]byte(data))
+		_ = w.Close()
+
+		r, err := NewDecompressionReadSeeker(bytes.NewReader(buf.Bytes()), c)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", c, err)
+			continue
+		}
+
+		lead := make([]byte, 7)
+		_, _ = io.ReadFull(r, lead)
+		if string(lead) != data[:7] {
+			t.Errorf("%s: read = %q, want %q", c, string(lead), data[:7])
+		}
+
+		if pos, err := r.Seek(0, io.SeekStart); err != nil || pos != 0 {
+			t.Errorf("%s: seek to start = %d, %v", c, pos, err)
+		}
+		if pos, err := r.Seek(16, io.SeekCurrent); err != nil || pos != 16 {
+			t.Errorf("%s: seek forward = %d, %v", c, pos, err)
+		}
+		rest, _ := ioutil.ReadAll(r)
+		if string(rest) != data[16:] {
+			t.Errorf("%s: read after seek = %q, want %q", c, string(rest), data[16:])
+		}
+
+		if _, err := r.Seek(0, io.SeekEnd); err == nil {
+			t.Errorf("%s: no error, want error for seeking from the end", c)
+		}
+		if err = r.Close(); err != nil {
+			t.Errorf("%s: unexpected error %q", c, err)
+		}
+	}
+}
@@ -10,3 +10,10 @@ const (
 	LockFileSuffix  = ".lock"
 	TempFileSuffix  = ".temp"
 )
+
+const (
+	JournalFilePrefix = ".csvq"
+	JournalFileSuffix = ".journal"
+)
+
+const HistoryDirName = ".csvq_history"
@@ -26,12 +26,16 @@ func randStrForLock() *rand.Rand {
 	return randForLock
 }
 
-func rlockFileSuffix() string {
+func randomFileSuffix(suffix string) string {
 	l := make([]rune, rlockFileSuffixLen)
 	for i := 0; i < rlockFileSuffixLen; i++ {
 		l[i] = letterRunes[randStrForLock().Intn(len(letterRunes))]
 	}
-	return "." + string(l) + RLockFileSuffix
+	return "." + string(l) + suffix
+}
+
+func rlockFileSuffix() string {
+	return randomFileSuffix(RLockFileSuffix)
 }
 
 func GetTimeoutContext(ctx context.Context, waitTimeOut time.Duration) (context.Context, context.CancelFunc) {
@@ -64,6 +68,17 @@ func TempFilePath(path string) string {
 	return getFilePath(path, TempFileSuffix)
 }
 
+func JournalFilePath(dir string) string {
+	var fpath string
+	for i := 0; i < 10; i++ {
+		fpath = filepath.Join(dir, JournalFilePrefix+randomFileSuffix(JournalFileSuffix))
+		if !Exists(fpath) {
+			break
+		}
+	}
+	return fpath
+}
+
 func getFilePath(path string, suffix string) string {
 	dir := filepath.Dir(path)
 	basename := filepath.Base(path)
@@ -58,3 +58,12 @@ func TestTempFilePath(t *testing.T) {
 		t.Errorf("result = %q, want %q", result, expect)
 	}
 }
+
+func TestJournalFilePath(t *testing.T) {
+	result := JournalFilePath(TestDir)
+	expect := GetTestFilePath(JournalFilePrefix + ".[0-9a-zA-Z]{12}" + JournalFileSuffix)
+	r := regexp.MustCompile(expect)
+	if !r.MatchString(result) {
+		t.Errorf("result = %q, want %q", result, expect)
+	}
+}
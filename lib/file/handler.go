@@ -3,7 +3,9 @@ package file
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/mithrandie/go-file/v2"
@@ -64,6 +66,30 @@ func (m *mngFile) close() error {
 	return nil
 }
 
+func (m *mngFile) closeFile() error {
+	if m != nil && m.fp != nil {
+		if err := file.Close(m.fp); err != nil {
+			return err
+		}
+		m.fp = nil
+	}
+	return nil
+}
+
+// discard closes and removes the file, and leaves m to be closed again.
+func (m *mngFile) discard() error {
+	if m == nil {
+		return nil
+	}
+	if err := m.closeFile(); err != nil {
+		return err
+	}
+	if Exists(m.path) {
+		return os.Remove(m.path)
+	}
+	return nil
+}
+
 func (m *mngFile) closeWithErrors() []error {
 	var errs []error
 	if m != nil {
@@ -92,6 +118,23 @@ type Handler struct {
 	lockFile  *mngFile
 	tempFile  *mngFile
 
+	// sidecarPath is the path of the file that accompanies the file, such as the schema of a table.
+	// The data written to sidecarFile replaces the sidecar file together with the file on commit.
+	sidecarPath string
+	sidecarFile *mngFile
+
+	// appendOnly is true if the contents of the temporary file are appended to the end of the file on commit.
+	appendOnly bool
+
+	// journaled is true if the handler is recorded in a committed journal.
+	// The data to be committed is kept on closing so that the commit can be finished on recovery.
+	journaled bool
+
+	// keepVersions is true if the contents of the file before the commit are kept in the history directory.
+	keepVersions bool
+	maxVersions  int
+	versionPath  string
+
 	closed bool
 }
 
@@ -230,6 +273,55 @@ func (h *Handler) FileForUpdate() (*os.File, error) {
 	return nil, fmt.Errorf("file %s cannot be updated", h.path)
 }
 
+// WriteSidecar writes the data that replaces the file at path when the handler is committed.
+// The sidecar file is committed or rolled back together with the handled file.
+func (h *Handler) WriteSidecar(path string, data []byte) error {
+	if h.openType == ForRead {
+		return fmt.Errorf("file %s cannot be updated", h.path)
+	}
+
+	if h.sidecarFile == nil {
+		tempPath := TempFilePath(path)
+		fp, err := file.Create(tempPath)
+		if err != nil {
+			return ParseError(err)
+		}
+		h.sidecarPath = path
+		h.sidecarFile = newMngFile(tempPath, fp)
+	}
+
+	fp := h.sidecarFile.fp
+	if err := fp.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := fp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	_, err := fp.Write(data)
+	return err
+}
+
+// KeepVersions makes the handler keep the contents of the file before the commit as a version.
+// If max is greater than 0, the oldest versions exceeding max are removed on commit.
+func (h *Handler) KeepVersions(max int) {
+	if h.openType != ForUpdate {
+		return
+	}
+	h.keepVersions = true
+	h.maxVersions = max
+}
+
+// FileForAppend returns the temporary file to write the data to be appended.
+// When the handler is committed, the data is appended to the end of the file
+// instead of replacing the whole file.
+func (h *Handler) FileForAppend() (*os.File, error) {
+	if h.openType != ForUpdate {
+		return nil, fmt.Errorf("file %s cannot be updated", h.path)
+	}
+	h.appendOnly = true
+	return h.tempFile.fp, nil
+}
+
 func (h *Handler) close() error {
 	if h.closed {
 		return nil
@@ -242,16 +334,29 @@ func (h *Handler) close() error {
 		h.fp = nil
 	}
 
-	if h.openType == ForCreate && Exists(h.path) {
+	if h.openType == ForCreate && !h.journaled && Exists(h.path) {
 		if err := os.Remove(h.path); err != nil {
 			return err
 		}
 	}
 
-	if err := h.tempFile.close(); err != nil {
-		return err
+	if h.journaled {
+		if err := h.tempFile.closeFile(); err != nil {
+			return err
+		}
+		if err := h.sidecarFile.closeFile(); err != nil {
+			return err
+		}
+	} else {
+		if err := h.tempFile.close(); err != nil {
+			return err
+		}
+		if err := h.sidecarFile.close(); err != nil {
+			return err
+		}
 	}
 	h.tempFile = nil
+	h.sidecarFile = nil
 
 	if err := h.lockFile.close(); err != nil {
 		return err
@@ -272,6 +377,21 @@ func (h *Handler) commit() error {
 		return nil
 	}
 
+	if h.openType == ForUpdate && h.appendOnly {
+		if h.keepVersions {
+			fi, err := h.fp.Stat()
+			if err != nil {
+				return err
+			}
+			if err = copyToVersion(h.path, h.versionFilePath(), h.fp, fi.Size()); err != nil {
+				return err
+			}
+		}
+		if err := h.appendTempFile(); err != nil {
+			return err
+		}
+	}
+
 	if h.fp != nil {
 		if err := file.Close(h.fp); err != nil {
 			return err
@@ -279,7 +399,7 @@ func (h *Handler) commit() error {
 		h.fp = nil
 	}
 
-	if h.openType == ForUpdate {
+	if h.openType == ForUpdate && !h.appendOnly {
 		if h.tempFile.fp != nil {
 			if err := file.Close(h.tempFile.fp); err != nil {
 				return err
@@ -288,7 +408,11 @@ func (h *Handler) commit() error {
 		}
 
 		if Exists(h.path) {
-			if err := os.Remove(h.path); err != nil {
+			if h.keepVersions {
+				if err := moveToVersion(h.path, h.versionFilePath()); err != nil {
+					return err
+				}
+			} else if err := os.Remove(h.path); err != nil {
 				return err
 			}
 		}
@@ -296,6 +420,9 @@ func (h *Handler) commit() error {
 		if err := os.Rename(h.tempFile.path, h.path); err != nil {
 			return err
 		}
+		if err := syncDir(filepath.Dir(h.path)); err != nil {
+			return err
+		}
 	} else {
 		if err := h.tempFile.close(); err != nil {
 			return err
@@ -303,6 +430,22 @@ func (h *Handler) commit() error {
 		h.tempFile = nil
 	}
 
+	if h.sidecarFile != nil {
+		if err := h.commitSidecar(); err != nil {
+			return err
+		}
+	} else if h.openType == ForCreate {
+		if err := syncDir(filepath.Dir(h.path)); err != nil {
+			return err
+		}
+	}
+
+	if h.keepVersions {
+		if err := PruneVersions(h.path, h.maxVersions); err != nil {
+			return err
+		}
+	}
+
 	if err := h.lockFile.close(); err != nil {
 		return err
 	}
@@ -317,6 +460,113 @@ func (h *Handler) commit() error {
 	return nil
 }
 
+// commitSidecar replaces the sidecar file with the written data.
+func (h *Handler) commitSidecar() error {
+	if err := h.sidecarFile.closeFile(); err != nil {
+		return err
+	}
+	if err := os.Rename(h.sidecarFile.path, h.sidecarPath); err != nil {
+		return err
+	}
+	h.sidecarFile = nil
+	return syncDir(filepath.Dir(h.sidecarPath))
+}
+
+// sync flushes the data to be committed to the storage.
+func (h *Handler) sync() error {
+	if h.sidecarFile != nil {
+		if err := h.sidecarFile.fp.Sync(); err != nil {
+			return err
+		}
+	}
+
+	switch h.openType {
+	case ForUpdate:
+		return h.tempFile.fp.Sync()
+	case ForCreate:
+		return h.fp.Sync()
+	}
+	return nil
+}
+
+// versionFilePath returns the path of the version to which the contents of the file before the commit are kept.
+// The path is decided once, so that the version is written to the same path on recovery.
+func (h *Handler) versionFilePath() string {
+	if len(h.versionPath) < 1 {
+		h.versionPath = versionFilePath(h.path, time.Now())
+	}
+	return h.versionPath
+}
+
+// journalEntries returns the entries that describe how the handler is committed.
+func (h *Handler) journalEntries() ([]journalEntry, error) {
+	var e journalEntry
+
+	switch h.openType {
+	case ForUpdate:
+		e = journalEntry{Type: journalUpdate, Temp: h.tempFile.path, Target: h.path}
+		if h.appendOnly {
+			fi, err := h.fp.Stat()
+			if err != nil {
+				return nil, err
+			}
+			e.Type = journalAppend
+			e.Size = fi.Size()
+		}
+		if h.keepVersions {
+			e.Version = h.versionFilePath()
+			e.MaxVersions = h.maxVersions
+		}
+	case ForCreate:
+		e = journalEntry{Type: journalCreate, Target: h.path}
+	default:
+		return nil, fmt.Errorf("file %s cannot be committed", h.path)
+	}
+
+	entries := []journalEntry{e}
+	if h.sidecarFile != nil {
+		entries = append(entries, journalEntry{Type: journalUpdate, Temp: h.sidecarFile.path, Target: h.sidecarPath})
+	}
+	return entries, nil
+}
+
+// discardJournaled removes the data kept to be committed on recovery after the commit failed.
+// The handler is no longer committed on recovery, and is rolled back when closed.
+func (h *Handler) discardJournaled() error {
+	if h.closed || !h.journaled {
+		return nil
+	}
+	h.journaled = false
+
+	if err := h.tempFile.discard(); err != nil {
+		return err
+	}
+	return h.sidecarFile.discard()
+}
+
+// appendTempFile appends the contents of the temporary file to the end of the file.
+// If it fails, the file is truncated to the original size.
+func (h *Handler) appendTempFile() error {
+	fi, err := h.fp.Stat()
+	if err != nil {
+		return err
+	}
+	size := fi.Size()
+
+	if _, err = h.tempFile.fp.Seek(0, io.SeekStart); err == nil {
+		if _, err = h.fp.Seek(size, io.SeekStart); err == nil {
+			if _, err = io.Copy(h.fp, h.tempFile.fp); err == nil {
+				err = h.fp.Sync()
+			}
+		}
+	}
+
+	if err != nil {
+		return NewCompositeError(err, h.fp.Truncate(size))
+	}
+	return nil
+}
+
 func (h *Handler) closeWithErrors() error {
 	if h.closed {
 		return nil
@@ -332,16 +582,34 @@ func (h *Handler) closeWithErrors() error {
 		}
 	}
 
-	if h.openType == ForCreate && Exists(h.path) {
+	if h.openType == ForCreate && !h.journaled && Exists(h.path) {
 		if err := os.Remove(h.path); err != nil {
 			errs = append(errs, err)
 		}
 	}
 
-	if cerrs := h.tempFile.closeWithErrors(); cerrs != nil {
-		errs = append(errs, cerrs...)
+	if h.journaled {
+		if err := h.tempFile.closeFile(); err != nil {
+			errs = append(errs, err)
+		} else {
+			h.tempFile = nil
+		}
+		if err := h.sidecarFile.closeFile(); err != nil {
+			errs = append(errs, err)
+		} else {
+			h.sidecarFile = nil
+		}
 	} else {
-		h.tempFile = nil
+		if cerrs := h.tempFile.closeWithErrors(); cerrs != nil {
+			errs = append(errs, cerrs...)
+		} else {
+			h.tempFile = nil
+		}
+		if cerrs := h.sidecarFile.closeWithErrors(); cerrs != nil {
+			errs = append(errs, cerrs...)
+		} else {
+			h.sidecarFile = nil
+		}
 	}
 
 	if cerrs := h.lockFile.closeWithErrors(); cerrs != nil {
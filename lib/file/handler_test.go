@@ -2,6 +2,7 @@ package file
 
 import (
 	"context"
+	"io/ioutil"
 	"testing"
 )
 
@@ -165,3 +166,66 @@ func TestHandler(t *testing.T) {
 		t.Fatalf("error = %#v, expect no error", err)
 	}
 }
+
+func TestHandler_FileForAppend(t *testing.T) {
+	fileForAppend := GetTestFilePath("append.txt")
+
+	ctx := context.Background()
+	container := NewContainer()
+	defer func() {
+		if err := container.CloseAllWithErrors(); err != nil {
+			t.Log(err)
+		}
+	}()
+
+	ch, err := NewHandlerForCreate(container, GetTestFilePath("create_for_append.txt"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err = ch.FileForAppend(); err == nil {
+		t.Fatalf("no error, want error")
+	}
+	_ = container.Close(ch)
+
+	uh, err := NewHandlerForUpdate(ctx, container, fileForAppend, waitTimeoutForTests, retryDelayForTests)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	fp, err := uh.FileForAppend()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err = fp.Write([]byte("line2\n")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	_ = container.Close(uh)
+
+	if b, _ := ioutil.ReadFile(fileForAppend); string(b) != "line1\n" {
+		t.Fatalf("file contents after rollback = %q, want %q", string(b), "line1\n")
+	}
+
+	uh, err = NewHandlerForUpdate(ctx, container, fileForAppend, waitTimeoutForTests, retryDelayForTests)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	fp, err = uh.FileForAppend()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err = fp.Write([]byte("line2\n")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err = container.Commit(uh); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if b, _ := ioutil.ReadFile(fileForAppend); string(b) != "line1\nline2\n" {
+		t.Fatalf("file contents after commit = %q, want %q", string(b), "line1\nline2\n")
+	}
+	if Exists(TempFilePath(fileForAppend)) {
+		t.Fatalf("temporary file %s remains", TempFilePath(fileForAppend))
+	}
+	if LockExists(fileForAppend) {
+		t.Fatalf("lock file for %s remains", fileForAppend)
+	}
+}
@@ -0,0 +1,588 @@
+package file
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/go-file/v2"
+)
+
+type journalEntryType string
+
+const (
+	journalCreate journalEntryType = "CREATE"
+	journalUpdate journalEntryType = "UPDATE"
+	journalAppend journalEntryType = "APPEND"
+)
+
+const (
+	journalCommitMark  = "COMMIT"
+	journalLinkMark    = "JOURNAL"
+	journalVersionMark = "VERSION"
+)
+
+type journalEntry struct {
+	Type   journalEntryType
+	Temp   string
+	Target string
+	Size   int64
+
+	// Version is the path of the version to which the contents of the target before the commit are kept.
+	// MaxVersions is the number of the versions to be kept.
+	Version     string
+	MaxVersions int
+}
+
+func (e journalEntry) String() string {
+	var s string
+	switch e.Type {
+	case journalCreate:
+		return fmt.Sprintf("%s %s", e.Type, strconv.Quote(e.Target))
+	case journalAppend:
+		s = fmt.Sprintf("%s %s %s %d", e.Type, strconv.Quote(e.Temp), strconv.Quote(e.Target), e.Size)
+	default: // journalUpdate
+		s = fmt.Sprintf("%s %s %s", e.Type, strconv.Quote(e.Temp), strconv.Quote(e.Target))
+	}
+
+	if 0 < len(e.Version) {
+		s = fmt.Sprintf("%s %s %s %d", s, journalVersionMark, strconv.Quote(e.Version), e.MaxVersions)
+	}
+	return s
+}
+
+func parseJournalEntry(line string) (journalEntry, error) {
+	var e journalEntry
+	var err error
+
+	idx := strings.IndexByte(line, ' ')
+	if idx < 0 {
+		return e, errors.New(fmt.Sprintf("invalid journal entry %q", line))
+	}
+	e.Type = journalEntryType(line[:idx])
+	s := line[idx+1:]
+
+	unquote := func() string {
+		if err != nil {
+			return ""
+		}
+		var q string
+		if q, err = strconv.QuotedPrefix(s); err != nil {
+			return ""
+		}
+		s = strings.TrimPrefix(s[len(q):], " ")
+		var ret string
+		ret, err = strconv.Unquote(q)
+		return ret
+	}
+
+	token := func() string {
+		if err != nil {
+			return ""
+		}
+		var ret string
+		if i := strings.IndexByte(s, ' '); -1 < i {
+			ret, s = s[:i], s[i+1:]
+		} else {
+			ret, s = s, ""
+		}
+		return ret
+	}
+
+	version := func() {
+		if err != nil || len(s) < 1 {
+			return
+		}
+		if token() != journalVersionMark {
+			err = errors.New("unknown option")
+			return
+		}
+		e.Version = unquote()
+		if err == nil {
+			e.MaxVersions, err = strconv.Atoi(token())
+		}
+	}
+
+	switch e.Type {
+	case journalCreate:
+		e.Target = unquote()
+	case journalUpdate:
+		e.Temp = unquote()
+		e.Target = unquote()
+		version()
+	case journalAppend:
+		e.Temp = unquote()
+		e.Target = unquote()
+		if err == nil {
+			e.Size, err = strconv.ParseInt(token(), 10, 64)
+		}
+		version()
+	default:
+		err = errors.New("unknown operation")
+	}
+
+	if err != nil || 0 < len(s) {
+		return e, errors.New(fmt.Sprintf("invalid journal entry %q", line))
+	}
+	return e, nil
+}
+
+// finish completes the operation of the entry.
+// Operations that have already been completed are not performed again.
+func (e journalEntry) finish() error {
+	switch e.Type {
+	case journalUpdate:
+		if !Exists(e.Temp) {
+			return nil
+		}
+		if Exists(e.Target) {
+			if 0 < len(e.Version) {
+				if err := moveToVersion(e.Target, e.Version); err != nil {
+					return err
+				}
+			} else if err := os.Remove(e.Target); err != nil {
+				return err
+			}
+		}
+		if err := os.Rename(e.Temp, e.Target); err != nil {
+			return err
+		}
+		if err := syncDir(filepath.Dir(e.Target)); err != nil {
+			return err
+		}
+	case journalAppend:
+		if !Exists(e.Temp) {
+			return nil
+		}
+		if 0 < len(e.Version) && !Exists(e.Version) {
+			if err := copyFileToVersion(e.Target, e.Version, e.Size); err != nil {
+				return err
+			}
+		}
+		if err := appendFile(e.Target, e.Temp, e.Size); err != nil {
+			return err
+		}
+		if err := os.Remove(e.Temp); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	if 0 < len(e.Version) {
+		return PruneVersions(e.Target, e.MaxVersions)
+	}
+	return nil
+}
+
+// rollback discards the data of the entry that has not been committed.
+func (e journalEntry) rollback() error {
+	switch e.Type {
+	case journalCreate:
+		if Exists(e.Target) {
+			return os.Remove(e.Target)
+		}
+	default:
+		if Exists(e.Temp) {
+			return os.Remove(e.Temp)
+		}
+	}
+	return nil
+}
+
+func appendFile(path string, src string, size int64) error {
+	fp, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	sfp, err := os.Open(src)
+	if err != nil {
+		_ = fp.Close()
+		return err
+	}
+
+	if err = fp.Truncate(size); err == nil {
+		if _, err = fp.Seek(size, io.SeekStart); err == nil {
+			if _, err = io.Copy(fp, sfp); err == nil {
+				err = fp.Sync()
+			}
+		}
+	}
+
+	return NewCompositeError(err, NewCompositeError(sfp.Close(), fp.Close()))
+}
+
+// Journal is a file that records the files to be committed in a transaction.
+// The journal is written before any file is replaced, so that a commit interrupted
+// in the middle can be finished on the next start.
+//
+// The journal is written in the directory of the first file, and a link to the journal
+// is written in each directory of the other files, so that the journal is found
+// from any of the directories.
+type Journal struct {
+	path     string
+	fp       *os.File
+	links    []*mngFile
+	handlers []*Handler
+}
+
+// BeginJournal flushes the data of the handlers and writes the journal next to the files.
+// Once this function returns successfully, the commit of the handlers is finished on recovery
+// even if the process is terminated in the middle of the commit.
+func BeginJournal(handlers []*Handler) (*Journal, error) {
+	journaled := make([]*Handler, 0, len(handlers))
+	entries := make([]journalEntry, 0, len(handlers))
+	dirs := make([]string, 0, len(handlers))
+	for _, h := range handlers {
+		if h == nil || h.closed || h.openType == ForRead {
+			continue
+		}
+
+		if err := h.sync(); err != nil {
+			return nil, err
+		}
+		es, err := h.journalEntries()
+		if err != nil {
+			return nil, err
+		}
+		journaled = append(journaled, h)
+		entries = append(entries, es...)
+
+		if dir := filepath.Dir(h.path); !containsString(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	if len(journaled) < 1 {
+		return nil, errors.New("no file to be committed")
+	}
+
+	fpath := JournalFilePath(dirs[0])
+	fp, err := file.Create(fpath)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	j := &Journal{
+		path:     fpath,
+		fp:       fp,
+		handlers: journaled,
+	}
+
+	if err := j.write(entries, dirs[1:]); err != nil {
+		return nil, NewCompositeError(err, j.remove())
+	}
+
+	for _, h := range journaled {
+		h.journaled = true
+	}
+	return j, nil
+}
+
+func (j *Journal) Path() string {
+	return j.path
+}
+
+// write writes the entries, the links and the commit mark in this order.
+// The commit mark is written after all of the links, so a journal that has the mark is always found
+// from all of the directories.
+func (j *Journal) write(entries []journalEntry, linkDirs []string) error {
+	var buf bytes.Buffer
+	for _, e := range entries {
+		buf.WriteString(e.String())
+		buf.WriteByte('\n')
+	}
+
+	if _, err := j.fp.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	if err := j.fp.Sync(); err != nil {
+		return err
+	}
+	if err := syncDir(filepath.Dir(j.path)); err != nil {
+		return err
+	}
+
+	for _, dir := range linkDirs {
+		if err := j.writeLink(dir); err != nil {
+			return err
+		}
+	}
+
+	if _, err := j.fp.Write([]byte(journalCommitMark + "\n")); err != nil {
+		return err
+	}
+	return j.fp.Sync()
+}
+
+func (j *Journal) writeLink(dir string) error {
+	fpath := JournalFilePath(dir)
+	fp, err := file.Create(fpath)
+	if err != nil {
+		return ParseError(err)
+	}
+	link := newMngFile(fpath, fp)
+	j.links = append(j.links, link)
+
+	if _, err = fp.Write([]byte(journalLinkMark + " " + strconv.Quote(j.path) + "\n")); err != nil {
+		return err
+	}
+	if err = fp.Sync(); err != nil {
+		return err
+	}
+	return syncDir(dir)
+}
+
+// Finish removes the journal after all of the files are committed.
+func (j *Journal) Finish() error {
+	return j.remove()
+}
+
+// Discard removes the journal and the data of the handlers that have not been committed.
+// It is called when the commit fails, so that the failed commit is not finished on recovery.
+func (j *Journal) Discard() error {
+	if err := j.remove(); err != nil {
+		return err
+	}
+
+	for _, h := range j.handlers {
+		if err := h.discardJournaled(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close releases the journal without removing it.
+// The commit recorded in the journal is finished on recovery.
+func (j *Journal) Close() error {
+	for _, link := range j.links {
+		if err := link.closeFile(); err != nil {
+			return err
+		}
+	}
+
+	if j.fp == nil {
+		return nil
+	}
+	err := file.Close(j.fp)
+	j.fp = nil
+	return err
+}
+
+// remove removes the journal first, and then the links.
+// Links to the journal that does not exist are ignored on recovery.
+func (j *Journal) remove() error {
+	if j.fp != nil {
+		if err := file.Close(j.fp); err != nil {
+			return err
+		}
+		j.fp = nil
+	}
+	if Exists(j.path) {
+		if err := os.Remove(j.path); err != nil {
+			return err
+		}
+		if err := syncDir(filepath.Dir(j.path)); err != nil {
+			return err
+		}
+	}
+
+	for _, link := range j.links {
+		if err := link.close(); err != nil {
+			return err
+		}
+		if err := syncDir(filepath.Dir(link.path)); err != nil {
+			return err
+		}
+	}
+	j.links = nil
+	return nil
+}
+
+func readJournal(r io.Reader) ([]journalEntry, bool, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, false, err
+	}
+
+	lines := strings.Split(string(b), "\n")
+	// The last element is empty or a line that was not completely written.
+	lines = lines[:len(lines)-1]
+
+	entries := make([]journalEntry, 0, len(lines))
+	for _, line := range lines {
+		if line == journalCommitMark {
+			return entries, true, nil
+		}
+
+		e, err := parseJournalEntry(line)
+		if err != nil {
+			return nil, false, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, false, nil
+}
+
+// parseJournalLink returns the path of the journal if the content is a link to a journal.
+// The second value is true if the content is a link even though it was not completely written.
+func parseJournalLink(content string) (string, bool) {
+	if !strings.HasPrefix(content, journalLinkMark+" ") {
+		return "", false
+	}
+
+	s := strings.TrimPrefix(content, journalLinkMark+" ")
+	if !strings.HasSuffix(s, "\n") {
+		return "", true
+	}
+	path, err := strconv.Unquote(strings.TrimSuffix(s, "\n"))
+	if err != nil {
+		return "", true
+	}
+	return path, true
+}
+
+// RecoveredJournal represents a journal processed by RecoverJournals.
+type RecoveredJournal struct {
+	Path      string
+	Committed bool
+}
+
+// RecoverJournals processes the journals left in the directory dir by interrupted commits.
+// If a journal was completely written, the commit is finished. Otherwise, the commit is rolled back.
+// The journals linked from the directory are also processed.
+// Journals used by running processes are ignored.
+func RecoverJournals(dir string) ([]RecoveredJournal, error) {
+	files, err := filepath.Glob(filepath.Join(dir, JournalFilePrefix+".*"+JournalFileSuffix))
+	if err != nil {
+		return nil, err
+	}
+
+	var list []RecoveredJournal
+	for _, fpath := range files {
+		rj, ok, err := recoverJournalFile(fpath)
+		if err != nil {
+			return list, err
+		}
+		if ok {
+			list = append(list, rj)
+		}
+	}
+	return list, nil
+}
+
+// recoverJournalFile processes the journal or the link to a journal, and removes it.
+// The second value is false if nothing is recovered.
+func recoverJournalFile(fpath string) (RecoveredJournal, bool, error) {
+	var rj RecoveredJournal
+	recovered := false
+
+	fp, err := file.TryOpenToUpdate(fpath)
+	if err != nil {
+		if _, ok := err.(*file.LockError); ok {
+			return rj, false, nil
+		}
+		if os.IsNotExist(err) {
+			// Removed by another process.
+			return rj, false, nil
+		}
+		return rj, false, ParseError(err)
+	}
+
+	b, err := ioutil.ReadAll(fp)
+	if err == nil {
+		if journalPath, ok := parseJournalLink(string(b)); ok {
+			// If the journal does not exist, the commit has been finished or recovered.
+			if 0 < len(journalPath) && Exists(journalPath) {
+				rj, recovered, err = recoverJournalFile(journalPath)
+				if err == nil && !recovered {
+					// The journal is used by a running process.
+					return rj, false, file.Close(fp)
+				}
+			}
+		} else {
+			var complete bool
+			rj.Path = fpath
+			rj.Committed, complete, err = recoverJournal(bytes.NewReader(b), fpath)
+			if err == nil && !complete {
+				// The journal is kept to recover the files locked by other processes later.
+				// The entries that have been processed are not processed again.
+				return rj, false, file.Close(fp)
+			}
+			recovered = true
+		}
+	}
+	if err != nil {
+		return rj, false, NewCompositeError(err, file.Close(fp))
+	}
+
+	if err = file.Close(fp); err != nil {
+		return rj, false, err
+	}
+	if err = os.Remove(fpath); err != nil {
+		return rj, false, err
+	}
+	return rj, recovered, syncDir(filepath.Dir(fpath))
+}
+
+// recoverJournal finishes or rolls back the entries of the journal.
+// The entries whose targets are locked by other processes are skipped, and the second value is false
+// if any entry is skipped.
+func recoverJournal(r io.Reader, name string) (bool, bool, error) {
+	entries, committed, err := readJournal(r)
+	if err != nil {
+		return false, false, errors.New(fmt.Sprintf("failed to read journal %s: %s", name, err.Error()))
+	}
+
+	complete := true
+	for _, e := range entries {
+		if isLockedByOtherProcess(e.Target) {
+			complete = false
+			continue
+		}
+
+		if committed {
+			err = e.finish()
+		} else {
+			err = e.rollback()
+		}
+		if err != nil {
+			return committed, false, err
+		}
+
+		if lockFilePath := LockFilePath(e.Target); Exists(lockFilePath) {
+			if err = os.Remove(lockFilePath); err != nil {
+				return committed, false, err
+			}
+		}
+	}
+	return committed, complete, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func isLockedByOtherProcess(path string) bool {
+	lockFilePath := LockFilePath(path)
+	if !Exists(lockFilePath) {
+		return false
+	}
+
+	fp, err := file.TryOpenToUpdate(lockFilePath)
+	if err != nil {
+		return true
+	}
+	_ = file.Close(fp)
+	return false
+}
@@ -0,0 +1,527 @@
+package file
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/go-file/v2"
+)
+
+var journalEntryTests = []struct {
+	Entry  journalEntry
+	String string
+}{
+	{
+		Entry:  journalEntry{Type: journalCreate, Target: "/path/to/new file.csv"},
+		String: "CREATE \"/path/to/new file.csv\"",
+	},
+	{
+		Entry:  journalEntry{Type: journalUpdate, Temp: "/path/to/.table.csv.temp", Target: "/path/to/table.csv"},
+		String: "UPDATE \"/path/to/.table.csv.temp\" \"/path/to/table.csv\"",
+	},
+	{
+		Entry:  journalEntry{Type: journalAppend, Temp: "/path/to/.table\"1.csv.temp", Target: "/path/to/table\"1.csv", Size: 120},
+		String: "APPEND \"/path/to/.table\\\"1.csv.temp\" \"/path/to/table\\\"1.csv\" 120",
+	}, {
+		Entry:  journalEntry{Type: journalUpdate, Temp: "/path/to/.table.csv.temp", Target: "/path/to/table.csv", Version: "/path/to/.csvq_history/table.csv.20120203T091815.000000000", MaxVersions: 3},
+		String: "UPDATE \"/path/to/.table.csv.temp\" \"/path/to/table.csv\" VERSION \"/path/to/.csvq_history/table.csv.20120203T091815.000000000\" 3",
+	},
+	{
+		Entry:  journalEntry{Type: journalAppend, Temp: "/path/to/.table.csv.temp", Target: "/path/to/table.csv", Size: 120, Version: "/path/to/.csvq_history/table.csv.20120203T091815.000000000"},
+		String: "APPEND \"/path/to/.table.csv.temp\" \"/path/to/table.csv\" 120 VERSION \"/path/to/.csvq_history/table.csv.20120203T091815.000000000\" 0",
+	},
+}
+
+func TestJournalEntry(t *testing.T) {
+	for _, v := range journalEntryTests {
+		s := v.Entry.String()
+		if s != v.String {
+			t.Errorf("string = %q, want %q", s, v.String)
+		}
+
+		e, err := parseJournalEntry(s)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.String, err)
+			continue
+		}
+		if !reflect.DeepEqual(e, v.Entry) {
+			t.Errorf("%s: entry = %v, want %v", v.String, e, v.Entry)
+		}
+	}
+
+	for _, s := range []string{"DELETE \"a\"", "UPDATE \"a\"", "APPEND \"a\" \"b\" x", "CREATE \"a\" \"b\"", "COMMIT", "UPDATE \"a\" \"b\" KEEP \"c\" 1", "UPDATE \"a\" \"b\" VERSION \"c\"", "APPEND \"a\" \"b\" 1 VERSION \"c\" 1 x"} {
+		if _, err := parseJournalEntry(s); err == nil {
+			t.Errorf("%s: no error, want error", s)
+		}
+	}
+}
+
+var readJournalTests = []struct {
+	Name      string
+	Content   string
+	Entries   []journalEntry
+	Committed bool
+	Error     bool
+}{
+	{
+		Name:    "Committed",
+		Content: "CREATE \"a\"\nUPDATE \"b.temp\" \"b\"\nCOMMIT\n",
+		Entries: []journalEntry{
+			{Type: journalCreate, Target: "a"},
+			{Type: journalUpdate, Temp: "b.temp", Target: "b"},
+		},
+		Committed: true,
+	},
+	{
+		Name:    "Not Committed",
+		Content: "CREATE \"a\"\nUPDATE \"b.temp\" \"b\"\nCOMM",
+		Entries: []journalEntry{
+			{Type: journalCreate, Target: "a"},
+			{Type: journalUpdate, Temp: "b.temp", Target: "b"},
+		},
+		Committed: false,
+	},
+	{
+		Name:      "Incomplete Entry",
+		Content:   "CREATE \"a\"\nUPDATE \"b.te",
+		Entries:   []journalEntry{{Type: journalCreate, Target: "a"}},
+		Committed: false,
+	},
+	{
+		Name:    "Invalid Entry",
+		Content: "CREATE \"a\"\nUPDATE \"b.temp\"\nCOMMIT\n",
+		Error:   true,
+	},
+}
+
+func TestReadJournal(t *testing.T) {
+	for _, v := range readJournalTests {
+		entries, committed, err := readJournal(strings.NewReader(v.Content))
+		if err != nil {
+			if !v.Error {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			}
+			continue
+		}
+		if v.Error {
+			t.Errorf("%s: no error, want error", v.Name)
+			continue
+		}
+		if !reflect.DeepEqual(entries, v.Entries) {
+			t.Errorf("%s: entries = %v, want %v", v.Name, entries, v.Entries)
+		}
+		if committed != v.Committed {
+			t.Errorf("%s: committed = %t, want %t", v.Name, committed, v.Committed)
+		}
+	}
+}
+
+func journalFiles(t *testing.T, dir string) []string {
+	files, err := filepath.Glob(filepath.Join(dir, JournalFilePrefix+".*"+JournalFileSuffix))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	return files
+}
+
+func TestRecoverJournals(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(TestDir, "journal")
+	_ = os.Mkdir(dir, 0755)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	path1 := filepath.Join(dir, "journal1.txt")
+	path2 := filepath.Join(dir, "journal2.txt")
+	_ = ioutil.WriteFile(path1, []byte("old1\n"), 0644)
+	_ = ioutil.WriteFile(path2, []byte("old2\n"), 0644)
+
+	// Finish the interrupted commit
+	container := NewContainer()
+	h1, err := NewHandlerForUpdate(ctx, container, path1, waitTimeoutForTests, retryDelayForTests)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	h2, err := NewHandlerForUpdate(ctx, container, path2, waitTimeoutForTests, retryDelayForTests)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	fp, _ := h1.FileForUpdate()
+	_, _ = fp.Write([]byte("new1\n"))
+	fp, _ = h2.FileForUpdate()
+	_, _ = fp.Write([]byte("new2\n"))
+
+	j, err := BeginJournal([]*Handler{h1, h2})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	if list, err := RecoverJournals(dir); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	} else if 0 < len(list) {
+		t.Errorf("journal used by the running transaction is recovered")
+	}
+
+	if err = container.Commit(h1); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	_ = j.Close()
+	_ = container.CloseAll()
+
+	if b, _ := ioutil.ReadFile(path2); string(b) != "old2\n" {
+		t.Fatalf("file contents = %q, want %q", string(b), "old2\n")
+	}
+
+	list, err := RecoverJournals(dir)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := []RecoveredJournal{{Path: j.Path(), Committed: true}}
+	if !reflect.DeepEqual(list, expect) {
+		t.Errorf("recovered journals = %v, want %v", list, expect)
+	}
+	if b, _ := ioutil.ReadFile(path1); string(b) != "new1\n" {
+		t.Errorf("file contents = %q, want %q", string(b), "new1\n")
+	}
+	if b, _ := ioutil.ReadFile(path2); string(b) != "new2\n" {
+		t.Errorf("file contents = %q, want %q", string(b), "new2\n")
+	}
+	if Exists(TempFilePath(path2)) {
+		t.Errorf("temporary file %s remains", TempFilePath(path2))
+	}
+	if files := journalFiles(t, dir); 0 < len(files) {
+		t.Errorf("journal files %v remain", files)
+	}
+
+	// Finish the interrupted append
+	_ = ioutil.WriteFile(path1, []byte("line1\nlin"), 0644)
+	_ = ioutil.WriteFile(TempFilePath(path1), []byte("line2\n"), 0644)
+	_ = ioutil.WriteFile(LockFilePath(path1), nil, 0644)
+	journalPath := JournalFilePath(dir)
+	_ = ioutil.WriteFile(journalPath, []byte(journalEntry{Type: journalAppend, Temp: TempFilePath(path1), Target: path1, Size: 6}.String()+"\nCOMMIT\n"), 0644)
+
+	list, err = RecoverJournals(dir)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect = []RecoveredJournal{{Path: journalPath, Committed: true}}
+	if !reflect.DeepEqual(list, expect) {
+		t.Errorf("recovered journals = %v, want %v", list, expect)
+	}
+	if b, _ := ioutil.ReadFile(path1); string(b) != "line1\nline2\n" {
+		t.Errorf("file contents = %q, want %q", string(b), "line1\nline2\n")
+	}
+	if Exists(TempFilePath(path1)) || Exists(LockFilePath(path1)) {
+		t.Errorf("management files for %s remain", path1)
+	}
+
+	// Roll back the journal that was not completely written
+	path3 := filepath.Join(dir, "journal3.txt")
+	_ = ioutil.WriteFile(path3, []byte("created\n"), 0644)
+	_ = ioutil.WriteFile(TempFilePath(path2), []byte("new2\n"), 0644)
+	journalPath = JournalFilePath(dir)
+	_ = ioutil.WriteFile(journalPath, []byte(
+		journalEntry{Type: journalCreate, Target: path3}.String()+"\n"+
+			journalEntry{Type: journalUpdate, Temp: TempFilePath(path2), Target: path2}.String()+"\nCOM"), 0644)
+
+	list, err = RecoverJournals(dir)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect = []RecoveredJournal{{Path: journalPath, Committed: false}}
+	if !reflect.DeepEqual(list, expect) {
+		t.Errorf("recovered journals = %v, want %v", list, expect)
+	}
+	if Exists(path3) {
+		t.Errorf("created file %s remains", path3)
+	}
+	if Exists(TempFilePath(path2)) {
+		t.Errorf("temporary file %s remains", TempFilePath(path2))
+	}
+	if b, _ := ioutil.ReadFile(path2); string(b) != "new2\n" {
+		t.Errorf("file contents = %q, want %q", string(b), "new2\n")
+	}
+}
+
+func TestJournal_Discard(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(TestDir, "journal_discard")
+	_ = os.Mkdir(dir, 0755)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	path1 := filepath.Join(dir, "discard1.txt")
+	path2 := filepath.Join(dir, "discard2.txt")
+	path3 := filepath.Join(dir, "discard3.txt")
+	_ = ioutil.WriteFile(path1, []byte("old1\n"), 0644)
+	_ = ioutil.WriteFile(path2, []byte("old2\n"), 0644)
+
+	container := NewContainer()
+	h1, err := NewHandlerForUpdate(ctx, container, path1, waitTimeoutForTests, retryDelayForTests)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	h2, err := NewHandlerForUpdate(ctx, container, path2, waitTimeoutForTests, retryDelayForTests)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	h3, err := NewHandlerForCreate(container, path3)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	fp, _ := h1.FileForUpdate()
+	_, _ = fp.Write([]byte("new1\n"))
+	fp, _ = h2.FileForUpdate()
+	_, _ = fp.Write([]byte("new2\n"))
+	_ = h2.WriteSidecar(path2+".sidecar", []byte("sidecar\n"))
+	fp, _ = h3.FileForUpdate()
+	_, _ = fp.Write([]byte("new3\n"))
+
+	j, err := BeginJournal([]*Handler{h1, h2, h3})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err = container.Commit(h1); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	if err = j.Discard(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if files := journalFiles(t, dir); 0 < len(files) {
+		t.Errorf("journal files %v remain", files)
+	}
+	if Exists(TempFilePath(path2)) || Exists(TempFilePath(path2+".sidecar")) {
+		t.Errorf("temporary files for %s remain", path2)
+	}
+
+	if err = container.CloseAll(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if Exists(path3) {
+		t.Errorf("created file %s remains", path3)
+	}
+	if b, _ := ioutil.ReadFile(path1); string(b) != "new1\n" {
+		t.Errorf("file contents = %q, want %q", string(b), "new1\n")
+	}
+	if b, _ := ioutil.ReadFile(path2); string(b) != "old2\n" {
+		t.Errorf("file contents = %q, want %q", string(b), "old2\n")
+	}
+	if Exists(path2 + ".sidecar") {
+		t.Errorf("sidecar file of %s is committed", path2)
+	}
+
+	if list, err := RecoverJournals(dir); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	} else if 0 < len(list) {
+		t.Errorf("discarded journal is recovered")
+	}
+}
+
+func TestRecoverJournals_LockedTarget(t *testing.T) {
+	dir := filepath.Join(TestDir, "journal_locked")
+	_ = os.Mkdir(dir, 0755)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	path1 := filepath.Join(dir, "locked1.txt")
+	path2 := filepath.Join(dir, "locked2.txt")
+	_ = ioutil.WriteFile(path1, []byte("old1\n"), 0644)
+	_ = ioutil.WriteFile(path2, []byte("old2\n"), 0644)
+	_ = ioutil.WriteFile(TempFilePath(path1), []byte("new1\n"), 0644)
+	_ = ioutil.WriteFile(TempFilePath(path2), []byte("new2\n"), 0644)
+	journalPath := JournalFilePath(dir)
+	_ = ioutil.WriteFile(journalPath, []byte(
+		journalEntry{Type: journalUpdate, Temp: TempFilePath(path1), Target: path1}.String()+"\n"+
+			journalEntry{Type: journalUpdate, Temp: TempFilePath(path2), Target: path2}.String()+"\nCOMMIT\n"), 0644)
+
+	// The file locked by another process is not recovered, and the journal is kept.
+	_ = ioutil.WriteFile(LockFilePath(path2), nil, 0644)
+	lock, err := file.TryOpenToUpdate(LockFilePath(path2))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	list, err := RecoverJournals(dir)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if 0 < len(list) {
+		t.Errorf("recovered journals = %v, want none", list)
+	}
+	if b, _ := ioutil.ReadFile(path1); string(b) != "new1\n" {
+		t.Errorf("file contents = %q, want %q", string(b), "new1\n")
+	}
+	if b, _ := ioutil.ReadFile(path2); string(b) != "old2\n" {
+		t.Errorf("file contents = %q, want %q", string(b), "old2\n")
+	}
+	if !Exists(TempFilePath(path2)) {
+		t.Errorf("temporary file %s is removed", TempFilePath(path2))
+	}
+	if !Exists(journalPath) {
+		t.Fatalf("journal %s is removed", journalPath)
+	}
+
+	// The rest of the journal is recovered after the lock is released.
+	_ = file.Close(lock)
+
+	list, err = RecoverJournals(dir)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := []RecoveredJournal{{Path: journalPath, Committed: true}}
+	if !reflect.DeepEqual(list, expect) {
+		t.Errorf("recovered journals = %v, want %v", list, expect)
+	}
+	if b, _ := ioutil.ReadFile(path1); string(b) != "new1\n" {
+		t.Errorf("file contents = %q, want %q", string(b), "new1\n")
+	}
+	if b, _ := ioutil.ReadFile(path2); string(b) != "new2\n" {
+		t.Errorf("file contents = %q, want %q", string(b), "new2\n")
+	}
+	if Exists(TempFilePath(path2)) || Exists(LockFilePath(path2)) {
+		t.Errorf("management files for %s remain", path2)
+	}
+	if files := journalFiles(t, dir); 0 < len(files) {
+		t.Errorf("journal files %v remain", files)
+	}
+}
+
+func TestRecoverJournals_Link(t *testing.T) {
+	ctx := context.Background()
+	dir1 := filepath.Join(TestDir, "journal_link1")
+	dir2 := filepath.Join(TestDir, "journal_link2")
+	_ = os.Mkdir(dir1, 0755)
+	_ = os.Mkdir(dir2, 0755)
+	defer func() {
+		_ = os.RemoveAll(dir1)
+		_ = os.RemoveAll(dir2)
+	}()
+
+	path1 := filepath.Join(dir1, "link1.txt")
+	path2 := filepath.Join(dir2, "link2.txt")
+	_ = ioutil.WriteFile(path1, []byte("old1\n"), 0644)
+	_ = ioutil.WriteFile(path2, []byte("old2\n"), 0644)
+
+	container := NewContainer()
+	h1, err := NewHandlerForUpdate(ctx, container, path1, waitTimeoutForTests, retryDelayForTests)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	h2, err := NewHandlerForUpdate(ctx, container, path2, waitTimeoutForTests, retryDelayForTests)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	fp, _ := h1.FileForUpdate()
+	_, _ = fp.Write([]byte("new1\n"))
+	fp, _ = h2.FileForUpdate()
+	_, _ = fp.Write([]byte("new2\n"))
+
+	j, err := BeginJournal([]*Handler{h1, h2})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if filepath.Dir(j.Path()) != dir1 {
+		t.Errorf("journal is written in %s, want %s", filepath.Dir(j.Path()), dir1)
+	}
+	links := journalFiles(t, dir2)
+	if len(links) != 1 {
+		t.Fatalf("%d links in %s, want 1", len(links), dir2)
+	}
+
+	if list, err := RecoverJournals(dir2); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	} else if 0 < len(list) {
+		t.Errorf("journal used by the running transaction is recovered")
+	}
+
+	if err = container.Commit(h1); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	_ = j.Close()
+	_ = container.CloseAll()
+
+	list, err := RecoverJournals(dir2)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := []RecoveredJournal{{Path: j.Path(), Committed: true}}
+	if !reflect.DeepEqual(list, expect) {
+		t.Errorf("recovered journals = %v, want %v", list, expect)
+	}
+	if b, _ := ioutil.ReadFile(path2); string(b) != "new2\n" {
+		t.Errorf("file contents = %q, want %q", string(b), "new2\n")
+	}
+	if files := append(journalFiles(t, dir1), journalFiles(t, dir2)...); 0 < len(files) {
+		t.Errorf("journal files %v remain", files)
+	}
+
+	// Remove the link to the journal that has been finished
+	_ = ioutil.WriteFile(links[0], []byte(journalLinkMark+" "+strconv.Quote(j.Path())+"\n"), 0644)
+	if list, err = RecoverJournals(dir2); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	} else if 0 < len(list) {
+		t.Errorf("recovered journals = %v, want no journal", list)
+	}
+	if Exists(links[0]) {
+		t.Errorf("link %s remains", links[0])
+	}
+}
+
+func TestRecoverJournals_Versions(t *testing.T) {
+	dir := filepath.Join(TestDir, "journal_versions")
+	_ = os.Mkdir(dir, 0755)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	path1 := filepath.Join(dir, "versions1.txt")
+	path2 := filepath.Join(dir, "versions2.txt")
+	sidecar := filepath.Join(dir, "versions1.txt.sidecar")
+	_ = ioutil.WriteFile(path1, []byte("old1\n"), 0644)
+	_ = ioutil.WriteFile(TempFilePath(path1), []byte("new1\n"), 0644)
+	_ = ioutil.WriteFile(TempFilePath(sidecar), []byte("sidecar\n"), 0644)
+	_ = ioutil.WriteFile(path2, []byte("line1\nlin"), 0644)
+	_ = ioutil.WriteFile(TempFilePath(path2), []byte("line2\n"), 0644)
+
+	version1 := filepath.Join(HistoryDirPath(path1), "versions1.txt.20120203T091815.000000000")
+	version2 := filepath.Join(HistoryDirPath(path2), "versions2.txt.20120203T091815.000000000")
+	_ = os.Mkdir(HistoryDirPath(path2), 0755)
+	_ = ioutil.WriteFile(filepath.Join(HistoryDirPath(path2), "versions2.txt.20120202T091815.000000000"), []byte("line0\n"), 0644)
+
+	journalPath := JournalFilePath(dir)
+	_ = ioutil.WriteFile(journalPath, []byte(
+		journalEntry{Type: journalUpdate, Temp: TempFilePath(path1), Target: path1, Version: version1}.String()+"\n"+
+			journalEntry{Type: journalUpdate, Temp: TempFilePath(sidecar), Target: sidecar}.String()+"\n"+
+			journalEntry{Type: journalAppend, Temp: TempFilePath(path2), Target: path2, Size: 6, Version: version2, MaxVersions: 1}.String()+"\n"+
+			"COMMIT\n"), 0644)
+
+	if _, err := RecoverJournals(dir); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect := map[string]string{
+		path1:    "new1\n",
+		sidecar:  "sidecar\n",
+		version1: "old1\n",
+		path2:    "line1\nline2\n",
+		version2: "line1\n",
+	}
+	for fpath, contents := range expect {
+		if b, _ := ioutil.ReadFile(fpath); string(b) != contents {
+			t.Errorf("contents of %s = %q, want %q", filepath.Base(fpath), string(b), contents)
+		}
+	}
+	if list, _ := Versions(path2); len(list) != 1 {
+		t.Errorf("%d versions of %s, want 1", len(list), filepath.Base(path2))
+	}
+}
@@ -1,6 +1,7 @@
 package file
 
 import (
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
@@ -41,6 +42,8 @@ func setup() {
 
 	fp, _ = os.Create(GetTestFilePath("update.txt"))
 	_ = fp.Close()
+
+	_ = ioutil.WriteFile(GetTestFilePath("append.txt"), []byte("line1\n"), 0644)
 }
 
 func teardown() {
@@ -0,0 +1,19 @@
+// +build !windows
+
+package file
+
+import (
+	"os"
+)
+
+func syncDir(dir string) error {
+	fp, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	if err = fp.Sync(); err != nil {
+		_ = fp.Close()
+		return err
+	}
+	return fp.Close()
+}
@@ -0,0 +1,8 @@
+// +build windows
+
+package file
+
+// syncDir does nothing on Windows because directories cannot be opened to be synchronized.
+func syncDir(_ string) error {
+	return nil
+}
@@ -0,0 +1,160 @@
+package file
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const versionTimeFormat = "20060102T150405.000000000"
+
+// Version represents the contents of a file before a commit, kept in the history directory.
+// Versions are numbered from 1 in order from the newest.
+type Version struct {
+	Number int
+	Path   string
+	Time   time.Time
+	Size   int64
+}
+
+func HistoryDirPath(path string) string {
+	return filepath.Join(filepath.Dir(path), HistoryDirName)
+}
+
+func versionFilePath(path string, t time.Time) string {
+	return filepath.Join(HistoryDirPath(path), filepath.Base(path)+"."+t.UTC().Format(versionTimeFormat))
+}
+
+// Versions returns the versions of the file in order from the newest.
+func Versions(path string) ([]Version, error) {
+	dir := HistoryDirPath(path)
+	if !Exists(dir) {
+		return nil, nil
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := filepath.Base(path) + "."
+	list := make([]Version, 0, len(files))
+	for _, f := range files {
+		if f.IsDir() || !strings.HasPrefix(f.Name(), prefix) {
+			continue
+		}
+		t, err := time.ParseInLocation(versionTimeFormat, f.Name()[len(prefix):], time.UTC)
+		if err != nil {
+			continue
+		}
+		list = append(list, Version{
+			Path: filepath.Join(dir, f.Name()),
+			Time: t,
+			Size: f.Size(),
+		})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Time.After(list[j].Time)
+	})
+	for i := range list {
+		list[i].Number = i + 1
+	}
+	return list, nil
+}
+
+// PruneVersions removes the versions of the file exceeding max from the oldest.
+// If max is less than 1, no version is removed.
+func PruneVersions(path string, max int) error {
+	if max < 1 {
+		return nil
+	}
+
+	list, err := Versions(path)
+	if err != nil || len(list) <= max {
+		return err
+	}
+
+	for _, v := range list[max:] {
+		if err = os.Remove(v.Path); err != nil {
+			return err
+		}
+	}
+	return syncDir(HistoryDirPath(path))
+}
+
+func createHistoryDir(path string) (string, error) {
+	dir := HistoryDirPath(path)
+	if !Exists(dir) {
+		if err := os.Mkdir(dir, 0755); err != nil && !os.IsExist(err) {
+			return dir, err
+		}
+		if err := syncDir(filepath.Dir(dir)); err != nil {
+			return dir, err
+		}
+	}
+	return dir, nil
+}
+
+// moveToVersion moves the file into the history directory as the version at vpath.
+func moveToVersion(path string, vpath string) error {
+	dir, err := createHistoryDir(path)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Rename(path, vpath); err != nil {
+		return err
+	}
+	return syncDir(dir)
+}
+
+// copyToVersion writes the first size bytes of the file opened as fp into the history directory
+// as the version at vpath. The version is written to a temporary file first and then renamed.
+func copyToVersion(path string, vpath string, fp *os.File, size int64) error {
+	dir, err := createHistoryDir(path)
+	if err != nil {
+		return err
+	}
+
+	fi, err := fp.Stat()
+	if err != nil {
+		return err
+	}
+
+	tmp := vpath + TempFileSuffix
+	vfp, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fi.Mode().Perm())
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(vfp, io.NewSectionReader(fp, 0, size)); err == nil {
+		err = vfp.Sync()
+	}
+	if err = NewCompositeError(err, vfp.Close()); err == nil {
+		err = os.Rename(tmp, vpath)
+	}
+	if err != nil {
+		return NewCompositeError(err, os.Remove(tmp))
+	}
+	return syncDir(dir)
+}
+
+// copyFileToVersion writes the first size bytes of the file into the history directory as the version at vpath.
+func copyFileToVersion(path string, vpath string, size int64) error {
+	fp, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	return NewCompositeError(copyToVersion(path, vpath, fp, size), fp.Close())
+}
+
+// OpenVersion opens the version file to read.
+// Version files are not locked because they are never modified.
+func OpenVersion(v Version) (*os.File, error) {
+	return os.Open(v.Path)
+}
@@ -0,0 +1,103 @@
+package file
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHandler_KeepVersions(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(TestDir, "version")
+	_ = os.Mkdir(dir, 0755)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	path := filepath.Join(dir, "version.txt")
+	_ = ioutil.WriteFile(path, []byte("v1\n"), 0644)
+	_ = os.Mkdir(HistoryDirPath(path), 0755)
+	_ = ioutil.WriteFile(filepath.Join(HistoryDirPath(path), "version.txt.invalid"), []byte("invalid\n"), 0644)
+	_ = ioutil.WriteFile(filepath.Join(HistoryDirPath(path), "other.txt.20120203T091815.000000000"), []byte("other\n"), 0644)
+
+	container := NewContainer()
+	defer func() {
+		if err := container.CloseAllWithErrors(); err != nil {
+			t.Log(err)
+		}
+	}()
+
+	if list, err := Versions(path); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	} else if 0 < len(list) {
+		t.Fatalf("versions = %v, want no version", list)
+	}
+
+	commit := func(data string, appendOnly bool) {
+		h, err := NewHandlerForUpdate(ctx, container, path, waitTimeoutForTests, retryDelayForTests)
+		if err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		h.KeepVersions(2)
+
+		var fp *os.File
+		if appendOnly {
+			fp, _ = h.FileForAppend()
+		} else {
+			fp, _ = h.FileForUpdate()
+		}
+		_, _ = fp.Write([]byte(data))
+
+		if err = container.Commit(h); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+	}
+
+	commit("v2\n", false)
+	commit("v3\n", true)
+	commit("v4\n", false)
+
+	if b, _ := ioutil.ReadFile(path); string(b) != "v4\n" {
+		t.Errorf("file contents = %q, want %q", string(b), "v4\n")
+	}
+
+	list, err := Versions(path)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect := []string{"v2\nv3\n", "v2\n"}
+	if len(list) != len(expect) {
+		t.Fatalf("%d versions, want %d versions", len(list), len(expect))
+	}
+	for i, v := range list {
+		if v.Number != i+1 {
+			t.Errorf("version number = %d, want %d", v.Number, i+1)
+		}
+		if v.Size != int64(len(expect[i])) {
+			t.Errorf("version %d: size = %d, want %d", v.Number, v.Size, len(expect[i]))
+		}
+
+		fp, err := OpenVersion(v)
+		if err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		b, _ := ioutil.ReadAll(fp)
+		_ = fp.Close()
+		if string(b) != expect[i] {
+			t.Errorf("version %d: contents = %q, want %q", v.Number, string(b), expect[i])
+		}
+	}
+	if !list[1].Time.Before(list[0].Time) {
+		t.Errorf("versions are not ordered from the newest")
+	}
+
+	if err = PruneVersions(path, 1); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if list, _ = Versions(path); len(list) != 1 || list[0].Size != int64(len("v2\nv3\n")) {
+		t.Errorf("versions after pruning = %v, want only the newest version", list)
+	}
+}
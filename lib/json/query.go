@@ -1,8 +1,11 @@
 package json
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/mithrandie/csvq/lib/value"
 
@@ -47,6 +50,73 @@ func LoadTable(queryString string, jsontext string) ([]string, [][]value.Primary
 	return h, rows, et, err
 }
 
+// LineError is an error on a line of JSON Lines that cannot be loaded as a row.
+type LineError struct {
+	Line    int
+	Message string
+	Text    string
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// LoadLinesTable loads a table from JSON Lines read from r.
+// Each line must be an object, and the header is the union of the keys of all of the objects.
+// If skipInvalidLines is true, the lines that cannot be loaded are skipped and returned as LineErrors.
+func LoadLinesTable(r io.Reader, skipInvalidLines bool) ([]string, [][]value.Primary, json.EscapeType, []*LineError, error) {
+	br := bufio.NewReader(r)
+	d := json.NewDecoder()
+	d.UseInteger = true
+
+	escapeType := json.Backslash
+	array := make(json.Array, 0, 100)
+	var invalidLines []*LineError
+
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := br.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, nil, escapeType, nil, err
+		}
+		if lineNumber == 1 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+
+		if 0 < len(strings.TrimSpace(line)) {
+			var lineErr *LineError
+
+			data, et, e := d.Decode(line)
+			if e != nil {
+				lineErr = &LineError{Line: lineNumber, Message: e.Error()}
+			} else if _, ok := data.(json.Object); !ok {
+				lineErr = &LineError{Line: lineNumber, Message: "rows loaded from json lines must be objects"}
+			}
+
+			if lineErr != nil {
+				if !skipInvalidLines {
+					return nil, nil, escapeType, nil, lineErr
+				}
+				lineErr.Text = line
+				invalidLines = append(invalidLines, lineErr)
+			} else {
+				if escapeType < et {
+					escapeType = et
+				}
+				array = append(array, data)
+			}
+		}
+
+		if err == io.EOF {
+			break
+		}
+	}
+
+	h, rows, err := ConvertToTableValue(array)
+	return h, rows, escapeType, invalidLines, err
+}
+
 func load(queryString string, jsontext string) (json.Structure, json.EscapeType, error) {
 	query, err := Query.Parse(queryString)
 	if err != nil {
@@ -2,6 +2,7 @@ package json
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/mithrandie/csvq/lib/value"
@@ -163,6 +164,102 @@ func TestLoadTable(t *testing.T) {
 	}
 }
 
+var loadLinesTableTests = []struct {
+	Name             string
+	Json             string
+	SkipInvalidLines bool
+	ExpectHeader     []string
+	ExpectValues     [][]value.Primary
+	EscapeType       json.EscapeType
+	InvalidLines     []*LineError
+	Error            string
+}{
+	{
+		Name:         "LoadLinesTable",
+		Json:         "{\"key1\":1, \"key2\":\"a\"}\n\n{\"key2\":\"b\", \"key3\":true}\n",
+		ExpectHeader: []string{"key1", "key2", "key3"},
+		ExpectValues: [][]value.Primary{
+			{
+				value.NewInteger(1),
+				value.NewString("a"),
+				value.NewNull(),
+			},
+			{
+				value.NewNull(),
+				value.NewString("b"),
+				value.NewBoolean(true),
+			},
+		},
+	},
+	{
+		Name:         "LoadLinesTable Without Trailing Line Break",
+		Json:         "\ufeff{\"key1\":\"\\u0041\"}\r\n{\"key1\":2}",
+		ExpectHeader: []string{"key1"},
+		ExpectValues: [][]value.Primary{
+			{
+				value.NewString("A"),
+			},
+			{
+				value.NewInteger(2),
+			},
+		},
+		EscapeType: json.AllWithHexDigits,
+	},
+	{
+		Name:  "LoadLinesTable Not Object Error",
+		Json:  "{\"key1\":1}\n[1, 2]\n",
+		Error: "line 2: rows loaded from json lines must be objects",
+	},
+	{
+		Name:             "LoadLinesTable Skip Invalid Lines",
+		Json:             "{\"key1\":1}\n[1, 2]\r\n{\"key1\":\n{\"key1\":2}\n",
+		SkipInvalidLines: true,
+		ExpectHeader:     []string{"key1"},
+		ExpectValues: [][]value.Primary{
+			{
+				value.NewInteger(1),
+			},
+			{
+				value.NewInteger(2),
+			},
+		},
+		InvalidLines: []*LineError{
+			{Line: 2, Message: "rows loaded from json lines must be objects", Text: "[1, 2]"},
+			{Line: 3, Message: "line 1, column 8: unexpected termination", Text: "{\"key1\":"},
+		},
+	},
+}
+
+func TestLoadLinesTable(t *testing.T) {
+	for _, v := range loadLinesTableTests {
+		header, values, et, invalidLines, err := LoadLinesTable(strings.NewReader(v.Json), v.SkipInvalidLines)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err.Error())
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err, v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(header, v.ExpectHeader) {
+			t.Errorf("%s: header = %#v, want %#v", v.Name, header, v.ExpectHeader)
+		}
+		if !reflect.DeepEqual(values, v.ExpectValues) {
+			t.Errorf("%s: values = %#v, want %#v", v.Name, values, v.ExpectValues)
+		}
+		if et != v.EscapeType {
+			t.Errorf("%s: escape type = %d, want %d", v.Name, et, v.EscapeType)
+		}
+		if !reflect.DeepEqual(invalidLines, v.InvalidLines) {
+			t.Errorf("%s: invalid lines = %#v, want %#v", v.Name, invalidLines, v.InvalidLines)
+		}
+	}
+}
+
 var extractTests = []struct {
 	Query  QueryExpression
 	Data   json.Structure
@@ -0,0 +1,160 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// julianDayOfEpoch is the Julian day number of 1970-01-01, used by INT96 timestamps.
+const julianDayOfEpoch = 2440588
+
+// convert returns a value converted from a raw value decoded from a page
+// according to the logical type of the column.
+func (c *Column) convert(raw interface{}) value.Primary {
+	e := c.element
+	lt := e.logicalType
+
+	if lt.kind == logicalUnknown {
+		return value.NewNull()
+	}
+
+	switch v := raw.(type) {
+	case bool:
+		return value.NewBoolean(v)
+	case int32:
+		if e.convertedType == convertedUint8 || e.convertedType == convertedUint16 || e.convertedType == convertedUint32 ||
+			(lt.kind == logicalInteger && !lt.isSigned) {
+			return c.convertInteger(int64(uint32(v)))
+		}
+		return c.convertInteger(int64(v))
+	case int64:
+		if (e.convertedType == convertedUint64 || (lt.kind == logicalInteger && !lt.isSigned)) && v < 0 {
+			return value.NewFloat(float64(uint64(v)))
+		}
+		return c.convertInteger(v)
+	case float32:
+		return value.NewFloat(float64(v))
+	case float64:
+		return value.NewFloat(v)
+	case []byte:
+		switch {
+		case e.typ == typeInt96:
+			return convertInt96(v)
+		case lt.kind == logicalDecimal || e.convertedType == convertedDecimal:
+			return c.convertDecimal(new(big.Int).SetBytes(v), len(v))
+		case lt.kind == logicalUUID && len(v) == 16:
+			return value.NewString(formatUUID(v))
+		}
+		return value.NewString(string(v))
+	}
+	return value.NewNull()
+}
+
+func (c *Column) convertInteger(i int64) value.Primary {
+	e := c.element
+	lt := e.logicalType
+
+	switch {
+	case lt.kind == logicalDate || e.convertedType == convertedDate:
+		t := time.Unix(i*86400, 0).UTC()
+		return value.NewDatetime(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, cmd.GetLocation()))
+	case lt.kind == logicalTimestamp:
+		return value.NewDatetime(timestamp(i, lt.unit, lt.isAdjustedToUTC))
+	case e.convertedType == convertedTimestampMillis:
+		return value.NewDatetime(timestamp(i, unitMillis, true))
+	case e.convertedType == convertedTimestampMicros:
+		return value.NewDatetime(timestamp(i, unitMicros, true))
+	case lt.kind == logicalTime:
+		return value.NewString(timeOfDay(i, lt.unit))
+	case e.convertedType == convertedTimeMillis:
+		return value.NewString(timeOfDay(i, unitMillis))
+	case e.convertedType == convertedTimeMicros:
+		return value.NewString(timeOfDay(i, unitMicros))
+	case lt.kind == logicalDecimal || e.convertedType == convertedDecimal:
+		return c.convertDecimal(big.NewInt(i), 0)
+	}
+	return value.NewInteger(i)
+}
+
+// convertDecimal returns a decimal value represented by the unscaled integer.
+// If size is greater than 0, the integer is treated as a two's complement big-endian number of the size.
+func (c *Column) convertDecimal(unscaled *big.Int, size int) value.Primary {
+	if 0 < size && unscaled.Bit(size*8-1) == 1 {
+		unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(size*8)))
+	}
+
+	scale := c.element.scale
+	if c.element.logicalType.kind == logicalDecimal {
+		scale = c.element.logicalType.scale
+	}
+
+	if scale <= 0 {
+		if unscaled.IsInt64() {
+			return value.NewInteger(unscaled.Int64())
+		}
+		f, _ := new(big.Float).SetInt(unscaled).Float64()
+		return value.NewFloat(f)
+	}
+
+	f, _ := new(big.Float).Quo(
+		new(big.Float).SetInt(unscaled),
+		new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)),
+	).Float64()
+	return value.NewFloat(f)
+}
+
+func timestamp(i int64, unit int16, isAdjustedToUTC bool) time.Time {
+	var t time.Time
+	switch unit {
+	case unitMillis:
+		t = time.Unix(i/1000, (i%1000)*int64(time.Millisecond))
+	case unitMicros:
+		t = time.Unix(i/1000000, (i%1000000)*int64(time.Microsecond))
+	default:
+		t = time.Unix(0, i)
+	}
+
+	if isAdjustedToUTC {
+		return t.In(cmd.GetLocation())
+	}
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), cmd.GetLocation())
+}
+
+func timeOfDay(i int64, unit int16) string {
+	var d time.Duration
+	switch unit {
+	case unitMillis:
+		d = time.Duration(i) * time.Millisecond
+	case unitMicros:
+		d = time.Duration(i) * time.Microsecond
+	default:
+		d = time.Duration(i)
+	}
+
+	t := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC).Add(d)
+	if t.Nanosecond() == 0 {
+		return t.Format("15:04:05")
+	}
+	return t.Format("15:04:05.999999999")
+}
+
+func convertInt96(b []byte) value.Primary {
+	if len(b) != 12 {
+		return value.NewNull()
+	}
+	nanos := int64(binary.LittleEndian.Uint64(b[:8]))
+	days := int64(binary.LittleEndian.Uint32(b[8:]))
+	t := time.Unix((days-julianDayOfEpoch)*86400, nanos)
+	return value.NewDatetime(t.In(cmd.GetLocation()))
+}
+
+func formatUUID(b []byte) string {
+	s := hex.EncodeToString(b)
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:32]
+}
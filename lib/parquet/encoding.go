@@ -0,0 +1,376 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+var errUnexpectedEndOfPage = errors.New("unexpected end of page")
+
+// maxPreallocatedValues limits the capacity allocated in advance from a count written in a file.
+const maxPreallocatedValues = 1 << 20
+
+func capacity(count int) int {
+	if count < 0 {
+		return 0
+	}
+	if maxPreallocatedValues < count {
+		return maxPreallocatedValues
+	}
+	return count
+}
+
+// bitWidth returns the number of bits required to represent the max value.
+func bitWidth(max int) int {
+	w := 0
+	for 0 < max {
+		w++
+		max >>= 1
+	}
+	return w
+}
+
+// unpackBits reads count values packed in width bits from the least significant bit.
+func unpackBits(data []byte, width int, count int, dst []uint64) ([]uint64, error) {
+	if width == 0 {
+		for i := 0; i < count; i++ {
+			dst = append(dst, 0)
+		}
+		return dst, nil
+	}
+	if 64 < width {
+		return dst, errors.New(fmt.Sprintf("invalid bit width %d", width))
+	}
+	if len(data)*8 < width*count {
+		return dst, errUnexpectedEndOfPage
+	}
+
+	bitPos := 0
+	for i := 0; i < count; i++ {
+		var v uint64
+		for b := 0; b < width; {
+			bytePos := bitPos >> 3
+			offset := uint(bitPos & 7)
+			n := 8 - int(offset)
+			if width-b < n {
+				n = width - b
+			}
+			bits := (uint64(data[bytePos]) >> offset) & (1<<uint(n) - 1)
+			v |= bits << uint(b)
+			b += n
+			bitPos += n
+		}
+		dst = append(dst, v)
+	}
+	return dst, nil
+}
+
+// decodeHybrid decodes count values encoded with the RLE/Bit-Packing Hybrid encoding,
+// and returns the values and the number of bytes read.
+func decodeHybrid(data []byte, width int, count int) ([]uint64, int, error) {
+	values := make([]uint64, 0, capacity(count))
+	byteWidth := (width + 7) / 8
+	pos := 0
+
+	for len(values) < count {
+		header, n := binary.Uvarint(data[pos:])
+		if n <= 0 {
+			return nil, pos, errUnexpectedEndOfPage
+		}
+		pos += n
+
+		if header&1 == 0 {
+			runLen := count - len(values)
+			if header>>1 < uint64(runLen) {
+				runLen = int(header >> 1)
+			}
+			if len(data) < pos+byteWidth {
+				return nil, pos, errUnexpectedEndOfPage
+			}
+			var v uint64
+			for i := 0; i < byteWidth; i++ {
+				v |= uint64(data[pos+i]) << uint(8*i)
+			}
+			pos += byteWidth
+
+			for i := 0; i < runLen; i++ {
+				values = append(values, v)
+			}
+		} else {
+			if 0 < width && uint64(len(data)-pos)/uint64(width) < header>>1 {
+				return nil, pos, errUnexpectedEndOfPage
+			}
+			groups := int(header >> 1)
+			size := groups * width
+
+			num := count - len(values)
+			if uint64(groups) < uint64(num+7)/8 {
+				num = groups * 8
+			}
+			var err error
+			if values, err = unpackBits(data[pos:pos+size], width, num, values); err != nil {
+				return nil, pos, err
+			}
+			pos += size
+		}
+	}
+	return values, pos, nil
+}
+
+// decodeLevels decodes repetition or definition levels encoded with RLE preceded by the length.
+func decodeLevels(data []byte, maxLevel int, count int) ([]uint64, int, error) {
+	if len(data) < 4 {
+		return nil, 0, errUnexpectedEndOfPage
+	}
+	l := int(binary.LittleEndian.Uint32(data))
+	if len(data)-4 < l {
+		return nil, 0, errUnexpectedEndOfPage
+	}
+	levels, _, err := decodeHybrid(data[4:4+l], bitWidth(maxLevel), count)
+	return levels, 4 + l, err
+}
+
+// decodePlain decodes count values of the physical type encoded with the PLAIN encoding.
+func decodePlain(data []byte, typ physicalType, typeLength int, count int) ([]interface{}, error) {
+	values := make([]interface{}, 0, capacity(count))
+
+	switch typ {
+	case typeBoolean:
+		bits, err := unpackBits(data, 1, count, make([]uint64, 0, capacity(count)))
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range bits {
+			values = append(values, b == 1)
+		}
+	case typeInt32:
+		if len(data) < 4*count {
+			return nil, errUnexpectedEndOfPage
+		}
+		for i := 0; i < count; i++ {
+			values = append(values, int32(binary.LittleEndian.Uint32(data[4*i:])))
+		}
+	case typeInt64:
+		if len(data) < 8*count {
+			return nil, errUnexpectedEndOfPage
+		}
+		for i := 0; i < count; i++ {
+			values = append(values, int64(binary.LittleEndian.Uint64(data[8*i:])))
+		}
+	case typeInt96:
+		if len(data) < 12*count {
+			return nil, errUnexpectedEndOfPage
+		}
+		for i := 0; i < count; i++ {
+			values = append(values, data[12*i:12*i+12])
+		}
+	case typeFloat:
+		if len(data) < 4*count {
+			return nil, errUnexpectedEndOfPage
+		}
+		for i := 0; i < count; i++ {
+			values = append(values, math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:])))
+		}
+	case typeDouble:
+		if len(data) < 8*count {
+			return nil, errUnexpectedEndOfPage
+		}
+		for i := 0; i < count; i++ {
+			values = append(values, math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:])))
+		}
+	case typeByteArray:
+		pos := 0
+		for i := 0; i < count; i++ {
+			if len(data) < pos+4 {
+				return nil, errUnexpectedEndOfPage
+			}
+			l := int(binary.LittleEndian.Uint32(data[pos:]))
+			pos += 4
+			if l < 0 || len(data)-pos < l {
+				return nil, errUnexpectedEndOfPage
+			}
+			values = append(values, data[pos:pos+l])
+			pos += l
+		}
+	case typeFixedLenByteArray:
+		if typeLength < 0 || len(data) < typeLength*count {
+			return nil, errUnexpectedEndOfPage
+		}
+		for i := 0; i < count; i++ {
+			values = append(values, data[typeLength*i:typeLength*(i+1)])
+		}
+	default:
+		return nil, errors.New(fmt.Sprintf("physical type %s is not supported", typ))
+	}
+	return values, nil
+}
+
+// decodeDeltaBinaryPacked decodes integers encoded with the DELTA_BINARY_PACKED encoding,
+// and returns the values and the number of bytes read.
+func decodeDeltaBinaryPacked(data []byte) ([]int64, int, error) {
+	pos := 0
+	readUvarint := func() (uint64, error) {
+		v, n := binary.Uvarint(data[pos:])
+		if n <= 0 {
+			return 0, errUnexpectedEndOfPage
+		}
+		pos += n
+		return v, nil
+	}
+	readVarint := func() (int64, error) {
+		v, n := binary.Varint(data[pos:])
+		if n <= 0 {
+			return 0, errUnexpectedEndOfPage
+		}
+		pos += n
+		return v, nil
+	}
+
+	blockSize, err := readUvarint()
+	if err != nil {
+		return nil, pos, err
+	}
+	miniBlocks, err := readUvarint()
+	if err != nil {
+		return nil, pos, err
+	}
+	total, err := readUvarint()
+	if err != nil {
+		return nil, pos, err
+	}
+	first, err := readVarint()
+	if err != nil {
+		return nil, pos, err
+	}
+	if miniBlocks == 0 || maxPreallocatedValues < blockSize || blockSize%miniBlocks != 0 || (blockSize/miniBlocks)%8 != 0 {
+		return nil, pos, errors.New("invalid delta binary packed header")
+	}
+	miniBlockSize := int(blockSize / miniBlocks)
+
+	n := maxPreallocatedValues
+	if total < uint64(n) {
+		n = int(total)
+	}
+	values := make([]int64, 0, n)
+	if 0 < total {
+		values = append(values, first)
+	}
+	last := first
+	buf := make([]uint64, 0, miniBlockSize)
+
+	for uint64(len(values)) < total {
+		minDelta, err := readVarint()
+		if err != nil {
+			return nil, pos, err
+		}
+		if uint64(len(data)-pos) < miniBlocks {
+			return nil, pos, errUnexpectedEndOfPage
+		}
+		widths := data[pos : pos+int(miniBlocks)]
+		pos += int(miniBlocks)
+
+		for _, w := range widths {
+			if total <= uint64(len(values)) {
+				break
+			}
+
+			size := miniBlockSize * int(w) / 8
+			if len(data) < pos+size {
+				return nil, pos, errUnexpectedEndOfPage
+			}
+			if buf, err = unpackBits(data[pos:pos+size], int(w), miniBlockSize, buf[:0]); err != nil {
+				return nil, pos, err
+			}
+			pos += size
+
+			for _, d := range buf {
+				if total <= uint64(len(values)) {
+					break
+				}
+				last = last + minDelta + int64(d)
+				values = append(values, last)
+			}
+		}
+	}
+	return values, pos, nil
+}
+
+// decodeDeltaLengthByteArray decodes byte arrays encoded with the DELTA_LENGTH_BYTE_ARRAY encoding.
+func decodeDeltaLengthByteArray(data []byte) ([]interface{}, error) {
+	lengths, pos, err := decodeDeltaBinaryPacked(data)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, 0, len(lengths))
+	for _, l := range lengths {
+		if l < 0 || int64(len(data)-pos) < l {
+			return nil, errUnexpectedEndOfPage
+		}
+		values = append(values, data[pos:pos+int(l)])
+		pos += int(l)
+	}
+	return values, nil
+}
+
+// decodeDeltaByteArray decodes byte arrays encoded with the DELTA_BYTE_ARRAY encoding.
+func decodeDeltaByteArray(data []byte) ([]interface{}, error) {
+	prefixLengths, pos, err := decodeDeltaBinaryPacked(data)
+	if err != nil {
+		return nil, err
+	}
+	suffixes, err := decodeDeltaLengthByteArray(data[pos:])
+	if err != nil {
+		return nil, err
+	}
+	if len(suffixes) != len(prefixLengths) {
+		return nil, errors.New("number of prefixes and suffixes does not match")
+	}
+
+	values := make([]interface{}, 0, len(suffixes))
+	var prev []byte
+	for i := range suffixes {
+		l := prefixLengths[i]
+		if l < 0 || int64(len(prev)) < l {
+			return nil, errors.New("invalid prefix length")
+		}
+		suffix := suffixes[i].([]byte)
+		v := make([]byte, 0, int(l)+len(suffix))
+		v = append(v, prev[:l]...)
+		v = append(v, suffix...)
+		values = append(values, v)
+		prev = v
+	}
+	return values, nil
+}
+
+// decodeByteStreamSplit decodes count values encoded with the BYTE_STREAM_SPLIT encoding.
+func decodeByteStreamSplit(data []byte, typ physicalType, typeLength int, count int) ([]interface{}, error) {
+	var width int
+	switch typ {
+	case typeInt32, typeFloat:
+		width = 4
+	case typeInt64, typeDouble:
+		width = 8
+	case typeFixedLenByteArray:
+		width = typeLength
+	default:
+		return nil, errors.New(fmt.Sprintf("encoding %s is not supported for physical type %s", encodingByteStreamSplit, typ))
+	}
+	if width < 1 || count < 0 {
+		return nil, errors.New(fmt.Sprintf("invalid byte stream split data of physical type %s", typ))
+	}
+	if len(data)/width < count {
+		return nil, errUnexpectedEndOfPage
+	}
+
+	joined := make([]byte, width*count)
+	for i := 0; i < count; i++ {
+		for k := 0; k < width; k++ {
+			joined[i*width+k] = data[k*count+i]
+		}
+	}
+	return decodePlain(joined, typ, typeLength, count)
+}
@@ -0,0 +1,298 @@
+package parquet
+
+import (
+	"reflect"
+	"testing"
+)
+
+var decodeHybridTests = []struct {
+	Name   string
+	Data   []byte
+	Width  int
+	Count  int
+	Result []uint64
+	Read   int
+	Error  string
+}{
+	{
+		Name:   "RLE Run",
+		Data:   []byte{0x06, 0x03},
+		Width:  2,
+		Count:  3,
+		Result: []uint64{3, 3, 3},
+		Read:   2,
+	},
+	{
+		Name:   "Bit-Packed Run",
+		Data:   []byte{0x03, 0x88, 0xc6, 0xfa},
+		Width:  3,
+		Count:  8,
+		Result: []uint64{0, 1, 2, 3, 4, 5, 6, 7},
+		Read:   4,
+	},
+	{
+		Name:   "Mixed Runs",
+		Data:   []byte{0x04, 0x01, 0x03, 0x05},
+		Width:  1,
+		Count:  4,
+		Result: []uint64{1, 1, 1, 0},
+		Read:   4,
+	},
+	{
+		Name:  "Unexpected End",
+		Data:  []byte{0x03, 0x88},
+		Width: 3,
+		Count: 8,
+		Error: "unexpected end of page",
+	},
+	{
+		Name:   "RLE Run Longer than Count",
+		Data:   []byte{0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x03},
+		Width:  2,
+		Count:  3,
+		Result: []uint64{3, 3, 3},
+		Read:   11,
+	},
+	{
+		Name:  "Bit-Packed Run Longer than Data",
+		Data:  []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x88},
+		Width: 3,
+		Count: 8,
+		Error: "unexpected end of page",
+	},
+	{
+		Name:   "Bit-Packed Run of Zero Width",
+		Data:   []byte{0x03},
+		Width:  0,
+		Count:  3,
+		Result: []uint64{0, 0, 0},
+		Read:   1,
+	},
+	{
+		Name:  "Invalid Bit Width",
+		Data:  []byte{0x03, 0x00},
+		Width: 65,
+		Count: 1,
+		Error: "unexpected end of page",
+	},
+	{
+		Name:  "Empty Data",
+		Data:  []byte{},
+		Width: 1,
+		Count: 1,
+		Error: "unexpected end of page",
+	},
+}
+
+func TestDecodeHybrid(t *testing.T) {
+	for _, v := range decodeHybridTests {
+		result, read, err := decodeHybrid(v.Data, v.Width, v.Count)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+		if read != v.Read {
+			t.Errorf("%s: read = %d, want %d", v.Name, read, v.Read)
+		}
+	}
+}
+
+var decodeDeltaBinaryPackedTests = []struct {
+	Name   string
+	Data   []byte
+	Result []int64
+	Error  string
+}{
+	{
+		Name: "Consecutive Values",
+		// block size 128, 4 miniblocks, 5 values, first value 1, min delta 1, all bit widths 0
+		Data:   []byte{0x80, 0x01, 0x04, 0x05, 0x02, 0x02, 0x00, 0x00, 0x00, 0x00},
+		Result: []int64{1, 2, 3, 4, 5},
+	},
+	{
+		Name: "Varying Deltas",
+		// block size 8, 1 miniblock, 4 values, first value 7, min delta -2, bit width 2, deltas 0, 3, 1
+		Data:   []byte{0x08, 0x01, 0x04, 0x0e, 0x03, 0x02, 0x1c, 0x00},
+		Result: []int64{7, 5, 6, 5},
+	},
+	{
+		Name:  "Invalid Header",
+		Data:  []byte{0x80, 0x01, 0x03, 0x05, 0x02},
+		Error: "invalid delta binary packed header",
+	},
+	{
+		Name:  "Too Large Block Size",
+		Data:  []byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x20, 0x01, 0x01, 0x00},
+		Error: "invalid delta binary packed header",
+	},
+	{
+		Name:  "Truncated Header",
+		Data:  []byte{0x80, 0x01, 0x04},
+		Error: "unexpected end of page",
+	},
+	{
+		Name:  "Truncated Bit Widths",
+		Data:  []byte{0x80, 0x01, 0x10, 0x05, 0x02, 0x02, 0x00, 0x00},
+		Error: "unexpected end of page",
+	},
+	{
+		Name:  "Truncated Miniblock",
+		Data:  []byte{0x08, 0x01, 0x04, 0x0e, 0x03, 0x02, 0x1c},
+		Error: "unexpected end of page",
+	},
+}
+
+func TestDecodeDeltaBinaryPacked(t *testing.T) {
+	for _, v := range decodeDeltaBinaryPackedTests {
+		result, _, err := decodeDeltaBinaryPacked(v.Data)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestDecodeByteStreamSplit(t *testing.T) {
+	data := []byte{0x01, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+	result, err := decodeByteStreamSplit(data, typeInt32, 0, 2)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := []interface{}{int32(1), int32(2)}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+}
+
+var decodeByteStreamSplitErrorTests = []struct {
+	Name       string
+	Data       []byte
+	Type       physicalType
+	TypeLength int
+	Count      int
+	Error      string
+}{
+	{
+		Name:  "Unexpected End",
+		Data:  []byte{0x01, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		Type:  typeInt32,
+		Count: 3,
+		Error: "unexpected end of page",
+	},
+	{
+		Name:       "Invalid Type Length",
+		Data:       []byte{0x01, 0x02},
+		Type:       typeFixedLenByteArray,
+		TypeLength: -1,
+		Count:      2,
+		Error:      "invalid byte stream split data of physical type FIXED_LEN_BYTE_ARRAY",
+	},
+	{
+		Name:  "Negative Count",
+		Data:  []byte{0x01, 0x02},
+		Type:  typeInt32,
+		Count: -1,
+		Error: "invalid byte stream split data of physical type INT32",
+	},
+	{
+		Name:  "Unsupported Type",
+		Data:  []byte{0x01},
+		Type:  typeBoolean,
+		Count: 1,
+		Error: "encoding BYTE_STREAM_SPLIT is not supported for physical type BOOLEAN",
+	},
+}
+
+func TestDecodeByteStreamSplit_Error(t *testing.T) {
+	for _, v := range decodeByteStreamSplitErrorTests {
+		_, err := decodeByteStreamSplit(v.Data, v.Type, v.TypeLength, v.Count)
+		if err == nil {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if err.Error() != v.Error {
+			t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+		}
+	}
+}
+
+var decodePlainErrorTests = []struct {
+	Name       string
+	Data       []byte
+	Type       physicalType
+	TypeLength int
+	Count      int
+	Error      string
+}{
+	{
+		Name:  "Truncated Int64",
+		Data:  []byte{0x01, 0x00, 0x00, 0x00},
+		Type:  typeInt64,
+		Count: 1,
+		Error: "unexpected end of page",
+	},
+	{
+		Name:  "Truncated Byte Array Length",
+		Data:  []byte{0x01, 0x00},
+		Type:  typeByteArray,
+		Count: 1,
+		Error: "unexpected end of page",
+	},
+	{
+		Name:  "Byte Array Longer than Data",
+		Data:  []byte{0xff, 0xff, 0xff, 0x7f, 0x61},
+		Type:  typeByteArray,
+		Count: 1,
+		Error: "unexpected end of page",
+	},
+	{
+		Name:       "Invalid Fixed Length",
+		Data:       []byte{0x61, 0x62},
+		Type:       typeFixedLenByteArray,
+		TypeLength: -2,
+		Count:      1,
+		Error:      "unexpected end of page",
+	},
+	{
+		Name:  "Truncated Booleans",
+		Data:  []byte{0x01},
+		Type:  typeBoolean,
+		Count: 9,
+		Error: "unexpected end of page",
+	},
+}
+
+func TestDecodePlain_Error(t *testing.T) {
+	for _, v := range decodePlainErrorTests {
+		_, err := decodePlain(v.Data, v.Type, v.TypeLength, v.Count)
+		if err == nil {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if err.Error() != v.Error {
+			t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+		}
+	}
+}
@@ -0,0 +1,578 @@
+package parquet
+
+import (
+	"errors"
+	"fmt"
+)
+
+type physicalType int32
+
+const (
+	typeBoolean           physicalType = 0
+	typeInt32             physicalType = 1
+	typeInt64             physicalType = 2
+	typeInt96             physicalType = 3
+	typeFloat             physicalType = 4
+	typeDouble            physicalType = 5
+	typeByteArray         physicalType = 6
+	typeFixedLenByteArray physicalType = 7
+)
+
+var physicalTypeLiteral = map[physicalType]string{
+	typeBoolean:           "BOOLEAN",
+	typeInt32:             "INT32",
+	typeInt64:             "INT64",
+	typeInt96:             "INT96",
+	typeFloat:             "FLOAT",
+	typeDouble:            "DOUBLE",
+	typeByteArray:         "BYTE_ARRAY",
+	typeFixedLenByteArray: "FIXED_LEN_BYTE_ARRAY",
+}
+
+func (t physicalType) String() string {
+	if s, ok := physicalTypeLiteral[t]; ok {
+		return s
+	}
+	return fmt.Sprintf("TYPE(%d)", int32(t))
+}
+
+type convertedType int32
+
+const (
+	convertedNone            convertedType = -1
+	convertedUTF8            convertedType = 0
+	convertedEnum            convertedType = 4
+	convertedDecimal         convertedType = 5
+	convertedDate            convertedType = 6
+	convertedTimeMillis      convertedType = 7
+	convertedTimeMicros      convertedType = 8
+	convertedTimestampMillis convertedType = 9
+	convertedTimestampMicros convertedType = 10
+	convertedUint8           convertedType = 11
+	convertedUint16          convertedType = 12
+	convertedUint32          convertedType = 13
+	convertedUint64          convertedType = 14
+	convertedJson            convertedType = 19
+)
+
+type repetitionType int32
+
+const (
+	repetitionRequired repetitionType = 0
+	repetitionOptional repetitionType = 1
+	repetitionRepeated repetitionType = 2
+)
+
+// Field ids of the LogicalType union.
+const (
+	logicalNone      = 0
+	logicalString    = 1
+	logicalEnum      = 4
+	logicalDecimal   = 5
+	logicalDate      = 6
+	logicalTime      = 7
+	logicalTimestamp = 8
+	logicalInteger   = 10
+	logicalUnknown   = 11
+	logicalJson      = 12
+	logicalUUID      = 14
+)
+
+// Field ids of the TimeUnit union.
+const (
+	unitMillis = 1
+	unitMicros = 2
+	unitNanos  = 3
+)
+
+type encoding int32
+
+const (
+	encodingPlain                encoding = 0
+	encodingPlainDictionary      encoding = 2
+	encodingRLE                  encoding = 3
+	encodingBitPacked            encoding = 4
+	encodingDeltaBinaryPacked    encoding = 5
+	encodingDeltaLengthByteArray encoding = 6
+	encodingDeltaByteArray       encoding = 7
+	encodingRLEDictionary        encoding = 8
+	encodingByteStreamSplit      encoding = 9
+)
+
+var encodingLiteral = map[encoding]string{
+	encodingPlain:                "PLAIN",
+	encodingPlainDictionary:      "PLAIN_DICTIONARY",
+	encodingRLE:                  "RLE",
+	encodingBitPacked:            "BIT_PACKED",
+	encodingDeltaBinaryPacked:    "DELTA_BINARY_PACKED",
+	encodingDeltaLengthByteArray: "DELTA_LENGTH_BYTE_ARRAY",
+	encodingDeltaByteArray:       "DELTA_BYTE_ARRAY",
+	encodingRLEDictionary:        "RLE_DICTIONARY",
+	encodingByteStreamSplit:      "BYTE_STREAM_SPLIT",
+}
+
+func (e encoding) String() string {
+	if s, ok := encodingLiteral[e]; ok {
+		return s
+	}
+	return fmt.Sprintf("ENCODING(%d)", int32(e))
+}
+
+type compressionCodec int32
+
+const (
+	codecUncompressed compressionCodec = 0
+	codecSnappy       compressionCodec = 1
+	codecGzip         compressionCodec = 2
+	codecLZO          compressionCodec = 3
+	codecBrotli       compressionCodec = 4
+	codecLZ4          compressionCodec = 5
+	codecZstd         compressionCodec = 6
+	codecLZ4Raw       compressionCodec = 7
+)
+
+var compressionCodecLiteral = map[compressionCodec]string{
+	codecUncompressed: "UNCOMPRESSED",
+	codecSnappy:       "SNAPPY",
+	codecGzip:         "GZIP",
+	codecLZO:          "LZO",
+	codecBrotli:       "BROTLI",
+	codecLZ4:          "LZ4",
+	codecZstd:         "ZSTD",
+	codecLZ4Raw:       "LZ4_RAW",
+}
+
+func (c compressionCodec) String() string {
+	if s, ok := compressionCodecLiteral[c]; ok {
+		return s
+	}
+	return fmt.Sprintf("CODEC(%d)", int32(c))
+}
+
+type pageType int32
+
+const (
+	pageData       pageType = 0
+	pageIndex      pageType = 1
+	pageDictionary pageType = 2
+	pageDataV2     pageType = 3
+)
+
+type logicalType struct {
+	kind            int16
+	unit            int16
+	isAdjustedToUTC bool
+	scale           int32
+	precision       int32
+	bitWidth        int8
+	isSigned        bool
+}
+
+type schemaElement struct {
+	typ            physicalType
+	hasType        bool
+	typeLength     int32
+	repetitionType repetitionType
+	name           string
+	numChildren    int32
+	convertedType  convertedType
+	scale          int32
+	precision      int32
+	logicalType    logicalType
+}
+
+type columnMetaData struct {
+	typ                  physicalType
+	pathInSchema         []string
+	codec                compressionCodec
+	numValues            int64
+	totalCompressedSize  int64
+	dataPageOffset       int64
+	dictionaryPageOffset int64
+}
+
+type columnChunk struct {
+	filePath string
+	metaData *columnMetaData
+}
+
+type rowGroup struct {
+	columns []columnChunk
+	numRows int64
+}
+
+type fileMetaData struct {
+	version   int32
+	schema    []schemaElement
+	numRows   int64
+	rowGroups []rowGroup
+}
+
+type dataPageHeader struct {
+	numValues               int32
+	encoding                encoding
+	definitionLevelEncoding encoding
+	repetitionLevelEncoding encoding
+}
+
+type dictionaryPageHeader struct {
+	numValues int32
+	encoding  encoding
+}
+
+type dataPageHeaderV2 struct {
+	numValues                  int32
+	numNulls                   int32
+	numRows                    int32
+	encoding                   encoding
+	definitionLevelsByteLength int32
+	repetitionLevelsByteLength int32
+	isCompressed               bool
+}
+
+type pageHeader struct {
+	typ                  pageType
+	uncompressedPageSize int32
+	compressedPageSize   int32
+	dataPageHeader       *dataPageHeader
+	dictionaryPageHeader *dictionaryPageHeader
+	dataPageHeaderV2     *dataPageHeaderV2
+}
+
+func readFileMetaData(r *thriftReader) (*fileMetaData, error) {
+	meta := &fileMetaData{}
+
+	err := r.readStruct(func(id int16, t byte) error {
+		var err error
+
+		switch id {
+		case 1:
+			meta.version, err = r.readI32()
+		case 2:
+			err = r.readList(func(_ byte) error {
+				e, err := readSchemaElement(r)
+				if err == nil {
+					meta.schema = append(meta.schema, e)
+				}
+				return err
+			})
+		case 3:
+			meta.numRows, err = r.readI64()
+		case 4:
+			err = r.readList(func(_ byte) error {
+				rg, err := readRowGroup(r)
+				if err == nil {
+					meta.rowGroups = append(meta.rowGroups, rg)
+				}
+				return err
+			})
+		default:
+			err = r.skip(t)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("invalid file metadata: %s", err.Error()))
+	}
+	return meta, nil
+}
+
+func readSchemaElement(r *thriftReader) (schemaElement, error) {
+	e := schemaElement{
+		convertedType: convertedNone,
+	}
+
+	err := r.readStruct(func(id int16, t byte) error {
+		var err error
+		var v int32
+
+		switch id {
+		case 1:
+			v, err = r.readI32()
+			e.typ = physicalType(v)
+			e.hasType = true
+		case 2:
+			e.typeLength, err = r.readI32()
+		case 3:
+			v, err = r.readI32()
+			e.repetitionType = repetitionType(v)
+		case 4:
+			e.name, err = r.readString()
+		case 5:
+			e.numChildren, err = r.readI32()
+		case 6:
+			v, err = r.readI32()
+			e.convertedType = convertedType(v)
+		case 7:
+			e.scale, err = r.readI32()
+		case 8:
+			e.precision, err = r.readI32()
+		case 10:
+			e.logicalType, err = readLogicalType(r)
+		default:
+			err = r.skip(t)
+		}
+		return err
+	})
+	return e, err
+}
+
+func readLogicalType(r *thriftReader) (logicalType, error) {
+	lt := logicalType{}
+
+	err := r.readStruct(func(id int16, t byte) error {
+		lt.kind = id
+
+		switch id {
+		case logicalDecimal:
+			return r.readStruct(func(id int16, t byte) error {
+				var err error
+				switch id {
+				case 1:
+					lt.scale, err = r.readI32()
+				case 2:
+					lt.precision, err = r.readI32()
+				default:
+					err = r.skip(t)
+				}
+				return err
+			})
+		case logicalTime, logicalTimestamp:
+			return r.readStruct(func(id int16, t byte) error {
+				var err error
+				switch id {
+				case 1:
+					lt.isAdjustedToUTC, err = r.readBool(t)
+				case 2:
+					err = r.readStruct(func(id int16, t byte) error {
+						lt.unit = id
+						return r.skip(t)
+					})
+				default:
+					err = r.skip(t)
+				}
+				return err
+			})
+		case logicalInteger:
+			return r.readStruct(func(id int16, t byte) error {
+				var err error
+				switch id {
+				case 1:
+					var b byte
+					b, err = r.readByte()
+					lt.bitWidth = int8(b)
+				case 2:
+					lt.isSigned, err = r.readBool(t)
+				default:
+					err = r.skip(t)
+				}
+				return err
+			})
+		}
+		return r.skip(t)
+	})
+	return lt, err
+}
+
+func readRowGroup(r *thriftReader) (rowGroup, error) {
+	rg := rowGroup{}
+
+	err := r.readStruct(func(id int16, t byte) error {
+		var err error
+
+		switch id {
+		case 1:
+			err = r.readList(func(_ byte) error {
+				c, err := readColumnChunk(r)
+				if err == nil {
+					rg.columns = append(rg.columns, c)
+				}
+				return err
+			})
+		case 3:
+			rg.numRows, err = r.readI64()
+		default:
+			err = r.skip(t)
+		}
+		return err
+	})
+	return rg, err
+}
+
+func readColumnChunk(r *thriftReader) (columnChunk, error) {
+	c := columnChunk{}
+
+	err := r.readStruct(func(id int16, t byte) error {
+		var err error
+
+		switch id {
+		case 1:
+			c.filePath, err = r.readString()
+		case 3:
+			c.metaData, err = readColumnMetaData(r)
+		default:
+			err = r.skip(t)
+		}
+		return err
+	})
+	return c, err
+}
+
+func readColumnMetaData(r *thriftReader) (*columnMetaData, error) {
+	m := &columnMetaData{}
+
+	err := r.readStruct(func(id int16, t byte) error {
+		var err error
+		var v int32
+
+		switch id {
+		case 1:
+			v, err = r.readI32()
+			m.typ = physicalType(v)
+		case 3:
+			err = r.readList(func(_ byte) error {
+				s, err := r.readString()
+				if err == nil {
+					m.pathInSchema = append(m.pathInSchema, s)
+				}
+				return err
+			})
+		case 4:
+			v, err = r.readI32()
+			m.codec = compressionCodec(v)
+		case 5:
+			m.numValues, err = r.readI64()
+		case 7:
+			m.totalCompressedSize, err = r.readI64()
+		case 9:
+			m.dataPageOffset, err = r.readI64()
+		case 11:
+			m.dictionaryPageOffset, err = r.readI64()
+		default:
+			err = r.skip(t)
+		}
+		return err
+	})
+	return m, err
+}
+
+func readPageHeader(r *thriftReader) (*pageHeader, error) {
+	h := &pageHeader{}
+
+	err := r.readStruct(func(id int16, t byte) error {
+		var err error
+		var v int32
+
+		switch id {
+		case 1:
+			v, err = r.readI32()
+			h.typ = pageType(v)
+		case 2:
+			h.uncompressedPageSize, err = r.readI32()
+		case 3:
+			h.compressedPageSize, err = r.readI32()
+		case 5:
+			h.dataPageHeader, err = readDataPageHeader(r)
+		case 7:
+			h.dictionaryPageHeader, err = readDictionaryPageHeader(r)
+		case 8:
+			h.dataPageHeaderV2, err = readDataPageHeaderV2(r)
+		default:
+			err = r.skip(t)
+		}
+		return err
+	})
+	if err == nil {
+		switch {
+		case h.dataPageHeader != nil && h.dataPageHeader.numValues < 0,
+			h.dictionaryPageHeader != nil && h.dictionaryPageHeader.numValues < 0,
+			h.dataPageHeaderV2 != nil && h.dataPageHeaderV2.numValues < 0:
+			err = errors.New("negative number of values")
+		}
+	}
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("invalid page header: %s", err.Error()))
+	}
+	return h, nil
+}
+
+func readDataPageHeader(r *thriftReader) (*dataPageHeader, error) {
+	h := &dataPageHeader{}
+
+	err := r.readStruct(func(id int16, t byte) error {
+		var err error
+		var v int32
+
+		switch id {
+		case 1:
+			h.numValues, err = r.readI32()
+		case 2:
+			v, err = r.readI32()
+			h.encoding = encoding(v)
+		case 3:
+			v, err = r.readI32()
+			h.definitionLevelEncoding = encoding(v)
+		case 4:
+			v, err = r.readI32()
+			h.repetitionLevelEncoding = encoding(v)
+		default:
+			err = r.skip(t)
+		}
+		return err
+	})
+	return h, err
+}
+
+func readDictionaryPageHeader(r *thriftReader) (*dictionaryPageHeader, error) {
+	h := &dictionaryPageHeader{}
+
+	err := r.readStruct(func(id int16, t byte) error {
+		var err error
+		var v int32
+
+		switch id {
+		case 1:
+			h.numValues, err = r.readI32()
+		case 2:
+			v, err = r.readI32()
+			h.encoding = encoding(v)
+		default:
+			err = r.skip(t)
+		}
+		return err
+	})
+	return h, err
+}
+
+func readDataPageHeaderV2(r *thriftReader) (*dataPageHeaderV2, error) {
+	h := &dataPageHeaderV2{
+		isCompressed: true,
+	}
+
+	err := r.readStruct(func(id int16, t byte) error {
+		var err error
+		var v int32
+
+		switch id {
+		case 1:
+			h.numValues, err = r.readI32()
+		case 2:
+			h.numNulls, err = r.readI32()
+		case 3:
+			h.numRows, err = r.readI32()
+		case 4:
+			v, err = r.readI32()
+			h.encoding = encoding(v)
+		case 5:
+			h.definitionLevelsByteLength, err = r.readI32()
+		case 6:
+			h.repetitionLevelsByteLength, err = r.readI32()
+		case 7:
+			h.isCompressed, err = r.readBool(t)
+		default:
+			err = r.skip(t)
+		}
+		return err
+	})
+	return h, err
+}
@@ -0,0 +1,474 @@
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	magic        = "PAR1"
+	footerLength = 8
+)
+
+// Column is a leaf column of the schema of a Parquet file.
+type Column struct {
+	Name string
+
+	index   int
+	element schemaElement
+	maxDef  int
+	maxRep  int
+}
+
+// Reader reads the columns of a Parquet file.
+type Reader struct {
+	r       io.ReadSeeker
+	size    int64
+	meta    *fileMetaData
+	columns []*Column
+}
+
+// NewReader reads the metadata of the Parquet file from r.
+func NewReader(r io.ReadSeeker) (*Reader, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	if size < int64(len(magic)+footerLength) {
+		return nil, errors.New("not a parquet file")
+	}
+
+	head := make([]byte, len(magic))
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err = io.ReadFull(r, head); err != nil {
+		return nil, err
+	}
+
+	footer := make([]byte, footerLength)
+	if _, err = r.Seek(size-footerLength, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err = io.ReadFull(r, footer); err != nil {
+		return nil, err
+	}
+
+	if string(head) != magic || string(footer[4:]) != magic {
+		return nil, errors.New("not a parquet file")
+	}
+
+	metaLen := int64(binary.LittleEndian.Uint32(footer))
+	if size-int64(len(magic)+footerLength) < metaLen {
+		return nil, errors.New("invalid file metadata length")
+	}
+
+	buf := make([]byte, metaLen)
+	if _, err = r.Seek(size-footerLength-metaLen, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err = io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+
+	meta, err := readFileMetaData(newThriftReader(buf))
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := leafColumns(meta.schema)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rg := range meta.rowGroups {
+		if len(rg.columns) != len(columns) {
+			return nil, errors.New("number of column chunks does not match the schema")
+		}
+	}
+
+	return &Reader{
+		r:       r,
+		size:    size,
+		meta:    meta,
+		columns: columns,
+	}, nil
+}
+
+func leafColumns(schema []schemaElement) ([]*Column, error) {
+	if len(schema) < 1 {
+		return nil, errors.New("schema is empty")
+	}
+
+	columns := make([]*Column, 0, len(schema)-1)
+	pos := 1
+
+	var walk func(path []string, maxDef int, maxRep int, numChildren int) error
+	walk = func(path []string, maxDef int, maxRep int, numChildren int) error {
+		for i := 0; i < numChildren; i++ {
+			if len(schema) <= pos {
+				return errors.New("schema is broken")
+			}
+			e := schema[pos]
+			pos++
+
+			def, rep := maxDef, maxRep
+			switch e.repetitionType {
+			case repetitionOptional:
+				def++
+			case repetitionRepeated:
+				def++
+				rep++
+			}
+			p := append(append(make([]string, 0, len(path)+1), path...), e.name)
+
+			if 0 < e.numChildren {
+				if err := walk(p, def, rep, int(e.numChildren)); err != nil {
+					return err
+				}
+				continue
+			}
+
+			columns = append(columns, &Column{
+				Name:    strings.Join(p, "."),
+				index:   len(columns),
+				element: e,
+				maxDef:  def,
+				maxRep:  rep,
+			})
+		}
+		return nil
+	}
+
+	if err := walk(nil, 0, 0, int(schema[0].numChildren)); err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
+
+// Columns returns the leaf columns in the order of the schema.
+func (r *Reader) Columns() []*Column {
+	return r.columns
+}
+
+// NumRows returns the number of rows in the file.
+func (r *Reader) NumRows() int64 {
+	return r.meta.numRows
+}
+
+// ReadColumn reads all of the values of the column from every row group.
+func (r *Reader) ReadColumn(column *Column) ([]value.Primary, error) {
+	if 0 < column.maxRep {
+		return nil, errors.New(fmt.Sprintf("column %s: repeated fields are not supported", column.Name))
+	}
+
+	values := make([]value.Primary, 0, capacity(int(r.meta.numRows)))
+	for _, rg := range r.meta.rowGroups {
+		chunk := rg.columns[column.index]
+		if 0 < len(chunk.filePath) {
+			return nil, errors.New(fmt.Sprintf("column %s: column chunks in external files are not supported", column.Name))
+		}
+		if chunk.metaData == nil {
+			return nil, errors.New(fmt.Sprintf("column %s: column metadata is not found", column.Name))
+		}
+
+		rows, err := r.readColumnChunk(column, chunk.metaData)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("column %s: %s", column.Name, err.Error()))
+		}
+		if int64(len(rows)) != rg.numRows {
+			return nil, errors.New(fmt.Sprintf("column %s: number of values does not match the number of rows", column.Name))
+		}
+		values = append(values, rows...)
+	}
+	return values, nil
+}
+
+func (r *Reader) readColumnChunk(column *Column, meta *columnMetaData) ([]value.Primary, error) {
+	offset := meta.dataPageOffset
+	if 0 < meta.dictionaryPageOffset && meta.dictionaryPageOffset < offset {
+		offset = meta.dictionaryPageOffset
+	}
+	if offset < 0 || meta.totalCompressedSize < 0 || r.size-offset < meta.totalCompressedSize {
+		return nil, errors.New("invalid column chunk offset")
+	}
+
+	if _, err := r.r.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, meta.totalCompressedSize)
+	if _, err := io.ReadFull(r.r, buf); err != nil {
+		return nil, errors.New("column chunk is broken")
+	}
+
+	values := make([]value.Primary, 0, capacity(int(meta.numValues)))
+	var dictionary []interface{}
+
+	for pos := 0; int64(len(values)) < meta.numValues; {
+		if len(buf) <= pos {
+			return nil, errUnexpectedEndOfPage
+		}
+
+		tr := newThriftReader(buf[pos:])
+		header, err := readPageHeader(tr)
+		if err != nil {
+			return nil, err
+		}
+		pos += tr.pos
+
+		size := int(header.compressedPageSize)
+		if size < 0 || len(buf)-pos < size {
+			return nil, errUnexpectedEndOfPage
+		}
+		page := buf[pos : pos+size]
+		pos += size
+
+		switch header.typ {
+		case pageDictionary:
+			if header.dictionaryPageHeader == nil {
+				return nil, errors.New("dictionary page header is not found")
+			}
+			data, err := decompress(meta.codec, page, int(header.uncompressedPageSize))
+			if err != nil {
+				return nil, err
+			}
+			switch header.dictionaryPageHeader.encoding {
+			case encodingPlain, encodingPlainDictionary:
+				dictionary, err = decodePlain(data, column.element.typ, int(column.element.typeLength), int(header.dictionaryPageHeader.numValues))
+			default:
+				err = errors.New(fmt.Sprintf("dictionary encoding %s is not supported", header.dictionaryPageHeader.encoding))
+			}
+			if err != nil {
+				return nil, err
+			}
+		case pageData:
+			if header.dataPageHeader == nil {
+				return nil, errors.New("data page header is not found")
+			}
+			data, err := decompress(meta.codec, page, int(header.uncompressedPageSize))
+			if err != nil {
+				return nil, err
+			}
+			if values, err = r.readDataPage(column, header.dataPageHeader, data, dictionary, values); err != nil {
+				return nil, err
+			}
+		case pageDataV2:
+			if header.dataPageHeaderV2 == nil {
+				return nil, errors.New("data page header is not found")
+			}
+			if values, err = r.readDataPageV2(column, meta.codec, header, page, dictionary, values); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return values, nil
+}
+
+func (r *Reader) readDataPage(column *Column, header *dataPageHeader, data []byte, dictionary []interface{}, values []value.Primary) ([]value.Primary, error) {
+	count := int(header.numValues)
+
+	var defLevels []uint64
+	if 0 < column.maxDef {
+		if header.definitionLevelEncoding != encodingRLE {
+			return nil, errors.New(fmt.Sprintf("definition level encoding %s is not supported", header.definitionLevelEncoding))
+		}
+		levels, n, err := decodeLevels(data, column.maxDef, count)
+		if err != nil {
+			return nil, err
+		}
+		defLevels = levels
+		data = data[n:]
+	}
+
+	return r.appendValues(column, header.encoding, data, count, defLevels, dictionary, values)
+}
+
+func (r *Reader) readDataPageV2(column *Column, codec compressionCodec, header *pageHeader, page []byte, dictionary []interface{}, values []value.Primary) ([]value.Primary, error) {
+	h := header.dataPageHeaderV2
+	count := int(h.numValues)
+	repLen := int(h.repetitionLevelsByteLength)
+	defLen := int(h.definitionLevelsByteLength)
+	if repLen < 0 || defLen < 0 || len(page) < repLen+defLen {
+		return nil, errUnexpectedEndOfPage
+	}
+
+	var defLevels []uint64
+	if 0 < column.maxDef {
+		levels, _, err := decodeHybrid(page[repLen:repLen+defLen], bitWidth(column.maxDef), count)
+		if err != nil {
+			return nil, err
+		}
+		defLevels = levels
+	}
+
+	data := page[repLen+defLen:]
+	if h.isCompressed {
+		var err error
+		if data, err = decompress(codec, data, int(header.uncompressedPageSize)-repLen-defLen); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.appendValues(column, h.encoding, data, count, defLevels, dictionary, values)
+}
+
+func (r *Reader) appendValues(column *Column, enc encoding, data []byte, count int, defLevels []uint64, dictionary []interface{}, values []value.Primary) ([]value.Primary, error) {
+	numNonNull := count
+	if defLevels != nil {
+		numNonNull = 0
+		for _, l := range defLevels {
+			if int(l) == column.maxDef {
+				numNonNull++
+			}
+		}
+	}
+
+	raw, err := decodeValues(column, enc, data, numNonNull, dictionary)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) < numNonNull {
+		return nil, errUnexpectedEndOfPage
+	}
+
+	idx := 0
+	for i := 0; i < count; i++ {
+		if defLevels != nil && int(defLevels[i]) != column.maxDef {
+			values = append(values, value.NewNull())
+			continue
+		}
+		values = append(values, column.convert(raw[idx]))
+		idx++
+	}
+	return values, nil
+}
+
+func decodeValues(column *Column, enc encoding, data []byte, count int, dictionary []interface{}) ([]interface{}, error) {
+	typ := column.element.typ
+	typeLength := int(column.element.typeLength)
+
+	switch enc {
+	case encodingPlain:
+		return decodePlain(data, typ, typeLength, count)
+	case encodingPlainDictionary, encodingRLEDictionary:
+		if dictionary == nil {
+			return nil, errors.New("dictionary page is not found")
+		}
+		if len(data) < 1 {
+			if count == 0 {
+				return nil, nil
+			}
+			return nil, errUnexpectedEndOfPage
+		}
+		indices, _, err := decodeHybrid(data[1:], int(data[0]), count)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]interface{}, 0, len(indices))
+		for _, i := range indices {
+			if uint64(len(dictionary)) <= i {
+				return nil, errors.New("dictionary index out of range")
+			}
+			values = append(values, dictionary[i])
+		}
+		return values, nil
+	case encodingRLE:
+		if typ != typeBoolean {
+			break
+		}
+		levels, _, err := decodeLevels(data, 1, count)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]interface{}, 0, len(levels))
+		for _, l := range levels {
+			values = append(values, l == 1)
+		}
+		return values, nil
+	case encodingDeltaBinaryPacked:
+		if typ != typeInt32 && typ != typeInt64 {
+			break
+		}
+		ints, _, err := decodeDeltaBinaryPacked(data)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]interface{}, 0, len(ints))
+		for _, i := range ints {
+			if typ == typeInt32 {
+				values = append(values, int32(i))
+			} else {
+				values = append(values, i)
+			}
+		}
+		return values, nil
+	case encodingDeltaLengthByteArray:
+		if typ != typeByteArray {
+			break
+		}
+		return decodeDeltaLengthByteArray(data)
+	case encodingDeltaByteArray:
+		if typ != typeByteArray && typ != typeFixedLenByteArray {
+			break
+		}
+		return decodeDeltaByteArray(data)
+	case encodingByteStreamSplit:
+		return decodeByteStreamSplit(data, typ, typeLength, count)
+	}
+	return nil, errors.New(fmt.Sprintf("encoding %s is not supported for physical type %s", enc, typ))
+}
+
+func decompress(codec compressionCodec, data []byte, size int) ([]byte, error) {
+	var decoded []byte
+	var err error
+
+	if size < 0 {
+		return nil, errors.New("invalid uncompressed page size")
+	}
+
+	switch codec {
+	case codecUncompressed:
+		return data, nil
+	case codecSnappy:
+		var l int
+		if l, err = snappy.DecodedLen(data); err == nil && l != size {
+			return nil, errors.New("uncompressed page size does not match")
+		}
+		if err == nil {
+			decoded, err = snappy.Decode(nil, data)
+		}
+	case codecGzip:
+		var r *gzip.Reader
+		if r, err = gzip.NewReader(bytes.NewReader(data)); err == nil {
+			decoded, err = ioutil.ReadAll(io.LimitReader(r, int64(size)+1))
+			_ = r.Close()
+		}
+	case codecZstd:
+		var d *zstd.Decoder
+		if d, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1)); err == nil {
+			decoded, err = d.DecodeAll(data, make([]byte, 0, capacity(size)))
+			d.Close()
+		}
+	default:
+		return nil, errors.New(fmt.Sprintf("compression codec %s is not supported", codec))
+	}
+
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("failed to decompress a page: %s", err.Error()))
+	}
+	if len(decoded) != size {
+		return nil, errors.New("uncompressed page size does not match")
+	}
+	return decoded, nil
+}
@@ -0,0 +1,223 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func openTestFile(t *testing.T) *os.File {
+	wdir, _ := os.Getwd()
+	fp, err := os.Open(filepath.Join(wdir, "..", "..", "testdata", "csv", "table8.parquet"))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	return fp
+}
+
+func TestNewReader(t *testing.T) {
+	fp := openTestFile(t)
+	defer func() { _ = fp.Close() }()
+
+	r, err := NewReader(fp)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	if r.NumRows() != 3 {
+		t.Errorf("number of rows = %d, want %d", r.NumRows(), 3)
+	}
+
+	names := make([]string, 0, len(r.Columns()))
+	for _, c := range r.Columns() {
+		names = append(names, c.Name)
+	}
+	expect := []string{"id", "name", "score", "flag", "created", "day"}
+	if !reflect.DeepEqual(names, expect) {
+		t.Errorf("columns = %v, want %v", names, expect)
+	}
+}
+
+func TestNewReader_InvalidFile(t *testing.T) {
+	wdir, _ := os.Getwd()
+	fp, err := os.Open(filepath.Join(wdir, "..", "..", "testdata", "csv", "table1.csv"))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer func() { _ = fp.Close() }()
+
+	_, err = NewReader(fp)
+	if err == nil {
+		t.Fatalf("no error, want error %q", "not a parquet file")
+	}
+	if err.Error() != "not a parquet file" {
+		t.Errorf("error %q, want error %q", err.Error(), "not a parquet file")
+	}
+}
+
+var readerReadColumnTests = []struct {
+	Name   string
+	Result []value.Primary
+}{
+	{
+		Name: "id",
+		Result: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(3),
+		},
+	},
+	{
+		Name: "name",
+		Result: []value.Primary{
+			value.NewString("str1"),
+			value.NewNull(),
+			value.NewString("str3"),
+		},
+	},
+	{
+		Name: "score",
+		Result: []value.Primary{
+			value.NewFloat(1.5),
+			value.NewFloat(2.25),
+			value.NewNull(),
+		},
+	},
+	{
+		Name: "flag",
+		Result: []value.Primary{
+			value.NewBoolean(true),
+			value.NewBoolean(false),
+			value.NewBoolean(true),
+		},
+	},
+	{
+		Name: "created",
+		Result: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC).In(cmd.GetLocation())),
+			value.NewDatetime(time.Date(2012, 2, 4, 9, 18, 15, 123000000, time.UTC).In(cmd.GetLocation())),
+			value.NewDatetime(time.Date(2012, 2, 5, 9, 18, 15, 0, time.UTC).In(cmd.GetLocation())),
+		},
+	},
+	{
+		Name: "day",
+		Result: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, cmd.GetLocation())),
+			value.NewDatetime(time.Date(2012, 2, 4, 0, 0, 0, 0, cmd.GetLocation())),
+			value.NewDatetime(time.Date(2012, 2, 5, 0, 0, 0, 0, cmd.GetLocation())),
+		},
+	},
+}
+
+func TestReader_ReadColumn(t *testing.T) {
+	fp := openTestFile(t)
+	defer func() { _ = fp.Close() }()
+
+	r, err := NewReader(fp)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	for i, v := range readerReadColumnTests {
+		result, err := r.ReadColumn(r.Columns()[i])
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func readTestFile(t *testing.T) []byte {
+	fp := openTestFile(t)
+	defer func() { _ = fp.Close() }()
+
+	src, err := ioutil.ReadAll(fp)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	return src
+}
+
+// readAll reads all of the columns in src, and returns an error instead of panicking.
+func readAll(src []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	r, err := NewReader(bytes.NewReader(src))
+	if err != nil {
+		return err
+	}
+	for _, c := range r.Columns() {
+		if _, err = r.ReadColumn(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestReader_TruncatedFile(t *testing.T) {
+	src := readTestFile(t)
+
+	for i := 0; i < len(src); i++ {
+		if err := readAll(src[:i]); err == nil {
+			t.Errorf("no error with %d bytes, want error", i)
+		} else if strings.HasPrefix(err.Error(), "panic") {
+			t.Errorf("%s with %d bytes", err, i)
+		}
+	}
+
+	// Data pages are truncated, and the file metadata is kept.
+	// Column chunks then overlap the metadata, so reading them does not always fail.
+	metaLen := int(binary.LittleEndian.Uint32(src[len(src)-footerLength:]))
+	tail := src[len(src)-footerLength-metaLen:]
+	for i := len(magic); i < len(src)-len(tail); i++ {
+		truncated := append(append([]byte{}, src[:i]...), tail...)
+		if err := readAll(truncated); err != nil && strings.HasPrefix(err.Error(), "panic") {
+			t.Errorf("%s with data pages truncated at %d", err, i)
+		}
+	}
+}
+
+func TestReader_CorruptedFile(t *testing.T) {
+	src := readTestFile(t)
+
+	for i := len(magic); i < len(src)-footerLength; i++ {
+		for _, b := range []byte{0x00, 0x01, 0x7f, 0x80, 0xff} {
+			corrupted := append([]byte{}, src...)
+			corrupted[i] = b
+			if err := readAll(corrupted); err != nil && strings.HasPrefix(err.Error(), "panic") {
+				t.Errorf("%s with byte %d replaced by %#x", err, i, b)
+			}
+		}
+	}
+}
+
+func TestReadPageHeader_NegativeNumberOfValues(t *testing.T) {
+	// type DATA_PAGE, uncompressed size 0, compressed size 0, data page header with num_values -1
+	data := []byte{0x15, 0x00, 0x15, 0x00, 0x15, 0x00, 0x2c, 0x15, 0x01, 0x00, 0x00}
+	expect := "invalid page header: negative number of values"
+
+	_, err := readPageHeader(newThriftReader(data))
+	if err == nil {
+		t.Fatalf("no error, want error %q", expect)
+	}
+	if err.Error() != expect {
+		t.Errorf("error %q, want error %q", err.Error(), expect)
+	}
+}
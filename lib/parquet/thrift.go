@@ -0,0 +1,243 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Element types of the Thrift Compact Protocol.
+const (
+	thriftStop         = 0
+	thriftBooleanTrue  = 1
+	thriftBooleanFalse = 2
+	thriftByte         = 3
+	thriftI16          = 4
+	thriftI32          = 5
+	thriftI64          = 6
+	thriftDouble       = 7
+	thriftBinary       = 8
+	thriftList         = 9
+	thriftSet          = 10
+	thriftMap          = 11
+	thriftStruct       = 12
+)
+
+const thriftMaxNestingDepth = 64
+
+var errThriftUnexpectedEOF = errors.New("unexpected end of metadata")
+
+// thriftReader decodes structures serialized with the Thrift Compact Protocol,
+// that is used to serialize the metadata and the page headers of Parquet files.
+type thriftReader struct {
+	buf   []byte
+	pos   int
+	depth int
+}
+
+func newThriftReader(buf []byte) *thriftReader {
+	return &thriftReader{
+		buf: buf,
+	}
+}
+
+func (r *thriftReader) readByte() (byte, error) {
+	if len(r.buf) <= r.pos {
+		return 0, errThriftUnexpectedEOF
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *thriftReader) readUvarint() (uint64, error) {
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	if n <= 0 {
+		return 0, errThriftUnexpectedEOF
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *thriftReader) readVarint() (int64, error) {
+	v, err := r.readUvarint()
+	if err != nil {
+		return 0, err
+	}
+	return int64(v>>1) ^ -int64(v&1), nil
+}
+
+func (r *thriftReader) readI32() (int32, error) {
+	v, err := r.readVarint()
+	return int32(v), err
+}
+
+func (r *thriftReader) readI64() (int64, error) {
+	return r.readVarint()
+}
+
+func (r *thriftReader) readDouble() (float64, error) {
+	if len(r.buf) < r.pos+8 {
+		return 0, errThriftUnexpectedEOF
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(r.buf[r.pos:]))
+	r.pos += 8
+	return v, nil
+}
+
+func (r *thriftReader) readBinary() ([]byte, error) {
+	l, err := r.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(r.buf)-r.pos) < l {
+		return nil, errThriftUnexpectedEOF
+	}
+	b := r.buf[r.pos : r.pos+int(l)]
+	r.pos += int(l)
+	return b, nil
+}
+
+func (r *thriftReader) readString() (string, error) {
+	b, err := r.readBinary()
+	return string(b), err
+}
+
+// readListHeader returns the element type and the number of the elements of a list or a set.
+func (r *thriftReader) readListHeader() (byte, int, error) {
+	b, err := r.readByte()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	size := int(b >> 4)
+	if size == 15 {
+		l, err := r.readUvarint()
+		if err != nil {
+			return 0, 0, err
+		}
+		if uint64(len(r.buf)-r.pos) < l {
+			return 0, 0, errThriftUnexpectedEOF
+		}
+		size = int(l)
+	}
+	return b & 0x0f, size, nil
+}
+
+// readList calls fn for each element of a list.
+func (r *thriftReader) readList(fn func(elemType byte) error) error {
+	elemType, size, err := r.readListHeader()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < size; i++ {
+		if err = fn(elemType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readStruct calls fn for each field of a structure with the field id and the field type.
+// Fields that are not processed by fn must be skipped by calling skip.
+func (r *thriftReader) readStruct(fn func(id int16, fieldType byte) error) error {
+	r.depth++
+	if thriftMaxNestingDepth < r.depth {
+		return errors.New("metadata is nested too deeply")
+	}
+	defer func() { r.depth-- }()
+
+	var lastId int16
+	for {
+		b, err := r.readByte()
+		if err != nil {
+			return err
+		}
+
+		fieldType := b & 0x0f
+		if fieldType == thriftStop {
+			return nil
+		}
+
+		var id int16
+		if delta := int16(b >> 4); delta != 0 {
+			id = lastId + delta
+		} else {
+			v, err := r.readVarint()
+			if err != nil {
+				return err
+			}
+			id = int16(v)
+		}
+		lastId = id
+
+		if err = fn(id, fieldType); err != nil {
+			return err
+		}
+	}
+}
+
+// readBool returns the value of a boolean field.
+// In the Compact Protocol, the value of a boolean field is held in the field type,
+// and the value of a boolean element of a list is held in a byte.
+func (r *thriftReader) readBool(fieldType byte) (bool, error) {
+	switch fieldType {
+	case thriftBooleanTrue:
+		return true, nil
+	case thriftBooleanFalse:
+		return false, nil
+	}
+
+	b, err := r.readByte()
+	return b == thriftBooleanTrue, err
+}
+
+func (r *thriftReader) skip(fieldType byte) error {
+	var err error
+
+	switch fieldType {
+	case thriftBooleanTrue, thriftBooleanFalse:
+	case thriftByte:
+		_, err = r.readByte()
+	case thriftI16, thriftI32, thriftI64:
+		_, err = r.readVarint()
+	case thriftDouble:
+		_, err = r.readDouble()
+	case thriftBinary:
+		_, err = r.readBinary()
+	case thriftList, thriftSet:
+		err = r.readList(func(elemType byte) error {
+			if elemType == thriftBooleanTrue || elemType == thriftBooleanFalse {
+				_, err := r.readByte()
+				return err
+			}
+			return r.skip(elemType)
+		})
+	case thriftMap:
+		var size uint64
+		if size, err = r.readUvarint(); err != nil || size == 0 {
+			break
+		}
+		var types byte
+		if types, err = r.readByte(); err != nil {
+			break
+		}
+		for i := uint64(0); i < size; i++ {
+			if err = r.skip(types >> 4); err != nil {
+				break
+			}
+			if err = r.skip(types & 0x0f); err != nil {
+				break
+			}
+		}
+	case thriftStruct:
+		err = r.readStruct(func(_ int16, t byte) error {
+			return r.skip(t)
+		})
+	default:
+		err = errors.New(fmt.Sprintf("unknown thrift type %d", fieldType))
+	}
+
+	return err
+}
@@ -252,6 +252,7 @@ type SelectQuery struct {
 	OrderByClause QueryExpression
 	LimitClause   QueryExpression
 	Context       Token
+	OutfileClause QueryExpression
 }
 
 func (e SelectQuery) IsForUpdate() bool {
@@ -273,6 +274,9 @@ func (e SelectQuery) String() string {
 	if e.IsForUpdate() {
 		s = append(s, keyword(FOR), e.Context.String())
 	}
+	if e.OutfileClause != nil {
+		s = append(s, e.OutfileClause.String())
+	}
 	return joinWithSpace(s)
 }
 
@@ -355,6 +359,30 @@ func (e IntoClause) String() string {
 	return joinWithSpace([]string{keyword(INTO), listQueryExpressions(vars)})
 }
 
+type OutfileClause struct {
+	*BaseExpr
+	Path    QueryExpression
+	Options []QueryExpression
+}
+
+func (e OutfileClause) String() string {
+	s := []string{keyword(INTO), keyword(OUTFILE), e.Path.String()}
+	for _, v := range e.Options {
+		s = append(s, v.String())
+	}
+	return joinWithSpace(s)
+}
+
+type OutfileOption struct {
+	*BaseExpr
+	Name  Identifier
+	Value QueryExpression
+}
+
+func (e OutfileOption) String() string {
+	return joinWithSpace([]string{e.Name.String(), e.Value.String()})
+}
+
 type FromClause struct {
 	*BaseExpr
 	Tables []QueryExpression
@@ -673,6 +701,26 @@ func (l Like) String() string {
 	return joinWithSpace(s)
 }
 
+type RegExp struct {
+	*BaseExpr
+	LHS      QueryExpression
+	Pattern  QueryExpression
+	Negation Token
+}
+
+func (e RegExp) IsNegated() bool {
+	return !e.Negation.IsEmpty()
+}
+
+func (e RegExp) String() string {
+	s := []string{e.LHS.String()}
+	if e.IsNegated() {
+		s = append(s, e.Negation.String())
+	}
+	s = append(s, keyword(REGEXP), e.Pattern.String())
+	return joinWithSpace(s)
+}
+
 type Exists struct {
 	*BaseExpr
 	Query Subquery
@@ -1267,6 +1315,21 @@ type CreateTable struct {
 	Query  QueryExpression
 }
 
+type ColumnDefinition struct {
+	*BaseExpr
+	Column  Identifier
+	Type    Identifier
+	NotNull bool
+}
+
+func (e ColumnDefinition) String() string {
+	s := []string{e.Column.String(), e.Type.String()}
+	if e.NotNull {
+		s = append(s, keyword(NOT), keyword(NULL))
+	}
+	return joinWithSpace(s)
+}
+
 type AddColumns struct {
 	*BaseExpr
 	Table    QueryExpression
@@ -1366,6 +1429,12 @@ type Reload struct {
 	Type Identifier
 }
 
+type Restore struct {
+	*BaseExpr
+	Table   QueryExpression
+	Version QueryExpression
+}
+
 type Execute struct {
 	*BaseExpr
 	Statements QueryExpression
@@ -1377,6 +1446,16 @@ type Syntax struct {
 	Keywords []QueryExpression
 }
 
+type Explain struct {
+	*BaseExpr
+	Analyze Token
+	Query   SelectQuery
+}
+
+func (e Explain) IsAnalyze() bool {
+	return !e.Analyze.IsEmpty()
+}
+
 type SetFlag struct {
 	*BaseExpr
 	Flag  Flag
@@ -1411,6 +1490,11 @@ type ShowFields struct {
 	Table QueryExpression
 }
 
+type ShowVersions struct {
+	*BaseExpr
+	Table QueryExpression
+}
+
 type If struct {
 	*BaseExpr
 	Condition  QueryExpression
@@ -418,6 +418,26 @@ func TestIntoClause_String(t *testing.T) {
 	}
 }
 
+func TestOutfileClause_String(t *testing.T) {
+	e := OutfileClause{
+		Path: NewStringValue("out.csv"),
+		Options: []QueryExpression{
+			OutfileOption{
+				Name:  Identifier{Literal: "format"},
+				Value: Identifier{Literal: "tsv"},
+			},
+			OutfileOption{
+				Name:  Identifier{Literal: "without"},
+				Value: Identifier{Literal: "header"},
+			},
+		},
+	}
+	expect := "INTO OUTFILE 'out.csv' format tsv without header"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestFromClause_String(t *testing.T) {
 	e := FromClause{
 		Tables: []QueryExpression{
@@ -904,6 +924,30 @@ func TestLike_String(t *testing.T) {
 	}
 }
 
+func TestRegExp_IsNegated(t *testing.T) {
+	e := RegExp{}
+	if e.IsNegated() == true {
+		t.Errorf("negation = %t, want %t for %#v", e.IsNegated(), false, e)
+	}
+
+	e = RegExp{Negation: Token{Token: NOT, Literal: "not"}}
+	if e.IsNegated() == false {
+		t.Errorf("negation = %t, want %t for %#v", e.IsNegated(), true, e)
+	}
+}
+
+func TestRegExp_String(t *testing.T) {
+	e := RegExp{
+		LHS:      Identifier{Literal: "column"},
+		Pattern:  NewStringValue("^pattern$"),
+		Negation: Token{Token: NOT, Literal: "not"},
+	}
+	expect := "column NOT REGEXP '^pattern$'"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestExists_String(t *testing.T) {
 	e := Exists{
 		Query: Subquery{
@@ -1675,3 +1719,24 @@ func TestCursorAttrebute_String(t *testing.T) {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
 }
+
+func TestColumnDefinition_String(t *testing.T) {
+	e := ColumnDefinition{
+		Column:  Identifier{Literal: "id"},
+		Type:    Identifier{Literal: "integer"},
+		NotNull: true,
+	}
+	expect := "id integer NOT NULL"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = ColumnDefinition{
+		Column: Identifier{Literal: "price"},
+		Type:   Identifier{Literal: "float"},
+	}
+	expect = "price float"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
@@ -12,7 +13,8 @@ func FormatTableName(s string) string {
 	if len(s) < 1 {
 		return ""
 	}
-	return strings.TrimSuffix(filepath.Base(s), filepath.Ext(s))
+	s = file.TrimCompressionExt(filepath.Base(s))
+	return strings.TrimSuffix(s, filepath.Ext(s))
 }
 
 func FormatFieldIdentifier(e QueryExpression) string {
@@ -117,87 +117,100 @@ const OR = 57415
 const NOT = 57416
 const BETWEEN = 57417
 const LIKE = 57418
-const IS = 57419
-const NULL = 57420
-const DISTINCT = 57421
-const WITH = 57422
-const RANGE = 57423
-const UNBOUNDED = 57424
-const PRECEDING = 57425
-const FOLLOWING = 57426
-const CURRENT = 57427
-const ROW = 57428
-const CASE = 57429
-const IF = 57430
-const ELSEIF = 57431
-const WHILE = 57432
-const WHEN = 57433
-const THEN = 57434
-const ELSE = 57435
-const DO = 57436
-const END = 57437
-const DECLARE = 57438
-const CURSOR = 57439
-const FOR = 57440
-const FETCH = 57441
-const OPEN = 57442
-const CLOSE = 57443
-const DISPOSE = 57444
-const PREPARE = 57445
-const NEXT = 57446
-const PRIOR = 57447
-const ABSOLUTE = 57448
-const RELATIVE = 57449
-const SEPARATOR = 57450
-const PARTITION = 57451
-const OVER = 57452
-const COMMIT = 57453
-const ROLLBACK = 57454
-const CONTINUE = 57455
-const BREAK = 57456
-const EXIT = 57457
-const ECHO = 57458
-const PRINT = 57459
-const PRINTF = 57460
-const SOURCE = 57461
-const EXECUTE = 57462
-const CHDIR = 57463
-const PWD = 57464
-const RELOAD = 57465
-const REMOVE = 57466
-const SYNTAX = 57467
-const TRIGGER = 57468
-const FUNCTION = 57469
-const AGGREGATE = 57470
-const BEGIN = 57471
-const RETURN = 57472
-const IGNORE = 57473
-const WITHIN = 57474
-const VAR = 57475
-const SHOW = 57476
-const TIES = 57477
-const NULLS = 57478
-const ROWS = 57479
-const ONLY = 57480
-const CSV = 57481
-const JSON = 57482
-const FIXED = 57483
-const LTSV = 57484
-const JSON_ROW = 57485
-const JSON_TABLE = 57486
-const SUBSTRING = 57487
-const COUNT = 57488
-const JSON_OBJECT = 57489
-const AGGREGATE_FUNCTION = 57490
-const LIST_FUNCTION = 57491
-const ANALYTIC_FUNCTION = 57492
-const FUNCTION_NTH = 57493
-const FUNCTION_WITH_INS = 57494
-const COMPARISON_OP = 57495
-const STRING_OP = 57496
-const SUBSTITUTION_OP = 57497
-const UMINUS = 57498
-const UPLUS = 57499
+const REGEXP = 57419
+const RLIKE = 57420
+const IS = 57421
+const NULL = 57422
+const DISTINCT = 57423
+const WITH = 57424
+const RANGE = 57425
+const UNBOUNDED = 57426
+const PRECEDING = 57427
+const FOLLOWING = 57428
+const CURRENT = 57429
+const ROW = 57430
+const CASE = 57431
+const IF = 57432
+const ELSEIF = 57433
+const WHILE = 57434
+const WHEN = 57435
+const THEN = 57436
+const ELSE = 57437
+const DO = 57438
+const END = 57439
+const DECLARE = 57440
+const CURSOR = 57441
+const FOR = 57442
+const FETCH = 57443
+const OPEN = 57444
+const CLOSE = 57445
+const DISPOSE = 57446
+const PREPARE = 57447
+const NEXT = 57448
+const PRIOR = 57449
+const ABSOLUTE = 57450
+const RELATIVE = 57451
+const SEPARATOR = 57452
+const PARTITION = 57453
+const OVER = 57454
+const COMMIT = 57455
+const ROLLBACK = 57456
+const CONTINUE = 57457
+const BREAK = 57458
+const EXIT = 57459
+const ECHO = 57460
+const PRINT = 57461
+const PRINTF = 57462
+const SOURCE = 57463
+const EXECUTE = 57464
+const CHDIR = 57465
+const PWD = 57466
+const RELOAD = 57467
+const REMOVE = 57468
+const SYNTAX = 57469
+const TRIGGER = 57470
+const RESTORE = 57471
+const FUNCTION = 57472
+const AGGREGATE = 57473
+const BEGIN = 57474
+const RETURN = 57475
+const IGNORE = 57476
+const WITHIN = 57477
+const VAR = 57478
+const SHOW = 57479
+const EXPLAIN = 57480
+const ANALYZE = 57481
+const TIES = 57482
+const NULLS = 57483
+const ROWS = 57484
+const ONLY = 57485
+const OUTFILE = 57486
+const VERSION = 57487
+const VERSIONS = 57488
+const OF = 57489
+const CSV = 57490
+const JSON = 57491
+const FIXED = 57492
+const LTSV = 57493
+const JSONL = 57494
+const XLSX = 57495
+const YAML = 57496
+const XML = 57497
+const JSON_ROW = 57498
+const JSON_TABLE = 57499
+const SUBSTRING = 57500
+const COUNT = 57501
+const JSON_OBJECT = 57502
+const AGGREGATE_FUNCTION = 57503
+const LIST_FUNCTION = 57504
+const ANALYTIC_FUNCTION = 57505
+const FUNCTION_NTH = 57506
+const FUNCTION_WITH_INS = 57507
+const COMPARISON_OP = 57508
+const STRING_OP = 57509
+const SUBSTITUTION_OP = 57510
+const UMINUS = 57511
+const UPLUS = 57512
 
 var yyToknames = [...]string{
 	"$end",
@@ -276,6 +289,8 @@ var yyToknames = [...]string{
 	"NOT",
 	"BETWEEN",
 	"LIKE",
+	"REGEXP",
+	"RLIKE",
 	"IS",
 	"NULL",
 	"DISTINCT",
@@ -326,6 +341,7 @@ var yyToknames = [...]string{
 	"REMOVE",
 	"SYNTAX",
 	"TRIGGER",
+	"RESTORE",
 	"FUNCTION",
 	"AGGREGATE",
 	"BEGIN",
@@ -334,14 +350,24 @@ var yyToknames = [...]string{
 	"WITHIN",
 	"VAR",
 	"SHOW",
+	"EXPLAIN",
+	"ANALYZE",
 	"TIES",
 	"NULLS",
 	"ROWS",
 	"ONLY",
+	"OUTFILE",
+	"VERSION",
+	"VERSIONS",
+	"OF",
 	"CSV",
 	"JSON",
 	"FIXED",
 	"LTSV",
+	"JSONL",
+	"XLSX",
+	"YAML",
+	"XML",
 	"JSON_ROW",
 	"JSON_TABLE",
 	"SUBSTRING",
@@ -370,13 +396,14 @@ var yyToknames = [...]string{
 	"','",
 	"'.'",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line parser.y:2714
+//line parser.y:2881
 
 func SetDebugLevel(level int, verbose bool) {
 	yyDebug = level
@@ -391,1119 +418,1314 @@ func Parse(s string, sourceFile string, datetimeFormats []string, forPrepared bo
 }
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int16{
 	-1, 0,
 	1, 1,
-	-2, 216,
+	-2, 234,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 21,
-	1, 26,
-	89, 26,
-	91, 26,
-	93, 26,
-	95, 26,
-	158, 26,
-	-2, 236,
-	-1, 33,
-	1, 78,
-	89, 78,
-	91, 78,
-	93, 78,
-	95, 78,
-	158, 78,
-	-2, 248,
-	-1, 113,
-	17, 216,
-	19, 216,
-	22, 216,
-	24, 216,
-	-2, 1,
-	-1, 115,
-	167, 307,
-	-2, 216,
+	-1, 22,
+	1, 27,
+	91, 27,
+	93, 27,
+	95, 27,
+	97, 27,
+	171, 27,
+	-2, 254,
+	-1, 34,
+	1, 79,
+	91, 79,
+	93, 79,
+	95, 79,
+	97, 79,
+	171, 79,
+	-2, 266,
 	-1, 124,
-	65, 184,
-	66, 184,
-	67, 184,
-	-2, 196,
-	-1, 162,
-	1, 122,
-	89, 122,
-	91, 122,
-	93, 122,
-	95, 122,
-	158, 122,
-	-2, 230,
-	-1, 163,
-	1, 163,
-	89, 163,
-	91, 163,
-	93, 163,
-	95, 163,
-	158, 163,
-	-2, 236,
-	-1, 168,
-	1, 156,
-	89, 156,
-	91, 156,
-	93, 156,
-	95, 156,
-	158, 156,
-	-2, 236,
-	-1, 169,
-	1, 157,
-	89, 157,
-	91, 157,
-	93, 157,
-	95, 157,
-	158, 157,
-	-2, 236,
-	-1, 170,
-	1, 158,
-	89, 158,
-	91, 158,
-	93, 158,
-	95, 158,
-	158, 158,
-	-2, 236,
-	-1, 171,
-	1, 161,
-	89, 161,
-	91, 161,
-	93, 161,
-	95, 161,
-	158, 161,
-	-2, 230,
-	-1, 172,
-	1, 162,
-	89, 162,
-	91, 162,
-	93, 162,
-	95, 162,
-	158, 162,
-	-2, 236,
+	17, 234,
+	19, 234,
+	22, 234,
+	24, 234,
+	-2, 1,
+	-1, 128,
+	180, 327,
+	-2, 234,
+	-1, 137,
+	65, 196,
+	66, 196,
+	67, 196,
+	-2, 214,
 	-1, 175,
+	1, 128,
+	91, 128,
+	93, 128,
+	95, 128,
+	97, 128,
+	171, 128,
+	-2, 248,
+	-1, 176,
 	1, 169,
-	89, 169,
 	91, 169,
 	93, 169,
 	95, 169,
-	158, 169,
-	-2, 230,
-	-1, 176,
-	1, 170,
-	89, 170,
-	91, 170,
-	93, 170,
-	95, 170,
-	158, 170,
-	-2, 236,
-	-1, 233,
-	89, 1,
-	93, 1,
+	97, 169,
+	171, 169,
+	-2, 254,
+	-1, 182,
+	1, 162,
+	91, 162,
+	93, 162,
+	95, 162,
+	97, 162,
+	171, 162,
+	-2, 254,
+	-1, 183,
+	1, 163,
+	91, 163,
+	93, 163,
+	95, 163,
+	97, 163,
+	171, 163,
+	-2, 254,
+	-1, 184,
+	1, 164,
+	91, 164,
+	93, 164,
+	95, 164,
+	97, 164,
+	171, 164,
+	-2, 254,
+	-1, 185,
+	1, 167,
+	91, 167,
+	93, 167,
+	95, 167,
+	97, 167,
+	171, 167,
+	-2, 248,
+	-1, 186,
+	1, 168,
+	91, 168,
+	93, 168,
+	95, 168,
+	97, 168,
+	171, 168,
+	-2, 254,
+	-1, 192,
+	1, 178,
+	91, 178,
+	93, 178,
+	95, 178,
+	97, 178,
+	171, 178,
+	-2, 248,
+	-1, 193,
+	1, 179,
+	91, 179,
+	93, 179,
+	95, 179,
+	97, 179,
+	171, 179,
+	-2, 254,
+	-1, 201,
+	179, 376,
+	-2, 505,
+	-1, 202,
+	179, 377,
+	-2, 506,
+	-1, 203,
+	179, 378,
+	-2, 507,
+	-1, 204,
+	179, 379,
+	-2, 508,
+	-1, 205,
+	179, 380,
+	-2, 509,
+	-1, 206,
+	179, 381,
+	-2, 510,
+	-1, 207,
+	179, 382,
+	-2, 511,
+	-1, 208,
+	179, 383,
+	-2, 512,
+	-1, 267,
+	91, 1,
 	95, 1,
-	-2, 216,
-	-1, 255,
-	166, 356,
-	-2, 477,
-	-1, 256,
-	166, 357,
-	-2, 478,
-	-1, 257,
-	166, 358,
-	-2, 479,
-	-1, 258,
-	166, 359,
-	-2, 480,
-	-1, 290,
-	4, 144,
-	135, 144,
-	136, 144,
-	137, 144,
-	139, 144,
-	140, 144,
-	141, 144,
-	142, 144,
-	-2, 236,
-	-1, 291,
-	4, 145,
-	135, 145,
-	136, 145,
-	137, 145,
-	139, 145,
-	140, 145,
-	141, 145,
-	142, 145,
-	-2, 236,
-	-1, 301,
-	1, 174,
-	89, 174,
-	91, 174,
-	93, 174,
-	95, 174,
-	158, 174,
-	-2, 236,
-	-1, 309,
-	95, 4,
-	-2, 216,
-	-1, 318,
+	97, 1,
+	-2, 234,
+	-1, 316,
+	4, 150,
+	140, 150,
+	141, 150,
+	142, 150,
+	144, 150,
+	145, 150,
+	146, 150,
+	147, 150,
+	148, 150,
+	149, 150,
+	150, 150,
+	151, 150,
+	152, 150,
+	153, 150,
+	154, 150,
+	155, 150,
+	-2, 254,
+	-1, 317,
+	4, 151,
+	140, 151,
+	141, 151,
+	142, 151,
+	144, 151,
+	145, 151,
+	146, 151,
+	147, 151,
+	148, 151,
+	149, 151,
+	150, 151,
+	151, 151,
+	152, 151,
+	153, 151,
+	154, 151,
+	155, 151,
+	-2, 254,
+	-1, 332,
+	1, 184,
+	91, 184,
+	93, 184,
+	95, 184,
+	97, 184,
+	171, 184,
+	-2, 254,
+	-1, 339,
+	97, 4,
+	-2, 234,
+	-1, 348,
 	71, 0,
 	75, 0,
 	76, 0,
 	77, 0,
-	153, 0,
-	159, 0,
-	-2, 277,
-	-1, 319,
+	78, 0,
+	79, 0,
+	166, 0,
+	172, 0,
+	-2, 295,
+	-1, 349,
 	71, 0,
 	75, 0,
 	76, 0,
 	77, 0,
-	153, 0,
-	159, 0,
-	-2, 279,
-	-1, 328,
+	78, 0,
+	79, 0,
+	166, 0,
+	172, 0,
+	-2, 297,
+	-1, 359,
 	71, 0,
 	75, 0,
 	76, 0,
 	77, 0,
-	153, 0,
-	159, 0,
-	-2, 289,
-	-1, 378,
-	95, 1,
-	-2, 216,
-	-1, 394,
-	54, 496,
-	-2, 413,
-	-1, 434,
-	1, 80,
-	89, 80,
-	91, 80,
-	93, 80,
-	95, 80,
-	158, 80,
-	-2, 236,
-	-1, 435,
+	78, 0,
+	79, 0,
+	166, 0,
+	172, 0,
+	-2, 307,
+	-1, 360,
+	71, 0,
+	75, 0,
+	76, 0,
+	77, 0,
+	78, 0,
+	79, 0,
+	166, 0,
+	172, 0,
+	-2, 309,
+	-1, 406,
+	1, 202,
+	91, 202,
+	93, 202,
+	95, 202,
+	97, 202,
+	171, 202,
+	-2, 248,
+	-1, 407,
+	1, 202,
+	91, 202,
+	93, 202,
+	95, 202,
+	97, 202,
+	171, 202,
+	-2, 254,
+	-1, 412,
+	97, 1,
+	-2, 234,
+	-1, 428,
+	54, 528,
+	-2, 437,
+	-1, 467,
 	1, 81,
-	89, 81,
 	91, 81,
 	93, 81,
 	95, 81,
-	158, 81,
-	-2, 230,
-	-1, 436,
+	97, 81,
+	171, 81,
+	-2, 254,
+	-1, 468,
 	1, 82,
-	89, 82,
 	91, 82,
 	93, 82,
 	95, 82,
-	158, 82,
-	-2, 236,
-	-1, 437,
+	97, 82,
+	171, 82,
+	-2, 248,
+	-1, 469,
 	1, 83,
-	89, 83,
 	91, 83,
 	93, 83,
 	95, 83,
-	158, 83,
-	-2, 230,
-	-1, 438,
-	1, 149,
-	89, 149,
-	91, 149,
-	93, 149,
-	95, 149,
-	158, 149,
-	-2, 230,
-	-1, 439,
-	1, 150,
-	89, 150,
-	91, 150,
-	93, 150,
-	95, 150,
-	158, 150,
-	-2, 236,
-	-1, 440,
-	1, 151,
-	89, 151,
-	91, 151,
-	93, 151,
-	95, 151,
-	158, 151,
-	-2, 230,
-	-1, 441,
-	1, 152,
-	89, 152,
-	91, 152,
-	93, 152,
-	95, 152,
-	158, 152,
-	-2, 236,
-	-1, 444,
-	1, 117,
-	89, 117,
-	91, 117,
-	93, 117,
-	95, 117,
-	158, 117,
-	168, 117,
-	-2, 236,
-	-1, 449,
-	1, 411,
-	89, 411,
-	91, 411,
-	93, 411,
-	95, 411,
-	158, 411,
-	-2, 236,
-	-1, 456,
-	1, 175,
-	89, 175,
-	91, 175,
-	93, 175,
-	95, 175,
-	158, 175,
-	-2, 236,
-	-1, 481,
+	97, 83,
+	171, 83,
+	-2, 254,
+	-1, 470,
+	1, 84,
+	91, 84,
+	93, 84,
+	95, 84,
+	97, 84,
+	171, 84,
+	-2, 248,
+	-1, 471,
+	1, 155,
+	91, 155,
+	93, 155,
+	95, 155,
+	97, 155,
+	171, 155,
+	-2, 248,
+	-1, 472,
+	1, 156,
+	91, 156,
+	93, 156,
+	95, 156,
+	97, 156,
+	171, 156,
+	-2, 254,
+	-1, 473,
+	1, 157,
+	91, 157,
+	93, 157,
+	95, 157,
+	97, 157,
+	171, 157,
+	-2, 248,
+	-1, 474,
+	1, 158,
+	91, 158,
+	93, 158,
+	95, 158,
+	97, 158,
+	171, 158,
+	-2, 254,
+	-1, 477,
+	1, 123,
+	91, 123,
+	93, 123,
+	95, 123,
+	97, 123,
+	171, 123,
+	181, 123,
+	-2, 254,
+	-1, 482,
+	1, 435,
+	91, 435,
+	93, 435,
+	95, 435,
+	97, 435,
+	171, 435,
+	-2, 254,
+	-1, 493,
+	180, 374,
+	181, 374,
+	-2, 248,
+	-1, 495,
+	1, 185,
+	91, 185,
+	93, 185,
+	95, 185,
+	97, 185,
+	171, 185,
+	-2, 254,
+	-1, 520,
 	71, 0,
 	75, 0,
 	76, 0,
 	77, 0,
-	153, 0,
-	159, 0,
-	-2, 290,
-	-1, 514,
-	95, 1,
-	-2, 216,
+	78, 0,
+	79, 0,
+	166, 0,
+	172, 0,
+	-2, 308,
 	-1, 521,
-	91, 1,
+	71, 0,
+	75, 0,
+	76, 0,
+	77, 0,
+	78, 0,
+	79, 0,
+	166, 0,
+	172, 0,
+	-2, 310,
+	-1, 558,
+	97, 1,
+	-2, 234,
+	-1, 565,
 	93, 1,
 	95, 1,
-	-2, 216,
-	-1, 524,
-	1, 206,
-	52, 206,
-	80, 206,
-	89, 206,
-	91, 206,
-	93, 206,
-	95, 206,
-	98, 206,
-	138, 206,
-	158, 206,
-	167, 206,
-	-2, 236,
-	-1, 525,
-	1, 211,
-	89, 211,
-	91, 211,
-	93, 211,
-	95, 211,
-	98, 211,
-	99, 211,
-	158, 211,
-	167, 211,
-	-2, 236,
-	-1, 560,
-	167, 354,
-	168, 354,
-	-2, 230,
-	-1, 602,
-	89, 4,
+	97, 1,
+	-2, 234,
+	-1, 568,
+	1, 224,
+	25, 224,
+	52, 224,
+	82, 224,
+	91, 224,
+	93, 224,
+	95, 224,
+	97, 224,
+	100, 224,
+	143, 224,
+	171, 224,
+	180, 224,
+	-2, 254,
+	-1, 569,
+	1, 229,
+	25, 229,
+	91, 229,
+	93, 229,
+	95, 229,
+	97, 229,
+	100, 229,
+	101, 229,
+	171, 229,
+	180, 229,
+	-2, 254,
+	-1, 643,
+	1, 182,
+	91, 182,
+	93, 182,
+	95, 182,
+	97, 182,
+	171, 182,
+	-2, 254,
+	-1, 648,
 	91, 4,
 	93, 4,
 	95, 4,
-	-2, 216,
-	-1, 605,
-	95, 4,
-	-2, 216,
-	-1, 606,
-	95, 4,
-	-2, 216,
-	-1, 671,
-	54, 496,
-	-2, 372,
-	-1, 692,
-	17, 507,
-	80, 507,
-	166, 507,
-	-2, 87,
-	-1, 718,
-	89, 4,
-	93, 4,
-	95, 4,
-	-2, 216,
-	-1, 723,
-	95, 4,
-	-2, 216,
-	-1, 724,
+	97, 4,
+	-2, 234,
+	-1, 651,
+	97, 4,
+	-2, 234,
+	-1, 652,
+	97, 4,
+	-2, 234,
+	-1, 684,
+	71, 248,
+	72, 248,
+	73, 248,
+	74, 248,
+	75, 248,
+	76, 248,
+	77, 248,
+	78, 248,
+	79, 248,
+	166, 248,
+	167, 248,
+	172, 248,
+	173, 248,
+	174, 248,
+	175, 248,
+	176, 248,
+	177, 248,
+	-2, 204,
+	-1, 685,
+	71, 254,
+	72, 254,
+	73, 254,
+	74, 254,
+	75, 254,
+	76, 254,
+	77, 254,
+	78, 254,
+	79, 254,
+	166, 254,
+	167, 254,
+	172, 254,
+	173, 254,
+	174, 254,
+	175, 254,
+	176, 254,
+	177, 254,
+	-2, 205,
+	-1, 720,
+	54, 528,
+	-2, 396,
+	-1, 738,
+	17, 539,
+	82, 539,
+	179, 539,
+	-2, 88,
+	-1, 768,
+	91, 4,
 	95, 4,
-	-2, 216,
-	-1, 749,
-	89, 1,
-	93, 1,
+	97, 4,
+	-2, 234,
+	-1, 773,
+	97, 4,
+	-2, 234,
+	-1, 774,
+	97, 4,
+	-2, 234,
+	-1, 799,
+	91, 1,
 	95, 1,
-	-2, 216,
-	-1, 792,
-	1, 95,
-	89, 95,
-	91, 95,
-	93, 95,
-	95, 95,
-	158, 95,
-	-2, 230,
-	-1, 793,
+	97, 1,
+	-2, 234,
+	-1, 841,
 	1, 96,
-	89, 96,
 	91, 96,
 	93, 96,
 	95, 96,
-	158, 96,
-	-2, 236,
-	-1, 795,
-	95, 6,
-	-2, 216,
-	-1, 801,
-	167, 128,
-	168, 128,
-	-2, 236,
-	-1, 806,
-	95, 4,
-	-2, 216,
-	-1, 877,
-	95, 6,
-	-2, 216,
-	-1, 878,
-	95, 6,
-	-2, 216,
-	-1, 882,
-	95, 4,
-	-2, 216,
-	-1, 886,
-	91, 4,
+	97, 96,
+	171, 96,
+	-2, 248,
+	-1, 842,
+	1, 97,
+	91, 97,
+	93, 97,
+	95, 97,
+	97, 97,
+	171, 97,
+	-2, 254,
+	-1, 845,
+	97, 6,
+	-2, 234,
+	-1, 851,
+	180, 134,
+	181, 134,
+	-2, 254,
+	-1, 859,
+	97, 4,
+	-2, 234,
+	-1, 928,
+	97, 6,
+	-2, 234,
+	-1, 929,
+	97, 6,
+	-2, 234,
+	-1, 934,
+	97, 4,
+	-2, 234,
+	-1, 938,
 	93, 4,
 	95, 4,
-	-2, 216,
-	-1, 929,
-	89, 6,
+	97, 4,
+	-2, 234,
+	-1, 980,
 	91, 6,
 	93, 6,
 	95, 6,
-	-2, 216,
-	-1, 936,
-	158, 62,
-	-2, 236,
-	-1, 976,
-	89, 6,
-	93, 6,
-	95, 6,
-	-2, 216,
-	-1, 979,
-	95, 8,
-	-2, 216,
-	-1, 986,
+	97, 6,
+	-2, 234,
+	-1, 987,
+	171, 63,
+	-2, 254,
+	-1, 1027,
+	91, 6,
 	95, 6,
-	-2, 216,
-	-1, 989,
-	89, 4,
-	93, 4,
+	97, 6,
+	-2, 234,
+	-1, 1030,
+	97, 8,
+	-2, 234,
+	-1, 1037,
+	97, 6,
+	-2, 234,
+	-1, 1040,
+	91, 4,
 	95, 4,
-	-2, 216,
-	-1, 1016,
-	95, 6,
-	-2, 216,
-	-1, 1049,
-	95, 6,
-	-2, 216,
-	-1, 1053,
-	91, 6,
+	97, 4,
+	-2, 234,
+	-1, 1067,
+	97, 6,
+	-2, 234,
+	-1, 1100,
+	97, 6,
+	-2, 234,
+	-1, 1104,
 	93, 6,
 	95, 6,
-	-2, 216,
-	-1, 1055,
-	89, 8,
+	97, 6,
+	-2, 234,
+	-1, 1106,
 	91, 8,
 	93, 8,
 	95, 8,
-	-2, 216,
-	-1, 1058,
-	95, 8,
-	-2, 216,
-	-1, 1059,
-	95, 8,
-	-2, 216,
-	-1, 1076,
-	89, 8,
-	93, 8,
-	95, 8,
-	-2, 216,
-	-1, 1081,
-	95, 8,
-	-2, 216,
-	-1, 1082,
+	97, 8,
+	-2, 234,
+	-1, 1109,
+	97, 8,
+	-2, 234,
+	-1, 1110,
+	97, 8,
+	-2, 234,
+	-1, 1127,
+	91, 8,
 	95, 8,
-	-2, 216,
-	-1, 1087,
-	89, 6,
-	93, 6,
+	97, 8,
+	-2, 234,
+	-1, 1132,
+	97, 8,
+	-2, 234,
+	-1, 1133,
+	97, 8,
+	-2, 234,
+	-1, 1138,
+	91, 6,
 	95, 6,
-	-2, 216,
-	-1, 1092,
-	95, 8,
-	-2, 216,
-	-1, 1107,
-	95, 8,
-	-2, 216,
-	-1, 1111,
-	91, 8,
+	97, 6,
+	-2, 234,
+	-1, 1143,
+	97, 8,
+	-2, 234,
+	-1, 1158,
+	97, 8,
+	-2, 234,
+	-1, 1162,
 	93, 8,
 	95, 8,
-	-2, 216,
-	-1, 1140,
-	89, 8,
-	93, 8,
+	97, 8,
+	-2, 234,
+	-1, 1191,
+	91, 8,
 	95, 8,
-	-2, 216,
+	97, 8,
+	-2, 234,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 4041
+const yyLast = 4685
 
-var yyAct = [...]int{
-
-	123, 21, 1106, 1105, 1118, 526, 1048, 350, 1077, 719,
-	977, 867, 881, 630, 116, 33, 949, 1047, 994, 951,
-	398, 27, 65, 121, 114, 880, 1025, 950, 754, 699,
-	188, 1024, 269, 574, 383, 670, 572, 840, 694, 464,
-	26, 649, 163, 513, 384, 164, 165, 590, 168, 169,
-	170, 172, 592, 176, 141, 141, 420, 144, 90, 463,
-	25, 389, 1, 553, 593, 250, 187, 666, 661, 239,
-	238, 181, 348, 185, 5, 459, 3, 448, 442, 244,
-	537, 532, 512, 101, 173, 536, 345, 700, 393, 465,
-	261, 248, 184, 503, 400, 186, 80, 192, 222, 266,
-	68, 78, 411, 182, 980, 130, 138, 568, 215, 919,
-	457, 214, 231, 131, 21, 127, 181, 1018, 129, 293,
-	126, 102, 215, 128, 1029, 214, 491, 202, 33, 214,
-	201, 200, 203, 199, 214, 124, 150, 184, 471, 142,
-	310, 856, 857, 241, 237, 183, 849, 166, 234, 711,
-	712, 683, 684, 26, 540, 184, 541, 542, 543, 535,
-	290, 291, 538, 540, 299, 541, 542, 543, 535, 788,
-	771, 538, 770, 25, 196, 742, 232, 709, 708, 301,
-	206, 205, 207, 208, 209, 693, 691, 685, 179, 3,
-	183, 681, 656, 600, 597, 262, 94, 550, 311, 489,
-	410, 311, 206, 205, 207, 208, 209, 405, 183, 197,
-	196, 313, 281, 315, 274, 198, 206, 205, 207, 208,
-	209, 311, 249, 215, 179, 1066, 214, 74, 1065, 1041,
-	270, 1040, 272, 1039, 21, 311, 131, 311, 1038, 273,
-	111, 382, 1037, 1036, 1011, 1010, 1008, 1006, 33, 1004,
-	1003, 74, 103, 104, 105, 314, 106, 107, 108, 109,
-	993, 325, 133, 326, 474, 992, 562, 539, 392, 417,
-	298, 974, 971, 26, 920, 675, 879, 858, 855, 362,
-	363, 821, 820, 578, 94, 434, 436, 439, 441, 444,
-	111, 819, 124, 25, 444, 449, 374, 141, 320, 449,
-	449, 818, 817, 456, 816, 812, 790, 787, 780, 3,
-	21, 779, 391, 326, 431, 341, 772, 741, 360, 361,
-	739, 738, 737, 388, 33, 730, 726, 707, 705, 370,
-	692, 690, 635, 455, 392, 628, 627, 551, 506, 626,
-	613, 584, 403, 488, 486, 484, 421, 416, 184, 408,
-	375, 306, 589, 415, 407, 307, 305, 1007, 135, 182,
-	1005, 504, 133, 958, 957, 956, 955, 413, 414, 954,
-	563, 953, 447, 418, 925, 911, 453, 454, 469, 21,
-	906, 427, 480, 903, 901, 133, 524, 525, 482, 483,
-	900, 893, 452, 33, 891, 530, 450, 451, 862, 686,
-	632, 183, 475, 609, 571, 547, 102, 559, 498, 497,
-	496, 495, 494, 473, 493, 492, 433, 477, 26, 432,
-	102, 184, 476, 502, 406, 184, 207, 208, 209, 139,
-	1055, 397, 253, 134, 501, 236, 230, 229, 25, 139,
-	219, 517, 184, 218, 217, 216, 112, 682, 224, 929,
-	531, 184, 430, 184, 3, 595, 287, 285, 602, 113,
-	507, 508, 603, 275, 587, 179, 672, 509, 392, 1084,
-	564, 368, 558, 654, 183, 904, 262, 756, 552, 902,
-	758, 834, 825, 745, 419, 650, 604, 986, 555, 899,
-	878, 877, 823, 795, 964, 576, 962, 557, 565, 134,
-	249, 566, 573, 826, 585, 102, 588, 580, 582, 610,
-	577, 745, 599, 824, 898, 21, 640, 567, 651, 569,
-	570, 102, 21, 967, 655, 897, 184, 220, 896, 33,
-	397, 253, 277, 221, 895, 755, 33, 103, 104, 105,
-	369, 255, 256, 257, 258, 549, 401, 394, 676, 894,
-	822, 103, 104, 105, 26, 106, 107, 108, 109, 815,
-	673, 26, 952, 631, 678, 917, 102, 646, 399, 652,
-	523, 522, 286, 284, 25, 429, 615, 639, 1139, 183,
-	1125, 25, 581, 634, 643, 276, 1115, 1114, 679, 1109,
-	3, 618, 619, 620, 621, 622, 638, 3, 444, 94,
-	687, 449, 1095, 21, 157, 158, 21, 21, 689, 631,
-	1094, 1086, 633, 660, 1068, 278, 279, 33, 702, 669,
-	33, 33, 1062, 1054, 668, 1107, 1051, 688, 988, 985,
-	984, 184, 146, 680, 647, 573, 103, 104, 105, 940,
-	255, 256, 257, 258, 928, 401, 753, 573, 890, 889,
-	884, 809, 103, 104, 105, 573, 106, 107, 108, 109,
-	808, 748, 757, 637, 530, 573, 601, 399, 518, 516,
-	1082, 155, 156, 159, 160, 1108, 713, 1081, 715, 1107,
-	1132, 1059, 1050, 1058, 725, 145, 1049, 1092, 740, 979,
-	724, 147, 723, 769, 735, 606, 761, 103, 104, 105,
-	605, 106, 107, 108, 109, 793, 883, 309, 102, 1049,
-	882, 801, 750, 717, 751, 148, 721, 722, 1016, 21,
-	882, 807, 260, 778, 21, 21, 515, 784, 782, 759,
-	514, 595, 800, 33, 253, 595, 806, 768, 33, 33,
-	514, 380, 773, 378, 1140, 774, 1111, 1087, 1076, 783,
-	21, 777, 1053, 382, 989, 976, 827, 886, 749, 718,
-	803, 798, 799, 521, 33, 555, 233, 1142, 1089, 1078,
-	573, 991, 852, 797, 978, 573, 752, 720, 838, 376,
-	240, 785, 786, 1131, 839, 1113, 843, 1112, 1074, 26,
-	947, 673, 946, 888, 184, 832, 21, 204, 887, 833,
-	716, 631, 184, 1108, 1050, 184, 850, 21, 883, 25,
-	33, 515, 831, 1146, 1138, 1103, 184, 1085, 865, 1032,
-	987, 33, 874, 830, 747, 3, 864, 873, 1129, 804,
-	1072, 944, 1119, 641, 810, 811, 1137, 1123, 1148, 103,
-	104, 105, 1134, 106, 107, 108, 109, 854, 1119, 1135,
-	1136, 1122, 1121, 1101, 744, 861, 74, 267, 863, 323,
-	1044, 908, 1012, 322, 324, 99, 914, 921, 915, 866,
-	673, 869, 930, 909, 926, 907, 932, 936, 21, 21,
-	184, 912, 913, 21, 943, 918, 923, 21, 223, 937,
-	938, 224, 33, 33, 860, 853, 931, 33, 933, 927,
-	1133, 33, 629, 1030, 874, 874, 935, 941, 1144, 873,
-	873, 1120, 631, 184, 74, 981, 74, 885, 961, 631,
-	960, 472, 1099, 960, 1117, 966, 312, 1120, 959, 1100,
-	21, 963, 1102, 924, 100, 365, 264, 972, 970, 364,
-	74, 975, 934, 412, 33, 968, 573, 546, 74, 74,
-	367, 366, 969, 869, 869, 990, 874, 859, 982, 330,
-	329, 873, 973, 263, 264, 265, 948, 841, 842, 235,
-	997, 998, 999, 1000, 1001, 781, 294, 21, 960, 1017,
-	21, 540, 631, 541, 542, 543, 1002, 21, 1014, 288,
-	21, 33, 807, 942, 33, 667, 184, 945, 1031, 848,
-	767, 33, 983, 874, 33, 869, 766, 665, 873, 573,
-	664, 386, 540, 874, 541, 542, 662, 21, 873, 385,
-	386, 1034, 1042, 1056, 658, 659, 1046, 960, 1052, 1035,
-	996, 33, 663, 184, 387, 1043, 829, 533, 242, 995,
-	1064, 704, 530, 874, 703, 295, 1063, 1057, 873, 1013,
-	21, 1071, 869, 81, 21, 1020, 21, 710, 1067, 21,
-	21, 1070, 869, 701, 33, 1073, 1069, 137, 33, 1026,
-	33, 631, 136, 33, 33, 195, 874, 21, 122, 1093,
-	874, 873, 21, 21, 1088, 873, 1045, 671, 21, 939,
-	1017, 33, 869, 21, 836, 837, 33, 33, 813, 1104,
-	1033, 802, 33, 631, 268, 174, 796, 33, 21, 1128,
-	1126, 1124, 21, 540, 874, 541, 542, 543, 535, 873,
-	794, 538, 33, 66, 180, 869, 33, 421, 706, 869,
-	598, 1020, 490, 308, 1020, 1020, 212, 213, 1145, 1141,
-	425, 21, 445, 1093, 246, 1026, 226, 227, 1026, 1026,
-	1149, 245, 1020, 422, 423, 33, 259, 1020, 1020, 149,
-	151, 125, 424, 869, 102, 247, 1026, 390, 1020, 180,
-	404, 1026, 1026, 1075, 122, 1009, 1079, 1080, 695, 696,
-	697, 698, 1026, 1020, 644, 340, 342, 1020, 174, 397,
-	253, 246, 409, 297, 1090, 296, 292, 1026, 95, 1096,
-	1097, 1026, 97, 95, 97, 94, 191, 194, 446, 67,
-	1110, 140, 762, 764, 102, 1091, 1020, 1015, 805, 377,
-	487, 10, 9, 554, 847, 1127, 8, 7, 379, 1130,
-	1026, 62, 346, 303, 347, 396, 102, 395, 251, 254,
-	112, 1143, 1116, 426, 1098, 1083, 89, 61, 60, 64,
-	317, 318, 319, 57, 321, 63, 58, 328, 1147, 331,
-	332, 333, 334, 335, 336, 337, 835, 102, 657, 174,
-	343, 349, 528, 202, 211, 210, 201, 200, 203, 199,
-	527, 56, 193, 653, 371, 648, 645, 243, 6, 20,
-	174, 19, 397, 253, 381, 103, 104, 105, 69, 255,
-	256, 257, 258, 154, 401, 17, 594, 591, 16, 443,
-	15, 485, 74, 14, 844, 846, 11, 102, 671, 18,
-	349, 13, 12, 1021, 870, 1019, 399, 174, 868, 428,
-	499, 500, 460, 458, 4, 2, 0, 0, 0, 0,
-	510, 0, 397, 253, 0, 103, 104, 105, 0, 106,
-	107, 108, 109, 0, 174, 197, 196, 0, 0, 0,
-	0, 198, 206, 205, 207, 208, 209, 103, 104, 105,
-	300, 106, 107, 108, 109, 0, 479, 845, 481, 0,
-	174, 202, 211, 210, 201, 200, 203, 199, 0, 0,
-	0, 0, 0, 102, 0, 174, 916, 671, 103, 104,
-	105, 0, 255, 256, 257, 258, 0, 401, 0, 0,
-	0, 0, 0, 0, 174, 174, 0, 0, 0, 253,
-	0, 0, 202, 211, 174, 201, 200, 203, 199, 399,
-	381, 0, 0, 0, 519, 0, 0, 0, 0, 0,
-	0, 529, 0, 0, 534, 0, 0, 84, 103, 104,
-	105, 0, 255, 256, 257, 258, 0, 401, 0, 0,
-	0, 617, 0, 197, 196, 0, 623, 624, 625, 198,
-	206, 205, 207, 208, 209, 0, 0, 304, 300, 399,
-	143, 0, 0, 0, 0, 152, 153, 0, 161, 162,
-	0, 0, 0, 0, 167, 0, 0, 0, 171, 0,
-	175, 0, 177, 178, 197, 196, 0, 0, 0, 0,
-	198, 206, 205, 207, 208, 209, 122, 202, 211, 210,
-	201, 200, 203, 199, 103, 104, 105, 0, 106, 107,
-	108, 109, 611, 202, 211, 210, 201, 200, 203, 199,
-	0, 614, 0, 349, 0, 174, 228, 0, 0, 0,
-	174, 174, 174, 0, 0, 0, 0, 0, 0, 0,
-	729, 0, 0, 0, 0, 636, 0, 202, 211, 210,
-	201, 200, 203, 199, 642, 252, 59, 252, 0, 0,
-	0, 0, 0, 252, 271, 252, 0, 0, 731, 732,
-	733, 734, 736, 280, 252, 282, 283, 0, 0, 197,
-	196, 0, 289, 0, 132, 198, 206, 205, 207, 208,
-	209, 102, 0, 0, 828, 197, 196, 0, 0, 0,
-	0, 198, 206, 205, 207, 208, 209, 0, 0, 728,
-	202, 211, 210, 201, 200, 203, 199, 253, 0, 0,
-	102, 0, 316, 0, 0, 0, 0, 0, 97, 197,
-	196, 0, 0, 0, 776, 198, 206, 205, 207, 208,
-	209, 0, 338, 0, 511, 352, 727, 0, 225, 0,
-	0, 0, 174, 174, 174, 174, 174, 0, 0, 372,
-	102, 0, 0, 0, 0, 540, 743, 541, 542, 543,
-	535, 841, 842, 538, 252, 252, 0, 0, 202, 211,
-	210, 201, 200, 203, 199, 397, 253, 252, 252, 0,
-	529, 0, 197, 196, 352, 0, 760, 174, 198, 206,
-	205, 207, 208, 209, 0, 0, 0, 300, 0, 0,
-	0, 0, 435, 437, 438, 440, 775, 0, 174, 0,
-	765, 0, 103, 104, 105, 252, 255, 256, 257, 258,
-	0, 0, 0, 0, 0, 789, 0, 0, 468, 0,
-	470, 132, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 103, 104, 105, 381, 106, 107, 108, 109, 327,
-	197, 196, 0, 814, 0, 0, 198, 206, 205, 207,
-	208, 209, 0, 0, 965, 0, 102, 327, 327, 0,
-	0, 202, 211, 210, 201, 200, 203, 199, 0, 0,
-	0, 103, 104, 105, 102, 255, 256, 257, 258, 0,
-	401, 397, 253, 402, 102, 0, 373, 922, 0, 0,
-	0, 0, 0, 0, 102, 352, 0, 402, 545, 0,
-	0, 0, 399, 544, 0, 0, 102, 252, 339, 0,
-	548, 0, 556, 252, 560, 0, 763, 252, 252, 397,
-	253, 0, 0, 0, 0, 0, 556, 575, 0, 0,
-	579, 556, 556, 583, 0, 0, 0, 586, 575, 0,
-	0, 596, 905, 197, 196, 0, 0, 0, 0, 198,
-	206, 205, 207, 208, 209, 910, 0, 892, 0, 0,
-	327, 0, 0, 0, 0, 0, 327, 327, 0, 0,
-	74, 174, 202, 211, 210, 201, 200, 203, 199, 607,
-	608, 0, 0, 575, 0, 0, 122, 103, 104, 105,
-	0, 255, 256, 257, 258, 0, 401, 352, 616, 0,
-	0, 327, 505, 505, 505, 103, 104, 105, 0, 106,
-	107, 108, 109, 0, 0, 103, 104, 105, 399, 106,
-	107, 108, 109, 0, 0, 103, 104, 105, 0, 255,
-	256, 257, 258, 0, 401, 0, 402, 103, 104, 105,
-	0, 106, 107, 108, 109, 0, 402, 252, 132, 0,
-	132, 132, 0, 674, 197, 196, 399, 677, 0, 556,
-	198, 206, 205, 207, 208, 209, 0, 0, 746, 0,
-	0, 556, 0, 0, 0, 0, 0, 0, 0, 556,
-	0, 0, 0, 0, 0, 0, 579, 0, 0, 556,
-	0, 0, 381, 0, 0, 102, 75, 76, 77, 0,
-	99, 79, 94, 97, 95, 96, 714, 71, 0, 0,
-	174, 0, 0, 0, 0, 0, 0, 0, 118, 0,
-	0, 112, 0, 0, 0, 202, 211, 210, 201, 200,
-	203, 199, 0, 0, 0, 0, 0, 122, 0, 0,
-	0, 327, 0, 0, 0, 376, 0, 0, 529, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 91, 0, 0, 352, 92, 0, 0, 0, 100,
-	0, 0, 252, 252, 0, 0, 402, 0, 120, 117,
-	0, 0, 0, 0, 0, 0, 0, 327, 98, 556,
-	0, 0, 381, 252, 556, 102, 0, 0, 0, 556,
-	0, 575, 94, 0, 0, 556, 556, 197, 196, 0,
-	0, 791, 792, 198, 206, 205, 207, 208, 209, 0,
-	0, 0, 0, 0, 354, 0, 103, 104, 105, 0,
-	106, 107, 108, 109, 111, 0, 85, 355, 86, 353,
-	356, 357, 358, 359, 0, 0, 0, 0, 0, 0,
-	0, 82, 83, 351, 0, 0, 93, 70, 344, 202,
-	211, 210, 201, 200, 203, 199, 327, 0, 0, 0,
-	0, 0, 0, 0, 252, 252, 0, 0, 252, 851,
-	520, 0, 0, 202, 211, 210, 201, 200, 203, 199,
-	0, 0, 0, 0, 0, 0, 579, 0, 0, 0,
-	0, 402, 402, 0, 0, 0, 0, 0, 0, 402,
-	0, 0, 102, 75, 76, 77, 0, 99, 79, 94,
-	97, 95, 96, 0, 71, 0, 103, 104, 105, 0,
-	106, 107, 108, 109, 0, 118, 0, 0, 112, 0,
-	0, 197, 196, 0, 0, 0, 0, 198, 206, 205,
-	207, 208, 209, 0, 0, 0, 252, 252, 0, 0,
-	0, 0, 0, 0, 0, 197, 196, 0, 0, 0,
-	556, 198, 206, 205, 207, 208, 209, 0, 91, 327,
-	0, 0, 92, 0, 0, 0, 100, 0, 0, 0,
-	0, 0, 0, 0, 0, 120, 117, 0, 0, 0,
-	402, 0, 402, 402, 402, 98, 0, 402, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 575,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 556, 0, 0, 0, 0, 0, 0,
-	0, 354, 0, 103, 104, 105, 0, 106, 107, 108,
-	109, 111, 0, 85, 355, 86, 353, 356, 357, 358,
-	359, 0, 0, 0, 0, 0, 0, 0, 82, 83,
-	351, 0, 0, 93, 70, 0, 0, 0, 0, 0,
-	0, 0, 402, 0, 402, 402, 402, 0, 1027, 1028,
-	327, 0, 0, 0, 0, 0, 0, 327, 102, 75,
-	76, 77, 0, 99, 79, 94, 97, 95, 96, 22,
-	71, 0, 0, 0, 35, 36, 0, 0, 0, 0,
-	0, 28, 0, 0, 112, 0, 29, 44, 0, 30,
-	0, 0, 0, 0, 0, 0, 0, 1060, 1061, 0,
-	0, 0, 352, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
-	327, 0, 0, 0, 91, 0, 0, 0, 92, 0,
-	0, 0, 100, 0, 74, 0, 0, 0, 0, 0,
-	0, 1023, 1022, 0, 875, 0, 0, 0, 0, 0,
-	32, 98, 0, 39, 37, 38, 34, 40, 0, 0,
-	0, 0, 0, 0, 0, 42, 43, 466, 467, 0,
-	47, 48, 49, 50, 41, 52, 53, 54, 45, 51,
-	55, 0, 0, 0, 876, 0, 0, 31, 46, 103,
-	104, 105, 0, 106, 107, 108, 109, 111, 0, 85,
-	88, 86, 87, 110, 0, 0, 0, 0, 0, 327,
-	0, 0, 0, 0, 82, 83, 0, 0, 0, 93,
-	70, 102, 75, 76, 77, 0, 99, 79, 94, 97,
-	95, 96, 22, 71, 0, 0, 0, 35, 36, 0,
-	0, 327, 0, 0, 28, 0, 0, 112, 0, 29,
-	44, 0, 30, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+var yyAct = [...]int16{
+	136, 22, 1157, 1169, 1128, 1099, 1156, 1028, 1098, 570,
+	1001, 382, 496, 933, 295, 432, 1076, 134, 628, 932,
+	1045, 217, 1003, 769, 557, 127, 93, 676, 893, 218,
+	417, 804, 746, 418, 1002, 698, 68, 719, 632, 741,
+	614, 129, 34, 176, 634, 453, 177, 178, 423, 182,
+	183, 184, 186, 710, 635, 193, 272, 597, 715, 273,
+	279, 380, 196, 581, 481, 503, 27, 187, 1, 154,
+	154, 556, 157, 475, 212, 576, 215, 580, 552, 747,
+	377, 143, 434, 287, 543, 283, 255, 427, 213, 83,
+	428, 502, 26, 81, 71, 608, 151, 222, 234, 584,
+	319, 585, 586, 587, 579, 248, 971, 582, 247, 247,
+	444, 1031, 216, 584, 340, 585, 586, 587, 579, 498,
+	3, 582, 1080, 248, 137, 22, 247, 504, 264, 212,
+	155, 163, 531, 510, 326, 247, 855, 856, 902, 1069,
+	195, 837, 179, 268, 759, 760, 226, 292, 644, 645,
+	821, 271, 237, 236, 238, 239, 240, 144, 275, 140,
+	77, 820, 142, 792, 139, 757, 34, 141, 756, 753,
+	739, 737, 730, 316, 317, 705, 646, 642, 639, 97,
+	341, 529, 237, 236, 238, 239, 240, 443, 439, 345,
+	27, 594, 300, 265, 1117, 122, 1116, 1092, 1091, 1090,
+	1089, 1088, 1087, 1062, 1061, 288, 1059, 1057, 210, 1055,
+	332, 210, 1054, 1044, 1043, 1025, 26, 248, 357, 513,
+	247, 341, 307, 284, 341, 583, 991, 972, 930, 908,
+	874, 296, 341, 298, 122, 873, 612, 872, 724, 871,
+	344, 870, 1075, 341, 3, 869, 865, 77, 854, 839,
+	836, 828, 144, 325, 827, 343, 822, 357, 602, 356,
+	791, 789, 788, 787, 780, 776, 755, 407, 22, 752,
+	738, 736, 681, 674, 673, 416, 672, 659, 625, 528,
+	394, 395, 546, 526, 524, 918, 464, 137, 454, 449,
+	409, 450, 337, 338, 336, 97, 148, 1058, 1056, 146,
+	299, 1010, 425, 1009, 1008, 544, 1007, 1006, 1005, 34,
+	976, 467, 469, 472, 474, 477, 350, 426, 963, 146,
+	477, 482, 958, 955, 953, 952, 482, 482, 945, 943,
+	355, 912, 492, 27, 495, 732, 408, 154, 422, 678,
+	22, 655, 489, 611, 595, 84, 591, 313, 631, 538,
+	537, 536, 535, 534, 533, 532, 238, 239, 240, 26,
+	257, 466, 465, 508, 152, 437, 331, 147, 440, 270,
+	514, 135, 213, 448, 441, 603, 263, 262, 426, 252,
+	519, 34, 251, 250, 249, 311, 731, 3, 522, 523,
+	487, 488, 1106, 480, 491, 980, 373, 460, 188, 392,
+	393, 446, 447, 105, 648, 124, 485, 486, 451, 301,
+	402, 210, 400, 22, 146, 324, 483, 484, 490, 211,
+	568, 569, 699, 542, 97, 266, 703, 806, 887, 1135,
+	956, 245, 246, 574, 954, 808, 125, 463, 77, 452,
+	516, 259, 260, 512, 515, 795, 951, 1037, 878, 929,
+	147, 876, 253, 928, 34, 541, 845, 700, 254, 1016,
+	1014, 950, 949, 152, 948, 947, 946, 875, 1004, 795,
+	879, 575, 868, 877, 211, 567, 312, 680, 27, 135,
+	704, 561, 303, 547, 548, 1019, 555, 549, 805, 695,
+	604, 643, 401, 188, 566, 190, 462, 1190, 1176, 1166,
+	641, 649, 637, 1133, 26, 1165, 288, 1160, 679, 97,
+	1132, 701, 1146, 1145, 310, 426, 1137, 1119, 1113, 650,
+	1105, 1102, 284, 1039, 590, 601, 607, 606, 609, 610,
+	605, 1036, 3, 656, 1035, 618, 992, 302, 979, 106,
+	111, 112, 159, 107, 108, 109, 110, 113, 114, 115,
+	116, 117, 118, 119, 120, 685, 334, 266, 696, 22,
+	689, 942, 941, 936, 862, 861, 22, 798, 304, 305,
+	686, 677, 347, 348, 349, 278, 351, 647, 248, 359,
+	360, 247, 363, 364, 365, 366, 367, 368, 369, 562,
+	560, 1110, 725, 1109, 188, 375, 381, 158, 1159, 722,
+	34, 1101, 1158, 160, 1030, 1100, 1158, 34, 935, 403,
+	661, 774, 934, 1193, 773, 652, 188, 170, 171, 651,
+	415, 677, 727, 339, 27, 1143, 559, 688, 161, 687,
+	558, 27, 683, 1100, 692, 1067, 934, 859, 381, 558,
+	477, 414, 412, 482, 1191, 188, 718, 461, 1162, 22,
+	26, 709, 22, 22, 734, 1138, 1127, 26, 729, 1104,
+	717, 767, 1040, 1027, 771, 772, 938, 799, 768, 565,
+	267, 235, 1140, 188, 720, 1129, 1042, 1029, 3, 664,
+	665, 666, 667, 668, 802, 3, 168, 169, 172, 173,
+	34, 770, 410, 34, 34, 803, 274, 1183, 518, 1182,
+	520, 521, 790, 188, 1164, 1163, 1125, 763, 999, 765,
+	998, 940, 939, 761, 766, 807, 1159, 574, 1101, 188,
+	935, 559, 1197, 1189, 1154, 1136, 1083, 811, 1038, 785,
+	883, 797, 1180, 1123, 996, 690, 1170, 819, 188, 188,
+	1152, 1188, 1174, 1186, 1187, 1170, 1199, 1185, 188, 801,
+	1173, 800, 842, 1172, 794, 77, 415, 1095, 293, 851,
+	563, 914, 833, 809, 104, 256, 102, 573, 1063, 22,
+	578, 860, 844, 257, 22, 22, 1184, 818, 823, 974,
+	832, 857, 675, 1081, 831, 1032, 863, 864, 824, 910,
+	511, 342, 397, 637, 850, 906, 396, 637, 290, 853,
+	22, 848, 849, 416, 812, 814, 399, 398, 445, 1150,
+	34, 847, 909, 77, 1195, 34, 34, 1171, 1151, 677,
+	880, 1153, 905, 1168, 77, 901, 1171, 362, 361, 892,
+	891, 896, 728, 894, 895, 77, 722, 103, 886, 885,
+	733, 34, 289, 290, 291, 77, 22, 135, 735, 353,
+	829, 77, 320, 352, 354, 243, 244, 314, 903, 749,
+	22, 716, 925, 657, 584, 27, 585, 586, 884, 817,
+	916, 816, 937, 660, 714, 381, 915, 188, 713, 419,
+	420, 420, 188, 188, 188, 707, 708, 34, 1085, 1047,
+	584, 26, 585, 586, 587, 579, 712, 682, 582, 421,
+	584, 34, 585, 586, 587, 711, 882, 897, 899, 959,
+	691, 720, 577, 276, 966, 1046, 967, 960, 722, 3,
+	751, 750, 961, 981, 330, 964, 965, 983, 987, 22,
+	22, 321, 758, 677, 973, 22, 995, 677, 977, 22,
+	970, 982, 748, 150, 458, 925, 925, 994, 985, 889,
+	890, 997, 149, 993, 225, 329, 986, 455, 456, 742,
+	743, 744, 745, 984, 1013, 920, 457, 990, 866, 826,
+	34, 34, 852, 846, 830, 1012, 34, 843, 1012, 1018,
+	34, 22, 1020, 454, 138, 1022, 754, 1011, 69, 640,
+	1015, 188, 968, 720, 530, 1021, 281, 925, 478, 285,
+	282, 677, 1023, 280, 777, 126, 28, 424, 1034, 438,
+	188, 188, 188, 188, 188, 1060, 1048, 1049, 1050, 1051,
+	1052, 1041, 34, 1033, 793, 162, 164, 693, 22, 281,
+	1068, 22, 442, 1012, 323, 322, 318, 98, 22, 100,
+	98, 22, 100, 860, 925, 1053, 97, 221, 920, 920,
+	479, 573, 224, 1084, 925, 70, 153, 810, 188, 1142,
+	191, 1066, 1086, 858, 411, 11, 10, 1093, 22, 34,
+	598, 9, 34, 8, 1107, 615, 413, 825, 65, 34,
+	191, 1012, 34, 269, 925, 378, 379, 430, 924, 429,
+	677, 1097, 1108, 1094, 838, 1115, 197, 574, 1114, 200,
+	920, 22, 1122, 1194, 1167, 22, 1149, 22, 1120, 34,
+	22, 22, 1134, 92, 64, 63, 415, 925, 67, 60,
+	66, 925, 677, 1118, 61, 867, 888, 706, 22, 572,
+	1144, 571, 1139, 22, 22, 191, 59, 223, 702, 22,
+	697, 1068, 34, 694, 22, 553, 34, 920, 34, 978,
+	1071, 34, 34, 191, 277, 925, 7, 920, 1077, 22,
+	1179, 1175, 6, 22, 1177, 21, 20, 72, 167, 34,
+	18, 924, 924, 636, 34, 34, 633, 17, 476, 16,
+	34, 15, 12, 19, 14, 34, 1192, 920, 1196, 13,
+	1072, 921, 22, 1070, 1144, 919, 499, 191, 497, 4,
+	34, 1200, 188, 599, 34, 2, 0, 0, 0, 0,
+	0, 0, 62, 1024, 988, 989, 613, 0, 0, 0,
+	920, 621, 623, 924, 920, 0, 1071, 957, 0, 1071,
+	1071, 294, 0, 34, 1077, 0, 0, 1077, 1077, 0,
+	962, 145, 0, 0, 0, 0, 1126, 1071, 0, 1130,
+	1131, 0, 1071, 1071, 0, 1077, 0, 87, 920, 0,
+	1077, 1077, 0, 1071, 0, 0, 1026, 1141, 5, 135,
+	924, 1077, 1147, 1148, 0, 0, 0, 0, 1071, 0,
+	924, 0, 1071, 1161, 0, 0, 1077, 0, 0, 0,
+	1077, 156, 0, 0, 0, 0, 165, 166, 1178, 174,
+	175, 0, 1181, 0, 0, 180, 0, 258, 0, 185,
+	924, 1071, 192, 1065, 194, 198, 209, 0, 0, 1077,
+	0, 0, 189, 1082, 0, 0, 0, 0, 0, 0,
+	0, 1198, 372, 374, 0, 0, 0, 0, 0, 0,
+	0, 0, 214, 924, 0, 0, 0, 924, 0, 0,
+	0, 0, 0, 1103, 0, 0, 0, 0, 0, 261,
+	613, 0, 0, 0, 191, 0, 0, 0, 613, 0,
+	0, 0, 0, 0, 0, 415, 613, 0, 0, 0,
+	0, 924, 0, 459, 0, 0, 1121, 613, 0, 0,
+	1124, 0, 0, 188, 0, 0, 0, 214, 198, 0,
+	198, 0, 0, 0, 145, 0, 198, 297, 198, 0,
+	0, 0, 0, 0, 0, 214, 306, 198, 308, 309,
+	135, 0, 0, 0, 1155, 315, 0, 0, 0, 0,
+	232, 573, 0, 231, 230, 233, 243, 244, 229, 0,
+	191, 0, 0, 0, 191, 358, 584, 0, 585, 586,
+	587, 579, 894, 895, 582, 0, 0, 525, 0, 328,
+	191, 0, 0, 0, 0, 0, 358, 358, 0, 191,
+	0, 191, 0, 0, 0, 415, 539, 540, 0, 0,
+	0, 0, 346, 0, 0, 0, 550, 0, 0, 0,
+	0, 0, 105, 0, 436, 599, 0, 613, 0, 436,
+	0, 0, 613, 0, 0, 370, 0, 0, 384, 834,
+	835, 0, 0, 0, 0, 0, 0, 431, 199, 0,
+	0, 0, 404, 0, 406, 227, 226, 0, 0, 0,
+	0, 228, 237, 236, 238, 239, 240, 0, 0, 198,
+	198, 0, 0, 198, 198, 0, 0, 0, 0, 0,
+	384, 191, 721, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 358, 0, 468, 470,
+	471, 473, 0, 0, 358, 358, 0, 0, 0, 0,
+	0, 198, 198, 0, 0, 0, 0, 0, 0, 493,
+	0, 0, 0, 0, 0, 0, 0, 0, 507, 0,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 358,
+	545, 545, 545, 0, 0, 663, 0, 0, 0, 0,
+	669, 670, 671, 0, 0, 0, 214, 0, 106, 111,
+	112, 0, 107, 108, 109, 110, 201, 202, 203, 204,
+	205, 206, 207, 208, 0, 435, 436, 0, 0, 0,
+	0, 0, 0, 0, 0, 436, 0, 145, 0, 145,
+	145, 0, 191, 0, 554, 554, 0, 433, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 613, 0, 384,
+	0, 0, 0, 0, 0, 0, 0, 588, 0, 0,
+	0, 198, 0, 0, 592, 0, 600, 198, 0, 0,
+	198, 198, 214, 0, 0, 0, 596, 0, 0, 600,
+	616, 0, 0, 620, 600, 600, 624, 0, 0, 0,
+	627, 629, 617, 0, 638, 0, 0, 0, 0, 764,
+	0, 626, 0, 630, 0, 0, 0, 0, 0, 0,
+	0, 613, 0, 0, 0, 0, 0, 0, 781, 782,
+	783, 784, 786, 0, 0, 0, 0, 358, 0, 0,
+	0, 0, 527, 0, 0, 0, 0, 0, 653, 654,
+	0, 0, 629, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 384, 662, 0,
+	0, 0, 0, 0, 0, 0, 436, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 358, 0, 0,
+	0, 554, 684, 214, 0, 232, 242, 241, 231, 230,
+	233, 243, 244, 229, 0, 0, 0, 0, 0, 191,
+	0, 0, 0, 0, 0, 191, 0, 0, 191, 0,
+	0, 198, 0, 0, 0, 0, 0, 723, 0, 0,
+	191, 726, 0, 600, 0, 0, 0, 0, 0, 0,
+	0, 600, 0, 0, 0, 0, 0, 0, 0, 600,
+	0, 0, 0, 0, 740, 0, 0, 620, 232, 242,
+	600, 231, 230, 233, 243, 244, 229, 0, 358, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 762, 0,
+	0, 0, 0, 0, 198, 105, 0, 0, 0, 0,
+	227, 226, 0, 0, 0, 191, 228, 237, 236, 238,
+	239, 240, 0, 0, 775, 327, 436, 436, 0, 0,
+	431, 199, 0, 0, 436, 0, 0, 0, 0, 0,
+	931, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 384, 0, 969, 0, 0, 0, 0,
+	0, 198, 198, 227, 226, 0, 0, 0, 0, 228,
+	237, 236, 238, 239, 240, 0, 0, 0, 600, 0,
+	600, 0, 0, 0, 0, 600, 0, 616, 0, 105,
+	0, 0, 600, 600, 0, 358, 0, 0, 840, 841,
+	0, 629, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 431, 199, 436, 0, 436, 436,
+	436, 0, 191, 436, 0, 0, 0, 0, 0, 0,
+	0, 106, 111, 112, 0, 107, 108, 109, 110, 201,
+	202, 203, 204, 205, 206, 207, 208, 105, 435, 900,
+	232, 242, 241, 231, 230, 233, 243, 244, 229, 191,
+	0, 0, 0, 0, 198, 198, 0, 0, 198, 904,
+	433, 0, 431, 199, 0, 0, 0, 0, 0, 779,
+	0, 907, 0, 0, 0, 620, 0, 911, 0, 0,
+	913, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 436, 917, 436, 436, 436, 0, 898, 0, 358,
+	0, 0, 0, 358, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 106, 111, 112, 0, 107,
+	108, 109, 110, 201, 202, 203, 204, 205, 206, 207,
+	208, 0, 435, 0, 0, 227, 226, 0, 0, 198,
+	198, 228, 237, 236, 238, 239, 240, 0, 0, 778,
+	600, 0, 0, 0, 433, 0, 0, 975, 0, 0,
+	0, 0, 436, 0, 0, 0, 0, 358, 0, 0,
+	0, 0, 0, 106, 111, 112, 0, 107, 108, 109,
+	110, 201, 202, 203, 204, 205, 206, 207, 208, 0,
+	435, 0, 1000, 0, 0, 0, 0, 0, 0, 0,
+	0, 629, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 433, 0, 600, 105, 78, 79, 80, 0,
+	102, 82, 97, 100, 98, 99, 23, 74, 0, 0,
+	0, 36, 37, 0, 0, 0, 0, 0, 29, 0,
+	0, 123, 0, 30, 45, 0, 31, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 358, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1078,
+	1079, 0, 0, 0, 1064, 0, 0, 0, 0, 0,
+	0, 94, 0, 0, 0, 95, 0, 0, 358, 0,
+	0, 103, 0, 77, 0, 0, 105, 0, 0, 0,
+	1074, 1073, 0, 926, 0, 0, 0, 0, 0, 33,
+	101, 1096, 40, 38, 39, 35, 41, 0, 1111, 1112,
+	0, 431, 199, 384, 43, 44, 505, 506, 0, 48,
+	49, 50, 51, 42, 54, 55, 56, 46, 52, 58,
+	57, 0, 0, 0, 927, 0, 0, 32, 47, 53,
+	0, 106, 111, 112, 0, 107, 108, 109, 110, 113,
+	114, 115, 116, 117, 118, 119, 120, 122, 0, 88,
+	91, 89, 90, 121, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 85, 86, 0, 0, 0, 96,
+	73, 105, 78, 79, 80, 0, 102, 82, 97, 100,
+	98, 99, 23, 74, 0, 0, 0, 36, 37, 0,
+	0, 0, 0, 0, 29, 0, 0, 123, 0, 30,
+	45, 0, 31, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 106, 111, 112, 0, 107, 108, 109, 110,
+	201, 202, 203, 204, 205, 206, 207, 208, 0, 435,
+	0, 0, 0, 0, 0, 0, 0, 94, 0, 0,
+	0, 95, 0, 0, 0, 0, 0, 103, 0, 77,
+	0, 433, 105, 0, 0, 0, 501, 500, 0, 75,
+	0, 0, 0, 0, 0, 33, 101, 0, 40, 38,
+	39, 35, 41, 0, 0, 0, 0, 431, 199, 0,
+	43, 44, 505, 506, 76, 48, 49, 50, 51, 42,
+	54, 55, 56, 46, 52, 58, 57, 0, 0, 0,
+	0, 0, 0, 32, 47, 53, 0, 106, 111, 112,
+	0, 107, 108, 109, 110, 113, 114, 115, 116, 117,
+	118, 119, 120, 122, 0, 88, 91, 89, 90, 121,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 91, 0, 0,
-	0, 92, 0, 0, 0, 100, 0, 74, 0, 0,
-	0, 0, 0, 0, 462, 461, 0, 72, 0, 0,
-	0, 0, 0, 32, 98, 0, 39, 37, 38, 34,
-	40, 0, 0, 0, 0, 0, 0, 0, 42, 43,
-	466, 467, 73, 47, 48, 49, 50, 41, 52, 53,
-	54, 45, 51, 55, 0, 0, 0, 0, 0, 0,
-	31, 46, 103, 104, 105, 0, 106, 107, 108, 109,
-	111, 0, 85, 88, 86, 87, 110, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 83, 0,
-	0, 0, 93, 70, 102, 75, 76, 77, 0, 99,
-	79, 94, 97, 95, 96, 22, 71, 0, 0, 0,
-	35, 36, 0, 0, 0, 0, 0, 28, 0, 0,
-	112, 0, 29, 44, 0, 30, 0, 0, 0, 0,
+	85, 86, 0, 0, 0, 96, 73, 105, 78, 79,
+	80, 0, 102, 82, 97, 100, 98, 99, 23, 74,
+	0, 0, 0, 36, 37, 0, 0, 0, 0, 0,
+	29, 0, 0, 123, 0, 30, 45, 0, 31, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 106, 111,
+	112, 0, 107, 108, 109, 110, 201, 202, 203, 204,
+	205, 206, 207, 208, 0, 435, 0, 0, 0, 0,
+	0, 0, 0, 94, 0, 0, 0, 95, 0, 0,
+	0, 0, 0, 103, 0, 77, 0, 433, 105, 0,
+	0, 0, 923, 922, 0, 926, 0, 0, 0, 0,
+	0, 33, 101, 0, 40, 38, 39, 35, 41, 0,
+	0, 0, 0, 0, 123, 0, 43, 44, 0, 0,
+	0, 48, 49, 50, 51, 42, 54, 55, 56, 46,
+	52, 58, 57, 0, 0, 0, 927, 0, 0, 32,
+	47, 53, 0, 106, 111, 112, 0, 107, 108, 109,
+	110, 113, 114, 115, 116, 117, 118, 119, 120, 122,
+	0, 88, 91, 89, 90, 121, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 85, 86, 0, 0,
+	0, 96, 73, 105, 78, 79, 80, 0, 102, 82,
+	97, 100, 98, 99, 23, 74, 0, 0, 0, 36,
+	37, 0, 0, 0, 0, 0, 29, 0, 0, 123,
+	0, 30, 45, 0, 31, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 106, 111, 112, 0, 107, 108,
+	109, 110, 113, 114, 115, 116, 117, 118, 119, 120,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 94,
+	0, 0, 0, 95, 0, 0, 0, 0, 0, 103,
+	0, 77, 105, 622, 0, 0, 0, 0, 25, 24,
+	0, 75, 0, 0, 0, 0, 0, 33, 101, 0,
+	40, 38, 39, 35, 41, 0, 0, 431, 199, 0,
+	0, 0, 43, 44, 0, 0, 76, 48, 49, 50,
+	51, 42, 54, 55, 56, 46, 52, 58, 57, 0,
+	0, 0, 0, 0, 0, 32, 47, 53, 0, 106,
+	111, 112, 815, 107, 108, 109, 110, 113, 114, 115,
+	116, 117, 118, 119, 120, 122, 0, 88, 91, 89,
+	90, 121, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 85, 86, 0, 0, 0, 96, 73, 105,
+	78, 79, 80, 0, 102, 82, 97, 100, 98, 99,
+	0, 74, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 0, 0, 123, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 106, 111,
+	112, 0, 107, 108, 109, 110, 201, 202, 203, 204,
+	205, 206, 207, 208, 0, 435, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 94, 0, 0, 0, 95,
+	0, 0, 0, 0, 0, 103, 0, 433, 0, 0,
+	0, 0, 0, 0, 133, 130, 0, 0, 0, 0,
+	0, 0, 0, 0, 101, 105, 78, 79, 80, 0,
+	102, 82, 97, 100, 98, 99, 0, 74, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 131, 0,
+	0, 123, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 386, 0, 0, 0, 106, 111, 112, 0, 107,
+	108, 109, 110, 113, 114, 115, 116, 117, 118, 119,
+	120, 122, 0, 88, 387, 89, 385, 388, 389, 390,
+	391, 94, 0, 0, 0, 95, 0, 0, 85, 86,
+	383, 103, 0, 96, 73, 376, 0, 0, 0, 0,
+	133, 130, 0, 0, 0, 0, 0, 0, 0, 0,
+	101, 105, 78, 79, 80, 0, 102, 82, 97, 100,
+	98, 99, 0, 74, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 131, 0, 0, 123, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 386, 0, 0,
+	0, 106, 111, 112, 0, 107, 108, 109, 110, 113,
+	114, 115, 116, 117, 118, 119, 120, 122, 0, 88,
+	387, 89, 385, 388, 389, 390, 391, 94, 0, 0,
+	0, 95, 0, 0, 85, 86, 383, 103, 0, 96,
+	73, 0, 0, 0, 0, 0, 133, 130, 0, 0,
+	0, 0, 0, 0, 0, 0, 101, 105, 78, 79,
+	80, 0, 102, 82, 97, 100, 98, 99, 0, 74,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	131, 0, 0, 123, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 386, 0, 0, 0, 106, 111, 112,
+	0, 107, 108, 109, 110, 113, 114, 115, 116, 117,
+	118, 119, 120, 122, 0, 88, 387, 89, 385, 388,
+	389, 390, 391, 94, 0, 0, 0, 95, 0, 0,
+	85, 86, 0, 103, 0, 96, 73, 0, 0, 0,
+	0, 0, 133, 130, 0, 0, 0, 0, 0, 0,
+	0, 220, 101, 105, 78, 79, 80, 0, 102, 82,
+	97, 100, 98, 99, 0, 74, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 131, 0, 0, 123,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 219,
+	0, 0, 0, 106, 111, 112, 0, 107, 108, 109,
+	110, 113, 114, 115, 116, 117, 118, 119, 120, 122,
+	0, 88, 91, 89, 90, 121, 0, 0, 0, 94,
+	0, 0, 0, 95, 0, 0, 85, 86, 0, 103,
+	0, 96, 73, 0, 0, 0, 0, 0, 133, 130,
+	0, 0, 0, 0, 0, 0, 0, 0, 101, 105,
+	78, 79, 80, 0, 102, 82, 97, 100, 98, 99,
+	0, 74, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 131, 0, 0, 123, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 132, 0, 0, 0, 106,
+	111, 112, 0, 107, 108, 109, 110, 113, 114, 115,
+	116, 117, 118, 119, 120, 122, 0, 88, 91, 89,
+	90, 121, 0, 0, 0, 94, 0, 0, 0, 95,
+	0, 0, 85, 86, 383, 103, 293, 96, 73, 0,
+	0, 0, 0, 0, 133, 130, 0, 0, 0, 0,
+	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
+	105, 78, 79, 80, 0, 102, 82, 97, 100, 98,
+	99, 0, 74, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 131, 0, 0, 123, 0, 0, 0,
+	0, 132, 0, 0, 0, 106, 111, 112, 0, 107,
+	108, 109, 110, 113, 114, 115, 116, 117, 118, 119,
+	120, 122, 0, 88, 91, 89, 90, 121, 0, 0,
+	0, 0, 0, 0, 0, 0, 94, 0, 85, 86,
+	95, 0, 0, 96, 73, 0, 103, 0, 77, 0,
+	0, 0, 0, 0, 0, 133, 130, 0, 0, 0,
+	0, 0, 0, 0, 0, 101, 105, 78, 79, 80,
+	0, 102, 82, 97, 100, 98, 99, 0, 74, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 131,
+	0, 0, 123, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 132, 0, 0, 0, 106, 111, 112, 0,
+	107, 108, 109, 110, 113, 114, 115, 116, 117, 118,
+	119, 120, 122, 0, 88, 91, 89, 90, 121, 0,
+	0, 0, 94, 0, 0, 0, 95, 0, 0, 85,
+	86, 0, 103, 0, 96, 73, 0, 0, 0, 0,
+	0, 133, 130, 0, 0, 0, 0, 0, 0, 0,
+	0, 101, 105, 78, 79, 80, 0, 102, 82, 97,
+	100, 98, 99, 0, 74, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 131, 0, 0, 123, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 132, 0,
+	0, 0, 106, 111, 112, 0, 107, 108, 109, 110,
+	113, 114, 115, 116, 117, 118, 119, 120, 122, 0,
+	88, 91, 89, 90, 121, 0, 0, 0, 94, 0,
+	0, 0, 95, 0, 0, 85, 86, 0, 103, 0,
+	96, 73, 0, 0, 0, 0, 0, 133, 130, 0,
+	0, 0, 0, 0, 0, 0, 0, 101, 105, 78,
+	79, 80, 0, 102, 82, 97, 100, 98, 99, 0,
+	74, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 131, 0, 0, 494, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 132, 0, 0, 0, 106, 111,
+	112, 0, 107, 108, 109, 110, 113, 114, 115, 116,
+	117, 118, 119, 120, 122, 0, 88, 91, 89, 90,
+	121, 0, 0, 0, 94, 0, 0, 0, 95, 0,
+	0, 85, 86, 0, 103, 0, 96, 128, 0, 0,
+	0, 0, 0, 133, 130, 0, 0, 0, 0, 0,
+	0, 0, 0, 101, 105, 78, 333, 80, 0, 102,
+	82, 97, 100, 98, 99, 0, 74, 232, 242, 241,
+	231, 230, 233, 243, 244, 229, 0, 131, 0, 0,
+	123, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 0, 0, 0, 106, 111, 112, 105, 107, 108,
+	109, 110, 113, 114, 115, 116, 117, 118, 119, 120,
+	122, 0, 88, 91, 89, 90, 121, 0, 0, 0,
+	94, 0, 431, 199, 95, 0, 0, 85, 86, 0,
+	103, 0, 96, 73, 0, 0, 0, 0, 0, 133,
+	130, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 0, 0, 0, 0, 0, 0, 813, 0, 0,
+	0, 0, 227, 226, 0, 0, 0, 0, 228, 237,
+	236, 238, 239, 240, 0, 0, 335, 327, 0, 0,
+	0, 0, 0, 0, 0, 0, 132, 0, 105, 0,
+	106, 111, 112, 0, 107, 108, 109, 110, 113, 114,
+	115, 116, 117, 118, 119, 120, 122, 0, 88, 91,
+	89, 90, 121, 232, 242, 241, 231, 230, 233, 243,
+	244, 229, 0, 85, 86, 0, 0, 0, 96, 73,
+	0, 0, 0, 106, 111, 112, 0, 107, 108, 109,
+	110, 201, 202, 203, 204, 205, 206, 207, 208, 0,
+	435, 232, 242, 241, 231, 230, 233, 243, 244, 229,
+	0, 0, 0, 232, 242, 241, 231, 230, 233, 243,
+	244, 229, 433, 0, 0, 232, 242, 241, 231, 230,
+	233, 243, 244, 229, 0, 0, 0, 232, 242, 241,
+	231, 230, 233, 243, 244, 229, 0, 0, 0, 232,
+	242, 241, 231, 230, 233, 243, 244, 229, 227, 226,
+	0, 0, 0, 0, 228, 237, 236, 238, 239, 240,
+	0, 0, 0, 881, 106, 111, 112, 0, 107, 108,
+	109, 110, 113, 114, 115, 116, 117, 118, 119, 120,
+	0, 0, 0, 0, 0, 0, 227, 226, 105, 0,
+	0, 0, 228, 237, 236, 238, 239, 240, 227, 226,
+	0, 551, 286, 619, 228, 237, 236, 238, 239, 240,
+	227, 226, 0, 327, 199, 0, 228, 237, 236, 238,
+	239, 240, 227, 226, 1017, 0, 0, 0, 228, 237,
+	236, 238, 239, 240, 227, 226, 944, 0, 0, 0,
+	228, 237, 236, 238, 239, 240, 0, 0, 796, 232,
+	242, 241, 231, 230, 233, 243, 244, 229, 0, 0,
+	0, 232, 242, 241, 231, 230, 233, 243, 244, 229,
+	105, 410, 0, 232, 242, 241, 231, 230, 233, 243,
+	244, 229, 0, 0, 564, 232, 658, 241, 231, 230,
+	233, 243, 244, 229, 105, 0, 123, 232, 517, 241,
+	231, 230, 233, 243, 244, 229, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 105, 0, 0, 0,
+	199, 0, 0, 0, 106, 111, 112, 0, 107, 108,
+	109, 110, 113, 114, 115, 116, 117, 118, 119, 120,
+	0, 0, 0, 0, 227, 226, 105, 0, 0, 0,
+	228, 237, 236, 238, 239, 240, 227, 226, 0, 0,
+	0, 0, 228, 237, 236, 238, 239, 240, 227, 226,
+	0, 105, 199, 0, 228, 237, 236, 238, 239, 240,
+	227, 226, 0, 0, 0, 0, 228, 237, 236, 238,
+	239, 240, 227, 226, 77, 593, 0, 0, 228, 237,
+	236, 238, 239, 240, 105, 0, 106, 111, 112, 0,
+	107, 108, 109, 110, 113, 114, 115, 116, 117, 118,
+	119, 120, 105, 0, 405, 0, 0, 0, 589, 0,
+	106, 111, 112, 0, 107, 108, 109, 110, 113, 114,
+	115, 116, 117, 118, 119, 120, 105, 0, 371, 0,
+	0, 0, 106, 111, 112, 0, 107, 108, 109, 110,
+	113, 114, 115, 116, 117, 118, 119, 120, 105, 0,
+	0, 0, 0, 0, 0, 0, 100, 0, 0, 0,
+	0, 0, 106, 111, 112, 0, 107, 108, 109, 110,
+	201, 202, 203, 204, 205, 206, 207, 208, 105, 0,
+	0, 0, 0, 0, 0, 97, 0, 106, 111, 112,
+	0, 107, 108, 109, 110, 113, 114, 115, 116, 117,
+	118, 119, 120, 105, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	106, 111, 112, 0, 107, 108, 109, 110, 113, 114,
+	115, 116, 117, 118, 119, 120, 0, 0, 106, 111,
+	112, 0, 107, 108, 109, 110, 113, 114, 115, 116,
+	117, 118, 119, 120, 0, 0, 0, 0, 0, 0,
+	0, 0, 106, 111, 112, 0, 107, 108, 109, 110,
+	113, 114, 115, 116, 117, 118, 119, 120, 0, 0,
+	0, 0, 0, 0, 106, 111, 112, 0, 107, 108,
+	181, 110, 113, 114, 115, 116, 117, 118, 119, 120,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	91, 0, 0, 0, 92, 0, 0, 0, 100, 0,
-	74, 0, 0, 0, 0, 0, 0, 872, 871, 0,
-	875, 0, 0, 0, 0, 0, 32, 98, 0, 39,
-	37, 38, 34, 40, 0, 0, 0, 0, 0, 0,
-	0, 42, 43, 0, 0, 0, 47, 48, 49, 50,
-	41, 52, 53, 54, 45, 51, 55, 0, 0, 0,
-	876, 0, 0, 31, 46, 103, 104, 105, 0, 106,
-	107, 108, 109, 111, 0, 85, 88, 86, 87, 110,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 83, 0, 0, 0, 93, 70, 102, 75, 76,
-	77, 0, 99, 79, 94, 97, 95, 96, 22, 71,
-	0, 0, 0, 35, 36, 0, 0, 0, 0, 0,
-	28, 0, 0, 112, 0, 29, 44, 0, 30, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 91, 0, 0, 0, 92, 0, 0,
-	0, 100, 0, 74, 0, 0, 0, 0, 0, 0,
-	24, 23, 0, 72, 0, 0, 0, 0, 0, 32,
-	98, 0, 39, 37, 38, 34, 40, 0, 0, 0,
-	0, 0, 0, 0, 42, 43, 0, 0, 73, 47,
-	48, 49, 50, 41, 52, 53, 54, 45, 51, 55,
-	0, 0, 0, 0, 0, 0, 31, 46, 103, 104,
-	105, 0, 106, 107, 108, 109, 111, 0, 85, 88,
-	86, 87, 110, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 83, 0, 0, 0, 93, 70,
-	102, 75, 76, 77, 0, 99, 79, 94, 97, 95,
-	96, 0, 71, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 118, 0, 0, 112, 0, 0, 0,
-	0, 0, 0, 0, 102, 75, 76, 77, 0, 99,
-	79, 94, 97, 95, 96, 0, 71, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
-	112, 0, 0, 0, 0, 0, 91, 0, 0, 0,
-	92, 0, 0, 0, 100, 0, 0, 0, 0, 0,
-	0, 0, 0, 120, 117, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 0, 0, 0, 0, 0,
-	91, 0, 0, 0, 92, 0, 0, 0, 100, 0,
-	0, 0, 0, 0, 0, 0, 0, 120, 117, 0,
-	0, 0, 0, 0, 0, 0, 190, 98, 0, 354,
-	0, 103, 104, 105, 0, 106, 107, 108, 109, 111,
-	0, 85, 355, 86, 353, 356, 357, 358, 359, 0,
-	0, 0, 0, 0, 0, 0, 82, 83, 0, 0,
-	0, 93, 70, 189, 0, 103, 104, 105, 0, 106,
-	107, 108, 109, 111, 0, 85, 88, 86, 87, 110,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 83, 0, 0, 0, 93, 70, 102, 75, 76,
-	77, 0, 99, 79, 94, 97, 95, 96, 0, 71,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	118, 0, 0, 112, 0, 0, 0, 0, 0, 0,
-	0, 102, 75, 76, 77, 0, 99, 79, 94, 97,
-	95, 96, 0, 71, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 118, 0, 0, 112, 0, 0,
-	0, 0, 0, 91, 0, 0, 0, 92, 0, 0,
-	0, 100, 0, 0, 0, 0, 0, 0, 0, 0,
-	120, 117, 0, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 0, 0, 91, 0, 0,
-	0, 92, 0, 0, 0, 100, 267, 0, 0, 0,
-	0, 0, 0, 0, 120, 117, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 119, 0, 103, 104,
-	105, 0, 106, 107, 108, 109, 111, 0, 85, 88,
-	86, 87, 110, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 83, 351, 0, 0, 93, 70,
-	119, 0, 103, 104, 105, 0, 106, 107, 108, 109,
-	111, 0, 85, 88, 86, 87, 110, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 83, 0,
-	0, 0, 93, 70, 102, 75, 76, 77, 0, 99,
-	79, 94, 97, 95, 96, 0, 71, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
-	112, 0, 0, 0, 0, 0, 0, 0, 102, 75,
-	76, 77, 0, 99, 79, 94, 97, 95, 96, 0,
-	71, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 118, 0, 0, 112, 0, 0, 0, 0, 0,
-	91, 0, 0, 0, 92, 0, 0, 0, 100, 0,
-	74, 0, 0, 0, 0, 0, 0, 120, 117, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 0, 0, 91, 0, 0, 0, 92, 0,
-	0, 0, 100, 0, 0, 0, 0, 0, 0, 0,
-	0, 120, 117, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 119, 0, 103, 104, 105, 0, 106,
-	107, 108, 109, 111, 0, 85, 88, 86, 87, 110,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 83, 0, 0, 0, 93, 70, 119, 0, 103,
-	104, 105, 0, 106, 107, 108, 109, 111, 0, 85,
-	88, 86, 87, 110, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 83, 0, 0, 0, 93,
-	70, 102, 75, 76, 77, 0, 99, 79, 94, 97,
-	95, 96, 0, 71, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 118, 0, 0, 112, 0, 0,
-	0, 0, 0, 0, 0, 102, 75, 76, 77, 0,
-	99, 79, 94, 97, 95, 96, 0, 71, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
-	0, 561, 0, 0, 0, 0, 0, 91, 0, 0,
-	0, 92, 0, 0, 0, 100, 0, 0, 0, 0,
-	0, 0, 0, 0, 120, 117, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 0, 0, 0, 0,
-	0, 91, 0, 0, 0, 92, 0, 0, 0, 100,
-	0, 0, 0, 0, 0, 0, 0, 0, 120, 117,
-	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	119, 0, 103, 104, 105, 0, 106, 107, 108, 109,
-	111, 0, 85, 88, 86, 87, 110, 202, 612, 210,
-	201, 200, 203, 199, 0, 0, 0, 82, 83, 0,
-	0, 0, 93, 115, 119, 0, 103, 104, 105, 0,
-	106, 107, 108, 109, 111, 0, 85, 88, 86, 87,
-	110, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 83, 0, 0, 0, 93, 70, 102, 75,
-	302, 77, 0, 99, 79, 94, 97, 95, 96, 0,
-	71, 202, 478, 210, 201, 200, 203, 199, 0, 0,
-	0, 118, 0, 0, 112, 0, 0, 0, 0, 197,
-	196, 0, 0, 0, 0, 198, 206, 205, 207, 208,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 91, 0, 0, 0, 92, 0,
-	0, 0, 100, 0, 0, 0, 0, 0, 0, 0,
-	0, 120, 117, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 197, 196, 0, 0, 0, 0, 198,
-	206, 205, 207, 208, 209, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 119, 0, 103,
-	104, 105, 0, 106, 107, 108, 109, 111, 0, 85,
-	88, 86, 87, 110, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 83, 0, 0, 0, 93,
-	70,
+	0, 0, 0, 0, 106, 111, 112, 0, 107, 108,
+	109, 110, 113, 114, 115, 116, 117, 118, 119, 120,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 106,
+	111, 112, 0, 107, 108, 109, 110, 113, 114, 115,
+	116, 117, 118, 119, 120,
 }
-var yyPact = [...]int{
 
-	2923, -1000, 301, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+var yyPact = [...]int16{
+	2759, -1000, 234, -1000, -1000, 980, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 3677, 3514, -1000, -1000, 96, 333, 1036,
-	1031, 273, 2131, -1000, 588, 1190, 1185, 562, 562, 567,
-	562, 3514, -1000, -1000, 3514, 3514, 1636, 3514, 3514, 3514,
-	3514, 3514, 3514, -1000, 562, 562, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 310, -1000, -1000, -1000, -1000,
-	3480, -1000, 3120, 1200, 1044, -1000, -1000, -1000, -1000, -1000,
-	-1000, 2152, 3514, 3514, -44, 279, 278, 277, 274, -1000,
-	374, 196, 3514, 3514, -1000, -1000, -1000, -1000, 562, -1000,
+	-1000, -1000, -1000, -1000, 3708, 3612, -1000, -1000, 140, 271,
+	916, 907, 284, 4504, -1000, 498, 1027, 1024, 4529, 4529,
+	580, 4529, 3612, -1000, -1000, 3612, 3612, 4474, 3612, 3612,
+	3612, 3612, 3612, 356, 3612, -1000, 4529, 4352, 4529, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 243, -1000,
+	-1000, -1000, -1000, 3516, -1000, 3223, 1041, 923, -1000, -1000,
+	-1000, -1000, -1000, -1000, 4212, 3612, 3612, -56, 205, 204,
+	203, 200, -1000, 286, 120, 3612, 3612, -1000, -1000, -1000,
+	-1000, 4529, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	271, 270, -57, 2923, 674, 3480, -1000, 269, 267, 263,
-	3514, 689, 2152, -1000, 993, 1126, 1140, 1607, 1131, 704,
-	898, 778, -1000, 776, 3514, 1607, 562, 1607, -1000, 778,
-	46, 308, -1000, 488, -1000, 562, 1389, 562, 562, 414,
-	413, -1000, 927, -1000, 562, -1000, -1000, -1000, -1000, 3514,
-	3514, 1178, 57, 914, 1002, 1177, -1000, 1175, -1000, -1000,
-	102, -44, -1000, -1000, 1559, -44, -1000, -1000, 3874, 3514,
-	1310, 189, 184, 188, 219, 613, 69, 855, 1194, 263,
-	-1000, -1000, -1000, 45, 562, -1000, 3514, 3514, 3514, 817,
-	3514, 788, 97, 3514, 891, 3514, 3514, 3514, 3514, 3514,
-	3514, 3514, -1000, -1000, 1842, 3317, 3514, 2031, 778, 778,
-	97, 97, 864, 882, -1000, -1000, 56, -1000, 394, 778,
-	3514, 1820, -1000, 2923, 184, 183, 3514, 688, 650, 648,
-	3514, 968, 986, 1173, 1144, 1194, 1263, 1607, 1150, 39,
-	-1000, -1000, -1000, -1000, 258, -1000, -1000, -1000, -1000, 1607,
-	1263, 1174, 32, 875, 875, 875, 2248, -1000, 180, -1000,
-	207, 318, 1120, 3514, 1194, 3514, 477, 286, 253, 250,
-	-1000, -1000, -1000, -1000, 3514, 3514, 3514, 3514, 3514, 1117,
-	-1000, -1000, 1203, 3514, 3514, 1192, 1192, 1607, 3514, 3514,
-	3514, -1000, 3514, 2152, -1000, -1000, -1000, -1000, 1173, 2597,
-	562, 1194, 562, 67, 850, 1044, 236, 42, 20, 20,
-	856, 3820, 3514, 97, 3514, -1000, 3480, -1000, 20, 97,
-	97, 264, 264, -1000, -1000, -1000, 1351, 56, -1000, -1000,
-	178, 3514, 177, 1202, -1000, 176, 31, 1104, -1000, 2152,
-	-1000, -1000, -40, 249, 248, 246, 245, 244, 243, 242,
-	3514, 3283, -1000, -1000, 97, 195, 195, 195, 817, -1000,
-	3514, 1496, -1000, -1000, 637, -1000, 3514, 574, 2923, 573,
-	3514, 2128, 671, 473, 471, 3514, 3514, 3086, 1144, 991,
-	3514, -1000, 30, -1000, 99, 1810, -1000, -1000, -1000, 1830,
-	-1000, 239, 517, 171, 1210, 1607, 3711, 204, 1144, 1263,
-	1389, 219, -1000, 219, 219, -1000, -1000, 238, 1210, 562,
-	776, -1000, 117, 416, 1210, 562, 174, -1000, 2152, 1232,
-	562, 776, 185, 562, -1000, -44, -1000, -44, -44, -1000,
-	-44, -1000, -1000, 26, 1102, 1194, -1000, -1000, -1000, 25,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 571, 300, -1000,
-	-1000, 3677, 3514, -1000, -1000, -1000, -1000, -1000, 606, -1000,
-	601, 562, 562, -1000, 237, 562, -1000, -1000, 3514, 3756,
-	-1000, 20, -1000, -1000, -1000, 173, -1000, 3514, -1000, 2248,
-	562, 3317, 778, 778, 778, 778, 3514, 3514, 3514, 172,
-	169, 168, 830, -1000, 147, -1000, 234, -1000, -1000, 512,
-	165, 3514, 568, 647, 2923, 3514, 746, -1000, -1000, 2152,
-	3514, 2923, 1165, 530, 432, 387, -1000, 24, 975, 2152,
-	-1000, 991, 969, 984, 2152, 956, 953, 939, 926, 402,
-	-1000, -1000, -1000, -1000, -1000, 562, 108, 3514, -1000, 562,
-	97, 1210, -1000, 1173, 23, 288, -35, -1000, -16, 19,
-	-44, -57, 233, 1210, -1000, 1144, -1000, 870, -1000, -1000,
-	870, 1210, 164, 18, 163, 17, -1000, 1141, 562, 1022,
-	-1000, 1210, 1001, 998, -1000, -1000, -1000, 161, -1000, 1100,
-	160, 10, -1000, -1000, 9, 1016, -18, 3514, 562, -1000,
-	3514, 710, 2597, 667, 686, 2597, 2597, 598, 596, 776,
-	159, 56, 3514, -1000, 1462, -1000, -1000, 158, 3514, 3514,
-	3514, 3283, 3514, 155, 154, 153, -1000, -1000, -1000, 97,
-	150, 7, 3514, -1000, 773, 351, 1841, 736, 566, -1000,
-	666, -1000, 1994, 685, -1000, 3514, -1000, -1000, 397, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 3086, 344, -1000, -1000,
-	969, -1000, 3514, 3514, 1792, 1676, 952, -1000, 946, 939,
-	-1000, 1058, 196, 4, -1000, -1000, 2, -1000, -1000, 149,
-	1144, 1210, 3514, -1000, 3514, 1389, 1210, 144, -1000, 141,
-	913, 1210, 1099, 562, -1000, -1000, -1000, 1210, 1210, 140,
-	1, 3514, 139, 562, 3514, 1092, 364, 1078, 1194, 1194,
-	3514, 1073, 1194, -1000, -1000, -1000, -1000, -1000, 2597, 643,
-	3514, 565, 556, 2597, 2597, 138, 1070, 56, -1000, 3514,
-	449, 137, 135, 134, 124, 115, 114, 440, 382, 372,
-	-1000, -1000, 97, 1446, -1000, 990, -1000, -1000, 735, 2923,
-	-1000, -1000, 3514, 432, 959, -1000, 346, -1000, 1057, 993,
-	2152, -1000, 957, 196, 1630, 196, 1313, 1160, 945, -22,
-	402, 3514, 869, -1000, -1000, 2152, 111, -26, 110, 895,
-	868, 232, -1000, 776, -1000, -1000, -1000, 1141, 562, 2152,
-	-1000, -1000, -44, -1000, 776, 2760, 362, -1000, -1000, -1000,
-	1016, -1000, 361, 109, 617, 555, 2597, 665, 708, 703,
-	554, 553, -1000, 228, 1730, 225, 439, 424, 418, 415,
-	404, 379, 224, 218, 343, 217, 339, -1000, 3514, 214,
-	-1000, 722, 397, -1000, -1000, -1000, -1000, -1000, 968, -1000,
-	-1000, 3514, 209, 906, 1630, 196, 957, 196, 501, 402,
-	-1000, -58, 107, 97, -1000, -1000, -1000, 3514, 860, 208,
-	97, -1000, 1210, -1000, -1000, -1000, -1000, 549, 291, -1000,
-	-1000, 3677, 3514, -1000, -1000, 3120, 3514, 2760, 2760, 1061,
-	544, 627, 2597, 3514, 744, -1000, 2597, -1000, -1000, 702,
-	700, 776, -1000, 453, 205, 203, 200, 199, 198, 197,
-	453, 453, 386, 453, 384, 1627, 993, -1000, -1000, 425,
-	2152, 562, -1000, -1000, 906, -1000, 957, 196, -1000, -1000,
-	-1000, -1000, 105, 97, -1000, 1210, -1000, 104, -1000, 2760,
-	663, 683, 595, 33, 844, 1194, -1000, 535, 534, 358,
-	732, 533, -1000, 662, -1000, 680, -1000, -1000, 98, 93,
-	-1000, 994, 982, 453, 453, 453, 453, 453, 453, 83,
-	993, 82, 194, 80, 191, -1000, 79, 1156, 78, -1000,
-	-1000, -1000, -1000, 77, 836, -1000, 2760, 625, 3514, 2434,
-	562, 562, 53, 832, -1000, -1000, 2760, -1000, 731, 2597,
-	-1000, 3514, -1000, -1000, -1000, 973, 3514, 76, 75, 71,
-	66, 64, 62, -1000, -1000, 453, -1000, 453, -1000, -1000,
-	-1000, 834, 97, -1000, 593, 531, 2760, 660, 528, 272,
-	-1000, -1000, 3677, 3514, -1000, -1000, -1000, 589, 587, 562,
-	562, 527, -1000, 719, 3086, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 61, 58, 97, -1000, -1000, 519, 616, 2760,
-	3514, 743, -1000, 2760, 698, 2434, 656, 678, 2434, 2434,
-	583, 576, -1000, -1000, 332, -1000, -1000, -1000, 729, 516,
-	-1000, 655, -1000, 677, -1000, -1000, 2434, 594, 3514, 515,
-	507, 2434, 2434, -1000, 847, -1000, 727, 2760, -1000, 3514,
-	586, 494, 2434, 654, 697, 695, 492, 491, -1000, 842,
-	769, 768, 751, -1000, 715, 485, 532, 2434, 3514, 741,
-	-1000, 2434, -1000, -1000, 693, 590, 828, 759, -1000, 766,
-	750, -1000, -1000, -1000, -1000, 726, 483, -1000, 652, -1000,
-	676, -1000, -1000, 826, -1000, -1000, -1000, -1000, -1000, 725,
-	2434, -1000, 3514, -1000, 754, -1000, -1000, 714, -1000, -1000,
+	-1000, 198, 197, -54, 2759, -1000, 281, 576, 3516, -1000,
+	190, 188, 185, 3612, 603, 4212, -1000, 868, 978, 975,
+	4352, 974, 4194, 777, 677, -1000, 673, 3612, 4352, 4529,
+	4352, -1000, 677, 11, 241, -1000, 438, -1000, 4529, 4300,
+	4529, 4529, 342, 304, -1000, 795, -1000, 4529, -1000, -1000,
+	-1000, -1000, 3612, 3612, 1018, 38, 790, 888, 1017, -1000,
+	1016, 268, -1000, -1000, 72, -56, -1000, -1000, 4042, -1000,
+	673, 235, -56, -1000, -1000, 881, -1000, -1000, -1000, -1000,
+	187, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 3900,
+	3612, 3846, 114, 112, 113, 527, 43, 720, 1035, 185,
+	-1000, -1000, -1000, 8, 4529, -1000, 3612, 3612, 3612, 699,
+	3612, 778, 39, 3612, 3612, 759, 3612, 3612, 3612, 3612,
+	3612, 3612, 3612, -1000, -1000, -1000, -1000, 4452, 3415, 3612,
+	2935, 677, 677, 39, 39, 721, 738, -1000, -1000, 1359,
+	-1000, 333, 677, 3612, 4428, -1000, 3612, 2759, 112, 110,
+	3612, 599, 547, 546, 3612, 828, 851, 1011, -1000, 984,
+	413, 2488, 4352, 989, 7, 4352, 2488, 1014, 6, 740,
+	740, 740, 3031, -1000, 109, -1000, 229, 260, 924, 3612,
+	1035, 3612, 396, 258, 183, 182, -1000, -1000, -1000, -1000,
+	3612, 3612, 3612, 3612, 3612, 973, -1000, -1000, 1045, 3612,
+	3612, 1030, 1030, 4352, 4352, 3612, 3612, 3612, -1000, 1011,
+	273, 3804, -1000, 3612, 4212, -1000, -1000, -1000, -1000, 2407,
+	4529, 1035, 4529, 62, 719, 923, 191, 9, -21, -21,
+	757, 4236, 3612, 39, 3612, 3612, -1000, 3516, -1000, -21,
+	-21, 39, 39, 181, 181, -1000, -1000, -1000, 1807, 1359,
+	-1000, -1000, 104, 3612, 103, 1744, -1000, 99, 0, 966,
+	-1000, 4212, -1000, -1000, -47, 176, 175, 174, 173, 172,
+	171, 170, 3612, 3319, -1000, -1000, 39, 126, 126, 126,
+	699, -1000, 3612, 4030, -1000, -1000, 399, 4529, 535, -1000,
+	3612, 493, 2759, 492, 3612, 4200, 575, 394, 374, 3612,
+	3612, 3127, 984, 866, 3612, -1000, -1, -1000, 44, 4410,
+	-1000, -1000, -1000, 2312, -1000, 167, 4377, 165, 4276, 4352,
+	196, 984, 2488, 4300, 235, -1000, 235, 235, -1000, -1000,
+	164, 4276, 4529, 673, -1000, 4034, 2664, 4276, 4529, 98,
+	-1000, 4212, 4322, 4529, 673, 168, 4529, -1000, -56, -1000,
+	-56, -56, -1000, -56, -1000, -1000, -3, 961, 1035, -1000,
+	-1000, -1000, -4, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	3612, -32, -5, -56, -54, -1000, 480, 233, -1000, -1000,
+	3708, 3612, -1000, -1000, -1000, -1000, -1000, 523, -1000, 519,
+	4529, 4529, -1000, 162, 4529, -1000, -1000, 3612, 4224, -1000,
+	-21, -21, -1000, -1000, -1000, 97, -1000, 3612, -1000, 3031,
+	4529, 3415, 677, 677, 677, 677, 3612, 3612, 3612, 96,
+	94, 93, 710, -1000, 78, -1000, 160, -1000, -1000, 406,
+	92, 3612, -1000, 4529, 3612, -1000, 473, 544, 2759, 3612,
+	646, -1000, -1000, 4212, 3612, 2759, 1008, 452, 369, 338,
+	-1000, -6, 836, 4212, -1000, 866, 858, 848, 4212, 824,
+	820, 805, 845, 1488, -1000, -1000, -1000, -1000, -1000, 4529,
+	58, 3612, -1000, 4529, 39, 4276, -1000, 1011, -9, 214,
+	-73, -1000, 156, 4276, -1000, 984, -1000, 732, -1000, -1000,
+	732, 4276, 91, -10, 90, -11, 4529, -1000, 922, 4529,
+	901, -1000, 4276, 878, 877, -1000, -1000, -1000, 89, -12,
+	-1000, 958, 86, -13, -1000, -1000, -16, 891, -36, 3612,
+	4529, -1000, 3612, -1000, -1000, 3612, 4300, 622, 2407, 574,
+	598, 2407, 2407, 518, 515, 673, 85, 1359, 3612, -1000,
+	1989, -1000, -1000, 84, 3612, 3612, 3612, 3319, 3612, 83,
+	82, 81, -1000, -1000, -1000, 39, 80, -18, 3612, -1000,
+	671, 310, 4078, -1000, -56, -1000, 641, 470, -1000, 573,
+	-1000, 4188, 591, -1000, 3612, -1000, -1000, 345, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 3127, 294, -1000, -1000, 858,
+	-1000, 3612, 3612, 3943, 2838, 817, -1000, 815, 805, -1000,
+	835, 120, -20, -1000, -1000, -31, -1000, -1000, 76, 984,
+	4276, 3612, 4276, 74, -1000, 71, 788, 4276, 955, 4529,
+	688, -1000, -1000, -1000, 4276, 4276, 70, -40, 3612, 69,
+	4529, 3612, 949, 4529, 324, 945, 1035, 1035, 3612, 944,
+	1035, -1000, -1000, -1000, 68, -44, -1000, -1000, 2407, 542,
+	3612, 468, 467, 2407, 2407, 66, 940, 1359, -1000, 3612,
+	360, 65, 61, 59, 57, 55, 50, 355, 339, 336,
+	-1000, -1000, 39, 3992, -1000, 860, -1000, -1000, 640, 2759,
+	-1000, -1000, 3612, 369, 829, -1000, 288, -1000, 912, 868,
+	4212, -1000, 809, 120, 1391, 120, 2053, 1995, 771, -43,
+	1488, 3612, 769, -1000, -1000, 4212, 49, 750, 763, 152,
+	-1000, 673, -1000, 681, -1000, -1000, 922, 4529, 4212, -1000,
+	-1000, -56, -1000, 673, -1000, 2583, 321, -1000, -1000, -1000,
+	891, -1000, 317, 48, -1000, -1000, 3612, 517, 466, 2407,
+	572, 620, 619, 465, 464, -1000, 150, 4066, 149, 354,
+	353, 352, 350, 349, 334, 146, 145, 293, 144, 289,
+	-1000, 3612, 143, -1000, 630, 345, -1000, -1000, -1000, -1000,
+	-1000, 828, -1000, -1000, 3612, 139, 772, 1391, 120, 809,
+	120, 1901, 1488, -1000, -74, 47, 39, -1000, 753, 131,
+	39, -1000, 4276, -1000, -1000, -1000, -1000, -1000, 441, 224,
+	-1000, -1000, 3708, 3612, -1000, -1000, 3223, 3612, 2583, 2583,
+	939, 46, 439, 541, 2407, 3612, 645, -1000, 2407, -1000,
+	-1000, 618, 616, 673, -1000, 357, 129, 128, 127, 125,
+	124, 122, 357, 357, 348, 357, 347, 4054, 868, -1000,
+	-1000, 385, 4212, 4529, -1000, -1000, 772, -1000, 809, 120,
+	-1000, -1000, -1000, -1000, 39, -1000, 4276, -1000, 35, -1000,
+	2583, 569, 584, 508, 40, 714, 1035, -1000, 437, 434,
+	315, -1000, 638, 426, -1000, 568, -1000, 583, -1000, -1000,
+	34, 33, -1000, 870, 841, 357, 357, 357, 357, 357,
+	357, 32, 868, 29, 119, 27, 118, -1000, 26, 996,
+	24, -1000, -1000, -1000, 23, 742, -1000, 2583, 540, 3612,
+	2231, 4529, 4529, 51, 712, -1000, -1000, 2583, -1000, 636,
+	2407, -1000, 3612, -1000, -1000, -1000, 840, 3612, 22, 21,
+	20, 19, 18, 17, -1000, -1000, 357, -1000, 357, -1000,
+	-1000, -1000, 731, 39, -1000, 510, 424, 2583, 565, 423,
+	221, -1000, -1000, 3708, 3612, -1000, -1000, -1000, 497, 495,
+	4529, 4529, 421, -1000, 629, 3127, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 16, 14, 39, -1000, -1000, 420, 538,
+	2583, 3612, 644, -1000, 2583, 614, 2231, 562, 582, 2231,
+	2231, 414, 407, -1000, -1000, 287, -1000, -1000, -1000, 635,
+	419, -1000, 561, -1000, 579, -1000, -1000, 2231, 530, 3612,
+	416, 415, 2231, 2231, -1000, 734, -1000, 634, 2583, -1000,
+	3612, 507, 410, 2231, 554, 613, 612, 408, 402, -1000,
+	739, 668, 665, 654, -1000, 627, 401, 511, 2231, 3612,
+	643, -1000, 2231, -1000, -1000, 607, 605, 704, 662, -1000,
+	658, 653, -1000, -1000, -1000, -1000, 633, 400, -1000, 550,
+	-1000, 520, -1000, -1000, 730, -1000, -1000, -1000, -1000, -1000,
+	632, 2231, -1000, 3612, -1000, 660, -1000, -1000, 625, -1000,
+	-1000,
 }
-var yyPgo = [...]int{
 
-	0, 62, 110, 11, 117, 75, 89, 1335, 59, 30,
-	39, 1334, 1333, 1332, 1328, 31, 26, 1325, 1324, 1323,
-	1322, 1321, 1319, 1316, 87, 29, 38, 1313, 1310, 1309,
-	78, 1308, 64, 1307, 1306, 52, 47, 1305, 1303, 1298,
-	1291, 1289, 74, 1288, 107, 105, 1133, 1287, 79, 61,
-	81, 68, 18, 34, 28, 1286, 1285, 41, 1283, 44,
-	21, 1282, 97, 1281, 101, 96, 83, 1053, 0, 72,
-	58, 13, 5, 1280, 1272, 1268, 1266, 1576, 1256, 93,
-	1255, 1253, 1249, 969, 1248, 1247, 1246, 7, 27, 16,
-	19, 1245, 1244, 4, 1242, 1241, 65, 1239, 1238, 94,
-	90, 91, 1237, 20, 35, 547, 1235, 37, 1234, 1232,
-	1231, 23, 69, 1228, 36, 32, 77, 88, 33, 86,
-	1227, 1226, 1223, 63, 1222, 1221, 43, 82, 12, 25,
-	6, 17, 2, 3, 70, 1219, 9, 1218, 10, 1217,
-	8, 1215, 1447, 22, 66, 14, 1211, 106, 1123, 1209,
-	100, 99, 98, 85, 67, 80, 102, 1207, 56, 797,
+var yyPgo = [...]int16{
+	0, 68, 12, 285, 139, 119, 127, 1205, 91, 29,
+	65, 1199, 1198, 1196, 1195, 242, 16, 1193, 1191, 1190,
+	1189, 1184, 1183, 1182, 79, 32, 39, 1181, 1179, 1178,
+	73, 1177, 54, 1176, 1173, 44, 38, 1170, 1168, 1167,
+	1166, 1165, 1268, 1162, 1156, 95, 81, 955, 1154, 436,
+	78, 1145, 60, 48, 75, 53, 20, 30, 31, 1143,
+	1140, 35, 1138, 33, 1006, 1137, 97, 1136, 93, 89,
+	764, 345, 0, 61, 26, 27, 9, 1131, 1129, 1127,
+	1126, 1212, 1124, 84, 1120, 1119, 1118, 1083, 1115, 1114,
+	1113, 11, 34, 10, 22, 1112, 1106, 3, 1104, 1103,
+	62, 1099, 1096, 82, 83, 85, 1089, 15, 37, 90,
+	1087, 28, 1086, 1085, 1078, 17, 59, 1076, 236, 14,
+	64, 87, 18, 1075, 40, 80, 1073, 1071, 1070, 57,
+	1066, 1065, 24, 71, 13, 19, 5, 8, 2, 6,
+	56, 1064, 23, 1063, 7, 1061, 4, 1059, 1257, 36,
+	21, 41, 1056, 96, 988, 1055, 94, 147, 86, 77,
+	58, 63, 110, 1052, 45, 671, 98,
 }
-var yyR1 = [...]int{
 
+var yyR1 = [...]uint8{
 	0, 1, 1, 1, 2, 2, 3, 3, 4, 4,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
-	5, 5, 5, 5, 5, 5, 5, 5, 6, 6,
-	7, 7, 8, 8, 8, 8, 8, 9, 9, 10,
-	10, 12, 12, 11, 11, 11, 11, 11, 13, 13,
-	13, 13, 13, 13, 14, 14, 15, 15, 15, 15,
-	15, 16, 16, 17, 17, 18, 18, 18, 18, 18,
-	19, 19, 19, 19, 19, 19, 20, 20, 20, 20,
-	21, 21, 21, 21, 21, 22, 22, 23, 23, 23,
-	23, 23, 23, 23, 23, 23, 23, 24, 24, 25,
-	25, 26, 26, 26, 26, 26, 27, 27, 27, 27,
-	27, 27, 27, 28, 28, 28, 28, 29, 29, 30,
-	30, 31, 31, 31, 31, 32, 33, 33, 34, 35,
-	35, 36, 36, 36, 37, 37, 37, 37, 37, 38,
-	38, 38, 38, 38, 38, 38, 39, 39, 39, 40,
+	5, 5, 5, 5, 5, 5, 5, 5, 5, 6,
+	6, 7, 7, 8, 8, 8, 8, 8, 9, 9,
+	10, 10, 12, 12, 11, 11, 11, 11, 11, 13,
+	13, 13, 13, 13, 13, 14, 14, 15, 15, 15,
+	15, 15, 16, 16, 17, 17, 18, 18, 18, 18,
+	18, 19, 19, 19, 19, 19, 19, 20, 20, 20,
+	20, 21, 21, 21, 21, 21, 22, 22, 23, 23,
+	23, 23, 23, 23, 23, 23, 23, 23, 123, 123,
+	123, 124, 124, 24, 24, 25, 25, 26, 26, 26,
+	26, 26, 27, 27, 27, 27, 27, 27, 27, 28,
+	28, 28, 28, 29, 29, 30, 30, 31, 31, 31,
+	31, 32, 33, 33, 34, 35, 35, 36, 36, 36,
+	37, 37, 37, 37, 37, 38, 38, 38, 38, 38,
+	38, 38, 39, 39, 39, 40, 40, 40, 40, 40,
 	40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
 	40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
 	40, 40, 40, 41, 41, 41, 42, 42, 43, 43,
-	44, 44, 44, 44, 45, 45, 46, 47, 48, 48,
+	44, 44, 45, 45, 45, 45, 46, 46, 47, 48,
 	49, 49, 50, 50, 51, 51, 52, 52, 53, 53,
-	53, 54, 54, 54, 55, 55, 56, 56, 57, 57,
-	57, 58, 58, 58, 59, 59, 60, 60, 61, 61,
-	62, 62, 63, 63, 63, 63, 63, 63, 64, 65,
-	66, 66, 66, 66, 66, 67, 67, 67, 68, 68,
-	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
-	68, 68, 68, 68, 68, 69, 70, 70, 70, 71,
-	71, 72, 72, 73, 73, 74, 74, 75, 75, 75,
-	76, 76, 77, 78, 79, 79, 79, 80, 80, 80,
-	80, 80, 80, 80, 80, 80, 80, 80, 80, 80,
-	80, 80, 80, 80, 80, 80, 81, 81, 81, 81,
-	81, 81, 81, 82, 82, 82, 82, 83, 83, 84,
-	84, 84, 84, 84, 84, 84, 84, 85, 85, 85,
-	85, 85, 85, 86, 86, 87, 87, 87, 87, 87,
-	87, 87, 87, 87, 87, 87, 87, 88, 89, 89,
-	90, 90, 91, 91, 92, 92, 92, 93, 93, 93,
-	94, 94, 95, 95, 96, 96, 97, 97, 97, 97,
-	98, 98, 98, 98, 99, 99, 102, 102, 102, 103,
-	103, 103, 104, 104, 104, 104, 105, 105, 105, 105,
-	105, 105, 105, 106, 106, 106, 106, 106, 106, 106,
-	106, 106, 106, 107, 107, 108, 108, 109, 109, 109,
-	110, 111, 111, 112, 112, 113, 113, 114, 114, 115,
-	115, 116, 116, 117, 117, 100, 100, 101, 101, 118,
-	118, 119, 119, 120, 120, 120, 120, 121, 122, 123,
-	123, 124, 124, 124, 124, 124, 124, 124, 124, 125,
-	125, 126, 126, 127, 127, 128, 128, 129, 129, 130,
-	130, 131, 131, 132, 132, 133, 133, 134, 134, 135,
-	135, 136, 136, 137, 137, 138, 138, 139, 139, 140,
-	140, 141, 141, 142, 142, 142, 142, 142, 142, 142,
-	142, 143, 144, 144, 145, 146, 146, 147, 147, 148,
-	149, 150, 151, 151, 152, 152, 153, 153, 154, 154,
-	155, 155, 155, 156, 156, 157, 157, 158, 158, 159,
-	159,
+	54, 54, 55, 55, 56, 56, 57, 57, 57, 58,
+	58, 58, 59, 59, 60, 60, 61, 61, 61, 62,
+	62, 62, 63, 63, 64, 64, 65, 65, 66, 66,
+	67, 67, 67, 67, 67, 67, 68, 69, 70, 70,
+	70, 70, 70, 71, 71, 71, 72, 72, 72, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 72,
+	72, 72, 72, 73, 74, 74, 74, 75, 75, 76,
+	76, 77, 77, 78, 78, 79, 79, 79, 80, 80,
+	81, 82, 83, 83, 83, 84, 84, 84, 84, 84,
+	84, 84, 84, 84, 84, 84, 84, 84, 84, 84,
+	84, 84, 84, 84, 84, 84, 85, 85, 85, 85,
+	85, 85, 85, 86, 86, 86, 86, 87, 87, 88,
+	88, 88, 88, 88, 88, 88, 88, 89, 89, 89,
+	89, 89, 89, 90, 90, 91, 91, 91, 91, 91,
+	91, 91, 91, 91, 91, 91, 91, 92, 93, 93,
+	94, 94, 95, 95, 96, 96, 96, 97, 97, 97,
+	98, 98, 99, 99, 100, 100, 101, 101, 101, 101,
+	101, 101, 101, 101, 102, 102, 102, 102, 103, 103,
+	106, 106, 106, 107, 107, 107, 108, 108, 108, 108,
+	109, 109, 109, 109, 109, 109, 109, 110, 110, 110,
+	110, 110, 110, 110, 110, 110, 110, 111, 111, 112,
+	112, 113, 113, 113, 114, 115, 115, 116, 116, 117,
+	117, 118, 118, 119, 119, 120, 120, 121, 121, 104,
+	104, 105, 105, 122, 122, 125, 125, 126, 126, 126,
+	126, 127, 128, 129, 129, 130, 130, 130, 130, 130,
+	130, 130, 130, 131, 131, 132, 132, 133, 133, 134,
+	134, 135, 135, 136, 136, 137, 137, 138, 138, 139,
+	139, 140, 140, 141, 141, 142, 142, 143, 143, 144,
+	144, 145, 145, 146, 146, 147, 147, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 148, 148, 148,
+	148, 148, 148, 149, 150, 150, 151, 152, 152, 153,
+	153, 154, 155, 156, 157, 157, 158, 158, 159, 159,
+	160, 160, 161, 161, 161, 162, 162, 163, 163, 164,
+	164, 165, 165, 166, 166,
 }
-var yyR2 = [...]int{
 
+var yyR2 = [...]int8{
 	0, 0, 1, 3, 0, 3, 0, 3, 0, 3,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 6, 8, 8, 9, 9, 1, 1, 1,
-	2, 1, 1, 7, 8, 6, 1, 1, 7, 8,
-	6, 1, 1, 1, 1, 1, 6, 8, 8, 9,
-	9, 1, 2, 1, 1, 7, 8, 6, 1, 1,
-	7, 8, 6, 1, 1, 1, 2, 2, 1, 2,
-	4, 4, 4, 4, 2, 1, 1, 6, 8, 5,
-	6, 8, 5, 7, 7, 7, 7, 1, 3, 1,
-	3, 0, 1, 1, 2, 2, 5, 5, 2, 4,
-	2, 3, 5, 6, 8, 5, 3, 1, 3, 1,
-	3, 4, 2, 4, 3, 1, 1, 3, 3, 1,
-	3, 1, 1, 3, 9, 10, 10, 12, 3, 0,
-	1, 1, 1, 1, 2, 2, 5, 6, 3, 4,
-	4, 4, 4, 4, 4, 2, 2, 2, 2, 4,
-	4, 2, 2, 2, 4, 1, 2, 2, 4, 2,
-	2, 1, 2, 2, 3, 4, 4, 6, 9, 11,
-	5, 4, 4, 4, 1, 1, 3, 2, 0, 2,
-	0, 2, 0, 3, 0, 2, 0, 3, 1, 6,
-	5, 0, 1, 2, 1, 1, 0, 1, 1, 1,
-	1, 0, 1, 1, 0, 3, 0, 2, 6, 9,
-	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 3, 3, 3, 1, 1, 3, 1, 1,
+	1, 1, 1, 6, 8, 8, 9, 9, 1, 1,
+	1, 2, 1, 1, 7, 8, 6, 1, 1, 7,
+	8, 6, 1, 1, 1, 1, 1, 6, 8, 8,
+	9, 9, 1, 2, 1, 1, 7, 8, 6, 1,
+	1, 7, 8, 6, 1, 1, 1, 2, 2, 1,
+	2, 4, 4, 4, 4, 2, 1, 1, 6, 8,
+	5, 6, 8, 5, 7, 7, 7, 7, 1, 2,
+	4, 1, 3, 1, 3, 1, 3, 0, 1, 1,
+	2, 2, 5, 5, 2, 4, 2, 3, 5, 6,
+	8, 5, 3, 1, 3, 1, 3, 4, 2, 4,
+	3, 1, 1, 3, 3, 1, 3, 1, 1, 3,
+	9, 10, 10, 12, 3, 0, 1, 1, 1, 1,
+	2, 2, 5, 6, 3, 4, 4, 4, 4, 4,
+	4, 2, 2, 2, 2, 4, 4, 2, 2, 2,
+	4, 1, 2, 2, 3, 2, 4, 4, 2, 2,
+	1, 2, 5, 2, 3, 4, 4, 6, 9, 11,
+	2, 3, 5, 4, 4, 4, 1, 1, 3, 2,
+	4, 4, 0, 2, 2, 2, 0, 2, 0, 2,
+	0, 3, 0, 2, 0, 3, 1, 6, 5, 0,
+	1, 2, 1, 1, 0, 1, 1, 1, 1, 0,
+	1, 1, 0, 3, 0, 2, 6, 9, 1, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	3, 3, 3, 1, 1, 3, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 3, 1, 1, 3, 1, 6, 1,
-	3, 1, 3, 2, 4, 1, 1, 0, 1, 1,
-	1, 1, 3, 3, 3, 1, 6, 3, 3, 3,
-	3, 4, 4, 5, 6, 6, 3, 4, 4, 3,
+	1, 3, 1, 1, 3, 1, 6, 1, 3, 1,
+	3, 2, 4, 1, 1, 0, 1, 1, 1, 1,
+	3, 3, 3, 1, 6, 3, 3, 3, 3, 4,
+	4, 5, 6, 6, 3, 4, 4, 3, 4, 3,
 	4, 4, 4, 4, 4, 2, 3, 3, 3, 3,
 	3, 2, 2, 3, 3, 2, 2, 0, 1, 4,
 	4, 6, 8, 3, 4, 4, 4, 5, 5, 5,
@@ -1511,271 +1733,284 @@ var yyR2 = [...]int{
 	9, 9, 8, 8, 10, 8, 10, 2, 1, 5,
 	0, 3, 2, 5, 2, 2, 2, 2, 2, 2,
 	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	4, 6, 6, 8, 1, 1, 1, 6, 6, 1,
-	2, 3, 1, 2, 3, 4, 1, 2, 3, 1,
-	1, 1, 3, 4, 5, 6, 5, 6, 5, 6,
-	7, 6, 7, 2, 4, 1, 1, 1, 3, 1,
-	5, 0, 1, 4, 5, 0, 2, 1, 3, 1,
-	3, 1, 3, 1, 3, 1, 3, 1, 3, 1,
-	3, 1, 3, 6, 9, 5, 8, 7, 3, 1,
-	3, 10, 13, 9, 12, 9, 12, 8, 11, 5,
-	6, 4, 5, 0, 2, 4, 5, 0, 2, 4,
+	1, 1, 1, 1, 4, 6, 6, 8, 1, 1,
+	1, 6, 6, 1, 2, 3, 1, 2, 3, 4,
+	1, 2, 3, 1, 1, 1, 3, 4, 5, 6,
+	5, 6, 5, 6, 7, 6, 7, 2, 4, 1,
+	1, 1, 3, 1, 5, 0, 1, 4, 5, 0,
+	2, 1, 3, 1, 3, 1, 3, 1, 3, 1,
+	3, 1, 3, 1, 3, 1, 3, 6, 9, 5,
+	8, 7, 3, 1, 3, 10, 13, 9, 12, 9,
+	12, 8, 11, 5, 6, 4, 5, 0, 2, 4,
 	5, 0, 2, 4, 5, 0, 2, 4, 5, 0,
 	2, 4, 5, 0, 2, 4, 5, 0, 2, 4,
-	5, 0, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 3, 3, 1, 3, 1, 3, 1,
-	1, 1, 0, 1, 0, 1, 0, 1, 0, 1,
-	1, 1, 1, 0, 1, 0, 1, 0, 1, 1,
-	1,
+	5, 0, 2, 4, 5, 0, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 3, 1, 3, 1,
+	3, 1, 1, 1, 0, 1, 0, 1, 0, 1,
+	0, 1, 1, 1, 1, 0, 1, 0, 1, 0,
+	1, 1, 1, 1, 1,
 }
-var yyChk = [...]int{
 
-	-1000, -1, -7, -5, -11, -42, -43, -120, -121, -124,
-	-125, -23, -20, -21, -27, -28, -31, -37, -22, -40,
-	-41, -68, 15, 88, 87, -8, -10, -60, 27, 32,
-	35, 133, 96, -145, 102, 20, 21, 100, 101, 99,
-	103, 120, 111, 112, 33, 124, 134, 116, 117, 118,
-	119, 125, 121, 122, 123, 126, -63, -81, -78, -77,
-	-84, -85, -110, -80, -82, -143, -148, -149, -150, -39,
-	166, 16, 90, 115, 80, 5, 6, 7, -64, 10,
-	-65, -67, 160, 161, -142, 145, 147, 148, 146, -86,
-	-70, 70, 74, 165, 11, 13, 14, 12, 97, 9,
-	78, -66, 4, 135, 136, 137, 139, 140, 141, 142,
-	149, 143, 30, 158, -68, 166, -145, 88, 27, 133,
-	87, -111, -67, -68, -44, -46, 24, 19, 27, 22,
-	-45, 17, -77, 166, 166, 25, 36, 36, -147, 166,
-	-146, -143, -147, -142, -143, 97, 44, 103, 127, -148,
-	-150, -148, -142, -142, -38, 104, 105, 37, 38, 106,
-	107, -142, -142, -68, -68, -68, -150, -142, -68, -68,
-	-68, -142, -68, -115, -67, -142, -68, -142, -142, 155,
-	-67, -68, -115, -42, -60, -68, -143, -144, -9, 133,
-	96, 6, -62, -61, -157, 31, 154, 153, 159, 77,
-	75, 74, 71, 76, -159, 161, 160, 162, 163, 164,
-	73, 72, -67, -67, 169, 166, 166, 166, 166, 166,
-	153, 159, -152, -159, 74, -77, -67, -67, -142, 166,
-	166, 169, -1, 92, -115, -83, 166, -111, -134, -112,
-	91, -52, 45, -47, -48, 25, 18, 25, -101, -99,
-	-96, -98, -142, 30, -97, 139, 140, 141, 142, 25,
-	18, -100, -96, 65, 66, 67, -151, 79, -83, -115,
-	-99, -142, -99, -151, 168, 155, 97, 44, 127, 128,
-	-142, -96, -142, -142, 159, 43, 159, 43, 62, -142,
-	-68, -68, 18, 62, 62, 43, 18, 18, 168, 62,
-	168, -68, 6, -67, 167, 167, 167, 167, -46, 94,
-	71, 168, 71, -143, -144, 168, -142, -67, -67, -67,
-	-152, -67, 75, 71, 76, -70, 166, -77, -67, 69,
-	68, -67, -67, -67, -67, -67, -67, -67, -142, 6,
-	-83, -151, -83, -67, 167, -119, -109, -108, -69, -67,
-	-87, 162, -142, 148, 133, 146, 149, 150, 151, 152,
-	-151, -151, -70, -70, 75, 71, 69, 68, 77, 146,
-	-151, -67, -142, 6, -1, 167, 91, -135, 93, -113,
-	93, -67, -68, -53, -59, 51, 52, 48, -48, -49,
-	23, -144, -143, -117, -105, -102, -106, 29, -103, 166,
-	-99, 144, -77, -99, 20, 168, 166, -99, -117, 18,
-	168, -156, 68, -156, -156, -119, 167, 62, 166, 166,
-	-158, 28, 33, 34, 42, 20, -83, -147, -67, 98,
-	166, 28, 166, 166, -68, -142, -68, -142, -142, -68,
-	-142, -68, -30, -29, -68, 25, 5, -30, -116, -68,
-	-150, -150, -99, -116, -116, -115, -68, -2, -12, -5,
-	-13, 88, 87, -8, -10, -6, 113, 114, -142, -144,
-	-142, 71, 71, -62, 28, 166, -64, -65, 72, -67,
-	-70, -67, -70, -70, 167, -83, 167, 18, 167, 168,
-	28, 166, 166, 166, 166, 166, 166, 166, 166, -83,
-	-83, -69, -70, -79, 166, -77, 143, -79, -79, -152,
-	-83, 168, -127, -126, 93, 89, 95, -1, 95, -67,
-	92, 92, 98, 99, -68, -68, -72, -73, -74, -67,
-	-87, -49, -50, 46, -67, 60, -153, -155, 63, 168,
-	55, 57, 58, 59, -142, 28, -105, 166, -142, 28,
-	26, 166, -42, -123, -122, -66, -142, -101, -96, -68,
-	-142, 30, 62, 166, -49, -117, -100, -45, -44, -45,
-	-45, 166, -114, -66, -118, -142, -42, -24, 166, -142,
-	-66, 166, -66, -142, 167, -42, -142, -118, -42, 167,
-	-36, -33, -35, -32, -34, -143, -142, 168, 28, -144,
-	168, 95, 158, -68, -111, 94, 94, -142, -142, 166,
-	-118, -67, 72, 167, -67, -119, -142, -83, -151, -151,
-	-151, -151, -151, -83, -83, -83, 167, 167, 167, 72,
-	-71, -70, 166, 100, 71, 167, -67, 95, -127, -1,
-	-68, 87, -67, -1, 19, -55, 37, 104, -56, -57,
-	53, 86, 137, -58, 86, 137, 168, -75, 49, 50,
-	-50, -51, 47, 48, 54, 54, -154, 56, -153, -155,
-	-104, -105, 64, -103, -142, 167, -68, -142, -71, -114,
-	-48, 168, 159, 167, 168, 168, 166, -114, -49, -114,
-	167, 168, 167, 168, -26, 37, 38, 39, 40, -25,
-	-24, 41, -114, 43, 43, 167, 28, 167, 168, 168,
-	41, 167, 168, -30, -142, -116, 90, -2, 92, -136,
-	91, -2, -2, 94, 94, -42, 167, -67, 167, 98,
-	167, -83, -83, -83, -83, -69, -83, 167, 167, 167,
-	-70, 167, 168, -67, 81, 132, 167, 88, 95, 92,
-	-112, -134, 91, -68, -54, 138, 80, -72, 136, -51,
-	-67, -115, -105, 64, -105, 64, 54, 54, -154, -103,
-	168, 168, 167, -49, -123, -67, -83, -96, -114, 167,
-	167, 62, -114, -158, -118, -66, -66, 167, 168, -67,
-	167, -142, -142, -68, 28, 129, 28, -32, -35, -35,
-	-143, -68, 28, -36, -2, -137, 93, -68, 95, 95,
-	-2, -2, 167, 28, -67, 110, 167, 167, 167, 167,
-	167, 167, 110, 110, 131, 110, 131, -71, 168, 46,
-	88, -1, -57, -59, 135, -76, 37, 38, -52, -103,
-	-107, 61, 62, -103, -105, 64, -105, 64, 54, 168,
-	-104, -142, -68, 26, -42, 167, 167, 168, 167, 62,
-	26, -42, 166, -42, -26, -25, -42, -3, -14, -5,
-	-18, 88, 87, -15, -16, 90, 130, 129, 129, 167,
-	-129, -128, 93, 89, 95, -2, 92, 90, 90, 95,
-	95, 166, 167, 166, 110, 110, 110, 110, 110, 110,
-	166, 166, 136, 166, 136, -67, 166, -126, -54, -53,
-	-67, 166, -107, -107, -103, -103, -105, 64, -104, 167,
-	167, -71, -83, 26, -42, 166, -71, -114, 95, 158,
-	-68, -111, -68, -143, -144, -9, -68, -3, -3, 28,
-	95, -129, -2, -68, 87, -2, 90, 90, -42, -89,
-	-88, -90, 109, 166, 166, 166, 166, 166, 166, -88,
-	-90, -89, 110, -88, 110, 167, -52, 98, -118, -107,
-	-103, 167, -71, -114, 167, -3, 92, -138, 91, 94,
-	71, 71, -143, -144, 95, 95, 129, 88, 95, 92,
-	-136, 91, 167, 167, -52, 45, 48, -89, -89, -89,
-	-89, -89, -88, 167, 167, 166, 167, 166, 167, 19,
-	167, 167, 26, -42, -3, -139, 93, -68, -4, -17,
-	-5, -19, 88, 87, -15, -16, -6, -142, -142, 71,
-	71, -3, 88, -2, 48, -115, 167, 167, 167, 167,
-	167, 167, -89, -88, 26, -42, -71, -131, -130, 93,
-	89, 95, -3, 92, 95, 158, -68, -111, 94, 94,
-	-142, -142, 95, -128, -72, 167, 167, -71, 95, -131,
-	-3, -68, 87, -3, 90, -4, 92, -140, 91, -4,
-	-4, 94, 94, -91, 137, 88, 95, 92, -138, 91,
-	-4, -141, 93, -68, 95, 95, -4, -4, -92, 75,
-	82, 6, 85, 88, -3, -133, -132, 93, 89, 95,
-	-4, 92, 90, 90, 95, 95, -94, 82, -93, 6,
-	85, 83, 83, 86, -130, 95, -133, -4, -68, 87,
-	-4, 90, 90, 72, 83, 83, 84, 86, 88, 95,
-	92, -140, 91, -95, 82, -93, 88, -4, 84, -132,
+var yyChk = [...]int16{
+	-1000, -1, -7, -5, -11, -42, -43, -44, -126, -127,
+	-130, -131, -23, -20, -21, -27, -28, -31, -37, -22,
+	-40, -41, -72, 15, 90, 89, -8, -10, -64, 27,
+	32, 35, 136, 98, -151, 104, 20, 21, 102, 103,
+	101, 105, 122, 113, 114, 33, 126, 137, 118, 119,
+	120, 121, 127, 138, 123, 124, 125, 129, 128, -67,
+	-85, -82, -81, -88, -89, -114, -84, -86, -149, -154,
+	-155, -156, -39, 179, 16, 92, 117, 82, 5, 6,
+	7, -68, 10, -69, -71, 173, 174, -148, 158, 160,
+	161, 159, -90, -74, 70, 74, 178, 11, 13, 14,
+	12, 99, 9, 80, -70, 4, 140, 144, 145, 146,
+	147, 141, 142, 148, 149, 150, 151, 152, 153, 154,
+	155, 162, 156, 30, 171, -49, 25, -72, 179, -151,
+	90, 27, 136, 89, -115, -71, -72, -45, -47, 24,
+	19, 27, 22, -46, 17, -81, 179, 179, 25, 36,
+	36, -153, 179, -152, -149, -153, -148, -149, 99, 44,
+	105, 130, -154, -156, -154, -148, -148, -38, 106, 107,
+	37, 38, 108, 109, -148, -148, -72, -72, -72, -156,
+	-148, 146, -72, -72, -72, -148, -72, -119, -71, -42,
+	139, -64, -148, -72, -148, -103, -100, -102, -148, 30,
+	-101, 148, 149, 150, 151, 152, 153, 154, 155, -148,
+	168, -71, -72, -119, -42, -72, -149, -150, -9, 136,
+	98, 6, -66, -65, -163, 31, 167, 166, 172, 79,
+	75, 74, 71, 76, -166, -165, 174, 173, 175, 176,
+	177, 73, 72, 77, 78, -71, -71, 182, 179, 179,
+	179, 179, 179, 166, 172, -158, -165, 74, -81, -71,
+	-71, -148, 179, 179, 182, -1, 144, 94, -119, -87,
+	179, -115, -140, -116, 93, -56, 45, -48, -49, -52,
+	25, 18, 25, -105, -103, 25, 18, -104, -100, 65,
+	66, 67, -157, 81, -87, -119, -103, -148, -103, -157,
+	181, 168, 99, 44, 130, 131, -148, -100, -148, -148,
+	172, 43, 172, 43, 62, -148, -72, -72, 18, 62,
+	62, 43, 18, 18, 147, 181, 62, 181, -42, -47,
+	43, 179, -72, 6, -71, 180, 180, 180, 180, 96,
+	71, 181, 71, -149, -150, 181, -148, -71, -71, -71,
+	-158, -71, 75, 71, 76, -166, -74, 179, -81, -71,
+	-71, 69, 68, -71, -71, -71, -71, -71, -71, -71,
+	-148, 6, -87, -157, -87, -71, 180, -125, -113, -112,
+	-73, -71, -91, 175, -148, 161, 136, 159, 162, 163,
+	164, 165, -157, -157, -74, -74, 75, 71, 69, 68,
+	79, 159, -157, -71, -148, 6, -148, -72, -1, 180,
+	93, -141, 95, -117, 95, -71, -72, -57, -63, 51,
+	52, 48, -52, -53, 23, -150, -149, -121, -109, -106,
+	-110, 29, -107, 179, -103, 157, -81, -103, 20, 181,
+	-103, -121, 18, 181, -162, 68, -162, -162, -125, 180,
+	62, 179, 179, -164, 28, 33, 34, 42, 20, -87,
+	-153, -71, 100, 179, 28, 179, 179, -72, -148, -72,
+	-148, -148, -72, -148, -72, -30, -29, -72, 25, 5,
+	-30, -120, -72, -156, -156, -103, -103, -120, -120, -119,
+	145, -100, -72, -148, 30, -72, -2, -12, -5, -13,
+	90, 89, -8, -10, -6, 115, 116, -148, -150, -148,
+	71, 71, -66, 28, 179, -68, -69, 72, -71, -74,
+	-71, -71, -74, -74, 180, -87, 180, 18, 180, 181,
+	28, 179, 179, 179, 179, 179, 179, 179, 179, -87,
+	-87, -73, -74, -83, 179, -81, 156, -83, -83, -158,
+	-87, 181, -50, -51, -148, -50, -133, -132, 95, 91,
+	97, -1, 97, -71, 94, 94, 100, 101, -72, -72,
+	-76, -77, -78, -71, -91, -53, -54, 46, -71, 60,
+	-159, -161, 63, 181, 55, 57, 58, 59, -148, 28,
+	-109, 179, -148, 28, 26, 179, -42, -129, -128, -70,
+	-148, -105, 62, 179, -53, -121, -104, -46, -45, -46,
+	-46, 179, -118, -70, -124, -123, -148, -42, -24, 179,
+	-148, -70, 179, -70, -148, 180, -42, -148, -122, -148,
+	-42, 180, -36, -33, -35, -32, -34, -149, -148, 181,
+	28, -150, 181, -72, 180, 181, 181, 97, 171, -72,
+	-115, 96, 96, -148, -148, 179, -122, -71, 72, 180,
+	-71, -125, -148, -87, -157, -157, -157, -157, -157, -87,
+	-87, -87, 180, 180, 180, 72, -75, -74, 179, 102,
+	71, 180, -71, -50, -148, -72, 97, -133, -1, -72,
+	89, -71, -1, 19, -59, 37, 106, -60, -61, 53,
+	88, 142, -62, 88, 142, 181, -79, 49, 50, -54,
+	-55, 47, 48, 54, 54, -160, 56, -159, -161, -108,
+	-109, 64, -107, -148, 180, -72, -148, -75, -118, -52,
+	181, 172, 179, -118, -53, -118, 180, 181, 180, 181,
+	-148, -26, 37, 38, 39, 40, -25, -24, 41, -118,
+	43, 43, 180, 181, 28, 180, 181, 181, 41, 180,
+	181, -30, -148, -120, -87, -100, 92, -2, 94, -142,
+	93, -2, -2, 96, 96, -42, 180, -71, 180, 100,
+	180, -87, -87, -87, -87, -73, -87, 180, 180, 180,
+	-74, 180, 181, -71, 83, 135, 180, 90, 97, 94,
+	-116, -140, 93, -72, -58, 143, 82, -76, 141, -55,
+	-71, -119, -109, 64, -109, 64, 54, 54, -160, -107,
+	181, 181, 180, -53, -129, -71, -118, 180, 180, 62,
+	-118, -164, -124, 74, -70, -70, 180, 181, -71, 180,
+	-148, -148, -72, 28, -122, 132, 28, -32, -35, -35,
+	-149, -72, 28, -36, 180, 180, 181, -2, -143, 95,
+	-72, 97, 97, -2, -2, 180, 28, -71, 112, 180,
+	180, 180, 180, 180, 180, 112, 112, 134, 112, 134,
+	-75, 181, 46, 90, -1, -61, -63, 140, -80, 37,
+	38, -56, -107, -111, 61, 62, -107, -109, 64, -109,
+	64, 54, 181, -108, -148, -72, 26, -42, 180, 62,
+	26, -42, 179, -42, 80, -26, -25, -42, -3, -14,
+	-5, -18, 90, 89, -15, -16, 92, 133, 132, 132,
+	180, -87, -135, -134, 95, 91, 97, -2, 94, 92,
+	92, 97, 97, 179, 180, 179, 112, 112, 112, 112,
+	112, 112, 179, 179, 141, 179, 141, -71, 179, -132,
+	-58, -57, -71, 179, -111, -111, -107, -107, -109, 64,
+	-108, 180, 180, -75, 26, -42, 179, -75, -118, 97,
+	171, -72, -115, -72, -149, -150, -9, -72, -3, -3,
+	28, 180, 97, -135, -2, -72, 89, -2, 92, 92,
+	-42, -93, -92, -94, 111, 179, 179, 179, 179, 179,
+	179, -92, -94, -93, 112, -92, 112, 180, -56, 100,
+	-122, -111, -107, -75, -118, 180, -3, 94, -144, 93,
+	96, 71, 71, -149, -150, 97, 97, 132, 90, 97,
+	94, -142, 93, 180, 180, -56, 45, 48, -93, -93,
+	-93, -93, -93, -92, 180, 180, 179, 180, 179, 180,
+	19, 180, 180, 26, -42, -3, -145, 95, -72, -4,
+	-17, -5, -19, 90, 89, -15, -16, -6, -148, -148,
+	71, 71, -3, 90, -2, 48, -119, 180, 180, 180,
+	180, 180, 180, -93, -92, 26, -42, -75, -137, -136,
+	95, 91, 97, -3, 94, 97, 171, -72, -115, 96,
+	96, -148, -148, 97, -134, -76, 180, 180, -75, 97,
+	-137, -3, -72, 89, -3, 92, -4, 94, -146, 93,
+	-4, -4, 96, 96, -95, 142, 90, 97, 94, -144,
+	93, -4, -147, 95, -72, 97, 97, -4, -4, -96,
+	75, 84, 6, 87, 90, -3, -139, -138, 95, 91,
+	97, -4, 94, 92, 92, 97, 97, -98, 84, -97,
+	6, 87, 85, 85, 88, -136, 97, -139, -4, -72,
+	89, -4, 92, 92, 72, 85, 85, 86, 88, 90,
+	97, 94, -146, 93, -99, 84, -97, 90, -4, 86,
+	-138,
 }
-var yyDef = [...]int{
 
-	-2, -2, 2, 30, 31, 10, 11, 12, 13, 14,
+var yyDef = [...]int16{
+	-2, -2, 2, 31, 32, 10, 11, 12, 13, 14,
 	15, 16, 17, 18, 19, 20, 21, 22, 23, 24,
-	25, -2, 27, 0, 401, 46, 47, 0, 0, 0,
-	0, 0, 0, -2, 0, 0, 0, 0, 0, 139,
-	0, 0, 85, 86, 0, 0, 0, 0, 0, 0,
-	0, 165, 0, 171, 0, 0, 238, 239, 240, 241,
-	242, 243, 244, 245, 246, 247, 249, 250, 251, 252,
-	216, 254, 0, 39, 505, 222, 223, 224, 225, 226,
-	227, 0, 0, 0, 230, 0, 0, 0, 0, 322,
-	494, 0, 0, 0, 481, 489, 490, 491, 0, 228,
-	229, 235, 473, 474, 475, 476, 477, 478, 479, 480,
-	0, 0, 0, -2, 236, -2, 248, 0, 0, 0,
-	401, 0, 402, 236, -2, 188, 0, 0, 0, 0,
-	0, 492, 185, 216, 307, 0, 0, 0, 76, 492,
-	487, 485, 77, 0, 79, 0, 0, 0, 0, 0,
-	0, 84, 108, 110, 0, 140, 141, 142, 143, 0,
-	0, 0, -2, -2, 236, 236, 155, 167, -2, -2,
-	-2, -2, -2, 166, 409, -2, -2, 172, 173, 0,
-	0, 236, 0, 0, 0, 236, 247, 0, 0, 37,
-	38, 40, 217, 220, 0, 506, 0, 509, 510, 494,
+	25, 26, -2, 28, 0, 425, 47, 48, 0, 0,
+	0, 0, 0, 0, -2, 0, 0, 0, 0, 0,
+	145, 0, 0, 86, 87, 0, 0, 0, 0, 0,
+	0, 0, 171, 234, 0, 180, 0, 0, 0, 256,
+	257, 258, 259, 260, 261, 262, 263, 264, 265, 267,
+	268, 269, 270, 234, 272, 0, 40, 537, 240, 241,
+	242, 243, 244, 245, 0, 0, 0, 248, 0, 0,
+	0, 0, 342, 526, 0, 0, 0, 513, 521, 522,
+	523, 0, 246, 247, 253, 497, 498, 499, 500, 501,
+	502, 503, 504, 505, 506, 507, 508, 509, 510, 511,
+	512, 0, 0, 0, -2, 190, 0, 254, -2, 266,
+	0, 0, 0, 425, 0, 426, 254, -2, 206, 0,
+	0, 0, 0, 0, 524, 197, 234, 327, 0, 0,
+	0, 77, 524, 519, 517, 78, 0, 80, 0, 0,
+	0, 0, 0, 0, 85, 114, 116, 0, 146, 147,
+	148, 149, 0, 0, 0, -2, -2, 254, 254, 161,
+	175, 501, -2, -2, -2, -2, -2, 172, 433, 173,
+	234, 0, -2, -2, 181, 0, 388, 389, 374, 375,
+	0, -2, -2, -2, -2, -2, -2, -2, -2, 183,
+	0, 0, 254, 0, 0, 254, 265, 0, 0, 38,
+	39, 41, 235, 238, 0, 538, 0, 541, 542, 526,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 301, 302, 0, 307, 307, 0, 492, 492,
-	509, 510, 0, 0, 495, 295, 305, 306, 0, 492,
-	0, 0, 3, -2, 0, 0, 307, 0, 459, 405,
-	0, 214, 0, 188, 190, 0, 0, 0, 0, 417,
-	364, 365, 354, 355, 0, -2, -2, -2, -2, 0,
-	0, 0, 415, 503, 503, 503, 0, 493, 0, 308,
-	0, 507, 0, 307, 0, 0, 0, 0, 0, 0,
-	111, 116, 124, 138, 0, 0, 0, 0, 0, 0,
-	-2, -2, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, -2, 223, 484, 237, 253, 256, 272, 188, -2,
-	0, 0, 0, 0, 0, 505, 0, 273, -2, -2,
-	0, 0, 0, 0, 0, 286, 216, 257, -2, 0,
-	0, 296, 297, 298, 299, 300, 303, 304, 231, 233,
-	0, 307, 0, 409, 313, 0, 421, 397, 399, 395,
-	396, 255, 230, 0, 0, 0, 0, 0, 0, 0,
-	307, 307, 278, 280, 0, 0, 0, 0, 494, 148,
-	307, 0, 232, 234, 443, 315, 0, 0, -2, 0,
-	0, 0, 236, 176, 198, 0, 0, 0, 190, 192,
-	0, 187, 482, 189, -2, 376, 379, 380, 381, 216,
-	366, 0, 369, 216, 0, 0, 0, 0, 190, 0,
-	0, 0, 504, 0, 0, 186, 316, 0, 0, 0,
-	216, 508, 0, 0, 0, 0, 0, 488, 486, 216,
-	0, 216, 0, 0, -2, -2, -2, -2, -2, -2,
-	-2, -2, 109, 119, -2, 0, 121, 123, 164, -2,
-	153, 154, 168, 159, 160, 410, -2, 0, 0, 41,
-	42, 0, 401, 51, 52, 53, 28, 29, 0, 483,
-	0, 0, 0, 221, 0, 0, 281, 282, 0, 0,
-	287, -2, 291, 293, 309, 0, 310, 0, 314, 0,
-	0, 307, 492, 492, 492, 492, 307, 307, 307, 0,
-	0, 0, 0, 288, 216, 275, 0, 292, 294, 0,
-	0, 0, 0, 443, -2, 0, 0, 460, 400, 406,
-	0, -2, 0, 0, -2, -2, 197, 261, 267, 265,
-	266, 192, 194, 0, 191, 0, 0, 498, 496, 0,
-	497, 500, 501, 502, 377, 0, 496, 0, 370, 0,
-	0, 0, 425, 188, 429, 0, 230, 418, 0, 236,
-	-2, 355, 0, 0, 439, 190, 416, 181, 184, 182,
-	183, 0, 0, 407, 0, 419, 89, 101, 0, 97,
-	92, 0, 0, 0, 319, 106, 107, 0, 115, 0,
-	0, 131, 132, 126, 129, 125, 0, 0, 0, 112,
-	0, 0, -2, 236, 0, -2, -2, 0, 0, 216,
-	0, 283, 0, 317, 0, 422, 398, 0, 307, 307,
-	307, 307, 307, 0, 0, 0, 318, 320, 321, 0,
-	0, 259, 0, 146, 0, 323, 0, 0, 0, 444,
-	236, 45, 403, 457, 177, 0, 204, 205, 201, 207,
-	208, 209, 210, 215, 212, 213, 0, 263, 268, 269,
-	194, 180, 0, 0, 0, 0, 0, 499, 0, 498,
-	414, -2, 0, 381, 378, 382, 236, 371, 423, 0,
-	190, 0, 0, 360, 307, 0, 0, 0, 440, 0,
-	0, 0, -2, 0, 90, 102, 103, 0, 0, 0,
-	99, 0, 0, 0, 0, 113, 0, 0, 0, 0,
-	0, 0, 0, 120, 118, 412, 32, 5, -2, 463,
-	0, 0, 0, -2, -2, 0, 0, 284, 311, 0,
-	309, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	285, 274, 0, 0, 147, 0, 258, 43, 0, -2,
-	404, 458, 0, 236, 214, 202, 0, 262, 0, 196,
-	195, 193, 383, 0, 496, 0, 0, 0, 0, 373,
-	0, 0, 216, 427, 430, 428, 0, 0, 0, 0,
-	216, 0, 408, 216, 420, 104, 105, 101, 0, 98,
-	93, 94, -2, -2, 216, -2, 0, 127, 133, 130,
-	0, -2, 0, 0, 447, 0, -2, 236, 0, 0,
-	0, 0, 218, 0, 0, 0, 317, 318, 319, 320,
-	321, 323, 0, 0, 0, 0, 0, 260, 0, 0,
-	44, 441, 201, 200, 203, 264, 270, 271, 214, 388,
-	384, 0, 0, 0, 496, 0, 386, 0, 0, 0,
-	374, 230, 236, 0, 426, 361, 362, 307, 216, 0,
-	0, 437, 0, 88, 91, 100, 114, 0, 0, 54,
-	55, 0, 401, 68, 69, 0, 61, -2, -2, 0,
-	0, 447, -2, 0, 0, 464, -2, 33, 34, 0,
-	0, 216, 312, 340, 0, 0, 0, 0, 0, 0,
-	340, 340, 0, 340, 0, 0, 196, 442, 199, 178,
-	393, 0, 389, 385, 0, 391, 387, 0, 375, 367,
-	368, 424, 0, 0, 433, 0, 435, 0, 134, -2,
-	236, 0, 236, 247, 0, 0, -2, 0, 0, 0,
-	0, 0, 448, 236, 50, 461, 35, 36, 0, 0,
-	338, 196, 0, 340, 340, 340, 340, 340, 340, 0,
-	196, 0, 0, 0, 0, 276, 0, 0, 0, 390,
-	392, 363, 431, 0, 216, 7, -2, 467, 0, -2,
-	0, 0, 0, 0, 135, 136, -2, 48, 0, -2,
-	462, 0, 219, 325, 337, 0, 0, 0, 0, 0,
-	0, 0, 0, 332, 333, 340, 335, 340, 324, 179,
-	394, 216, 0, 438, 451, 0, -2, 236, 0, 0,
-	63, 64, 0, 401, 73, 74, 75, 0, 0, 0,
-	0, 0, 49, 445, 0, 341, 326, 327, 328, 329,
-	330, 331, 0, 0, 0, 434, 436, 0, 451, -2,
-	0, 0, 468, -2, 0, -2, 236, 0, -2, -2,
-	0, 0, 137, 446, 197, 334, 336, 432, 0, 0,
-	452, 236, 67, 465, 56, 9, -2, 471, 0, 0,
-	0, -2, -2, 339, 0, 65, 0, -2, 466, 0,
-	455, 0, -2, 236, 0, 0, 0, 0, 342, 0,
-	0, 0, 0, 66, 449, 0, 455, -2, 0, 0,
-	472, -2, 57, 58, 0, 0, 0, 0, 351, 0,
-	0, 344, 345, 346, 450, 0, 0, 456, 236, 72,
-	469, 59, 60, 0, 350, 347, 348, 349, 70, 0,
-	-2, 470, 0, 343, 0, 353, 71, 453, 352, 454,
+	0, 0, 0, 543, 544, 321, 322, 0, 327, 327,
+	0, 524, 524, 541, 542, 0, 0, 527, 315, 325,
+	326, 0, 524, 0, 0, 3, 0, -2, 0, 0,
+	327, 0, 483, 429, 0, 232, 0, 206, 191, 208,
+	0, 0, 0, 0, 441, 0, 0, 0, 439, 535,
+	535, 535, 0, 525, 0, 328, 0, 539, 0, 327,
+	0, 0, 0, 0, 0, 0, 117, 122, 130, 144,
+	0, 0, 0, 0, 0, 0, -2, -2, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 174, 206,
+	0, 0, -2, 241, 516, 255, 271, 274, 290, -2,
+	0, 0, 0, 0, 0, 537, 0, 291, -2, -2,
+	0, 0, 0, 0, 0, 0, 304, 234, 275, -2,
+	-2, 0, 0, 316, 317, 318, 319, 320, 323, 324,
+	249, 251, 0, 327, 0, 433, 333, 0, 445, 421,
+	423, 419, 420, 273, 248, 0, 0, 0, 0, 0,
+	0, 0, 327, 327, 296, 298, 0, 0, 0, 0,
+	526, 154, 327, 0, 250, 252, -2, -2, 467, 335,
+	0, 0, -2, 0, 0, 0, 254, 186, 216, 0,
+	0, 0, 208, 210, 0, 199, 514, 207, -2, 400,
+	403, 404, 405, 234, 390, 0, 393, 234, 0, 0,
+	0, 208, 0, 0, 0, 536, 0, 0, 198, 336,
+	0, 0, 0, 234, 540, 0, 0, 0, 0, 0,
+	520, 518, 234, 0, 234, 0, 0, -2, -2, -2,
+	-2, -2, -2, -2, -2, 115, 125, -2, 0, 127,
+	129, 170, -2, 159, 160, 176, 177, 165, 166, 434,
+	0, 0, 254, -2, 375, -2, 0, 0, 42, 43,
+	0, 425, 52, 53, 54, 29, 30, 0, 515, 0,
+	0, 0, 239, 0, 0, 299, 300, 0, 0, 305,
+	-2, -2, 311, 313, 329, 0, 330, 0, 334, 0,
+	0, 327, 524, 524, 524, 524, 327, 327, 327, 0,
+	0, 0, 0, 306, 234, 293, 0, 312, 314, 0,
+	0, 0, 200, 202, 0, 201, 0, 467, -2, 0,
+	0, 484, 424, 430, 0, -2, 0, 0, -2, -2,
+	215, 279, 285, 283, 284, 210, 212, 0, 209, 0,
+	0, 530, 528, 0, 529, 532, 533, 534, 401, 0,
+	528, 0, 394, 0, 0, 0, 449, 206, 453, 0,
+	248, 442, 0, 0, 463, 208, 440, 193, 196, 194,
+	195, 0, 0, 431, 0, 101, 98, 90, 107, 0,
+	103, 93, 0, 0, 0, 339, 112, 113, 0, 443,
+	121, 0, 0, 137, 138, 132, 135, 131, 0, 0,
+	0, 118, 0, -2, 384, 327, 0, 0, -2, 254,
+	0, -2, -2, 0, 0, 234, 0, 301, 0, 337,
+	0, 446, 422, 0, 327, 327, 327, 327, 327, 0,
+	0, 0, 338, 340, 341, 0, 0, 277, 0, 152,
+	0, 343, 0, 203, -2, -2, 0, 0, 468, 254,
+	46, 427, 481, 187, 0, 222, 223, 219, 225, 226,
+	227, 228, 233, 230, 231, 0, 281, 286, 287, 212,
+	192, 0, 0, 0, 0, 0, 531, 0, 530, 438,
+	-2, 0, 405, 402, 406, 254, 395, 447, 0, 208,
+	0, 0, 0, 0, 464, 0, 0, 0, -2, 0,
+	99, 91, 108, 109, 0, 0, 0, 105, 0, 0,
+	0, 0, 119, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 124, 436, 0, 0, 33, 5, -2, 487,
+	0, 0, 0, -2, -2, 0, 0, 302, 331, 0,
+	329, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	303, 292, 0, 0, 153, 0, 276, 44, 0, -2,
+	428, 482, 0, 254, 232, 220, 0, 280, 0, 214,
+	213, 211, 407, 0, 528, 0, 0, 0, 0, 397,
+	0, 0, 234, 451, 454, 452, 0, 0, 234, 0,
+	432, 234, 102, 0, 110, 111, 107, 0, 104, 94,
+	95, -2, -2, 234, 444, -2, 0, 133, 139, 136,
+	0, -2, 0, 0, 385, 386, 327, 471, 0, -2,
+	254, 0, 0, 0, 0, 236, 0, 0, 0, 337,
+	338, 339, 340, 341, 343, 0, 0, 0, 0, 0,
+	278, 0, 0, 45, 465, 219, 218, 221, 282, 288,
+	289, 232, 412, 408, 0, 0, 0, 528, 0, 410,
+	0, 0, 0, 398, 248, 254, 0, 450, 234, 0,
+	0, 461, 0, 89, 100, 92, 106, 120, 0, 0,
+	55, 56, 0, 425, 69, 70, 0, 62, -2, -2,
+	0, 0, 0, 471, -2, 0, 0, 488, -2, 34,
+	35, 0, 0, 234, 332, 360, 0, 0, 0, 0,
+	0, 0, 360, 360, 0, 360, 0, 0, 214, 466,
+	217, 188, 417, 0, 413, 409, 0, 415, 411, 0,
+	399, 391, 392, 448, 0, 457, 0, 459, 0, 140,
+	-2, 254, 0, 254, 265, 0, 0, -2, 0, 0,
+	0, 387, 0, 0, 472, 254, 51, 485, 36, 37,
+	0, 0, 358, 214, 0, 360, 360, 360, 360, 360,
+	360, 0, 214, 0, 0, 0, 0, 294, 0, 0,
+	0, 414, 416, 455, 0, 234, 7, -2, 491, 0,
+	-2, 0, 0, 0, 0, 141, 142, -2, 49, 0,
+	-2, 486, 0, 237, 345, 357, 0, 0, 0, 0,
+	0, 0, 0, 0, 352, 353, 360, 355, 360, 344,
+	189, 418, 234, 0, 462, 475, 0, -2, 254, 0,
+	0, 64, 65, 0, 425, 74, 75, 76, 0, 0,
+	0, 0, 0, 50, 469, 0, 361, 346, 347, 348,
+	349, 350, 351, 0, 0, 0, 458, 460, 0, 475,
+	-2, 0, 0, 492, -2, 0, -2, 254, 0, -2,
+	-2, 0, 0, 143, 470, 215, 354, 356, 456, 0,
+	0, 476, 254, 68, 489, 57, 9, -2, 495, 0,
+	0, 0, -2, -2, 359, 0, 66, 0, -2, 490,
+	0, 479, 0, -2, 254, 0, 0, 0, 0, 362,
+	0, 0, 0, 0, 67, 473, 0, 479, -2, 0,
+	0, 496, -2, 58, 59, 0, 0, 0, 0, 371,
+	0, 0, 364, 365, 366, 474, 0, 0, 480, 254,
+	73, 493, 60, 61, 0, 370, 367, 368, 369, 71,
+	0, -2, 494, 0, 363, 0, 373, 72, 477, 372,
+	478,
 }
-var yyTok1 = [...]int{
 
+var yyTok1 = [...]uint8{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 165, 3, 3, 3, 164, 3, 3,
-	166, 167, 162, 161, 168, 160, 169, 163, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 158,
-	3, 159,
+	3, 3, 3, 178, 3, 3, 3, 177, 3, 3,
+	179, 180, 175, 174, 181, 173, 182, 176, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 171,
+	3, 172,
 }
-var yyTok2 = [...]int{
 
+var yyTok2 = [...]uint8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
@@ -1791,9 +2026,11 @@ var yyTok2 = [...]int{
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
 	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
-	152, 153, 154, 155, 156, 157,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 165, 166, 167, 168, 169, 170,
 }
-var yyTok3 = [...]int{
+
+var yyTok3 = [...]int8{
 	0,
 }
 
@@ -1875,9 +2112,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -1887,13 +2124,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -1924,30 +2161,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -2002,7 +2239,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -2013,8 +2250,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -2027,7 +2264,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -2036,18 +2273,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -2069,10 +2306,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -2108,7 +2345,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -2119,16 +2356,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -2136,1060 +2373,1120 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:248
+//line parser.y:256
 		{
 			yyVAL.program = nil
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:253
+//line parser.y:261
 		{
 			yyVAL.program = []Statement{yyDollar[1].statement}
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:258
+//line parser.y:266
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 4:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:265
+//line parser.y:273
 		{
 			yyVAL.program = nil
 		}
 	case 5:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:269
+//line parser.y:277
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 6:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:275
+//line parser.y:283
 		{
 			yyVAL.program = nil
 		}
 	case 7:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:279
+//line parser.y:287
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 8:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:285
+//line parser.y:293
 		{
 			yyVAL.program = nil
 		}
 	case 9:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:289
+//line parser.y:297
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:295
+//line parser.y:303
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:299
+//line parser.y:307
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:303
+//line parser.y:311
 		{
-			yyVAL.statement = yyDollar[1].expression
+			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:307
+//line parser.y:315
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:311
+//line parser.y:319
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:315
+//line parser.y:323
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:319
+//line parser.y:327
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = yyDollar[1].expression
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:323
+//line parser.y:331
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:327
+//line parser.y:335
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:331
+//line parser.y:339
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:335
+//line parser.y:343
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:339
+//line parser.y:347
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:343
+//line parser.y:351
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:347
+//line parser.y:355
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:351
+//line parser.y:359
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:355
+//line parser.y:363
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:359
+//line parser.y:367
 		{
-			yyVAL.statement = yyDollar[1].queryexpr
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:363
+//line parser.y:371
 		{
-			yyVAL.statement = ExternalCommand{BaseExpr: NewBaseExpr(yyDollar[1].token), Command: yyDollar[1].token.Literal}
+			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:369
+//line parser.y:375
 		{
-			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
+			yyVAL.statement = ExternalCommand{BaseExpr: NewBaseExpr(yyDollar[1].token), Command: yyDollar[1].token.Literal}
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:373
+//line parser.y:381
 		{
 			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:379
+//line parser.y:385
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:383
+//line parser.y:391
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 32:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:395
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 33:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:389
+//line parser.y:401
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
-	case 33:
+	case 34:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:393
+//line parser.y:405
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:397
+//line parser.y:409
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:401
+//line parser.y:413
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: []Variable{yyDollar[3].variable}, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:405
+//line parser.y:417
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: yyDollar[3].variables, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:411
+//line parser.y:423
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 38:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:415
+//line parser.y:427
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 39:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:421
+//line parser.y:433
 		{
 			yyVAL.statement = Exit{}
 		}
-	case 40:
+	case 41:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:425
+//line parser.y:437
 		{
 			yyVAL.statement = Exit{Code: value.NewIntegerFromString(yyDollar[2].token.Literal)}
 		}
-	case 41:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:431
+//line parser.y:443
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 42:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:435
+//line parser.y:447
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:441
+//line parser.y:453
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:445
+//line parser.y:457
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:449
+//line parser.y:461
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 46:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:453
+//line parser.y:465
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 47:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:457
+//line parser.y:469
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 48:
+	case 49:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:463
+//line parser.y:475
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 49:
+	case 50:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:467
+//line parser.y:479
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 50:
+	case 51:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:471
+//line parser.y:483
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 51:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:475
-		{
-			yyVAL.statement = yyDollar[1].statement
-		}
 	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:479
+//line parser.y:487
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:483
+//line parser.y:491
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:489
+//line parser.y:495
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:493
+//line parser.y:501
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 56:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:505
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 57:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:499
+//line parser.y:511
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
-	case 57:
+	case 58:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:503
+//line parser.y:515
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 58:
+	case 59:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:507
+//line parser.y:519
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 59:
+	case 60:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:511
+//line parser.y:523
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: []Variable{yyDollar[3].variable}, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 60:
+	case 61:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:515
+//line parser.y:527
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: yyDollar[3].variables, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 61:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:521
+//line parser.y:533
 		{
 			yyVAL.statement = Return{Value: NewNullValue()}
 		}
-	case 62:
+	case 63:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:525
+//line parser.y:537
 		{
 			yyVAL.statement = Return{Value: yyDollar[2].queryexpr}
 		}
-	case 63:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:531
+//line parser.y:543
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 64:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:535
+//line parser.y:547
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 65:
+	case 66:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:541
+//line parser.y:553
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 66:
+	case 67:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:545
+//line parser.y:557
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 67:
+	case 68:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:549
+//line parser.y:561
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 68:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:553
+//line parser.y:565
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 69:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:557
+//line parser.y:569
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 70:
+	case 71:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:563
+//line parser.y:575
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 71:
+	case 72:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:567
+//line parser.y:579
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 72:
+	case 73:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:571
+//line parser.y:583
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 73:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:575
+//line parser.y:587
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 74:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:579
+//line parser.y:591
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 75:
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:583
+//line parser.y:595
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 76:
+	case 77:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:589
+//line parser.y:601
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
-	case 77:
+	case 78:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:593
+//line parser.y:605
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
-	case 78:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:597
+//line parser.y:609
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
-	case 79:
+	case 80:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:601
+//line parser.y:613
 		{
 			yyVAL.statement = DisposeVariable{Variable: yyDollar[2].variable}
 		}
-	case 80:
+	case 81:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:607
+//line parser.y:619
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
-	case 81:
+	case 82:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:611
+//line parser.y:623
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
-	case 82:
+	case 83:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:615
+//line parser.y:627
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
-	case 83:
+	case 84:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:619
+//line parser.y:631
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
-	case 84:
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:623
+//line parser.y:635
 		{
 			yyVAL.statement = UnsetEnvVar{EnvVar: yyDollar[2].envvar}
 		}
-	case 85:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:629
+//line parser.y:641
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
-	case 86:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:633
+//line parser.y:645
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
-	case 87:
+	case 88:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:639
+//line parser.y:651
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs}
 		}
-	case 88:
+	case 89:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:643
+//line parser.y:655
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
 		}
-	case 89:
+	case 90:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:647
+//line parser.y:659
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Query: yyDollar[5].queryexpr}
 		}
-	case 90:
+	case 91:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:651
+//line parser.y:663
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: []ColumnDefault{yyDollar[5].columndef}, Position: yyDollar[6].expression}
 		}
-	case 91:
+	case 92:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:655
+//line parser.y:667
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].columndefs, Position: yyDollar[8].expression}
 		}
-	case 92:
+	case 93:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:659
+//line parser.y:671
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: []QueryExpression{yyDollar[5].queryexpr}}
 		}
-	case 93:
+	case 94:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:663
+//line parser.y:675
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].queryexprs}
 		}
-	case 94:
+	case 95:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:667
+//line parser.y:679
 		{
 			yyVAL.statement = RenameColumn{Table: yyDollar[3].queryexpr, Old: yyDollar[5].queryexpr, New: yyDollar[7].identifier}
 		}
-	case 95:
+	case 96:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:671
+//line parser.y:683
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].identifier}
 		}
-	case 96:
+	case 97:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:675
+//line parser.y:687
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].queryexpr}
 		}
-	case 97:
+	case 98:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:693
+		{
+			yyVAL.queryexpr = yyDollar[1].identifier
+		}
+	case 99:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:697
+		{
+			yyVAL.queryexpr = ColumnDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier, Type: yyDollar[2].identifier}
+		}
+	case 100:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:701
+		{
+			yyVAL.queryexpr = ColumnDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier, Type: yyDollar[2].identifier, NotNull: true}
+		}
+	case 101:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:707
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 102:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:711
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:681
+//line parser.y:717
 		{
 			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier}
 		}
-	case 98:
+	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:685
+//line parser.y:721
 		{
 			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, Value: yyDollar[3].queryexpr}
 		}
-	case 99:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:691
+//line parser.y:727
 		{
 			yyVAL.columndefs = []ColumnDefault{yyDollar[1].columndef}
 		}
-	case 100:
+	case 106:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:695
+//line parser.y:731
 		{
 			yyVAL.columndefs = append([]ColumnDefault{yyDollar[1].columndef}, yyDollar[3].columndefs...)
 		}
-	case 101:
+	case 107:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:701
+//line parser.y:737
 		{
 			yyVAL.expression = nil
 		}
-	case 102:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:705
+//line parser.y:741
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
 		}
-	case 103:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:709
+//line parser.y:745
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
 		}
-	case 104:
+	case 110:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:713
+//line parser.y:749
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
 		}
-	case 105:
+	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:717
+//line parser.y:753
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
 		}
-	case 106:
+	case 112:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:723
+//line parser.y:759
 		{
 			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 107:
+	case 113:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:727
+//line parser.y:763
 		{
 			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Statement: yyDollar[5].identifier}
 		}
-	case 108:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:731
+//line parser.y:767
 		{
 			yyVAL.statement = OpenCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 109:
+	case 115:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:735
+//line parser.y:771
 		{
 			yyVAL.statement = OpenCursor{Cursor: yyDollar[2].identifier, Values: yyDollar[4].replacevals}
 		}
-	case 110:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:739
+//line parser.y:775
 		{
 			yyVAL.statement = CloseCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 111:
+	case 117:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:743
+//line parser.y:779
 		{
 			yyVAL.statement = DisposeCursor{Cursor: yyDollar[3].identifier}
 		}
-	case 112:
+	case 118:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:747
+//line parser.y:783
 		{
 			yyVAL.statement = FetchCursor{Position: yyDollar[2].fetchpos, Cursor: yyDollar[3].identifier, Variables: yyDollar[5].variables}
 		}
-	case 113:
+	case 119:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:753
+//line parser.y:789
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs}
 		}
-	case 114:
+	case 120:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:757
+//line parser.y:793
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
 		}
-	case 115:
+	case 121:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:761
+//line parser.y:797
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Query: yyDollar[5].queryexpr}
 		}
-	case 116:
+	case 122:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:765
+//line parser.y:801
 		{
 			yyVAL.statement = DisposeView{View: yyDollar[3].queryexpr}
 		}
-	case 117:
+	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:771
+//line parser.y:807
 		{
 			yyVAL.replaceval = ReplaceValue{Value: yyDollar[1].queryexpr}
 		}
-	case 118:
+	case 124:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:775
+//line parser.y:811
 		{
 			yyVAL.replaceval = ReplaceValue{Value: yyDollar[1].queryexpr, Name: yyDollar[3].identifier}
 		}
-	case 119:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:781
+//line parser.y:817
 		{
 			yyVAL.replacevals = []ReplaceValue{yyDollar[1].replaceval}
 		}
-	case 120:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:785
+//line parser.y:821
 		{
 			yyVAL.replacevals = append([]ReplaceValue{yyDollar[1].replaceval}, yyDollar[3].replacevals...)
 		}
-	case 121:
+	case 127:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:791
+//line parser.y:827
 		{
 			yyVAL.statement = StatementPreparation{Name: yyDollar[2].identifier, Statement: value.NewString(yyDollar[4].token.Literal)}
 		}
-	case 122:
+	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:795
+//line parser.y:831
 		{
 			yyVAL.statement = ExecuteStatement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier}
 		}
-	case 123:
+	case 129:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:799
+//line parser.y:835
 		{
 			yyVAL.statement = ExecuteStatement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier, Values: yyDollar[4].replacevals}
 		}
-	case 124:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:803
+//line parser.y:839
 		{
 			yyVAL.statement = DisposeStatement{Name: yyDollar[3].identifier}
 		}
-	case 125:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:809
+//line parser.y:845
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 126:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:815
+//line parser.y:851
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 127:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:819
+//line parser.y:855
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassign)
 		}
-	case 128:
+	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:825
+//line parser.y:861
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 129:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:831
+//line parser.y:867
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 130:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:835
+//line parser.y:871
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 131:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:841
+//line parser.y:877
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 132:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:845
+//line parser.y:881
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 133:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:849
+//line parser.y:885
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassigns...)
 		}
-	case 134:
+	case 140:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:855
+//line parser.y:891
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Statements: yyDollar[8].program}
 		}
-	case 135:
+	case 141:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:859
+//line parser.y:895
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Parameters: yyDollar[5].varassigns, Statements: yyDollar[9].program}
 		}
-	case 136:
+	case 142:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:863
+//line parser.y:899
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Statements: yyDollar[9].program}
 		}
-	case 137:
+	case 143:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:867
+//line parser.y:903
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Parameters: yyDollar[7].varassigns, Statements: yyDollar[11].program}
 		}
-	case 138:
+	case 144:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:871
+//line parser.y:907
 		{
 			yyVAL.statement = DisposeFunction{Name: yyDollar[3].identifier}
 		}
-	case 139:
+	case 145:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:877
+//line parser.y:913
 		{
 			yyVAL.fetchpos = FetchPosition{}
 		}
-	case 140:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:881
+//line parser.y:917
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 141:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:885
+//line parser.y:921
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 142:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:889
+//line parser.y:925
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 143:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:893
+//line parser.y:929
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 144:
+	case 150:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:897
+//line parser.y:933
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 145:
+	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:901
+//line parser.y:937
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 146:
+	case 152:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:907
+//line parser.y:943
 		{
 			yyVAL.queryexpr = CursorStatus{Cursor: yyDollar[2].identifier, Negation: yyDollar[4].token, Type: yyDollar[5].token}
 		}
-	case 147:
+	case 153:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:911
+//line parser.y:947
 		{
 			yyVAL.queryexpr = CursorStatus{Cursor: yyDollar[2].identifier, Negation: yyDollar[4].token, Type: yyDollar[6].token}
 		}
-	case 148:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:915
+//line parser.y:951
 		{
 			yyVAL.queryexpr = CursorAttrebute{Cursor: yyDollar[2].identifier, Attrebute: yyDollar[3].token}
 		}
-	case 149:
+	case 155:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:921
+//line parser.y:957
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].identifier}
 		}
-	case 150:
+	case 156:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:925
+//line parser.y:961
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].queryexpr}
 		}
-	case 151:
+	case 157:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:929
+//line parser.y:965
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].identifier}
 		}
-	case 152:
+	case 158:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:933
+//line parser.y:969
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].queryexpr}
 		}
-	case 153:
+	case 159:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:937
+//line parser.y:973
 		{
 			yyVAL.statement = AddFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[4].flag, Value: yyDollar[2].queryexpr}
 		}
-	case 154:
+	case 160:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:941
+//line parser.y:977
 		{
 			yyVAL.statement = RemoveFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[4].flag, Value: yyDollar[2].queryexpr}
 		}
-	case 155:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:945
+//line parser.y:981
 		{
 			yyVAL.statement = ShowFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag}
 		}
-	case 156:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:949
+//line parser.y:985
 		{
 			yyVAL.statement = Echo{Value: yyDollar[2].queryexpr}
 		}
-	case 157:
+	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:953
+//line parser.y:989
 		{
 			yyVAL.statement = Print{Value: yyDollar[2].queryexpr}
 		}
-	case 158:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:957
+//line parser.y:993
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr}
 		}
-	case 159:
+	case 165:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:961
+//line parser.y:997
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 160:
+	case 166:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:965
+//line parser.y:1001
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 161:
+	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:969
+//line parser.y:1005
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].identifier}
 		}
-	case 162:
+	case 168:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:973
+//line parser.y:1009
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].queryexpr}
 		}
-	case 163:
+	case 169:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:977
+//line parser.y:1013
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr}
 		}
-	case 164:
+	case 170:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:981
+//line parser.y:1017
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 165:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:985
+//line parser.y:1021
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 166:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:989
+//line parser.y:1025
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token), Keywords: yyDollar[2].queryexprs}
 		}
-	case 167:
+	case 173:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1029
+		{
+			yyVAL.statement = Explain{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr.(SelectQuery)}
+		}
+	case 174:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1033
+		{
+			yyVAL.statement = Explain{BaseExpr: NewBaseExpr(yyDollar[1].token), Analyze: yyDollar[2].token, Query: yyDollar[3].queryexpr.(SelectQuery)}
+		}
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:993
+//line parser.y:1037
 		{
 			yyVAL.statement = ShowObjects{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
 		}
-	case 168:
+	case 176:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:997
+//line parser.y:1041
 		{
 			yyVAL.statement = ShowFields{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier, Table: yyDollar[4].queryexpr}
 		}
-	case 169:
+	case 177:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1045
+		{
+			yyVAL.statement = ShowVersions{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[4].queryexpr}
+		}
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1001
+//line parser.y:1049
 		{
 			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].identifier}
 		}
-	case 170:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1005
+//line parser.y:1053
 		{
 			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].queryexpr}
 		}
-	case 171:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1009
+//line parser.y:1057
 		{
 			yyVAL.statement = Pwd{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 172:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1013
+//line parser.y:1061
 		{
 			yyVAL.statement = Reload{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
 		}
-	case 173:
+	case 182:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1065
+		{
+			yyVAL.statement = Restore{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[2].queryexpr, Version: yyDollar[5].queryexpr}
+		}
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1019
+//line parser.y:1071
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier}
 		}
-	case 174:
+	case 184:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1023
+//line parser.y:1075
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[3].queryexpr}
 		}
-	case 175:
+	case 185:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1027
+//line parser.y:1079
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[4].queryexpr, Code: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 176:
+	case 186:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1033
+//line parser.y:1085
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause:    yyDollar[1].queryexpr,
@@ -3198,9 +3495,9 @@ yydefault:
 				LimitClause:   yyDollar[4].queryexpr,
 			}
 		}
-	case 177:
+	case 187:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1042
+//line parser.y:1094
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause:    yyDollar[1].queryexpr,
@@ -3210,9 +3507,9 @@ yydefault:
 				Context:       yyDollar[6].token,
 			}
 		}
-	case 178:
+	case 188:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1054
+//line parser.y:1106
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause: yyDollar[1].queryexpr,
@@ -3228,9 +3525,9 @@ yydefault:
 				LimitClause:   yyDollar[9].queryexpr,
 			}
 		}
-	case 179:
+	case 189:
 		yyDollar = yyS[yypt-11 : yypt+1]
-		//line parser.y:1070
+//line parser.y:1122
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause: yyDollar[1].queryexpr,
@@ -3247,9 +3544,29 @@ yydefault:
 				Context:       yyDollar[11].token,
 			}
 		}
-	case 180:
+	case 190:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1141
+		{
+			query := yyDollar[1].queryexpr.(SelectQuery)
+			query.OutfileClause = yyDollar[2].queryexpr
+			yyVAL.queryexpr = query
+		}
+	case 191:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1147
+		{
+			yyVAL.queryexpr = SelectQuery{
+				WithClause: yyDollar[1].queryexpr,
+				SelectEntity: SelectEntity{
+					SelectClause: yyDollar[2].queryexpr,
+				},
+				OutfileClause: yyDollar[3].queryexpr,
+			}
+		}
+	case 192:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1089
+//line parser.y:1159
 		{
 			yyVAL.queryexpr = SelectEntity{
 				SelectClause:  yyDollar[1].queryexpr,
@@ -3259,9 +3576,9 @@ yydefault:
 				HavingClause:  yyDollar[5].queryexpr,
 			}
 		}
-	case 181:
+	case 193:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1099
+//line parser.y:1169
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -3270,9 +3587,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 182:
+	case 194:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1108
+//line parser.y:1178
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -3281,9 +3598,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 183:
+	case 195:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1117
+//line parser.y:1187
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -3292,93 +3609,129 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 184:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1128
+//line parser.y:1198
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 185:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1132
+//line parser.y:1202
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 186:
+	case 198:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1138
+//line parser.y:1208
 		{
 			yyVAL.queryexpr = SelectClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Distinct: yyDollar[2].token, Fields: yyDollar[3].queryexprs}
 		}
-	case 187:
+	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1144
+//line parser.y:1214
 		{
 			yyVAL.queryexpr = IntoClause{Variables: yyDollar[2].variables}
 		}
-	case 188:
+	case 200:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1220
+		{
+			yyVAL.queryexpr = OutfileClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Path: yyDollar[3].identifier, Options: yyDollar[4].queryexprs}
+		}
+	case 201:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1224
+		{
+			yyVAL.queryexpr = OutfileClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Path: yyDollar[3].queryexpr, Options: yyDollar[4].queryexprs}
+		}
+	case 202:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1230
+		{
+			yyVAL.queryexprs = nil
+		}
+	case 203:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1234
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[2].queryexprs...)
+		}
+	case 204:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1240
+		{
+			yyVAL.queryexpr = OutfileOption{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier, Value: yyDollar[2].identifier}
+		}
+	case 205:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1244
+		{
+			yyVAL.queryexpr = OutfileOption{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier, Value: yyDollar[2].queryexpr}
+		}
+	case 206:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1150
+//line parser.y:1250
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 189:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1154
+//line parser.y:1254
 		{
 			yyVAL.queryexpr = FromClause{Tables: yyDollar[2].queryexprs}
 		}
-	case 190:
+	case 208:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1160
+//line parser.y:1260
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 191:
+	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1164
+//line parser.y:1264
 		{
 			yyVAL.queryexpr = WhereClause{Filter: yyDollar[2].queryexpr}
 		}
-	case 192:
+	case 210:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1170
+//line parser.y:1270
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 193:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1174
+//line parser.y:1274
 		{
 			yyVAL.queryexpr = GroupByClause{Items: yyDollar[3].queryexprs}
 		}
-	case 194:
+	case 212:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1180
+//line parser.y:1280
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 195:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1184
+//line parser.y:1284
 		{
 			yyVAL.queryexpr = HavingClause{Filter: yyDollar[2].queryexpr}
 		}
-	case 196:
+	case 214:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1190
+//line parser.y:1290
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 197:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1194
+//line parser.y:1294
 		{
 			yyVAL.queryexpr = OrderByClause{Items: yyDollar[3].queryexprs}
 		}
-	case 198:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1200
+//line parser.y:1300
 		{
 			if yyDollar[1].queryexpr == nil {
 				yyVAL.queryexpr = yyDollar[1].queryexpr
@@ -3386,9 +3739,9 @@ yydefault:
 				yyVAL.queryexpr = LimitClause{BaseExpr: yyDollar[1].queryexpr.(OffsetClause).BaseExpr, OffsetClause: yyDollar[1].queryexpr}
 			}
 		}
-	case 199:
+	case 217:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1208
+//line parser.y:1308
 		{
 			var base *BaseExpr
 			if yyDollar[1].queryexpr == nil {
@@ -3398,333 +3751,333 @@ yydefault:
 			}
 			yyVAL.queryexpr = LimitClause{BaseExpr: base, Type: yyDollar[2].token, Position: yyDollar[3].token, Value: yyDollar[4].queryexpr, Unit: yyDollar[5].token, Restriction: yyDollar[6].token, OffsetClause: yyDollar[1].queryexpr}
 		}
-	case 200:
+	case 218:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1218
+//line parser.y:1318
 		{
 			yyVAL.queryexpr = LimitClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Value: yyDollar[2].queryexpr, Unit: yyDollar[3].token, Restriction: yyDollar[4].token, OffsetClause: yyDollar[5].queryexpr}
 		}
-	case 201:
+	case 219:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1224
+//line parser.y:1324
 		{
 			yyVAL.token = Token{}
 		}
-	case 202:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1228
+//line parser.y:1328
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 203:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1232
+//line parser.y:1332
 		{
 			yyVAL.token = yyDollar[2].token
 		}
-	case 204:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1238
+//line parser.y:1338
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 205:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1242
+//line parser.y:1342
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 206:
+	case 224:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1248
+//line parser.y:1348
 		{
 			yyVAL.token = Token{}
 		}
-	case 207:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1252
+//line parser.y:1352
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 208:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1258
+//line parser.y:1358
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 209:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1262
+//line parser.y:1362
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 210:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1266
+//line parser.y:1366
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 211:
+	case 229:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1272
+//line parser.y:1372
 		{
 			yyVAL.token = Token{}
 		}
-	case 212:
+	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1276
+//line parser.y:1376
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 213:
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1280
+//line parser.y:1380
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 214:
+	case 232:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1286
+//line parser.y:1386
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 215:
+	case 233:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1290
+//line parser.y:1390
 		{
 			yyVAL.queryexpr = OffsetClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: yyDollar[2].queryexpr, Unit: yyDollar[3].token}
 		}
-	case 216:
+	case 234:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1296
+//line parser.y:1396
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 217:
+	case 235:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1300
+//line parser.y:1400
 		{
 			yyVAL.queryexpr = WithClause{InlineTables: yyDollar[2].queryexprs}
 		}
-	case 218:
+	case 236:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1306
+//line parser.y:1406
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 219:
+	case 237:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1310
+//line parser.y:1410
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, Fields: yyDollar[4].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 220:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1316
+//line parser.y:1416
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 221:
+	case 239:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1320
+//line parser.y:1420
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 222:
+	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1326
+//line parser.y:1426
 		{
 			yyVAL.queryexpr = NewStringValue(yyDollar[1].token.Literal)
 		}
-	case 223:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1330
+//line parser.y:1430
 		{
 			yyVAL.queryexpr = NewIntegerValueFromString(yyDollar[1].token.Literal)
 		}
-	case 224:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1334
+//line parser.y:1434
 		{
 			yyVAL.queryexpr = NewFloatValueFromString(yyDollar[1].token.Literal)
 		}
-	case 225:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1338
+//line parser.y:1438
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 226:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1342
+//line parser.y:1442
 		{
 			yyVAL.queryexpr = NewDatetimeValueFromString(yyDollar[1].token.Literal, yylex.(*Lexer).GetDatetimeFormats())
 		}
-	case 227:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1346
+//line parser.y:1446
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 228:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1352
+//line parser.y:1452
 		{
 			yyVAL.queryexpr = NewTernaryValueFromString(yyDollar[1].token.Literal)
 		}
-	case 229:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1358
+//line parser.y:1458
 		{
 			yyVAL.queryexpr = NewNullValue()
 		}
-	case 230:
+	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1364
+//line parser.y:1464
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier}
 		}
-	case 231:
+	case 249:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1368
+//line parser.y:1468
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Column: yyDollar[3].identifier}
 		}
-	case 232:
+	case 250:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1372
+//line parser.y:1472
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Column: yyDollar[3].identifier}
 		}
-	case 233:
+	case 251:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1376
+//line parser.y:1476
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 234:
+	case 252:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1380
+//line parser.y:1480
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 235:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1386
+//line parser.y:1486
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 236:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1390
+//line parser.y:1490
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 237:
+	case 255:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1394
+//line parser.y:1494
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 238:
+	case 256:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1400
+//line parser.y:1500
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 239:
+	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1404
+//line parser.y:1504
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 240:
+	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1408
+//line parser.y:1508
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 241:
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1412
+//line parser.y:1512
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 242:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1416
+//line parser.y:1516
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 243:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1420
+//line parser.y:1520
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 244:
+	case 262:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1424
+//line parser.y:1524
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 245:
+	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1428
+//line parser.y:1528
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 246:
+	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1432
+//line parser.y:1532
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 247:
+	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1436
+//line parser.y:1536
 		{
 			yyVAL.queryexpr = yyDollar[1].variable
 		}
-	case 248:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1440
+//line parser.y:1540
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 249:
+	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1444
+//line parser.y:1544
 		{
 			yyVAL.queryexpr = yyDollar[1].envvar
 		}
-	case 250:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1448
+//line parser.y:1548
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 251:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1452
+//line parser.y:1552
 		{
 			yyVAL.queryexpr = yyDollar[1].flag
 		}
-	case 252:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1456
+//line parser.y:1556
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 253:
+	case 271:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1460
+//line parser.y:1560
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 254:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1464
+//line parser.y:1564
 		{
 			name := ""
 			if yyDollar[1].token.Literal[0] == ':' {
@@ -3732,117 +4085,117 @@ yydefault:
 			}
 			yyVAL.queryexpr = Placeholder{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Ordinal: yyDollar[1].token.HolderOrdinal, Name: name}
 		}
-	case 255:
+	case 273:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1474
+//line parser.y:1574
 		{
 			yyVAL.queryexpr = AllColumns{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 256:
+	case 274:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1480
+//line parser.y:1580
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: ValueList{Values: yyDollar[2].queryexprs}}
 		}
-	case 257:
+	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1484
+//line parser.y:1584
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: yyDollar[1].queryexpr.GetBaseExpr(), Value: yyDollar[1].queryexpr}
 		}
-	case 258:
+	case 276:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1488
+//line parser.y:1588
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: JsonQuery{JsonQuery: yyDollar[1].token, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}}
 		}
-	case 259:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1494
+//line parser.y:1594
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 260:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1498
+//line parser.y:1598
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 261:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1504
+//line parser.y:1604
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 262:
+	case 280:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1508
+//line parser.y:1608
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 263:
+	case 281:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1514
+//line parser.y:1614
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token}
 		}
-	case 264:
+	case 282:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1518
+//line parser.y:1618
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token, NullsPosition: yyDollar[4].token}
 		}
-	case 265:
+	case 283:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1524
+//line parser.y:1624
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 266:
+	case 284:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1528
+//line parser.y:1628
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 267:
+	case 285:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1534
+//line parser.y:1634
 		{
 			yyVAL.token = Token{}
 		}
-	case 268:
+	case 286:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1538
+//line parser.y:1638
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 269:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1542
+//line parser.y:1642
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 270:
+	case 288:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1548
+//line parser.y:1648
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 271:
+	case 289:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1552
+//line parser.y:1652
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 272:
+	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1558
+//line parser.y:1658
 		{
 			yyVAL.queryexpr = Subquery{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr.(SelectQuery)}
 		}
-	case 273:
+	case 291:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1564
+//line parser.y:1664
 		{
 			var item1 []QueryExpression
 			var item2 []QueryExpression
@@ -3863,1446 +4216,1543 @@ yydefault:
 
 			yyVAL.queryexpr = Concat{Items: append(item1, item2...)}
 		}
-	case 274:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1587
+//line parser.y:1687
 		{
 			yyVAL.queryexpr = RowValueList{RowValues: yyDollar[2].queryexprs}
 		}
-	case 275:
+	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1591
+//line parser.y:1691
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 276:
+	case 294:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1595
+//line parser.y:1695
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
 		}
-	case 277:
+	case 295:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1601
+//line parser.y:1701
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 278:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1605
+//line parser.y:1705
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 279:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1609
+//line parser.y:1709
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 280:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1613
+//line parser.y:1713
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 281:
+	case 299:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1617
+//line parser.y:1717
 		{
 			yyVAL.queryexpr = Is{LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 282:
+	case 300:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1621
+//line parser.y:1721
 		{
 			yyVAL.queryexpr = Is{LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 283:
+	case 301:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1625
+//line parser.y:1725
 		{
 			yyVAL.queryexpr = Between{LHS: yyDollar[1].queryexpr, Low: yyDollar[3].queryexpr, High: yyDollar[5].queryexpr}
 		}
-	case 284:
+	case 302:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1629
+//line parser.y:1729
 		{
 			yyVAL.queryexpr = Between{LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 285:
+	case 303:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1633
+//line parser.y:1733
 		{
 			yyVAL.queryexpr = Between{LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 286:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1637
+//line parser.y:1737
 		{
 			yyVAL.queryexpr = In{LHS: yyDollar[1].queryexpr, Values: yyDollar[3].queryexpr}
 		}
-	case 287:
+	case 305:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1641
+//line parser.y:1741
 		{
 			yyVAL.queryexpr = In{LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 288:
+	case 306:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1645
+//line parser.y:1745
 		{
 			yyVAL.queryexpr = In{LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 289:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1649
+//line parser.y:1749
 		{
 			yyVAL.queryexpr = Like{LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
 		}
-	case 290:
+	case 308:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1653
+//line parser.y:1753
 		{
 			yyVAL.queryexpr = Like{LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 291:
+	case 309:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1757
+		{
+			yyVAL.queryexpr = RegExp{BaseExpr: NewBaseExpr(yyDollar[2].token), LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
+		}
+	case 310:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1761
+		{
+			yyVAL.queryexpr = RegExp{BaseExpr: NewBaseExpr(yyDollar[2].token), LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
+		}
+	case 311:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1657
+//line parser.y:1765
 		{
 			yyVAL.queryexpr = Any{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 292:
+	case 312:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1661
+//line parser.y:1769
 		{
 			yyVAL.queryexpr = Any{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 293:
+	case 313:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1665
+//line parser.y:1773
 		{
 			yyVAL.queryexpr = All{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 294:
+	case 314:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1669
+//line parser.y:1777
 		{
 			yyVAL.queryexpr = All{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 295:
+	case 315:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1673
+//line parser.y:1781
 		{
 			yyVAL.queryexpr = Exists{Query: yyDollar[2].queryexpr.(Subquery)}
 		}
-	case 296:
+	case 316:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1679
+//line parser.y:1787
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 297:
+	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1683
+//line parser.y:1791
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 298:
+	case 318:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1687
+//line parser.y:1795
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 299:
+	case 319:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1691
+//line parser.y:1799
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 300:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1695
+//line parser.y:1803
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 301:
+	case 321:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1699
+//line parser.y:1807
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 302:
+	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1703
+//line parser.y:1811
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 303:
+	case 323:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1709
+//line parser.y:1817
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 304:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1713
+//line parser.y:1821
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 305:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1717
+//line parser.y:1825
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 306:
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1721
+//line parser.y:1829
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 307:
+	case 327:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1727
+//line parser.y:1835
 		{
 			yyVAL.queryexprs = nil
 		}
-	case 308:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1731
+//line parser.y:1839
 		{
 			yyVAL.queryexprs = yyDollar[1].queryexprs
 		}
-	case 309:
+	case 329:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1737
+//line parser.y:1845
 		{
 			yyVAL.queryexpr = Function{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 310:
+	case 330:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1741
+//line parser.y:1849
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 311:
+	case 331:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1745
+//line parser.y:1853
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: []QueryExpression{yyDollar[3].queryexpr, yyDollar[5].queryexpr}, From: yyDollar[4].token}
 		}
-	case 312:
+	case 332:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1749
+//line parser.y:1857
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: []QueryExpression{yyDollar[3].queryexpr, yyDollar[5].queryexpr, yyDollar[7].queryexpr}, From: yyDollar[4].token, For: yyDollar[6].token}
 		}
-	case 313:
+	case 333:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1753
+//line parser.y:1861
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 314:
+	case 334:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1757
+//line parser.y:1865
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 315:
+	case 335:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1761
+//line parser.y:1869
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 316:
+	case 336:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1765
+//line parser.y:1873
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 317:
+	case 337:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1772
+//line parser.y:1880
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 318:
+	case 338:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1776
+//line parser.y:1884
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 319:
+	case 339:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1780
+//line parser.y:1888
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 320:
+	case 340:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1784
+//line parser.y:1892
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 321:
+	case 341:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1788
+//line parser.y:1896
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}}
 		}
-	case 322:
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1792
+//line parser.y:1900
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 323:
+	case 343:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1798
+//line parser.y:1906
 		{
 			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 324:
+	case 344:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1802
+//line parser.y:1910
 		{
 			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, OrderBy: yyDollar[9].queryexpr}
 		}
-	case 325:
+	case 345:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1808
+//line parser.y:1916
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 326:
+	case 346:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1812
+//line parser.y:1920
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 327:
+	case 347:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1816
+//line parser.y:1924
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 328:
+	case 348:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1820
+//line parser.y:1928
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 329:
+	case 349:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1824
+//line parser.y:1932
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 330:
+	case 350:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1828
+//line parser.y:1936
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 331:
+	case 351:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1832
+//line parser.y:1940
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 332:
+	case 352:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1836
+//line parser.y:1944
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 333:
+	case 353:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1840
+//line parser.y:1948
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 334:
+	case 354:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1844
+//line parser.y:1952
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreType: yyDollar[6].token, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
 		}
-	case 335:
+	case 355:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1848
+//line parser.y:1956
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 336:
+	case 356:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1852
+//line parser.y:1960
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreType: yyDollar[6].token, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
 		}
-	case 337:
+	case 357:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1858
+//line parser.y:1966
 		{
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: yyDollar[2].queryexpr}
 		}
-	case 338:
+	case 358:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1864
+//line parser.y:1972
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 339:
+	case 359:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1868
+//line parser.y:1976
 		{
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: OrderByClause{Items: yyDollar[4].queryexprs}, WindowingClause: yyDollar[5].queryexpr}
 		}
-	case 340:
+	case 360:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1874
+//line parser.y:1982
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 341:
+	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1878
+//line parser.y:1986
 		{
 			yyVAL.queryexpr = PartitionClause{Values: yyDollar[3].queryexprs}
 		}
-	case 342:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1884
+//line parser.y:1992
 		{
 			yyVAL.queryexpr = WindowingClause{FrameLow: yyDollar[2].queryexpr}
 		}
-	case 343:
+	case 363:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1888
+//line parser.y:1996
 		{
 			yyVAL.queryexpr = WindowingClause{FrameLow: yyDollar[3].queryexpr, FrameHigh: yyDollar[5].queryexpr}
 		}
-	case 344:
+	case 364:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1894
+//line parser.y:2002
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Unbounded: yyDollar[1].token}
 		}
-	case 345:
+	case 365:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1898
+//line parser.y:2006
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Offset: i}
 		}
-	case 346:
+	case 366:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1903
+//line parser.y:2011
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token}
 		}
-	case 347:
+	case 367:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1909
+//line parser.y:2017
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Offset: i}
 		}
-	case 348:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1914
+//line parser.y:2022
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Offset: i}
 		}
-	case 349:
+	case 369:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1919
+//line parser.y:2027
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token}
 		}
-	case 350:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1925
+//line parser.y:2033
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Unbounded: yyDollar[1].token}
 		}
-	case 351:
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1929
+//line parser.y:2037
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 352:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1935
+//line parser.y:2043
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Unbounded: yyDollar[1].token}
 		}
-	case 353:
+	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1939
+//line parser.y:2047
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 354:
+	case 374:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1945
+//line parser.y:2053
 		{
 			yyVAL.queryexpr = yyDollar[1].identifier
 		}
-	case 355:
+	case 375:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1949
+//line parser.y:2057
 		{
 			yyVAL.queryexpr = Stdin{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 356:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1955
+//line parser.y:2063
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 357:
+	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1959
+//line parser.y:2067
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 358:
+	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1963
+//line parser.y:2071
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 359:
+	case 379:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1967
+//line parser.y:2075
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 360:
+	case 380:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2079
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 381:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2083
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 382:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2087
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 383:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2091
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 384:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1973
+//line parser.y:2097
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Path: yyDollar[3].queryexpr, Args: nil}
 		}
-	case 361:
+	case 385:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1977
+//line parser.y:2101
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Path: yyDollar[3].queryexpr, Args: yyDollar[5].queryexprs}
 		}
-	case 362:
+	case 386:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1981
+//line parser.y:2105
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].queryexpr, Args: nil}
 		}
-	case 363:
+	case 387:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1985
+//line parser.y:2109
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].queryexpr, Args: yyDollar[7].queryexprs}
 		}
-	case 364:
+	case 388:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1991
+//line parser.y:2115
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 365:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1995
+//line parser.y:2119
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 366:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2001
+//line parser.y:2125
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 367:
+	case 391:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2005
+//line parser.y:2129
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].identifier}
 		}
-	case 368:
+	case 392:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2009
+//line parser.y:2133
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
 		}
-	case 369:
+	case 393:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2015
+//line parser.y:2139
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 370:
+	case 394:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2019
+//line parser.y:2143
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 371:
+	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2023
+//line parser.y:2147
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
 		}
-	case 372:
+	case 396:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2029
+//line parser.y:2153
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 373:
+	case 397:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2033
+//line parser.y:2157
 		{
 			yyDollar[2].table.Lateral = yyDollar[1].token
 			yyDollar[2].table.BaseExpr = NewBaseExpr(yyDollar[1].token)
 			yyVAL.queryexprs = []QueryExpression{yyDollar[2].table}
 		}
-	case 374:
+	case 398:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2039
+//line parser.y:2163
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].table}, yyDollar[3].queryexprs...)
 		}
-	case 375:
+	case 399:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2043
+//line parser.y:2167
 		{
 			yyDollar[2].table.Lateral = yyDollar[1].token
 			yyDollar[2].table.BaseExpr = NewBaseExpr(yyDollar[1].token)
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[2].table}, yyDollar[4].queryexprs...)
 		}
-	case 376:
+	case 400:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2051
+//line parser.y:2175
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 377:
+	case 401:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2055
+//line parser.y:2179
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 378:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2059
+//line parser.y:2183
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
 		}
-	case 379:
+	case 403:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2063
+//line parser.y:2187
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 380:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2067
+//line parser.y:2191
 		{
 			yyVAL.queryexpr = Table{Object: Dual{}}
 		}
-	case 381:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2071
+//line parser.y:2195
 		{
 			yyVAL.queryexpr = yyDollar[1].table
 		}
-	case 382:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2075
+//line parser.y:2199
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 383:
+	case 407:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2081
+//line parser.y:2205
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: nil}
 		}
-	case 384:
+	case 408:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2085
+//line parser.y:2209
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: yyDollar[5].queryexpr}
 		}
-	case 385:
+	case 409:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2089
+//line parser.y:2213
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: yyDollar[6].queryexpr}
 		}
-	case 386:
+	case 410:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2093
+//line parser.y:2217
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 387:
+	case 411:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2097
+//line parser.y:2221
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].queryexpr, JoinType: yyDollar[4].token, Direction: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 388:
+	case 412:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2101
+//line parser.y:2225
 		{
 			yyDollar[5].table.Lateral = yyDollar[4].token
 			yyDollar[5].table.BaseExpr = NewBaseExpr(yyDollar[4].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].table, JoinType: yyDollar[2].token, Condition: nil}
 		}
-	case 389:
+	case 413:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2107
+//line parser.y:2231
 		{
 			yyDollar[5].table.Lateral = yyDollar[4].token
 			yyDollar[5].table.BaseExpr = NewBaseExpr(yyDollar[4].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].table, JoinType: yyDollar[2].token, Condition: yyDollar[6].queryexpr}
 		}
-	case 390:
+	case 414:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:2113
+//line parser.y:2237
 		{
 			yyDollar[6].table.Lateral = yyDollar[5].token
 			yyDollar[6].table.BaseExpr = NewBaseExpr(yyDollar[5].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].table, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: yyDollar[7].queryexpr}
 		}
-	case 391:
+	case 415:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2119
+//line parser.y:2243
 		{
 			yyDollar[6].table.Lateral = yyDollar[5].token
 			yyDollar[6].table.BaseExpr = NewBaseExpr(yyDollar[5].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].table, JoinType: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 392:
+	case 416:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:2125
+//line parser.y:2249
 		{
 			yyDollar[7].table.Lateral = yyDollar[6].token
 			yyDollar[7].table.BaseExpr = NewBaseExpr(yyDollar[6].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[7].table, JoinType: yyDollar[4].token, Direction: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 393:
+	case 417:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2133
+//line parser.y:2257
 		{
 			yyVAL.queryexpr = JoinCondition{On: yyDollar[2].queryexpr}
 		}
-	case 394:
+	case 418:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2137
+//line parser.y:2261
 		{
 			yyVAL.queryexpr = JoinCondition{Using: yyDollar[3].queryexprs}
 		}
-	case 395:
+	case 419:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2143
+//line parser.y:2267
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 396:
+	case 420:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2147
+//line parser.y:2271
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 397:
+	case 421:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2153
+//line parser.y:2277
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 398:
+	case 422:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2157
+//line parser.y:2281
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
 		}
-	case 399:
+	case 423:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2161
+//line parser.y:2285
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 400:
+	case 424:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2167
+//line parser.y:2291
 		{
 			yyVAL.queryexpr = CaseExpr{Value: yyDollar[2].queryexpr, When: yyDollar[3].queryexprs, Else: yyDollar[4].queryexpr}
 		}
-	case 401:
+	case 425:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2173
+//line parser.y:2297
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 402:
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2177
+//line parser.y:2301
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 403:
+	case 427:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2183
+//line parser.y:2307
 		{
 			yyVAL.queryexprs = []QueryExpression{CaseExprWhen{Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}
 		}
-	case 404:
+	case 428:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2187
+//line parser.y:2311
 		{
 			yyVAL.queryexprs = append([]QueryExpression{CaseExprWhen{Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}, yyDollar[5].queryexprs...)
 		}
-	case 405:
+	case 429:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2193
+//line parser.y:2317
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 406:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2197
+//line parser.y:2321
 		{
 			yyVAL.queryexpr = CaseExprElse{Result: yyDollar[2].queryexpr}
 		}
-	case 407:
+	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2203
+//line parser.y:2327
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 408:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2207
+//line parser.y:2331
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 409:
+	case 433:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2213
+//line parser.y:2337
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 410:
+	case 434:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2217
+//line parser.y:2341
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 411:
+	case 435:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2223
+//line parser.y:2347
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 412:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2227
+//line parser.y:2351
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 413:
+	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2233
+//line parser.y:2357
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 414:
+	case 438:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2237
+//line parser.y:2361
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 415:
+	case 439:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2243
+//line parser.y:2367
 		{
 			yyVAL.queryexprs = []QueryExpression{Table{Object: yyDollar[1].queryexpr}}
 		}
-	case 416:
+	case 440:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2247
+//line parser.y:2371
 		{
 			yyVAL.queryexprs = append([]QueryExpression{Table{Object: yyDollar[1].queryexpr}}, yyDollar[3].queryexprs...)
 		}
-	case 417:
+	case 441:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2253
+//line parser.y:2377
 		{
 			yyVAL.queryexprs = []QueryExpression{Table{Object: yyDollar[1].queryexpr}}
 		}
-	case 418:
+	case 442:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2257
+//line parser.y:2381
 		{
 			yyVAL.queryexprs = append([]QueryExpression{Table{Object: yyDollar[1].queryexpr}}, yyDollar[3].queryexprs...)
 		}
-	case 419:
+	case 443:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2263
+//line parser.y:2387
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].identifier}
 		}
-	case 420:
+	case 444:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2267
+//line parser.y:2391
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].identifier}, yyDollar[3].queryexprs...)
 		}
-	case 421:
+	case 445:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2273
+//line parser.y:2397
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 422:
+	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2277
+//line parser.y:2401
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 423:
+	case 447:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2283
+//line parser.y:2407
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, ValuesList: yyDollar[6].queryexprs}
 		}
-	case 424:
+	case 448:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:2287
+//line parser.y:2411
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
 		}
-	case 425:
+	case 449:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2291
+//line parser.y:2415
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 426:
+	case 450:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:2295
+//line parser.y:2419
 		{
 			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 427:
+	case 451:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:2301
+//line parser.y:2425
 		{
 			yyVAL.expression = UpdateQuery{WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, SetList: yyDollar[5].updatesets, FromClause: yyDollar[6].queryexpr, WhereClause: yyDollar[7].queryexpr}
 		}
-	case 428:
+	case 452:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2307
+//line parser.y:2431
 		{
 			yyVAL.updateset = UpdateSet{Field: yyDollar[1].queryexpr, Value: yyDollar[3].queryexpr}
 		}
-	case 429:
+	case 453:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2313
+//line parser.y:2437
 		{
 			yyVAL.updatesets = []UpdateSet{yyDollar[1].updateset}
 		}
-	case 430:
+	case 454:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2317
+//line parser.y:2441
 		{
 			yyVAL.updatesets = append([]UpdateSet{yyDollar[1].updateset}, yyDollar[3].updatesets...)
 		}
-	case 431:
+	case 455:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:2323
+//line parser.y:2447
 		{
 			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, ValuesList: yyDollar[10].queryexprs}
 		}
-	case 432:
+	case 456:
 		yyDollar = yyS[yypt-13 : yypt+1]
-		//line parser.y:2327
+//line parser.y:2451
 		{
 			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, ValuesList: yyDollar[13].queryexprs}
 		}
-	case 433:
+	case 457:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:2331
+//line parser.y:2455
 		{
 			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, Query: yyDollar[9].queryexpr.(SelectQuery)}
 		}
-	case 434:
+	case 458:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:2335
+//line parser.y:2459
 		{
 			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, Query: yyDollar[12].queryexpr.(SelectQuery)}
 		}
-	case 435:
+	case 459:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:2339
+//line parser.y:2463
 		{
 			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
 		}
-	case 436:
+	case 460:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:2343
+//line parser.y:2467
 		{
 			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, ValuesList: yyDollar[12].queryexprs}
 		}
-	case 437:
+	case 461:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:2347
+//line parser.y:2471
 		{
 			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 438:
+	case 462:
 		yyDollar = yyS[yypt-11 : yypt+1]
-		//line parser.y:2351
+//line parser.y:2475
 		{
 			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, Query: yyDollar[11].queryexpr.(SelectQuery)}
 		}
-	case 439:
+	case 463:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2357
+//line parser.y:2481
 		{
 			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, FromClause: FromClause{Tables: yyDollar[4].queryexprs}, WhereClause: yyDollar[5].queryexpr}
 		}
-	case 440:
+	case 464:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2361
+//line parser.y:2485
 		{
 			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, FromClause: FromClause{Tables: yyDollar[5].queryexprs}, WhereClause: yyDollar[6].queryexpr}
 		}
-	case 441:
+	case 465:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2367
+//line parser.y:2491
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 442:
+	case 466:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2371
+//line parser.y:2495
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 443:
+	case 467:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2377
+//line parser.y:2501
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 444:
+	case 468:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2381
+//line parser.y:2505
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 445:
+	case 469:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2387
+//line parser.y:2511
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 446:
+	case 470:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2391
+//line parser.y:2515
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 447:
+	case 471:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2397
+//line parser.y:2521
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 448:
+	case 472:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2401
+//line parser.y:2525
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 449:
+	case 473:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2407
+//line parser.y:2531
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 450:
+	case 474:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2411
+//line parser.y:2535
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 451:
+	case 475:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2417
+//line parser.y:2541
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 452:
+	case 476:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2421
+//line parser.y:2545
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 453:
+	case 477:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2427
+//line parser.y:2551
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 454:
+	case 478:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2431
+//line parser.y:2555
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 455:
+	case 479:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2437
+//line parser.y:2561
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 456:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2441
+//line parser.y:2565
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 457:
+	case 481:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2447
+//line parser.y:2571
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 458:
+	case 482:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2451
+//line parser.y:2575
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 459:
+	case 483:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2457
+//line parser.y:2581
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 460:
+	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2461
+//line parser.y:2585
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 461:
+	case 485:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2467
+//line parser.y:2591
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 462:
+	case 486:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2471
+//line parser.y:2595
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 463:
+	case 487:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2477
+//line parser.y:2601
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 464:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2481
+//line parser.y:2605
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 465:
+	case 489:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2487
+//line parser.y:2611
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 466:
+	case 490:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2491
+//line parser.y:2615
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 467:
+	case 491:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2497
+//line parser.y:2621
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 468:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2501
+//line parser.y:2625
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 469:
+	case 493:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2507
+//line parser.y:2631
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 470:
+	case 494:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2511
+//line parser.y:2635
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 471:
+	case 495:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2517
+//line parser.y:2641
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 472:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2521
+//line parser.y:2645
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 473:
+	case 497:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2527
+//line parser.y:2651
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 474:
+	case 498:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2531
+//line parser.y:2655
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 475:
+	case 499:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2535
+//line parser.y:2659
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 476:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2539
+//line parser.y:2663
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 477:
+	case 501:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2543
+//line parser.y:2667
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 478:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2547
+//line parser.y:2671
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 479:
+	case 503:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2551
+//line parser.y:2675
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 480:
+	case 504:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2555
+//line parser.y:2679
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 481:
+	case 505:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2683
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 506:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2687
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 507:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2561
+//line parser.y:2691
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 508:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2695
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 509:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2699
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 510:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2703
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 511:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2707
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 512:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2711
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 513:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2717
 		{
 			yyVAL.variable = Variable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 482:
+	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2567
+//line parser.y:2723
 		{
 			yyVAL.variables = []Variable{yyDollar[1].variable}
 		}
-	case 483:
+	case 515:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2571
+//line parser.y:2727
 		{
 			yyVAL.variables = append([]Variable{yyDollar[1].variable}, yyDollar[3].variables...)
 		}
-	case 484:
+	case 516:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2577
+//line parser.y:2733
 		{
 			yyVAL.queryexpr = VariableSubstitution{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 485:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2583
+//line parser.y:2739
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 486:
+	case 518:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2587
+//line parser.y:2743
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 487:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2593
+//line parser.y:2749
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 488:
+	case 520:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2597
+//line parser.y:2753
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 489:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2603
+//line parser.y:2759
 		{
 			yyVAL.envvar = EnvironmentVariable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 490:
+	case 522:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2609
+//line parser.y:2765
 		{
 			yyVAL.queryexpr = RuntimeInformation{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 491:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2615
+//line parser.y:2771
 		{
 			yyVAL.flag = Flag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 492:
+	case 524:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2621
+//line parser.y:2777
 		{
 			yyVAL.token = Token{}
 		}
-	case 493:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2625
+//line parser.y:2781
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 494:
+	case 526:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2631
+//line parser.y:2787
 		{
 			yyVAL.token = Token{}
 		}
-	case 495:
+	case 527:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2635
+//line parser.y:2791
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 496:
+	case 528:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2641
+//line parser.y:2797
 		{
 			yyVAL.token = Token{}
 		}
-	case 497:
+	case 529:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2645
+//line parser.y:2801
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 498:
+	case 530:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2651
+//line parser.y:2807
 		{
 			yyVAL.token = Token{}
 		}
-	case 499:
+	case 531:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2655
+//line parser.y:2811
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 500:
+	case 532:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2661
+//line parser.y:2817
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 501:
+	case 533:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2665
+//line parser.y:2821
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 502:
+	case 534:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2669
+//line parser.y:2825
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 503:
+	case 535:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2675
+//line parser.y:2831
 		{
 			yyVAL.token = Token{}
 		}
-	case 504:
+	case 536:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2679
+//line parser.y:2835
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 505:
+	case 537:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2685
+//line parser.y:2841
 		{
 			yyVAL.token = Token{}
 		}
-	case 506:
+	case 538:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2689
+//line parser.y:2845
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 507:
+	case 539:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2695
+//line parser.y:2851
 		{
 			yyVAL.token = Token{}
 		}
-	case 508:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2699
+//line parser.y:2855
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 509:
+	case 541:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2705
+//line parser.y:2861
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 510:
+	case 542:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2709
+//line parser.y:2865
 		{
 			yyDollar[1].token.Token = COMPARISON_OP
 			yyVAL.token = yyDollar[1].token
 		}
+	case 543:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2872
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 544:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2876
+		{
+			yyDollar[1].token.Token = REGEXP
+			yyVAL.token = yyDollar[1].token
+		}
 	}
 	goto yystack /* stack new state and value */
 }
@@ -80,10 +80,14 @@ import (
 %type<statement>   trigger_statement
 %type<queryexpr>   select_query
 %type<queryexpr>   select_into_query
+%type<queryexpr>   select_outfile_query
 %type<queryexpr>   select_entity
 %type<queryexpr>   select_set_entity
 %type<queryexpr>   select_clause
 %type<queryexpr>   into_clause
+%type<queryexpr>   outfile_clause
+%type<queryexprs>  outfile_options
+%type<queryexpr>   outfile_option
 %type<queryexpr>   from_clause
 %type<queryexpr>   where_clause
 %type<queryexpr>   group_by_clause
@@ -155,6 +159,8 @@ import (
 %type<queryexprs>  substantial_values
 %type<queryexprs>  tables
 %type<queryexprs>  identifiers
+%type<queryexpr>   column_definition
+%type<queryexprs>  column_definitions
 %type<queryexprs>  fields
 %type<expression>  insert_query
 %type<expression>  update_query
@@ -196,6 +202,7 @@ import (
 %type<token>       recursive
 %type<token>       as
 %type<token>       comparison_operator
+%type<token>       regexp_operator
 
 %token<token> IDENTIFIER STRING INTEGER FLOAT BOOLEAN TERNARY DATETIME
 %token<token> VARIABLE FLAG ENVIRONMENT_VARIABLE RUNTIME_INFORMATION EXTERNAL_COMMAND PLACEHOLDER
@@ -206,7 +213,7 @@ import (
 %token<token> JOIN INNER OUTER LEFT RIGHT FULL CROSS ON USING NATURAL LATERAL
 %token<token> UNION INTERSECT EXCEPT
 %token<token> ALL ANY EXISTS IN
-%token<token> AND OR NOT BETWEEN LIKE IS NULL
+%token<token> AND OR NOT BETWEEN LIKE REGEXP RLIKE IS NULL
 %token<token> DISTINCT WITH
 %token<token> RANGE UNBOUNDED PRECEDING FOLLOWING CURRENT ROW
 %token<token> CASE IF ELSEIF WHILE WHEN THEN ELSE DO END
@@ -215,12 +222,13 @@ import (
 %token<token> SEPARATOR PARTITION OVER
 %token<token> COMMIT ROLLBACK
 %token<token> CONTINUE BREAK EXIT
-%token<token> ECHO PRINT PRINTF SOURCE EXECUTE CHDIR PWD RELOAD REMOVE SYNTAX TRIGGER
+%token<token> ECHO PRINT PRINTF SOURCE EXECUTE CHDIR PWD RELOAD REMOVE SYNTAX TRIGGER RESTORE
 %token<token> FUNCTION AGGREGATE BEGIN RETURN
 %token<token> IGNORE WITHIN
 %token<token> VAR SHOW
-%token<token> TIES NULLS ROWS ONLY
-%token<token> CSV JSON FIXED LTSV
+%token<token> EXPLAIN ANALYZE
+%token<token> TIES NULLS ROWS ONLY OUTFILE VERSION VERSIONS OF
+%token<token> CSV JSON FIXED LTSV JSONL XLSX YAML XML
 %token<token> JSON_ROW JSON_TABLE
 %token<token> SUBSTRING COUNT JSON_OBJECT
 %token<token> AGGREGATE_FUNCTION LIST_FUNCTION ANALYTIC_FUNCTION FUNCTION_NTH FUNCTION_WITH_INS
@@ -235,7 +243,7 @@ import (
 %left OR
 %left AND
 %right NOT
-%nonassoc '=' COMPARISON_OP IS BETWEEN IN LIKE
+%nonassoc '=' COMPARISON_OP IS BETWEEN IN LIKE REGEXP RLIKE
 %left STRING_OP
 %left '+' '-'
 %left '*' '/' '%'
@@ -299,6 +307,10 @@ common_statement
     {
         $$ = $1
     }
+    | select_outfile_query
+    {
+        $$ = $1
+    }
     | insert_query
     {
         $$ = $1
@@ -635,11 +647,11 @@ transaction_statement
     }
 
 table_operation_statement
-    : CREATE TABLE identifier '(' identifiers ')'
+    : CREATE TABLE identifier '(' column_definitions ')'
     {
         $$ = CreateTable{Table: $3, Fields: $5}
     }
-    | CREATE TABLE identifier '(' identifiers ')' as select_query
+    | CREATE TABLE identifier '(' column_definitions ')' as select_query
     {
         $$ = CreateTable{Table: $3, Fields: $5, Query: $8}
     }
@@ -676,6 +688,30 @@ table_operation_statement
         $$ = SetTableAttribute{BaseExpr: NewBaseExpr($1), Table: $3, Attribute: $5, Value: $7}
     }
 
+column_definition
+    : identifier
+    {
+        $$ = $1
+    }
+    | identifier identifier
+    {
+        $$ = ColumnDefinition{BaseExpr: $1.BaseExpr, Column: $1, Type: $2}
+    }
+    | identifier identifier NOT NULL
+    {
+        $$ = ColumnDefinition{BaseExpr: $1.BaseExpr, Column: $1, Type: $2, NotNull: true}
+    }
+
+column_definitions
+    : column_definition
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | column_definition ',' column_definitions
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
 column_default
     : identifier
     {
@@ -989,6 +1025,14 @@ command_statement
     {
         $$ = Syntax{BaseExpr: NewBaseExpr($1), Keywords: $2}
     }
+    | EXPLAIN select_query
+    {
+        $$ = Explain{BaseExpr: NewBaseExpr($1), Query: $2.(SelectQuery)}
+    }
+    | EXPLAIN ANALYZE select_query
+    {
+        $$ = Explain{BaseExpr: NewBaseExpr($1), Analyze: $2, Query: $3.(SelectQuery)}
+    }
     | SHOW identifier
     {
         $$ = ShowObjects{BaseExpr: NewBaseExpr($1), Type: $2}
@@ -997,6 +1041,10 @@ command_statement
     {
         $$ = ShowFields{BaseExpr: NewBaseExpr($1), Type: $2, Table: $4}
     }
+    | SHOW VERSIONS OF updatable_table_identifier
+    {
+        $$ = ShowVersions{BaseExpr: NewBaseExpr($1), Table: $4}
+    }
     | CHDIR identifier
     {
         $$ = Chdir{BaseExpr: NewBaseExpr($1), DirPath: $2}
@@ -1013,6 +1061,10 @@ command_statement
     {
         $$ = Reload{BaseExpr: NewBaseExpr($1), Type: $2}
     }
+    | RESTORE updatable_table_identifier TO VERSION substantial_value
+    {
+        $$ = Restore{BaseExpr: NewBaseExpr($1), Table: $2, Version: $5}
+    }
 
 trigger_statement
     : TRIGGER identifier
@@ -1084,6 +1136,24 @@ select_into_query
         }
     }
 
+select_outfile_query
+    : select_query outfile_clause
+    {
+        query := $1.(SelectQuery)
+        query.OutfileClause = $2
+        $$ = query
+    }
+    | with_clause select_clause outfile_clause
+    {
+        $$ = SelectQuery{
+            WithClause:    $1,
+            SelectEntity:  SelectEntity{
+                SelectClause: $2,
+            },
+            OutfileClause: $3,
+        }
+    }
+
 select_entity
     : select_clause from_clause where_clause group_by_clause having_clause
     {
@@ -1145,6 +1215,36 @@ into_clause
         $$ = IntoClause{Variables: $2}
     }
 
+outfile_clause
+    : INTO OUTFILE identifier outfile_options
+    {
+        $$ = OutfileClause{BaseExpr: NewBaseExpr($1), Path: $3, Options: $4}
+    }
+    | INTO OUTFILE substantial_value outfile_options
+    {
+        $$ = OutfileClause{BaseExpr: NewBaseExpr($1), Path: $3, Options: $4}
+    }
+
+outfile_options
+    :
+    {
+        $$ = nil
+    }
+    | outfile_option outfile_options
+    {
+        $$ = append([]QueryExpression{$1}, $2...)
+    }
+
+outfile_option
+    : identifier identifier
+    {
+        $$ = OutfileOption{BaseExpr: $1.BaseExpr, Name: $1, Value: $2}
+    }
+    | identifier substantial_value
+    {
+        $$ = OutfileOption{BaseExpr: $1.BaseExpr, Name: $1, Value: $2}
+    }
+
 from_clause
     :
     {
@@ -1653,6 +1753,14 @@ comparison
     {
         $$ = Like{LHS: $1, Pattern: $4, Negation: $2}
     }
+    | value regexp_operator value %prec REGEXP
+    {
+        $$ = RegExp{BaseExpr: NewBaseExpr($2), LHS: $1, Pattern: $3}
+    }
+    | value NOT regexp_operator value %prec REGEXP
+    {
+        $$ = RegExp{BaseExpr: NewBaseExpr($2), LHS: $1, Pattern: $4, Negation: $2}
+    }
     | value comparison_operator ANY row_value
     {
         $$ = Any{LHS: $1, Operator: $2, Values: $4}
@@ -1967,6 +2075,22 @@ table_object_type
     {
         $$ = $1
     }
+    | JSONL
+    {
+        $$ = $1
+    }
+    | XLSX
+    {
+        $$ = $1
+    }
+    | YAML
+    {
+        $$ = $1
+    }
+    | XML
+    {
+        $$ = $1
+    }
 
 table_object
     : table_object_type '(' table_identifier ')'
@@ -2531,6 +2655,22 @@ identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | OUTFILE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | VERSION
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | VERSIONS
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | OF
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
     | NULLS
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
@@ -2555,6 +2695,22 @@ identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | JSONL
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | XLSX
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | YAML
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | XML
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
 
 variable
     : VARIABLE
@@ -2711,6 +2867,17 @@ comparison_operator
         $$ = $1
     }
 
+regexp_operator
+    : REGEXP
+    {
+        $$ = $1
+    }
+    | RLIKE
+    {
+        $1.Token = REGEXP
+        $$ = $1
+    }
+
 %%
 
 func SetDebugLevel(level int, verbose bool) {
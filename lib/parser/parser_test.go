@@ -193,6 +193,63 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select c1 from stdin into outfile 'out.json' format json without header",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{Object: Stdin{BaseExpr: &BaseExpr{line: 1, char: 16}}},
+					}},
+				},
+				OutfileClause: OutfileClause{
+					BaseExpr: &BaseExpr{line: 1, char: 22},
+					Path:     NewStringValue("out.json"),
+					Options: []QueryExpression{
+						OutfileOption{
+							BaseExpr: &BaseExpr{line: 1, char: 46},
+							Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "format"},
+							Value:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "json"},
+						},
+						OutfileOption{
+							BaseExpr: &BaseExpr{line: 1, char: 58},
+							Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 58}, Literal: "without"},
+							Value:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 66}, Literal: "header"},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 into outfile @path delimiter ';'",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+				},
+				OutfileClause: OutfileClause{
+					BaseExpr: &BaseExpr{line: 1, char: 10},
+					Path:     Variable{BaseExpr: &BaseExpr{line: 1, char: 23}, Name: "path"},
+					Options: []QueryExpression{
+						OutfileOption{
+							BaseExpr: &BaseExpr{line: 1, char: 29},
+							Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "delimiter"},
+							Value:    NewStringValue(";"),
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select c1 from fixed('[1, 2, 3]', `fixed_length.dat`) fl",
 		Output: []Statement{
@@ -304,6 +361,118 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select c1 from jsonl(`table.jsonl`)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: TableObject{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								Type:     Token{Token: JSONL, Literal: "jsonl", Line: 1, Char: 16},
+								Path:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "table.jsonl", Quoted: true},
+							},
+						},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select c1 from yaml('items', `table.yaml`)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: TableObject{
+								BaseExpr:      &BaseExpr{line: 1, char: 16},
+								Type:          Token{Token: YAML, Literal: "yaml", Line: 1, Char: 16},
+								FormatElement: NewStringValue("items"),
+								Path:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "table.yaml", Quoted: true},
+								Args:          nil,
+							},
+						},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select c1 from xlsx(`table.xlsx`, 'Sheet1')",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: TableObject{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								Type:     Token{Token: XLSX, Literal: "xlsx", Line: 1, Char: 16},
+								Path:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "table.xlsx", Quoted: true},
+								Args: []QueryExpression{
+									NewStringValue("Sheet1"),
+								},
+							},
+						},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select c1 from xml(`table.xml`, 'items/item')",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: TableObject{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								Type:     Token{Token: XML, Literal: "xml", Line: 1, Char: 16},
+								Path:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "table.xml", Quoted: true},
+								Args: []QueryExpression{
+									NewStringValue("items/item"),
+								},
+							},
+						},
+					}},
+				},
+			},
+		},
+	},
 	{
 		Input: "select c1 from ltsv(`table.ltsv`, 'utf8')",
 		Output: []Statement{
@@ -1582,6 +1751,34 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select column1 not regexp '^a' and column2 rlike 'b$'",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: Logic{
+								LHS: RegExp{
+									BaseExpr: &BaseExpr{line: 1, char: 16},
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Pattern:  NewStringValue("^a"),
+									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 16},
+								},
+								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 32},
+								RHS: RegExp{
+									BaseExpr: &BaseExpr{line: 1, char: 44},
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 36}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "column2"}},
+									Pattern:  NewStringValue("b$"),
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select column1 like 'pattern1' or column2 not like 'pattern2'",
 		Output: []Statement{
@@ -4546,6 +4743,28 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "create table newtable (id integer not null, price float, column3)",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					ColumnDefinition{
+						BaseExpr: &BaseExpr{line: 1, char: 24},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "id"},
+						Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "integer"},
+						NotNull:  true,
+					},
+					ColumnDefinition{
+						BaseExpr: &BaseExpr{line: 1, char: 45},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 45}, Literal: "price"},
+						Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "float"},
+					},
+					Identifier{BaseExpr: &BaseExpr{line: 1, char: 58}, Literal: "column3"},
+				},
+			},
+		},
+	},
 	{
 		Input: "create table newtable (column1, column2) select 1, 2",
 		Output: []Statement{
@@ -4936,6 +5155,29 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "explain select foo",
+		Output: []Statement{
+			Explain{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Query: SelectQuery{SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 9}, Fields: []QueryExpression{Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "foo"}}}}},
+				}},
+			},
+		},
+	},
+	{
+		Input: "explain analyze select foo",
+		Output: []Statement{
+			Explain{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Analyze:  Token{Token: ANALYZE, Literal: "analyze", Line: 1, Char: 9},
+				Query: SelectQuery{SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 17}, Fields: []QueryExpression{Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 24}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "foo"}}}}},
+				}},
+			},
+		},
+	},
 	{
 		Input: "chdir `dirpath`",
 		Output: []Statement{
@@ -4971,6 +5213,26 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "restore table1 to version 1",
+		Output: []Statement{
+			Restore{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "table1"},
+				Version:  NewIntegerValueFromString("1"),
+			},
+		},
+	},
+	{
+		Input: "restore version to version '2012-02-03 09:18:15'",
+		Output: []Statement{
+			Restore{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "version"},
+				Version:  NewDatetimeValueFromString("2012-02-03 09:18:15", nil),
+			},
+		},
+	},
 	{
 		Input: "set @@delimiter = ','",
 		Output: []Statement{
@@ -5074,6 +5336,24 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "show versions of table1",
+		Output: []Statement{
+			ShowVersions{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 18}, Literal: "table1"},
+			},
+		},
+	},
+	{
+		Input: "show versions",
+		Output: []Statement{
+			ShowObjects{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "versions"},
+			},
+		},
+	},
 	{
 		Input: "trigger error",
 		Output: []Statement{
@@ -6547,6 +6827,9 @@ func TestParse(t *testing.T) {
 				if !reflect.DeepEqual(parsedStmt.Context, expectStmt.Context) {
 					t.Errorf("select query context for %q\n result: %#v\n expect: %#v", v.Input, parsedStmt.Context, expectStmt.Context)
 				}
+				if !reflect.DeepEqual(parsedStmt.OutfileClause, expectStmt.OutfileClause) {
+					t.Errorf("outfile clause for %q\n result: %#v\n expect: %#v", v.Input, parsedStmt.OutfileClause, expectStmt.OutfileClause)
+				}
 			default:
 				if !reflect.DeepEqual(stmt, expect) {
 					t.Errorf("output for %q\n result: %#v\n expect: %#v", v.Input, stmt, expect)
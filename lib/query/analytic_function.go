@@ -125,6 +125,7 @@ func Analyze(ctx context.Context, scope *ReferenceScope, view *View, fn parser.A
 		minReq = int(math.Ceil(float64(len(partitionMapKeys)) / (math.Floor(float64(calcCnt) / MinimumRequiredPerCPUCore))))
 	}
 	gm := NewGoroutineTaskManager(len(partitionMapKeys), minReq, scope.Tx.Flags.CPU)
+	explainGoroutines(ctx, gm.Number)
 
 	var analyzeFn = func(thIdx int) {
 		start, end := gm.RecordRange(thIdx)
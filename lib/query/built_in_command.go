@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
@@ -216,16 +217,18 @@ func SetFlag(ctx context.Context, scope *ReferenceScope, expr parser.SetFlag) er
 	switch strings.ToUpper(expr.Flag.Name) {
 	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DatetimeFormatFlag,
 		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
+		cmd.ColumnTypesFlag, cmd.QuoteCharFlag, cmd.EscapeStyleFlag, cmd.CommentPrefixFlag, cmd.OnParseErrorFlag,
 		cmd.ExportEncodingFlag, cmd.FormatFlag, cmd.ExportDelimiterFlag, cmd.ExportDelimiterPositionsFlag,
-		cmd.LineBreakFlag, cmd.JsonEscapeFlag:
+		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.XmlRootElementFlag, cmd.XmlRowElementFlag,
+		cmd.SqlTableNameFlag, cmd.SqlDialectFlag:
 		p = value.ToString(v)
 		if value.IsNull(p) {
 			return NewFlagValueNotAllowedFormatError(expr)
 		}
 		val = p.(*value.String).Raw()
-	case cmd.AnsiQuotesFlag, cmd.StrictEqualFlag,
-		cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAllFlag,
-		cmd.PrettyPrintFlag, cmd.StripEndingLineBreakFlag,
+	case cmd.AnsiQuotesFlag, cmd.StrictEqualFlag, cmd.KeepVersionsFlag,
+		cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.GlobUnionByNameFlag, cmd.TrimSpaceFlag, cmd.WithoutHeaderFlag, cmd.EncloseAllFlag,
+		cmd.PrettyPrintFlag, cmd.HtmlStandaloneFlag, cmd.StripEndingLineBreakFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag,
 		cmd.QuietFlag, cmd.StatsFlag:
 		p = value.ToBoolean(v)
@@ -239,7 +242,7 @@ func SetFlag(ctx context.Context, scope *ReferenceScope, expr parser.SetFlag) er
 			return NewFlagValueNotAllowedFormatError(expr)
 		}
 		val = p.(*value.Float).Raw()
-	case cmd.LimitRecursion, cmd.CPUFlag:
+	case cmd.MaxVersionsFlag, cmd.SkipLinesFlag, cmd.LimitRecursion, cmd.CPUFlag:
 		p = value.ToInteger(v)
 		if value.IsNull(p) {
 			return NewFlagValueNotAllowedFormatError(expr)
@@ -268,12 +271,15 @@ func AddFlagElement(ctx context.Context, scope *ReferenceScope, expr parser.AddF
 		return SetFlag(ctx, scope, e)
 	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag, cmd.StrictEqualFlag,
 		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
+		cmd.ColumnTypesFlag, cmd.QuoteCharFlag, cmd.EscapeStyleFlag, cmd.CommentPrefixFlag, cmd.SkipLinesFlag, cmd.TrimSpaceFlag,
+		cmd.OnParseErrorFlag,
 		cmd.ExportEncodingFlag, cmd.FormatFlag, cmd.ExportDelimiterFlag, cmd.ExportDelimiterPositionsFlag,
-		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag,
-		cmd.EncloseAllFlag, cmd.PrettyPrintFlag, cmd.StripEndingLineBreakFlag,
+		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.GlobUnionByNameFlag,
+		cmd.WithoutHeaderFlag, cmd.EncloseAllFlag, cmd.PrettyPrintFlag, cmd.XmlRootElementFlag, cmd.XmlRowElementFlag,
+		cmd.SqlTableNameFlag, cmd.SqlDialectFlag, cmd.HtmlStandaloneFlag, cmd.StripEndingLineBreakFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag,
 		cmd.QuietFlag, cmd.StatsFlag,
-		cmd.WaitTimeoutFlag,
+		cmd.WaitTimeoutFlag, cmd.KeepVersionsFlag, cmd.MaxVersionsFlag,
 		cmd.LimitRecursion, cmd.CPUFlag:
 
 		return NewAddFlagNotSupportedNameError(expr)
@@ -316,12 +322,15 @@ func RemoveFlagElement(ctx context.Context, scope *ReferenceScope, expr parser.R
 		}
 	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag, cmd.StrictEqualFlag,
 		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
+		cmd.ColumnTypesFlag, cmd.QuoteCharFlag, cmd.EscapeStyleFlag, cmd.CommentPrefixFlag, cmd.SkipLinesFlag, cmd.TrimSpaceFlag,
+		cmd.OnParseErrorFlag,
 		cmd.ExportEncodingFlag, cmd.FormatFlag, cmd.ExportDelimiterFlag, cmd.ExportDelimiterPositionsFlag,
-		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag,
-		cmd.EncloseAllFlag, cmd.PrettyPrintFlag, cmd.StripEndingLineBreakFlag,
+		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.GlobUnionByNameFlag,
+		cmd.WithoutHeaderFlag, cmd.EncloseAllFlag, cmd.PrettyPrintFlag, cmd.XmlRootElementFlag, cmd.XmlRowElementFlag,
+		cmd.SqlTableNameFlag, cmd.SqlDialectFlag, cmd.HtmlStandaloneFlag, cmd.StripEndingLineBreakFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag,
 		cmd.QuietFlag, cmd.StatsFlag,
-		cmd.WaitTimeoutFlag,
+		cmd.WaitTimeoutFlag, cmd.KeepVersionsFlag, cmd.MaxVersionsFlag,
 		cmd.LimitRecursion, cmd.CPUFlag:
 
 		return NewRemoveFlagNotSupportedNameError(expr)
@@ -372,9 +381,16 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		} else {
 			s = tx.Palette.Render(cmd.StringEffect, p.Raw())
 		}
+	case cmd.ColumnTypesFlag:
+		p := val.(*value.String)
+		if len(p.Raw()) < 1 {
+			s = tx.Palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			s = tx.Palette.Render(cmd.StringEffect, p.Raw())
+		}
 	case cmd.ExportEncodingFlag:
 		switch tx.Flags.ExportOptions.Format {
-		case cmd.JSON:
+		case cmd.JSON, cmd.JSONL, cmd.YAML, cmd.XML, cmd.SQL, cmd.HTML:
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
 		default:
 			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
@@ -395,7 +411,7 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		}
 	case cmd.WithoutHeaderFlag:
 		switch tx.Flags.ExportOptions.Format {
-		case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.GFM, cmd.ORG:
+		case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.SQL, cmd.HTML, cmd.GFM, cmd.ORG:
 			if tx.Flags.ExportOptions.Format == cmd.FIXED && tx.Flags.ExportOptions.SingleLine {
 				s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
 			} else {
@@ -419,7 +435,7 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		}
 	case cmd.JsonEscapeFlag:
 		switch tx.Flags.ExportOptions.Format {
-		case cmd.JSON:
+		case cmd.JSON, cmd.JSONL:
 			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
 		default:
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
@@ -431,6 +447,27 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		default:
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
 		}
+	case cmd.XmlRootElementFlag, cmd.XmlRowElementFlag:
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.XML:
+			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
+		default:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
+		}
+	case cmd.SqlTableNameFlag, cmd.SqlDialectFlag:
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.SQL:
+			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
+		default:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
+		}
+	case cmd.HtmlStandaloneFlag:
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.HTML:
+			s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
+		default:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+		}
 	case cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag:
 		switch tx.Flags.ExportOptions.Format {
 		case cmd.GFM, cmd.ORG, cmd.TEXT:
@@ -439,8 +476,22 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
 		}
 	case cmd.DelimiterFlag:
+		if tx.Flags.ImportOptions.Delimiter == cmd.AutoDelimiter {
+			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
+		} else {
+			s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).String())
+		}
+	case cmd.QuoteCharFlag:
 		s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).String())
-	case cmd.TimezoneFlag, cmd.ImportFormatFlag, cmd.DelimiterPositionsFlag, cmd.EncodingFlag, cmd.FormatFlag:
+	case cmd.CommentPrefixFlag:
+		p := val.(*value.String)
+		if len(p.Raw()) < 1 {
+			s = tx.Palette.Render(cmd.NullEffect, "(not set)")
+		} else {
+			s = tx.Palette.Render(cmd.StringEffect, p.String())
+		}
+	case cmd.TimezoneFlag, cmd.ImportFormatFlag, cmd.DelimiterPositionsFlag, cmd.EncodingFlag, cmd.EscapeStyleFlag, cmd.OnParseErrorFlag,
+		cmd.FormatFlag:
 		s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).Raw())
 	case cmd.LimitRecursion:
 		p := val.(*value.Integer)
@@ -449,12 +500,19 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		} else {
 			s = tx.Palette.Render(cmd.NumberEffect, p.String())
 		}
-	case cmd.CPUFlag:
+	case cmd.MaxVersionsFlag:
+		p := val.(*value.Integer)
+		if p.Raw() < 1 {
+			s = tx.Palette.Render(cmd.NullEffect, "(no limit)")
+		} else {
+			s = tx.Palette.Render(cmd.NumberEffect, p.String())
+		}
+	case cmd.SkipLinesFlag, cmd.CPUFlag:
 		s = tx.Palette.Render(cmd.NumberEffect, val.(*value.Integer).String())
 	case cmd.WaitTimeoutFlag:
 		s = tx.Palette.Render(cmd.NumberEffect, val.(*value.Float).String())
-	case cmd.AnsiQuotesFlag, cmd.StrictEqualFlag,
-		cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.StripEndingLineBreakFlag,
+	case cmd.AnsiQuotesFlag, cmd.StrictEqualFlag, cmd.KeepVersionsFlag,
+		cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.GlobUnionByNameFlag, cmd.TrimSpaceFlag, cmd.StripEndingLineBreakFlag,
 		cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag:
 		s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
 	}
@@ -737,6 +795,13 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 
 		w.WriteColorWithoutLineBreak("Delimiter Positions: ", cmd.LableEffect)
 		w.WriteWithoutLineBreak(dp)
+	case cmd.YAML:
+		w.WriteColorWithoutLineBreak("Query: ", cmd.LableEffect)
+		if len(info.JsonQuery) < 1 {
+			w.WriteColorWithoutLineBreak("(empty)", cmd.NullEffect)
+		} else {
+			w.WriteColorWithoutLineBreak(info.JsonQuery, cmd.NullEffect)
+		}
 	case cmd.JSON:
 		escapeStr := cmd.JsonEscapeTypeToString(info.JsonEscape)
 		w.WriteColorWithoutLineBreak("Escape: ", cmd.LableEffect)
@@ -754,6 +819,23 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 		} else {
 			w.WriteColorWithoutLineBreak(info.JsonQuery, cmd.NullEffect)
 		}
+	case cmd.JSONL:
+		w.WriteColorWithoutLineBreak("Escape: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(cmd.JsonEscapeTypeToString(info.JsonEscape))
+	case cmd.XLSX:
+		w.WriteColorWithoutLineBreak("Sheet: ", cmd.LableEffect)
+		if len(info.SheetName) < 1 {
+			w.WriteColorWithoutLineBreak("(first)", cmd.NullEffect)
+		} else {
+			w.WriteWithoutLineBreak(info.SheetName)
+		}
+	case cmd.XML:
+		w.WriteColorWithoutLineBreak("Row Path: ", cmd.LableEffect)
+		if len(info.RowPath) < 1 {
+			w.WriteColorWithoutLineBreak("(children of root)", cmd.NullEffect)
+		} else {
+			w.WriteWithoutLineBreak(info.RowPath)
+		}
 	}
 
 	switch info.Format {
@@ -767,7 +849,7 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 
 	w.WriteColor("Encoding: ", cmd.LableEffect)
 	switch info.Format {
-	case cmd.JSON:
+	case cmd.JSON, cmd.JSONL, cmd.PARQUET, cmd.XLSX, cmd.YAML, cmd.XML:
 		w.WriteColorWithoutLineBreak(text.UTF8.String(), cmd.NullEffect)
 	default:
 		w.WriteWithoutLineBreak(info.Encoding.String())
@@ -791,6 +873,34 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 			w.WriteWithoutLineBreak(strconv.FormatBool(!info.NoHeader))
 		}
 	}
+
+	switch info.Format {
+	case cmd.CSV, cmd.TSV:
+		if dialect := info.Dialect(); !dialect.IsStandard() {
+			w.NewLine()
+			w.WriteColor("Quote: ", cmd.LableEffect)
+			w.WriteWithoutLineBreak("'" + cmd.EscapeString(string(dialect.Quote)) + "'")
+			w.WriteSpaces(2)
+			w.WriteColorWithoutLineBreak("Escape: ", cmd.LableEffect)
+			w.WriteWithoutLineBreak(dialect.Escape.String())
+			w.WriteSpaces(2)
+			w.WriteColorWithoutLineBreak("Comment Prefix: ", cmd.LableEffect)
+			if len(dialect.CommentPrefix) < 1 {
+				w.WriteColorWithoutLineBreak("(none)", cmd.NullEffect)
+			} else {
+				w.WriteWithoutLineBreak("'" + cmd.EscapeString(dialect.CommentPrefix) + "'")
+			}
+			w.WriteSpaces(2)
+			w.WriteColorWithoutLineBreak("Trim Space: ", cmd.LableEffect)
+			w.WriteWithoutLineBreak(strconv.FormatBool(dialect.TrimSpace))
+		}
+	}
+
+	if info.Compression != file.NoCompression {
+		w.NewLine()
+		w.WriteColor("Compression: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(info.Compression.String())
+	}
 }
 
 func writeFields(w *ObjectWriter, fields []string) {
@@ -956,6 +1066,58 @@ func writeQuery(w *ObjectWriter, s string) {
 	w.EndSubBlock()
 }
 
+func ShowVersions(ctx context.Context, scope *ReferenceScope, expr parser.ShowVersions) (string, error) {
+	queryScope := scope.CreateNode()
+	defer queryScope.CloseCurrentNode()
+
+	view, err := LoadViewFromTableIdentifier(ctx, queryScope, expr.Table, false, false)
+	if err != nil {
+		return "", err
+	}
+	if !view.FileInfo.IsFile() {
+		return "", NewVersionNotAvailableError(expr.Table)
+	}
+
+	versions, err := file.Versions(view.FileInfo.Path)
+	if err != nil {
+		return "", NewIOError(expr.Table, err.Error())
+	}
+	if len(versions) < 1 {
+		return scope.Tx.Warn(fmt.Sprintf("No version of %q is kept", view.FileInfo.Path)), nil
+	}
+
+	l := len(versions)
+	digits := len(strconv.Itoa(l))
+
+	w := NewObjectWriter(scope.Tx)
+	w.WriteColorWithoutLineBreak("Path: ", cmd.LableEffect)
+	w.WriteColorWithoutLineBreak(view.FileInfo.Path, cmd.ObjectEffect)
+	w.NewLine()
+	w.WriteColorWithoutLineBreak("Versions:", cmd.LableEffect)
+	w.NewLine()
+	w.WriteSpaces(2)
+	w.BeginSubBlock()
+	for _, v := range versions {
+		idxstr := strconv.Itoa(v.Number)
+		w.WriteColor(strings.Repeat(" ", digits-len(idxstr))+idxstr, cmd.NumberEffect)
+		w.Write(".")
+		w.WriteSpaces(1)
+		w.WriteColor(v.Time.In(cmd.GetLocation()).Format(time.RFC3339Nano), cmd.DatetimeEffect)
+		w.WriteSpaces(2)
+		w.WriteColorWithoutLineBreak(FormatCount(int(v.Size), "byte"), cmd.NumberEffect)
+		w.NewLine()
+	}
+
+	w.Title1 = "Versions of"
+	if e, ok := expr.Table.(parser.Identifier); ok {
+		w.Title2 = e.Literal
+	} else {
+		w.Title2 = expr.Table.String()
+	}
+	w.Title2Effect = cmd.IdentifierEffect
+	return "\n" + w.String() + "\n", nil
+}
+
 func SetEnvVar(ctx context.Context, scope *ReferenceScope, expr parser.SetEnvVar) error {
 	var p value.Primary
 	var err error
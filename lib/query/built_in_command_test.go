@@ -2184,6 +2184,8 @@ var showObjectsTests = []struct {
 			"               @@ANSI_QUOTES: false\n" +
 			"              @@STRICT_EQUAL: false\n" +
 			"              @@WAIT_TIMEOUT: 15\n" +
+			"             @@KEEP_VERSIONS: false\n" +
+			"              @@MAX_VERSIONS: (no limit)\n" +
 			"             @@IMPORT_FORMAT: CSV\n" +
 			"                 @@DELIMITER: ','\n" +
 			"       @@DELIMITER_POSITIONS: SPACES\n" +
@@ -2191,6 +2193,14 @@ var showObjectsTests = []struct {
 			"                  @@ENCODING: AUTO\n" +
 			"                 @@NO_HEADER: false\n" +
 			"              @@WITHOUT_NULL: false\n" +
+			"        @@GLOB_UNION_BY_NAME: false\n" +
+			"              @@COLUMN_TYPES: (not set)\n" +
+			"                @@QUOTE_CHAR: '\"'\n" +
+			"              @@ESCAPE_STYLE: DOUBLE\n" +
+			"            @@COMMENT_PREFIX: (not set)\n" +
+			"                @@SKIP_LINES: 0\n" +
+			"                @@TRIM_SPACE: false\n" +
+			"            @@ON_PARSE_ERROR: FAIL\n" +
 			"   @@STRIP_ENDING_LINE_BREAK: false\n" +
 			"                    @@FORMAT: CSV\n" +
 			"            @@WRITE_ENCODING: UTF8\n" +
@@ -2201,6 +2211,11 @@ var showObjectsTests = []struct {
 			"               @@ENCLOSE_ALL: false\n" +
 			"               @@JSON_ESCAPE: (ignored) BACKSLASH\n" +
 			"              @@PRETTY_PRINT: (ignored) false\n" +
+			"          @@XML_ROOT_ELEMENT: (ignored) root\n" +
+			"           @@XML_ROW_ELEMENT: (ignored) row\n" +
+			"            @@SQL_TABLE_NAME: (ignored) result\n" +
+			"               @@SQL_DIALECT: (ignored) ANSI\n" +
+			"           @@HTML_STANDALONE: (ignored) false\n" +
 			"       @@EAST_ASIAN_ENCODING: (ignored) false\n" +
 			"    @@COUNT_DIACRITICAL_SIGN: (ignored) false\n" +
 			"         @@COUNT_FORMAT_CODE: (ignored) false\n" +
@@ -2223,6 +2238,7 @@ var showObjectsTests = []struct {
 			"           @#UPDATED: 0\n" +
 			"     @#UPDATED_VIEWS: 0\n" +
 			"     @#LOADED_TABLES: 0\n" +
+			"   @#SKIPPED_RECORDS: 0\n" +
 			" @#WORKING_DIRECTORY: " + GetWD() + "\n" +
 			"           @#VERSION: v1.0.0\n" +
 			"\n",
@@ -2617,6 +2633,103 @@ func TestShowFields(t *testing.T) {
 	}
 }
 
+var showVersionsTests = []struct {
+	Name   string
+	Expr   parser.ShowVersions
+	Scope  *ReferenceScope
+	Expect string
+	Error  string
+}{
+	{
+		Name: "ShowVersions",
+		Expr: parser.ShowVersions{
+			Table: parser.Identifier{Literal: "restore"},
+		},
+		Expect: "\n" +
+			strings.Repeat(" ", (calcShowVersionsWidth("restore.csv")-len("Versions of restore"))/2) + "Versions of restore\n" +
+			strings.Repeat("-", calcShowVersionsWidth("restore.csv")) + "\n" +
+			" Path: " + GetTestFilePath("restore.csv") + "\n" +
+			" Versions:\n" +
+			"   1. 2012-02-02T00:00:00Z  40 bytes\n" +
+			"   2. 2012-02-01T00:00:00Z  39 bytes\n" +
+			"\n",
+	},
+	{
+		Name: "ShowVersions No Version",
+		Expr: parser.ShowVersions{
+			Table: parser.Identifier{Literal: "table1"},
+		},
+		Expect: fmt.Sprintf("No version of %q is kept", GetTestFilePath("table1.csv")),
+	},
+	{
+		Name: "ShowVersions Temporary Table Error",
+		Expr: parser.ShowVersions{
+			Table: parser.Identifier{Literal: "view1"},
+		},
+		Scope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"VIEW1": &View{
+						Header: NewHeader("view1", []string{"column1", "column2"}),
+						FileInfo: &FileInfo{
+							Path:     "view1",
+							ViewType: ViewTypeTemporaryTable,
+						},
+					},
+				},
+			},
+		}, nil, time.Time{}, nil),
+		Error: "versions of view1 are not available",
+	},
+}
+
+func calcShowVersionsWidth(fileName string) int {
+	w := 36
+	if pathLen := 8 + len(GetTestFilePath(fileName)); w < pathLen {
+		w = pathLen
+	}
+	if 75 < w {
+		w = 75
+	}
+	return w
+}
+
+func TestShowVersions(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	initFlag(TestTx.Flags)
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+
+	for _, v := range showVersionsTests {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		if v.Scope == nil {
+			v.Scope = NewReferenceScope(TestTx)
+		}
+
+		result, err := ShowVersions(ctx, v.Scope, v.Expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result != v.Expect {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, v.Expect)
+		}
+	}
+}
+
 var setEnvVarTests = []struct {
 	Name   string
 	Expr   parser.SetEnvVar
@@ -0,0 +1,220 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+// referencedColumns returns the names of the columns that a simple select query
+// refers to, so that formats storing values by column can load only those columns.
+//
+// The result is nil if the query selects from anything other than a single table,
+// or if the query contains an expression that can refer to columns implicitly,
+// such as a wildcard, a column number, a subquery or a user-defined function.
+// In that case, all of the columns must be loaded.
+func referencedColumns(query parser.SelectQuery) []string {
+	if query.WithClause != nil || query.IsForUpdate() {
+		return nil
+	}
+
+	entity, ok := query.SelectEntity.(parser.SelectEntity)
+	if !ok || entity.FromClause == nil {
+		return nil
+	}
+
+	tables := entity.FromClause.(parser.FromClause).Tables
+	if len(tables) != 1 {
+		return nil
+	}
+	table, ok := tables[0].(parser.Table)
+	if !ok || !table.Lateral.IsEmpty() {
+		return nil
+	}
+	if _, ok := table.Object.(parser.Identifier); !ok {
+		return nil
+	}
+
+	c := &columnCollector{
+		names: make([]string, 0, 10),
+	}
+
+	for _, f := range entity.SelectClause.(parser.SelectClause).Fields {
+		c.collect(f.(parser.Field).Object)
+	}
+	if entity.WhereClause != nil {
+		c.collect(entity.WhereClause.(parser.WhereClause).Filter)
+	}
+	if entity.GroupByClause != nil {
+		c.collectList(entity.GroupByClause.(parser.GroupByClause).Items)
+	}
+	if entity.HavingClause != nil {
+		c.collect(entity.HavingClause.(parser.HavingClause).Filter)
+	}
+	if query.OrderByClause != nil {
+		for _, item := range query.OrderByClause.(parser.OrderByClause).Items {
+			c.collect(item.(parser.OrderItem).Value)
+		}
+	}
+
+	if c.unknown || len(c.names) < 1 {
+		return nil
+	}
+	return c.names
+}
+
+// partitionFilter returns the filter of the where clause of a select query that selects from a single table,
+// so that the partitions of the table that cannot match the query can be skipped.
+// The result is nil if the query has no where clause or selects from more than one table.
+func partitionFilter(query parser.SelectQuery) parser.QueryExpression {
+	if query.IsForUpdate() {
+		return nil
+	}
+
+	entity, ok := query.SelectEntity.(parser.SelectEntity)
+	if !ok || entity.FromClause == nil || entity.WhereClause == nil {
+		return nil
+	}
+
+	tables := entity.FromClause.(parser.FromClause).Tables
+	if len(tables) != 1 {
+		return nil
+	}
+	if _, ok := tables[0].(parser.Table); !ok {
+		return nil
+	}
+	return entity.WhereClause.(parser.WhereClause).Filter
+}
+
+type columnCollector struct {
+	names   []string
+	unknown bool
+}
+
+func (c *columnCollector) add(name string) {
+	for _, s := range c.names {
+		if strings.EqualFold(s, name) {
+			return
+		}
+	}
+	c.names = append(c.names, name)
+}
+
+func (c *columnCollector) collectList(exprs []parser.QueryExpression) {
+	for _, expr := range exprs {
+		c.collect(expr)
+	}
+}
+
+func (c *columnCollector) collect(expr parser.QueryExpression) {
+	if expr == nil || c.unknown {
+		return
+	}
+
+	switch expr.(type) {
+	case parser.PrimitiveType, parser.Variable, parser.EnvironmentVariable, parser.RuntimeInformation,
+		parser.Flag, parser.CursorStatus, parser.CursorAttrebute, parser.Placeholder:
+	case parser.FieldReference:
+		c.add(expr.(parser.FieldReference).Column.Literal)
+	case parser.Parentheses:
+		c.collect(expr.(parser.Parentheses).Expr)
+	case parser.RowValue:
+		c.collect(expr.(parser.RowValue).Value)
+	case parser.ValueList:
+		c.collectList(expr.(parser.ValueList).Values)
+	case parser.RowValueList:
+		c.collectList(expr.(parser.RowValueList).RowValues)
+	case parser.Arithmetic:
+		e := expr.(parser.Arithmetic)
+		c.collect(e.LHS)
+		c.collect(e.RHS)
+	case parser.UnaryArithmetic:
+		c.collect(expr.(parser.UnaryArithmetic).Operand)
+	case parser.Concat:
+		c.collectList(expr.(parser.Concat).Items)
+	case parser.Comparison:
+		e := expr.(parser.Comparison)
+		c.collect(e.LHS)
+		c.collect(e.RHS)
+	case parser.Is:
+		e := expr.(parser.Is)
+		c.collect(e.LHS)
+		c.collect(e.RHS)
+	case parser.Between:
+		e := expr.(parser.Between)
+		c.collect(e.LHS)
+		c.collect(e.Low)
+		c.collect(e.High)
+	case parser.In:
+		e := expr.(parser.In)
+		c.collect(e.LHS)
+		c.collect(e.Values)
+	case parser.Any:
+		e := expr.(parser.Any)
+		c.collect(e.LHS)
+		c.collect(e.Values)
+	case parser.All:
+		e := expr.(parser.All)
+		c.collect(e.LHS)
+		c.collect(e.Values)
+	case parser.Like:
+		e := expr.(parser.Like)
+		c.collect(e.LHS)
+		c.collect(e.Pattern)
+	case parser.RegExp:
+		e := expr.(parser.RegExp)
+		c.collect(e.LHS)
+		c.collect(e.Pattern)
+	case parser.Logic:
+		e := expr.(parser.Logic)
+		c.collect(e.LHS)
+		c.collect(e.RHS)
+	case parser.UnaryLogic:
+		c.collect(expr.(parser.UnaryLogic).Operand)
+	case parser.CaseExpr:
+		e := expr.(parser.CaseExpr)
+		c.collect(e.Value)
+		c.collectList(e.When)
+		c.collect(e.Else)
+	case parser.CaseExprWhen:
+		e := expr.(parser.CaseExprWhen)
+		c.collect(e.Condition)
+		c.collect(e.Result)
+	case parser.CaseExprElse:
+		c.collect(expr.(parser.CaseExprElse).Result)
+	case parser.Function:
+		e := expr.(parser.Function)
+		name := strings.ToUpper(e.Name)
+		if _, ok := Functions[name]; !ok && name != "NOW" && name != "JSON_OBJECT" {
+			c.unknown = true
+			return
+		}
+		if name == "JSON_OBJECT" && len(e.Args) < 1 {
+			c.unknown = true
+			return
+		}
+		c.collectList(e.Args)
+	case parser.AggregateFunction:
+		e := expr.(parser.AggregateFunction)
+		if _, ok := AggregateFunctions[strings.ToUpper(e.Name)]; !ok {
+			c.unknown = true
+			return
+		}
+		for _, arg := range e.Args {
+			if _, ok := arg.(parser.AllColumns); ok && strings.EqualFold(e.Name, "COUNT") {
+				continue
+			}
+			c.collect(arg)
+		}
+	case parser.ListFunction:
+		e := expr.(parser.ListFunction)
+		c.collectList(e.Args)
+		if e.OrderBy != nil {
+			for _, item := range e.OrderBy.(parser.OrderByClause).Items {
+				c.collect(item.(parser.OrderItem).Value)
+			}
+		}
+	default:
+		c.unknown = true
+	}
+}
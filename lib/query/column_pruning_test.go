@@ -0,0 +1,194 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var referencedColumnsTests = []struct {
+	Name   string
+	Query  parser.SelectQuery
+	Result []string
+}{
+	{
+		Name: "ReferencedColumns",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "name"}}},
+						parser.Field{Object: parser.AggregateFunction{
+							Name: "count",
+							Args: []parser.QueryExpression{parser.AllColumns{}},
+						}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table8.parquet"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.Function{Name: "abs", Args: []parser.QueryExpression{parser.FieldReference{View: parser.Identifier{Literal: "table8"}, Column: parser.Identifier{Literal: "id"}}}},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+					},
+				},
+				GroupByClause: parser.GroupByClause{
+					Items: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "NAME"}},
+					},
+				},
+			},
+			OrderByClause: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "score"}}},
+				},
+			},
+		},
+		Result: []string{"name", "id", "score"},
+	},
+	{
+		Name: "ReferencedColumns with All Columns",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table8.parquet"}},
+					},
+				},
+			},
+		},
+		Result: nil,
+	},
+	{
+		Name: "ReferencedColumns with Subquery",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "name"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table8.parquet"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Exists{
+						Query: parser.Subquery{
+							Query: parser.SelectQuery{
+								SelectEntity: parser.SelectEntity{
+									SelectClause: parser.SelectClause{
+										Fields: []parser.QueryExpression{
+											parser.Field{Object: parser.NewIntegerValueFromString("1")},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: nil,
+	},
+	{
+		Name: "ReferencedColumns with Joined Tables",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "name"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table8.parquet"}},
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Result: nil,
+	},
+}
+
+func TestReferencedColumns(t *testing.T) {
+	for _, v := range referencedColumnsTests {
+		result := referencedColumns(v.Query)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestSelect_LoadReferencedColumns(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+
+	ctx := context.Background()
+	scope := NewReferenceScope(TestTx)
+
+	selectQuery := func(fields ...string) parser.SelectQuery {
+		exprs := make([]parser.QueryExpression, 0, len(fields))
+		for _, f := range fields {
+			exprs = append(exprs, parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: f}}})
+		}
+		return parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{Fields: exprs},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table8.parquet"}},
+					},
+				},
+			},
+		}
+	}
+
+	loadedFields := func() []string {
+		view, ok := TestTx.cachedViews.Load(GetTestFilePath("table8.parquet"))
+		if !ok {
+			return nil
+		}
+		return view.Header.TableColumnNames()
+	}
+
+	if _, err := Select(ctx, scope, selectQuery("name", "id")); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := []string{"id", "name"}
+	if result := loadedFields(); !reflect.DeepEqual(result, expect) {
+		t.Errorf("loaded fields = %v, want %v", result, expect)
+	}
+
+	if _, err := Select(ctx, scope, selectQuery("score")); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect = []string{"score"}
+	if result := loadedFields(); !reflect.DeepEqual(result, expect) {
+		t.Errorf("loaded fields = %v, want %v", result, expect)
+	}
+
+	if _, err := Select(ctx, scope, selectQuery("notexist")); err == nil {
+		t.Errorf("no error, want error %q", "field notexist does not exist")
+	} else if err.Error() != "field notexist does not exist" {
+		t.Errorf("error %q, want error %q", err.Error(), "field notexist does not exist")
+	}
+}
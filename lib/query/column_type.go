@@ -0,0 +1,343 @@
+package query
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/ternary"
+)
+
+const SchemaFileExtension = ".schema"
+
+type ColumnType int
+
+const (
+	StringColumn ColumnType = iota
+	IntegerColumn
+	FloatColumn
+	BooleanColumn
+	DatetimeColumn
+)
+
+var columnTypeLiterals = map[ColumnType]string{
+	StringColumn:   "STRING",
+	IntegerColumn:  "INTEGER",
+	FloatColumn:    "FLOAT",
+	BooleanColumn:  "BOOLEAN",
+	DatetimeColumn: "DATETIME",
+}
+
+func (t ColumnType) String() string {
+	return columnTypeLiterals[t]
+}
+
+func ParseColumnType(s string) (ColumnType, error) {
+	s = strings.ToUpper(s)
+	for t, lit := range columnTypeLiterals {
+		if s == lit {
+			return t, nil
+		}
+	}
+	return StringColumn, errors.New(fmt.Sprintf("%s is not a valid column type", s))
+}
+
+func (t ColumnType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+func (t *ColumnType) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	ct, err := ParseColumnType(s)
+	if err != nil {
+		return err
+	}
+	*t = ct
+	return nil
+}
+
+// Convert returns the value converted to the column type.
+// Null values are returned as they are, and false is returned if a value cannot be converted.
+func (t ColumnType) Convert(p value.Primary, datetimeFormats []string) (value.Primary, bool) {
+	if value.IsNull(p) {
+		return p, true
+	}
+
+	var converted value.Primary
+	switch t {
+	case IntegerColumn:
+		converted = value.ToInteger(p)
+	case FloatColumn:
+		converted = value.ToFloat(p)
+	case BooleanColumn:
+		converted = value.ToBoolean(p)
+	case DatetimeColumn:
+		converted = value.ToDatetime(p, datetimeFormats)
+	default:
+		switch p.(type) {
+		case *value.Boolean:
+			converted = value.NewString(p.(*value.Boolean).String())
+		case *value.Ternary:
+			if p.(*value.Ternary).Ternary() == ternary.UNKNOWN {
+				return value.NewNull(), true
+			}
+			converted = value.NewString(strconv.FormatBool(p.(*value.Ternary).Ternary().ParseBool()))
+		case *value.Datetime:
+			converted = value.NewString(p.(*value.Datetime).Format(time.RFC3339Nano))
+		default:
+			converted = value.ToString(p)
+		}
+	}
+	return converted, !value.IsNull(converted)
+}
+
+type ColumnSchema struct {
+	Name    string     `json:"name"`
+	Type    ColumnType `json:"type"`
+	NotNull bool       `json:"not_null,omitempty"`
+}
+
+// TableSchema holds the column types declared in a CREATE TABLE statement.
+// It is stored in a hidden file next to the table file, see SchemaFilePath.
+type TableSchema []ColumnSchema
+
+func (s TableSchema) Column(name string) (ColumnSchema, bool) {
+	for _, c := range s {
+		if strings.EqualFold(c.Name, name) {
+			return c, true
+		}
+	}
+	return ColumnSchema{}, false
+}
+
+// Filter returns the schema of the columns that exist in the header.
+func (s TableSchema) Filter(header Header) TableSchema {
+	if s == nil {
+		return nil
+	}
+
+	filtered := make(TableSchema, 0, len(s))
+	for _, column := range header.TableColumnNames() {
+		if c, ok := s.Column(column); ok {
+			c.Name = column
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+func (s TableSchema) Rename(oldName string, newName string) TableSchema {
+	if s == nil {
+		return nil
+	}
+
+	renamed := make(TableSchema, len(s))
+	for i, c := range s {
+		if strings.EqualFold(c.Name, oldName) {
+			c.Name = newName
+		}
+		renamed[i] = c
+	}
+	return renamed
+}
+
+// ConvertValue converts the value to the declared type of the column.
+// An error is returned if the value cannot be stored in the column.
+func (s TableSchema) ConvertValue(expr parser.QueryExpression, column string, p value.Primary, datetimeFormats []string) (value.Primary, error) {
+	c, ok := s.Column(column)
+	if !ok {
+		return p, nil
+	}
+
+	converted, ok := c.Type.Convert(p, datetimeFormats)
+	if !ok {
+		return nil, NewColumnTypeMismatchError(expr, p, column, c.Type)
+	}
+	if c.NotNull && value.IsNull(converted) {
+		return nil, NewNotNullColumnError(expr, column)
+	}
+	return converted, nil
+}
+
+// SchemaFilePath returns the path of the file that holds the schema of the table.
+// The file name starts with a dot so that it is not read as a part of a partitioned directory.
+func SchemaFilePath(tablePath string) string {
+	return filepath.Join(filepath.Dir(tablePath), "."+filepath.Base(tablePath)+SchemaFileExtension)
+}
+
+// LoadTableSchema reads the schema of the table. Nil is returned if the table has no schema file.
+func LoadTableSchema(tablePath string) (TableSchema, error) {
+	b, err := ioutil.ReadFile(SchemaFilePath(tablePath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var schema TableSchema
+	if err = json.Unmarshal(b, &schema); err != nil {
+		return nil, errors.New(fmt.Sprintf("invalid schema file %s: %s", SchemaFilePath(tablePath), err.Error()))
+	}
+	return schema, nil
+}
+
+// WriteTableSchema writes the schema of the table to be committed together with the table file.
+func WriteTableSchema(h *file.Handler, tablePath string, schema TableSchema) error {
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return err
+	}
+	return h.WriteSidecar(SchemaFilePath(tablePath), b)
+}
+
+// applyTableSchema converts the fields of the columns that have declared types.
+func applyTableSchema(view *View, schema TableSchema, datetimeFormats []string) error {
+	if len(schema) < 1 {
+		return nil
+	}
+
+	columns := make([]ColumnSchema, view.FieldLen())
+	typed := make([]bool, view.FieldLen())
+	for i := range view.Header {
+		if !view.Header[i].IsFromTable {
+			continue
+		}
+		columns[i], typed[i] = schema.Column(view.Header[i].Column)
+	}
+
+	for i := range view.RecordSet {
+		for j := range columns {
+			if !typed[j] {
+				continue
+			}
+
+			p := view.RecordSet[i][j][0]
+			converted, ok := columns[j].Type.Convert(p, datetimeFormats)
+			if !ok {
+				return fieldConversionError(i+1, p, view.Header[j].Column, columns[j].Type)
+			}
+			if columns[j].NotNull && value.IsNull(converted) {
+				return errors.New(fmt.Sprintf("record %d: column %s cannot be null", i+1, view.Header[j].Column))
+			}
+			view.RecordSet[i][j] = NewCell(converted)
+		}
+	}
+	view.FileInfo.Schema = schema
+	return nil
+}
+
+func fieldConversionError(recordNumber int, p value.Primary, column string, columnType ColumnType) error {
+	return errors.New(fmt.Sprintf("record %d: value %s in column %s cannot be converted to %s", recordNumber, p, column, columnType))
+}
+
+type rawRecord struct {
+	fields  []text.RawText
+	columns []*ColumnSchema
+}
+
+// fieldConverter converts the fields of records read from text files to the types specified by column type hints.
+//
+// The columns are resolved by their names when a record that has more fields than ever is read,
+// because the header of LTSV grows while reading.
+type fieldConverter struct {
+	schema          TableSchema
+	datetimeFormats []string
+	columnNames     func(fieldLen int) []string
+
+	columns []*ColumnSchema
+}
+
+// newFieldConverter returns a converter for the hints. Nil is returned if no hint is specified.
+// columnNames is called in the goroutine that reads records.
+func newFieldConverter(hints []cmd.ColumnTypeHint, datetimeFormats []string, columnNames func(fieldLen int) []string) *fieldConverter {
+	if len(hints) < 1 {
+		return nil
+	}
+
+	schema := make(TableSchema, 0, len(hints))
+	for _, hint := range hints {
+		if t, err := ParseColumnType(hint.Type); err == nil {
+			schema = append(schema, ColumnSchema{Name: hint.Column, Type: t})
+		}
+	}
+
+	return &fieldConverter{
+		schema:          schema,
+		datetimeFormats: datetimeFormats,
+		columnNames:     columnNames,
+	}
+}
+
+// Columns returns the column types of the fields of a record that has fieldLen fields.
+// A returned slice is never modified, so it can be passed to another goroutine.
+func (c *fieldConverter) Columns(fieldLen int) []*ColumnSchema {
+	if c == nil {
+		return nil
+	}
+	if fieldLen <= len(c.columns) {
+		return c.columns
+	}
+
+	names := c.columnNames(fieldLen)
+	columns := make([]*ColumnSchema, fieldLen)
+	for i := 0; i < fieldLen && i < len(names); i++ {
+		if col, ok := c.schema.Column(names[i]); ok {
+			columns[i] = &col
+		}
+	}
+	c.columns = columns
+	return columns
+}
+
+// Convert converts the fields of the record in place.
+func (c *fieldConverter) Convert(recordNumber int, record Record, columns []*ColumnSchema) error {
+	if c == nil {
+		return nil
+	}
+
+	for i := 0; i < len(record) && i < len(columns); i++ {
+		if columns[i] == nil {
+			continue
+		}
+
+		p := record[i][0]
+		converted, ok := columns[i].Type.Convert(p, c.datetimeFormats)
+		if !ok {
+			return fieldConversionError(recordNumber, p, columns[i].Name, columns[i].Type)
+		}
+		record[i] = NewCell(converted)
+	}
+	return nil
+}
+
+// headerOrDefaultColumnNames returns a function that returns the header,
+// or the names in the form of "c1", "c2", ... if the file has no header.
+func headerOrDefaultColumnNames(header []string) func(int) []string {
+	return func(fieldLen int) []string {
+		if header != nil {
+			return header
+		}
+
+		names := make([]string, fieldLen)
+		for i := range names {
+			names[i] = "c" + strconv.Itoa(i+1)
+		}
+		return names
+	}
+}
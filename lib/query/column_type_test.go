@@ -0,0 +1,214 @@
+package query
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+var columnTypeConvertTests = []struct {
+	Type   ColumnType
+	Value  value.Primary
+	Result value.Primary
+	OK     bool
+}{
+	{
+		Type:   IntegerColumn,
+		Value:  value.NewString("12"),
+		Result: value.NewInteger(12),
+		OK:     true,
+	},
+	{
+		Type:   IntegerColumn,
+		Value:  value.NewString("1.5"),
+		Result: value.NewNull(),
+		OK:     false,
+	},
+	{
+		Type:   FloatColumn,
+		Value:  value.NewInteger(2),
+		Result: value.NewFloat(2),
+		OK:     true,
+	},
+	{
+		Type:   BooleanColumn,
+		Value:  value.NewString("true"),
+		Result: value.NewBoolean(true),
+		OK:     true,
+	},
+	{
+		Type:   DatetimeColumn,
+		Value:  value.NewString("2012-02-03 09:18:15"),
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		OK:     true,
+	},
+	{
+		Type:   DatetimeColumn,
+		Value:  value.NewString("abc"),
+		Result: value.NewNull(),
+		OK:     false,
+	},
+	{
+		Type:   StringColumn,
+		Value:  value.NewTernary(ternary.FALSE),
+		Result: value.NewString("false"),
+		OK:     true,
+	},
+	{
+		Type:   IntegerColumn,
+		Value:  value.NewNull(),
+		Result: value.NewNull(),
+		OK:     true,
+	},
+}
+
+func TestColumnType_Convert(t *testing.T) {
+	for _, v := range columnTypeConvertTests {
+		result, ok := v.Type.Convert(v.Value, nil)
+		if ok != v.OK {
+			t.Errorf("%s: ok = %t, want %t for %s", v.Type, ok, v.OK, v.Value)
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s for %s", v.Type, result, v.Result, v.Value)
+		}
+	}
+}
+
+var applyTableSchemaTests = []struct {
+	Name      string
+	Schema    TableSchema
+	RecordSet RecordSet
+	Result    RecordSet
+	Error     string
+}{
+	{
+		Name:   "Apply Table Schema",
+		Schema: TableSchema{{Name: "id", Type: IntegerColumn, NotNull: true}},
+		RecordSet: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("a")}),
+		},
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a")}),
+		},
+	},
+	{
+		Name:   "Apply Table Schema Conversion Error",
+		Schema: TableSchema{{Name: "id", Type: IntegerColumn}},
+		RecordSet: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewString("a")}),
+			NewRecord([]value.Primary{value.NewString("x"), value.NewString("b")}),
+		},
+		Error: "record 2: value 'x' in column id cannot be converted to INTEGER",
+	},
+	{
+		Name:   "Apply Table Schema Not Null Error",
+		Schema: TableSchema{{Name: "name", Type: StringColumn, NotNull: true}},
+		RecordSet: RecordSet{
+			NewRecord([]value.Primary{value.NewString("1"), value.NewNull()}),
+		},
+		Error: "record 1: column name cannot be null",
+	},
+}
+
+func TestApplyTableSchema(t *testing.T) {
+	for _, v := range applyTableSchemaTests {
+		view := &View{
+			Header:    NewHeader("table1", []string{"id", "name"}),
+			RecordSet: v.RecordSet,
+			FileInfo:  &FileInfo{},
+		}
+
+		err := applyTableSchema(view, v.Schema, nil)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(view.RecordSet, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, view.RecordSet, v.Result)
+		}
+		if !reflect.DeepEqual(view.FileInfo.Schema, v.Schema) {
+			t.Errorf("%s: schema = %v, want %v", v.Name, view.FileInfo.Schema, v.Schema)
+		}
+	}
+}
+
+func TestWriteTableSchema(t *testing.T) {
+	tablePath := filepath.Join(TestDir, "write_table_schema.csv")
+	schema := TableSchema{
+		{Name: "id", Type: IntegerColumn, NotNull: true},
+		{Name: "at", Type: DatetimeColumn},
+	}
+	defer func() {
+		_ = os.Remove(tablePath)
+		_ = os.Remove(SchemaFilePath(tablePath))
+	}()
+
+	container := file.NewContainer()
+	h, err := file.NewHandlerForCreate(container, tablePath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err = WriteTableSchema(h, tablePath, schema); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if file.Exists(SchemaFilePath(tablePath)) {
+		t.Fatalf("schema file is written before the commit")
+	}
+	if err = container.Commit(h); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	result, err := LoadTableSchema(tablePath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(result, schema) {
+		t.Errorf("schema = %v, want %v", result, schema)
+	}
+
+	h, err = file.NewHandlerForUpdate(context.Background(), container, tablePath, TestTx.WaitTimeout, TestTx.RetryDelay)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err = WriteTableSchema(h, tablePath, schema[:1]); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err = container.Close(h); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	result, err = LoadTableSchema(tablePath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(result, schema) {
+		t.Errorf("schema after rollback = %v, want %v", result, schema)
+	}
+	if file.Exists(file.TempFilePath(SchemaFilePath(tablePath))) {
+		t.Errorf("temporary schema file remains")
+	}
+
+	result, err = LoadTableSchema(filepath.Join(TestDir, "notexist.csv"))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if result != nil {
+		t.Errorf("schema = %v, want nil", result)
+	}
+}
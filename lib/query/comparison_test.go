@@ -156,6 +156,75 @@ func TestLike(t *testing.T) {
 	}
 }
 
+var matchRegExpTests = []struct {
+	LHS     value.Primary
+	Pattern value.Primary
+	Result  ternary.Value
+	Error   string
+}{
+	{
+		LHS:     value.NewString("str"),
+		Pattern: value.NewNull(),
+		Result:  ternary.UNKNOWN,
+	},
+	{
+		LHS:     value.NewNull(),
+		Pattern: value.NewString("^s"),
+		Result:  ternary.UNKNOWN,
+	},
+	{
+		LHS:     value.NewBoolean(true),
+		Pattern: value.NewString("^s"),
+		Result:  ternary.UNKNOWN,
+	},
+	{
+		LHS:     value.NewString("str"),
+		Pattern: value.NewString("^s.r$"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("STR"),
+		Pattern: value.NewString("^s"),
+		Result:  ternary.FALSE,
+	},
+	{
+		LHS:     value.NewString("STR"),
+		Pattern: value.NewString("(?i)^s"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewInteger(123),
+		Pattern: value.NewString("^[0-9]+$"),
+		Result:  ternary.TRUE,
+	},
+	{
+		LHS:     value.NewString("str"),
+		Pattern: value.NewString("(str"),
+		Error:   "invalid regular expression: missing closing ): `(str`",
+	},
+}
+
+func TestMatchRegExp(t *testing.T) {
+	for _, v := range matchRegExpTests {
+		r, err := MatchRegExp(v.LHS, v.Pattern)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for (%s regexp %s)", err, v.LHS, v.Pattern)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for (%s regexp %s)", err.Error(), v.Error, v.LHS, v.Pattern)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for (%s regexp %s)", v.Error, v.LHS, v.Pattern)
+			continue
+		}
+		if r != v.Result {
+			t.Errorf("result = %s, want %s for (%s regexp %s)", r, v.Result, v.LHS, v.Pattern)
+		}
+	}
+}
+
 var inRowValueListTests = []struct {
 	LHS      value.RowValue
 	List     []value.RowValue
@@ -46,9 +46,11 @@ var statementPrefix = []string{
 	"CHDIR",
 	"EXECUTE",
 	"SHOW",
+	"EXPLAIN",
 	"SOURCE",
 	"SYNTAX",
 	"RELOAD",
+	"RESTORE",
 }
 
 var singleCommandStatement = []string{
@@ -83,7 +85,11 @@ var tableObjectCandidates = []string{
 	"CSV()",
 	"FIXED()",
 	"JSON()",
+	"JSONL()",
 	"LTSV()",
+	"XLSX()",
+	"XML()",
+	"YAML()",
 }
 
 var exportEncodingsCandidates = []string{
@@ -411,6 +417,8 @@ func (c *Completer) Statements(line string, origLine string, index int) readline
 		return c.UsingArgs(line, origLine, index)
 	case parser.SHOW:
 		return c.ShowArgs(line, origLine, index)
+	case parser.EXPLAIN:
+		return c.ExplainArgs(line, origLine, index)
 	case parser.SOURCE:
 		return c.SearchExecutableFiles(line, origLine, index)
 	case parser.RELOAD:
@@ -419,6 +427,8 @@ func (c *Completer) Statements(line string, origLine string, index int) readline
 		} else {
 			return nil
 		}
+	case parser.RESTORE:
+		return c.RestoreArgs(line, origLine, index)
 	case parser.DISPOSE:
 		return c.DisposeArgs(line, origLine, index)
 	case parser.OPEN:
@@ -466,6 +476,36 @@ func (c *Completer) TableObjectArgs(line string, origLine string, index int) rea
 	var cands readline.CandidateList
 
 	switch strings.ToUpper(c.tokens[0].Literal) {
+	case "JSONL":
+		if commaCnt == 0 && c.tokens[c.lastIdx].Token == '(' {
+			cands = c.SearchAllTables(line, origLine, index)
+		}
+	case "XLSX":
+		switch commaCnt {
+		case 0:
+			if c.tokens[c.lastIdx].Token == '(' {
+				cands = c.SearchAllTables(line, origLine, index)
+			}
+		case 2, 3:
+			if c.tokens[c.lastIdx].Token == ',' {
+				cands = c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false)
+			}
+		}
+	case "XML":
+		if commaCnt == 0 && c.tokens[c.lastIdx].Token == '(' {
+			cands = c.SearchAllTables(line, origLine, index)
+		}
+	case "YAML":
+		switch commaCnt {
+		case 0:
+			if c.tokens[c.lastIdx].Token == '(' {
+				cands = c.SearchAllTables(line, origLine, index)
+			}
+		case 1:
+			if c.tokens[c.lastIdx].Token == ',' {
+				cands = c.SearchAllTables(line, origLine, index)
+			}
+		}
 	case "LTSV":
 		switch commaCnt {
 		case 0:
@@ -1720,7 +1760,9 @@ func (c *Completer) AlterArgs(line string, origLine string, index int) readline.
 						return nil, c.candidateList(c.lineBreakList(), false), true
 					case TableJsonEscape:
 						return nil, c.candidateList(c.jsonEscapeTypeList(), false), true
-					case TableHeader, TableEncloseAll, TablePrettyPrint:
+					case TableEscapeStyle:
+						return nil, c.candidateList(c.escapeStyleList(), false), true
+					case TableHeader, TableEncloseAll, TablePrettyPrint, TableTrimSpace:
 						return nil, c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false), true
 					}
 				}
@@ -1896,8 +1938,8 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.candidateList(c.encodingList(), false), true
 					case cmd.ExportEncodingFlag:
 						return nil, c.candidateList(exportEncodingsCandidates, false), true
-					case cmd.AnsiQuotesFlag, cmd.StrictEqualFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag,
-						cmd.WithoutHeaderFlag, cmd.EncloseAllFlag, cmd.PrettyPrintFlag,
+					case cmd.AnsiQuotesFlag, cmd.StrictEqualFlag, cmd.KeepVersionsFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.TrimSpaceFlag,
+						cmd.GlobUnionByNameFlag, cmd.WithoutHeaderFlag, cmd.EncloseAllFlag, cmd.PrettyPrintFlag, cmd.HtmlStandaloneFlag,
 						cmd.StripEndingLineBreakFlag, cmd.EastAsianEncodingFlag,
 						cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag,
 						cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag:
@@ -1908,6 +1950,12 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.candidateList(c.lineBreakList(), false), true
 					case cmd.JsonEscapeFlag:
 						return nil, c.candidateList(c.jsonEscapeTypeList(), false), true
+					case cmd.EscapeStyleFlag:
+						return nil, c.candidateList(c.escapeStyleList(), false), true
+					case cmd.OnParseErrorFlag:
+						return nil, c.candidateList(c.parseErrorModeList(), false), true
+					case cmd.SqlDialectFlag:
+						return nil, c.candidateList(c.sqlDialectList(), false), true
 					}
 				}
 				return nil, c.SearchValues(line, origLine, index), true
@@ -2051,10 +2099,39 @@ func (c *Completer) DisposeArgs(line string, origLine string, index int) readlin
 	)
 }
 
+func (c *Completer) ExplainArgs(line string, origLine string, index int) readline.CandidateList {
+	queryStart := 1
+	if 1 < len(c.tokens) && c.tokens[1].Token == parser.ANALYZE {
+		queryStart = 2
+	}
+
+	if queryStart <= c.lastIdx {
+		c.tokens = c.tokens[queryStart:]
+		c.SetLastIndex(line)
+
+		switch c.tokens[0].Token {
+		case parser.WITH:
+			return c.WithArgs(line, origLine, index)
+		case parser.SELECT:
+			return c.SelectArgs(line, origLine, index)
+		}
+		return nil
+	}
+
+	keywords := []string{"SELECT", "WITH"}
+	if queryStart == 1 {
+		keywords = append(keywords, "ANALYZE")
+	}
+	cands := c.candidateList(keywords, true)
+	cands.Sort()
+	return cands
+}
+
 func (c *Completer) ShowArgs(line string, origLine string, index int) readline.CandidateList {
 	var showChild = func() readline.CandidateList {
 		cands := c.candidateList(ShowObjectList, false)
 		cands = append(cands, c.candidate("FIELDS", true))
+		cands = append(cands, c.candidate("VERSIONS", true))
 		cands.Sort()
 		cands = append(cands, c.candidateList(c.flagList, false)...)
 		return cands
@@ -2066,7 +2143,7 @@ func (c *Completer) ShowArgs(line string, origLine string, index int) readline.C
 		index,
 		func(i int) (keywords []string, customList readline.CandidateList, breakLoop bool) {
 			switch c.tokens[i].Token {
-			case parser.FROM:
+			case parser.FROM, parser.OF:
 				switch i {
 				case c.lastIdx:
 					return nil, c.allTableCandidatesForUpdate(line, origLine, index), true
@@ -2079,6 +2156,36 @@ func (c *Completer) ShowArgs(line string, origLine string, index int) readline.C
 					if c.tokens[c.lastIdx].Token == parser.IDENTIFIER && strings.ToUpper(c.tokens[c.lastIdx].Literal) == "FIELDS" {
 						return []string{"FROM"}, nil, true
 					}
+					if c.tokens[c.lastIdx].Token == parser.VERSIONS {
+						return []string{"OF"}, nil, true
+					}
+				}
+			default:
+				return nil, nil, false
+			}
+			return nil, nil, true
+		},
+	)
+}
+
+func (c *Completer) RestoreArgs(line string, origLine string, index int) readline.CandidateList {
+	return c.completeArgs(
+		line,
+		origLine,
+		index,
+		func(i int) (keywords []string, customList readline.CandidateList, breakLoop bool) {
+			switch c.tokens[i].Token {
+			case parser.TO:
+				switch i {
+				case c.lastIdx:
+					return []string{"VERSION"}, nil, true
+				}
+			case parser.RESTORE:
+				switch i {
+				case c.lastIdx:
+					return nil, c.allTableCandidatesForUpdate(line, origLine, index), true
+				case c.lastIdx - 1:
+					return []string{"TO"}, nil, true
 				}
 			default:
 				return nil, nil, false
@@ -2098,7 +2205,7 @@ func (c *Completer) SearchAllTablesWithSpace(line string, origLine string, index
 
 func (c *Completer) SearchAllTables(line string, origLine string, index int) readline.CandidateList {
 	tableKeys := c.scope.Tx.cachedViews.SortedKeys()
-	files := c.ListFiles(line, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.LtsvExt, cmd.TextExt}, c.scope.Tx.Flags.Repository)
+	files := c.ListFiles(line, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.JsonlExt, cmd.NdjsonExt, cmd.LtsvExt, cmd.ParquetExt, cmd.XlsxExt, cmd.YamlExt, cmd.YmlExt, cmd.XmlExt, cmd.TextExt}, c.scope.Tx.Flags.Repository)
 
 	defaultDir := c.scope.Tx.Flags.Repository
 	if len(defaultDir) < 1 {
@@ -2208,6 +2315,7 @@ func (c *Completer) SearchValues(line string, origLine string, index int) readli
 		"IS",
 		"BETWEEN",
 		"LIKE",
+		"REGEXP",
 		"IN",
 		"ANY",
 		"ALL",
@@ -2694,7 +2802,7 @@ func (c *Completer) combineFunction() {
 
 func (c *Completer) isTableObject(token parser.Token) bool {
 	switch token.Token {
-	case parser.CSV, parser.JSON, parser.FIXED, parser.LTSV, parser.JSON_TABLE:
+	case parser.CSV, parser.JSON, parser.FIXED, parser.LTSV, parser.JSONL, parser.XLSX, parser.YAML, parser.XML, parser.JSON_TABLE:
 		return true
 	}
 	return false
@@ -2808,7 +2916,10 @@ func (c *Completer) environmentVariableList(line string) []string {
 
 func (c *Completer) tableFormatList() []string {
 	list := make([]string, 0, len(cmd.FormatLiteral))
-	for _, v := range cmd.FormatLiteral {
+	for k, v := range cmd.FormatLiteral {
+		if k == cmd.PARQUET {
+			continue
+		}
 		list = append(list, v)
 	}
 	sort.Strings(list)
@@ -2850,3 +2961,30 @@ func (c *Completer) jsonEscapeTypeList() []string {
 	sort.Strings(list)
 	return list
 }
+
+func (c *Completer) escapeStyleList() []string {
+	list := make([]string, 0, len(cmd.EscapeStyleLiteral))
+	for _, v := range cmd.EscapeStyleLiteral {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func (c *Completer) parseErrorModeList() []string {
+	list := make([]string, 0, len(cmd.ParseErrorModeLiteral))
+	for _, v := range cmd.ParseErrorModeLiteral {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func (c *Completer) sqlDialectList() []string {
+	list := make([]string, 0, len(cmd.SqlDialectLiteral))
+	for _, v := range cmd.SqlDialectLiteral {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return list
+}
@@ -265,6 +265,7 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("ECHO"), AppendSpace: true},
 			{Name: []rune("EXECUTE"), AppendSpace: true},
 			{Name: []rune("EXIT")},
+			{Name: []rune("EXPLAIN"), AppendSpace: true},
 			{Name: []rune("FETCH"), AppendSpace: true},
 			{Name: []rune("INSERT"), AppendSpace: true},
 			{Name: []rune("OPEN"), AppendSpace: true},
@@ -275,6 +276,7 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("RELOAD"), AppendSpace: true},
 			{Name: []rune("REMOVE"), AppendSpace: true},
 			{Name: []rune("REPLACE"), AppendSpace: true},
+			{Name: []rune("RESTORE"), AppendSpace: true},
 			{Name: []rune("ROLLBACK")},
 			{Name: []rune("SELECT"), AppendSpace: true},
 			{Name: []rune("SET"), AppendSpace: true},
@@ -491,9 +493,42 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("RUNINFO")},
 			{Name: []rune("STATEMENTS")},
 			{Name: []rune("TABLES")},
+			{Name: []rune("VERSIONS"), AppendSpace: true},
 			{Name: []rune("VIEWS")},
 		}, completer.candidateList(completer.flagList, false)...),
 	},
+	{
+		Name:     "Statements EXPLAIN",
+		Line:     "",
+		OrigLine: "explain ",
+		Index:    8,
+		Expect: readline.CandidateList{
+			{Name: []rune("ANALYZE"), AppendSpace: true},
+			{Name: []rune("SELECT"), AppendSpace: true},
+			{Name: []rune("WITH"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "Statements EXPLAIN SELECT",
+		Line:     "fro",
+		OrigLine: "explain analyze select 1 fro",
+		Index:    28,
+		Expect: readline.CandidateList{
+			{Name: []rune("AS"), AppendSpace: true},
+			{Name: []rune("EXCEPT"), AppendSpace: true},
+			{Name: []rune("FETCH"), AppendSpace: true},
+			{Name: []rune("FOR UPDATE")},
+			{Name: []rune("FROM"), AppendSpace: true},
+			{Name: []rune("GROUP BY"), AppendSpace: true},
+			{Name: []rune("HAVING"), AppendSpace: true},
+			{Name: []rune("INTERSECT"), AppendSpace: true},
+			{Name: []rune("LIMIT"), AppendSpace: true},
+			{Name: []rune("OFFSET"), AppendSpace: true},
+			{Name: []rune("ORDER BY"), AppendSpace: true},
+			{Name: []rune("UNION"), AppendSpace: true},
+			{Name: []rune("WHERE"), AppendSpace: true},
+		},
+	},
 	{
 		Name:     "Statements SOURCE",
 		Line:     "",
@@ -1248,6 +1283,7 @@ var completerSelectArgsTests = []completerTest{
 		Index:    14,
 		Expect: readline.CandidateList{
 			{Name: []rune("RANK() OVER ()")},
+			{Name: []rune("REGEXP"), AppendSpace: true},
 		},
 	},
 	{
@@ -1310,8 +1346,12 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1384,8 +1424,12 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1568,8 +1612,12 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1589,8 +1637,12 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1610,8 +1662,12 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1630,8 +1686,12 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2069,7 +2129,11 @@ var completerInsertArgsTests = []completerTest{
 			{Name: []rune("CSV()"), AppendSpace: true},
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
+			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
+			{Name: []rune("XML()"), AppendSpace: true},
+			{Name: []rune("YAML()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2154,7 +2218,11 @@ var completerUpdateArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2238,7 +2306,11 @@ var completerReplaceArgsTests = []completerTest{
 			{Name: []rune("CSV()"), AppendSpace: true},
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
+			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
+			{Name: []rune("XML()"), AppendSpace: true},
+			{Name: []rune("YAML()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2347,8 +2419,12 @@ var completerDeleteArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2376,8 +2452,12 @@ var completerDeleteArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2527,7 +2607,11 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("CSV()"), AppendSpace: true},
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
+			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
+			{Name: []rune("XML()"), AppendSpace: true},
+			{Name: []rune("YAML()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2669,15 +2753,19 @@ var completerAlterArgsTests = []completerTest{
 		OrigLine: "alter table `newtable.csv` set ",
 		Index:    31,
 		Expect: readline.CandidateList{
+			{Name: []rune("COMMENT_PREFIX"), AppendSpace: true},
 			{Name: []rune("DELIMITER"), AppendSpace: true},
 			{Name: []rune("DELIMITER_POSITIONS"), AppendSpace: true},
 			{Name: []rune("ENCLOSE_ALL"), AppendSpace: true},
 			{Name: []rune("ENCODING"), AppendSpace: true},
+			{Name: []rune("ESCAPE_STYLE"), AppendSpace: true},
 			{Name: []rune("FORMAT"), AppendSpace: true},
 			{Name: []rune("HEADER"), AppendSpace: true},
 			{Name: []rune("JSON_ESCAPE"), AppendSpace: true},
 			{Name: []rune("LINE_BREAK"), AppendSpace: true},
 			{Name: []rune("PRETTY_PRINT"), AppendSpace: true},
+			{Name: []rune("QUOTE_CHAR"), AppendSpace: true},
+			{Name: []rune("TRIM_SPACE"), AppendSpace: true},
 		},
 	},
 	{
@@ -2719,11 +2807,17 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("CSV")},
 			{Name: []rune("FIXED")},
 			{Name: []rune("GFM")},
+			{Name: []rune("HTML")},
 			{Name: []rune("JSON")},
+			{Name: []rune("JSONL")},
 			{Name: []rune("LTSV")},
 			{Name: []rune("ORG")},
+			{Name: []rune("SQL")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XLSX")},
+			{Name: []rune("XML")},
+			{Name: []rune("YAML")},
 		},
 	},
 	{
@@ -3078,8 +3172,13 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("CSV")},
 			{Name: []rune("FIXED")},
 			{Name: []rune("JSON")},
+			{Name: []rune("JSONL")},
 			{Name: []rune("LTSV")},
+			{Name: []rune("PARQUET")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XLSX")},
+			{Name: []rune("XML")},
+			{Name: []rune("YAML")},
 		},
 	},
 	{
@@ -3155,11 +3254,17 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("CSV")},
 			{Name: []rune("FIXED")},
 			{Name: []rune("GFM")},
+			{Name: []rune("HTML")},
 			{Name: []rune("JSON")},
+			{Name: []rune("JSONL")},
 			{Name: []rune("LTSV")},
 			{Name: []rune("ORG")},
+			{Name: []rune("SQL")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XLSX")},
+			{Name: []rune("XML")},
+			{Name: []rune("YAML")},
 		},
 	},
 	{
@@ -3184,6 +3289,18 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("HEXALL")},
 		},
 	},
+	{
+		Name:     "SetArgs After TO for Sql Dialect Flag",
+		Line:     "",
+		OrigLine: "set @@sql_dialect to ",
+		Index:    21,
+		Expect: readline.CandidateList{
+			{Name: []rune("ANSI")},
+			{Name: []rune("MYSQL")},
+			{Name: []rune("POSTGRESQL")},
+			{Name: []rune("SQLITE")},
+		},
+	},
 	{
 		Name:     "SetArgs After TO",
 		Line:     "@",
@@ -3435,6 +3552,7 @@ var completerShowArgsTests = []completerTest{
 			{Name: []rune("RUNINFO")},
 			{Name: []rune("STATEMENTS")},
 			{Name: []rune("TABLES")},
+			{Name: []rune("VERSIONS"), AppendSpace: true},
 			{Name: []rune("VIEWS")},
 		}, completer.candidateList(completer.flagList, false)...),
 	},
@@ -3452,6 +3570,7 @@ var completerShowArgsTests = []completerTest{
 			{Name: []rune("RUNINFO")},
 			{Name: []rune("STATEMENTS")},
 			{Name: []rune("TABLES")},
+			{Name: []rune("VERSIONS"), AppendSpace: true},
 			{Name: []rune("VIEWS")},
 		}, completer.candidateList(completer.flagList, false)...),
 	},
@@ -3469,6 +3588,7 @@ var completerShowArgsTests = []completerTest{
 			{Name: []rune("RUNINFO")},
 			{Name: []rune("STATEMENTS")},
 			{Name: []rune("TABLES")},
+			{Name: []rune("VERSIONS"), AppendSpace: true},
 			{Name: []rune("VIEWS")},
 		}, completer.candidateList(completer.flagList, false)...),
 	},
@@ -3490,7 +3610,43 @@ var completerShowArgsTests = []completerTest{
 			{Name: []rune("CSV()")},
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
+			{Name: []rune("LTSV()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
+			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
+			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
+			{Name: []rune("tempview"), FormatAsIdentifier: true},
+			{Name: []rune("."), FormatAsIdentifier: true},
+			{Name: []rune(".."), FormatAsIdentifier: true},
+			{Name: []rune("sub/"), FormatAsIdentifier: true},
+			{Name: []rune("table1.csv"), FormatAsIdentifier: true},
+		},
+	},
+	{
+		Name:     "ShowArgs After VERSIONS",
+		Line:     "",
+		OrigLine: "show versions ",
+		Index:    14,
+		Expect: readline.CandidateList{
+			{Name: []rune("OF"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "ShowArgs After OF",
+		Line:     "",
+		OrigLine: "show versions of ",
+		Index:    17,
+		Expect: readline.CandidateList{
+			{Name: []rune("CSV()")},
+			{Name: []rune("FIXED()")},
+			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -3513,6 +3669,54 @@ func TestCompleter_ShowArgs(t *testing.T) {
 	testCompleter(t, completer.ShowArgs, completerShowArgsTests)
 }
 
+var completerRestoreArgsTests = []completerTest{
+	{
+		Name:     "RestoreArgs After RESTORE",
+		Line:     "",
+		OrigLine: "restore ",
+		Index:    8,
+		Expect: readline.CandidateList{
+			{Name: []rune("CSV()")},
+			{Name: []rune("FIXED()")},
+			{Name: []rune("JSON()")},
+			{Name: []rune("JSONL()")},
+			{Name: []rune("LTSV()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
+			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
+			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
+			{Name: []rune("tempview"), FormatAsIdentifier: true},
+			{Name: []rune("."), FormatAsIdentifier: true},
+			{Name: []rune(".."), FormatAsIdentifier: true},
+			{Name: []rune("sub/"), FormatAsIdentifier: true},
+			{Name: []rune("table1.csv"), FormatAsIdentifier: true},
+		},
+	},
+	{
+		Name:     "RestoreArgs After Table",
+		Line:     "",
+		OrigLine: "restore table1 ",
+		Index:    15,
+		Expect: readline.CandidateList{
+			{Name: []rune("TO"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "RestoreArgs After TO",
+		Line:     "",
+		OrigLine: "restore table1 to ",
+		Index:    18,
+		Expect: readline.CandidateList{
+			{Name: []rune("VERSION"), AppendSpace: true},
+		},
+	},
+}
+
+func TestCompleter_RestoreArgs(t *testing.T) {
+	testCompleter(t, completer.RestoreArgs, completerRestoreArgsTests)
+}
+
 var completerSearchAllTablesTests = []completerTest{
 	{
 		Name:     "SearchAllTables",
@@ -3677,6 +3881,15 @@ var completerSearchValuesTests = []completerTest{
 			{Name: []rune("CURSOR"), AppendSpace: true},
 		},
 	},
+	{
+		Name:     "SearchValues Regular Expression Operator",
+		Line:     "r",
+		OrigLine: "select r",
+		Index:    8,
+		Expect: readline.CandidateList{
+			{Name: []rune("REGEXP"), AppendSpace: true},
+		},
+	},
 	{
 		Name:     "SearchValues Subquery",
 		Line:     "s",
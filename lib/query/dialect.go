@@ -0,0 +1,217 @@
+package query
+
+import (
+	"io"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/dsv"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
+)
+
+// DialectSampleRecords is the number of records read from the beginning of a file to detect its dialect.
+const DialectSampleRecords = 100
+
+// DelimiterCandidates are the delimiters that can be detected automatically, in order of priority.
+var DelimiterCandidates = []rune{',', '\t', ';', '|'}
+
+type sampleFieldType int
+
+const (
+	unknownSampleField sampleFieldType = iota
+	stringSampleField
+	numberSampleField
+	datetimeSampleField
+	booleanSampleField
+)
+
+// csvReader is implemented by the csv reader of go-text and the dsv reader.
+type csvReader interface {
+	RecordReader
+	ReadHeader() ([]string, error)
+}
+
+// newCSVReader returns a reader for the dialect of the file.
+// The csv reader of go-text is used unless the file needs the dsv reader.
+// The dsv reader is also used to skip malformed records, because it can continue reading after them.
+func newCSVReader(r io.Reader, fileInfo *FileInfo, withoutNull bool) (csvReader, error) {
+	dialect := fileInfo.Dialect()
+	if dialect.IsStandard() && fileInfo.SkipLines < 1 && fileInfo.OnParseError == cmd.FailOnParseError {
+		reader, err := csv.NewReader(r, fileInfo.Encoding)
+		if err != nil {
+			return nil, err
+		}
+		reader.Delimiter = dialect.Delimiter
+		reader.WithoutNull = withoutNull
+		return reader, nil
+	}
+
+	reader, err := dsv.NewReader(r, fileInfo.Encoding, dialect)
+	if err != nil {
+		return nil, err
+	}
+	reader.WithoutNull = withoutNull
+	reader.SkipLines = fileInfo.SkipLines
+	return reader, nil
+}
+
+// csvReaderState returns the properties of the file that the reader has found while reading.
+func csvReaderState(reader csvReader) (fieldsPerRecord int, lineBreak text.LineBreak, enclosedAll bool, skippedLines []string) {
+	switch r := reader.(type) {
+	case *csv.Reader:
+		return r.FieldsPerRecord, r.DetectedLineBreak, r.EnclosedAll, nil
+	case *dsv.Reader:
+		return r.FieldsPerRecord, r.DetectedLineBreak, r.EnclosedAll, r.SkippedLines
+	}
+	return 0, "", false, nil
+}
+
+// newDSVDialect returns the dialect for the options. The zero quote character is treated as a double quote.
+func newDSVDialect(delimiter rune, quote rune, escape cmd.EscapeStyle, commentPrefix string, trimSpace bool) dsv.Dialect {
+	dialect := dsv.NewDialect(delimiter)
+	if quote != 0 {
+		dialect.Quote = quote
+	}
+	dialect.Escape = escape
+	dialect.CommentPrefix = commentPrefix
+	dialect.TrimSpace = trimSpace
+	return dialect
+}
+
+type csvDialect struct {
+	Delimiter rune
+	NoHeader  bool
+}
+
+// detectCSVDialect detects the delimiter of a CSV file and whether the first record is a header
+// by reading the first records. The quote style is detected by the reader as usual.
+//
+// A delimiter with which the records are read without errors into the most fields is chosen.
+// The other options of the dialect, such as the quote character, are taken from the file information.
+// If NoHeader of the file information is true, the first record is always treated as a record.
+func detectCSVDialect(fp io.ReadSeeker, fileInfo *FileInfo, datetimeFormats []string) (csvDialect, error) {
+	dialect := csvDialect{
+		Delimiter: DelimiterCandidates[0],
+		NoHeader:  fileInfo.NoHeader,
+	}
+
+	var sample [][]text.RawText
+	fieldLen := 0
+	for _, delimiter := range DelimiterCandidates {
+		records, err := readSampleRecords(fp, fileInfo, delimiter)
+		if err != nil {
+			return dialect, err
+		}
+		if records == nil {
+			continue
+		}
+
+		if sample == nil || (0 < len(records) && fieldLen < len(records[0])) {
+			dialect.Delimiter = delimiter
+			sample = records
+			if 0 < len(records) {
+				fieldLen = len(records[0])
+			}
+		}
+	}
+
+	if _, err := fp.Seek(0, io.SeekStart); err != nil {
+		return dialect, err
+	}
+
+	if !fileInfo.NoHeader {
+		dialect.NoHeader = !looksLikeHeader(sample, datetimeFormats)
+	}
+	return dialect, nil
+}
+
+// readSampleRecords reads the records used to detect the dialect.
+// Nil is returned if the records cannot be read with the delimiter.
+func readSampleRecords(fp io.ReadSeeker, fileInfo *FileInfo, delimiter rune) ([][]text.RawText, error) {
+	if _, err := fp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	sampleInfo := *fileInfo
+	sampleInfo.Delimiter = delimiter
+	if sampleInfo.Dialect().Quote == delimiter {
+		return nil, nil
+	}
+
+	reader, err := newCSVReader(fp, &sampleInfo, false)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([][]text.RawText, 0, DialectSampleRecords)
+	for len(records) < DialectSampleRecords {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// looksLikeHeader guesses whether the first record is a header.
+//
+// Each column in which the fields of the other records have the same type other than string votes:
+// for a header if the first field does not have the type, and against it otherwise.
+// The first record is treated as a header unless the votes against it win.
+func looksLikeHeader(records [][]text.RawText, datetimeFormats []string) bool {
+	if len(records) < 2 {
+		return true
+	}
+
+	votes := 0
+	for i := range records[0] {
+		columnType := unknownSampleField
+		for _, record := range records[1:] {
+			t := sampleFieldTypeOf(record[i], datetimeFormats)
+			if t == unknownSampleField {
+				continue
+			}
+			if columnType == unknownSampleField {
+				columnType = t
+			} else if columnType != t {
+				columnType = stringSampleField
+			}
+			if columnType == stringSampleField {
+				break
+			}
+		}
+		if columnType == unknownSampleField || columnType == stringSampleField {
+			continue
+		}
+
+		if sampleFieldTypeOf(records[0][i], datetimeFormats) == columnType {
+			votes--
+		} else {
+			votes++
+		}
+	}
+	return 0 <= votes
+}
+
+func sampleFieldTypeOf(field text.RawText, datetimeFormats []string) sampleFieldType {
+	if len(field) < 1 {
+		return unknownSampleField
+	}
+
+	p := value.NewString(string(field))
+	switch {
+	case !value.IsNull(value.ToFloat(p)):
+		return numberSampleField
+	case !value.IsNull(value.ToDatetime(p, datetimeFormats)):
+		return datetimeSampleField
+	case !value.IsNull(value.ToBoolean(p)):
+		return booleanSampleField
+	}
+	return stringSampleField
+}
@@ -0,0 +1,89 @@
+package query
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/go-text"
+)
+
+var detectCSVDialectTests = []struct {
+	Name      string
+	Input     string
+	NoHeader  bool
+	QuoteChar rune
+	SkipLines int
+	Result    csvDialect
+}{
+	{
+		Name:   "Detect Comma",
+		Input:  "id,name\n1,str1\n2,str2\n",
+		Result: csvDialect{Delimiter: ',', NoHeader: false},
+	},
+	{
+		Name:   "Detect Tab",
+		Input:  "id\tname\n1\tstr1\n2\tstr2\n",
+		Result: csvDialect{Delimiter: '\t', NoHeader: false},
+	},
+	{
+		Name:   "Detect Semicolon",
+		Input:  "id;price\n1;\"1,5\"\n2;\"2,5\"\n",
+		Result: csvDialect{Delimiter: ';', NoHeader: false},
+	},
+	{
+		Name:   "Detect Pipe with Quoted Delimiters",
+		Input:  "id|name\n1|\"a|b\"\n2|\"c|d\"\n",
+		Result: csvDialect{Delimiter: '|', NoHeader: false},
+	},
+	{
+		Name:   "Detect No Header",
+		Input:  "1,str1,2012-02-03\n2,str2,2012-02-04\n3,str3,2012-02-05\n",
+		Result: csvDialect{Delimiter: ',', NoHeader: true},
+	},
+	{
+		Name:   "Detect Header by Types",
+		Input:  "id,name,created\n1,str1,2012-02-03\n2,str2,2012-02-04\n",
+		Result: csvDialect{Delimiter: ',', NoHeader: false},
+	},
+	{
+		Name:   "Header Only",
+		Input:  "id,name\n",
+		Result: csvDialect{Delimiter: ',', NoHeader: false},
+	},
+	{
+		Name:     "Specified No Header",
+		Input:    "id,name\n1,str1\n2,str2\n",
+		NoHeader: true,
+		Result:   csvDialect{Delimiter: ',', NoHeader: true},
+	},
+	{
+		Name:      "Detect with Quote Character and Skipped Lines",
+		Input:     "Report, 2012\nid;name\n1;'a;b'\n2;'c;d'\n",
+		QuoteChar: '\'',
+		SkipLines: 1,
+		Result:    csvDialect{Delimiter: ';', NoHeader: false},
+	},
+	{
+		Name:   "Single Column",
+		Input:  "name\nstr1\nstr2\n",
+		Result: csvDialect{Delimiter: ',', NoHeader: false},
+	},
+}
+
+func TestDetectCSVDialect(t *testing.T) {
+	for _, v := range detectCSVDialectTests {
+		result, err := detectCSVDialect(strings.NewReader(v.Input), &FileInfo{
+			Encoding:  text.UTF8,
+			NoHeader:  v.NoHeader,
+			QuoteChar: v.QuoteChar,
+			SkipLines: v.SkipLines,
+		}, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if result != v.Result {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
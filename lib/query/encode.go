@@ -5,13 +5,20 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"html"
 	"io"
 	"strconv"
 	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/dsv"
 	"github.com/mithrandie/csvq/lib/json"
+	"github.com/mithrandie/csvq/lib/sql"
 	"github.com/mithrandie/csvq/lib/value"
+	"github.com/mithrandie/csvq/lib/xlsx"
+	"github.com/mithrandie/csvq/lib/xml"
+	"github.com/mithrandie/csvq/lib/yaml"
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/color"
@@ -32,8 +39,20 @@ func EncodeView(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 		return "", encodeFixedLengthFormat(ctx, fp, view, options)
 	case cmd.JSON:
 		return "", encodeJson(ctx, fp, view, options, palette)
+	case cmd.JSONL:
+		return "", encodeJsonl(ctx, fp, view, options)
 	case cmd.LTSV:
 		return "", encodeLTSV(ctx, fp, view, options)
+	case cmd.XLSX:
+		return "", encodeXlsx(ctx, fp, view, options)
+	case cmd.YAML:
+		return "", encodeYaml(ctx, fp, view, options)
+	case cmd.XML:
+		return "", encodeXml(ctx, fp, view, options)
+	case cmd.SQL:
+		return "", encodeSql(ctx, fp, view, options)
+	case cmd.HTML:
+		return "", encodeHtml(ctx, fp, view, options)
 	case cmd.GFM, cmd.ORG, cmd.TEXT:
 		return encodeText(ctx, fp, view, options, palette)
 	case cmd.TSV:
@@ -45,47 +64,15 @@ func EncodeView(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 }
 
 func encodeCSV(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
-	w, err := csv.NewWriter(fp, options.LineBreak, options.Encoding)
-	if err != nil {
-		return NewDataEncodingError(err.Error())
-	}
-	w.Delimiter = options.Delimiter
-
-	fields := make([]csv.Field, view.FieldLen())
-
-	if !options.WithoutHeader {
-		for i := range view.Header {
-			fields[i] = csv.NewField(view.Header[i].Column, options.EncloseAll)
-		}
-		if err := w.Write(fields); err != nil {
-			return NewSystemError(err.Error())
-		}
-	} else if view.RecordLen() < 1 {
+	if options.WithoutHeader && view.RecordLen() < 1 {
 		return DataEmpty
 	}
 
-	for i := range view.RecordSet {
-		if i&15 == 0 && ctx.Err() != nil {
-			err = ConvertContextError(ctx.Err())
-			break
-		}
-
-		for j := range view.RecordSet[i] {
-			str, effect, _ := ConvertFieldContents(view.RecordSet[i][j][0], false)
-			quote := false
-			if options.EncloseAll && (effect == cmd.StringEffect || effect == cmd.DatetimeEffect) {
-				quote = true
-			}
-			fields[j] = csv.NewField(str, quote)
-		}
-		if err := w.Write(fields); err != nil {
-			return NewSystemError(err.Error())
-		}
-	}
-	if err = w.Flush(); err != nil {
-		return NewSystemError(err.Error())
+	e, err := newCSVEncoder(fp, view.Header, options)
+	if err != nil {
+		return err
 	}
-	return nil
+	return writeRecords(ctx, e, view.RecordSet)
 }
 
 func encodeFixedLengthFormat(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
@@ -148,53 +135,25 @@ func encodeFixedLengthFormat(ctx context.Context, fp io.Writer, view *View, opti
 		}
 
 	} else {
-		w, err := fixedlen.NewWriter(fp, options.DelimiterPositions, options.LineBreak, options.Encoding)
-		if err != nil {
-			return NewDataEncodingError(err.Error())
-		}
-		w.SingleLine = options.SingleLine
-
-		fields := make([]fixedlen.Field, view.FieldLen())
-
-		if options.WithoutHeader {
-			if view.RecordLen() < 1 {
-				return DataEmpty
-			}
-		} else if !options.SingleLine {
-			for i := range view.Header {
-				fields[i] = fixedlen.NewField(view.Header[i].Column, text.NotAligned)
-			}
-			if err := w.Write(fields); err != nil {
-				return NewDataEncodingError(err.Error())
-			}
+		if options.WithoutHeader && view.RecordLen() < 1 {
+			return DataEmpty
 		}
 
-		for i := range view.RecordSet {
-			if i&15 == 0 && ctx.Err() != nil {
-				return ConvertContextError(ctx.Err())
-			}
-
-			for j := range view.RecordSet[i] {
-				str, _, a := ConvertFieldContents(view.RecordSet[i][j][0], false)
-				fields[j] = fixedlen.NewField(str, a)
-			}
-			if err := w.Write(fields); err != nil {
-				return NewDataEncodingError(err.Error())
-			}
-		}
-		if err = w.Flush(); err != nil {
-			return NewSystemError(err.Error())
+		e, err := newFixedLengthEncoder(fp, view.Header, options)
+		if err != nil {
+			return err
 		}
+		return writeRecords(ctx, e, view.RecordSet)
 	}
 	return nil
 }
 
-func encodeJson(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions, palette *color.Palette) error {
+func convertViewToJsonStructure(ctx context.Context, view *View) (txjson.Structure, error) {
 	header := view.Header.TableColumnNames()
 	records := make([][]value.Primary, view.RecordLen())
 	for i := range view.RecordSet {
 		if i&15 == 0 && ctx.Err() != nil {
-			return ConvertContextError(ctx.Err())
+			return nil, ConvertContextError(ctx.Err())
 		}
 
 		row := make([]value.Primary, view.FieldLen())
@@ -207,9 +166,17 @@ func encodeJson(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 	data, err := json.ConvertTableValueToJsonStructure(ctx, header, records)
 	if err != nil {
 		if ctx.Err() != nil {
-			return ConvertContextError(ctx.Err())
+			return nil, ConvertContextError(ctx.Err())
 		}
-		return NewDataEncodingError(err.Error())
+		return nil, NewDataEncodingError(err.Error())
+	}
+	return data, nil
+}
+
+func encodeJson(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions, palette *color.Palette) error {
+	data, err := convertViewToJsonStructure(ctx, view)
+	if err != nil {
+		return err
 	}
 
 	e := txjson.NewEncoder()
@@ -357,35 +324,534 @@ func encodeLTSV(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 		return DataEmpty
 	}
 
-	hfields := make([]string, view.FieldLen())
-	for i := range view.Header {
-		hfields[i] = view.Header[i].Column
+	e, err := newLTSVEncoder(fp, view.Header, options)
+	if err != nil {
+		return err
 	}
+	return writeRecords(ctx, e, view.RecordSet)
+}
 
-	w, err := ltsv.NewWriter(fp, hfields, options.LineBreak, options.Encoding)
+func encodeJsonl(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	if view.RecordLen() < 1 {
+		return DataEmpty
+	}
+
+	e, err := newJsonlEncoder(fp, view.Header, options)
 	if err != nil {
-		return NewDataEncodingError(err.Error())
+		return err
+	}
+	return writeRecords(ctx, e, view.RecordSet)
+}
+
+func encodeXlsx(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	if options.WithoutHeader && view.RecordLen() < 1 {
+		return DataEmpty
+	}
+
+	w, err := xlsx.NewWriter(fp, "Sheet1")
+	if err != nil {
+		return NewSystemError(err.Error())
+	}
+
+	if !options.WithoutHeader {
+		values := make([]value.Primary, view.FieldLen())
+		for i := range view.Header {
+			values[i] = value.NewString(view.Header[i].Column)
+		}
+		if err = w.Write(values); err != nil {
+			return NewSystemError(err.Error())
+		}
 	}
 
-	fields := make([]string, view.FieldLen())
+	values := make([]value.Primary, view.FieldLen())
 	for i := range view.RecordSet {
 		if i&15 == 0 && ctx.Err() != nil {
 			return ConvertContextError(ctx.Err())
 		}
 
 		for j := range view.RecordSet[i] {
-			fields[j], _, _ = ConvertFieldContents(view.RecordSet[i][j][0], false)
+			values[j] = view.RecordSet[i][j][0]
 		}
-		if err := w.Write(fields); err != nil {
-			return NewDataEncodingError(err.Error())
+		if err = w.Write(values); err != nil {
+			return NewSystemError(err.Error())
 		}
 	}
+
+	if err = w.Close(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func encodeYaml(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	data, err := convertViewToJsonStructure(ctx, view)
+	if err != nil {
+		return err
+	}
+
+	e := yaml.NewEncoder()
+	e.LineBreak = options.LineBreak
+
+	w := bufio.NewWriter(fp)
+	if _, err = w.WriteString(e.Encode(data)); err != nil {
+		return NewSystemError(err.Error())
+	}
 	if err = w.Flush(); err != nil {
 		return NewSystemError(err.Error())
 	}
 	return nil
 }
 
+func encodeXml(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	e, err := newXmlEncoder(fp, view.Header, options)
+	if err != nil {
+		return err
+	}
+	return writeRecords(ctx, e, view.RecordSet)
+}
+
+func encodeSql(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	header := make([]string, view.FieldLen())
+	for i := range view.Header {
+		header[i] = view.Header[i].Column
+	}
+
+	records := make([][]value.Primary, view.RecordLen())
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		records[i] = make([]value.Primary, len(view.RecordSet[i]))
+		for j := range view.RecordSet[i] {
+			records[i][j] = view.RecordSet[i][j][0]
+		}
+	}
+
+	e := sql.NewEncoder(options.SqlTableName)
+	e.Dialect = options.SqlDialect
+	e.LineBreak = options.LineBreak
+	e.WithoutCreate = options.WithoutHeader
+
+	if err := e.Encode(fp, header, records); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func encodeHtml(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	e, err := newHtmlEncoder(fp, view.Header, options)
+	if err != nil {
+		return err
+	}
+	return writeRecords(ctx, e, view.RecordSet)
+}
+
+// recordEncoder writes records one by one to the underlying writer.
+// It is used to output a result set without holding all of the records.
+type recordEncoder interface {
+	Write(record Record) error
+	Flush() error
+}
+
+// newRecordEncoder returns a recordEncoder for the format specified by the options,
+// and writes the header if the format requires it.
+// Formats that need all of the records to determine their layout are not supported.
+func newRecordEncoder(fp io.Writer, header Header, options cmd.ExportOptions) (recordEncoder, error) {
+	switch options.Format {
+	case cmd.CSV:
+		return newCSVEncoder(fp, header, options)
+	case cmd.TSV:
+		options.Delimiter = '\t'
+		return newCSVEncoder(fp, header, options)
+	case cmd.LTSV:
+		return newLTSVEncoder(fp, header, options)
+	case cmd.JSONL:
+		return newJsonlEncoder(fp, header, options)
+	case cmd.XML:
+		return newXmlEncoder(fp, header, options)
+	case cmd.HTML:
+		return newHtmlEncoder(fp, header, options)
+	case cmd.FIXED:
+		if options.DelimiterPositions != nil {
+			return newFixedLengthEncoder(fp, header, options)
+		}
+	}
+	return nil, NewSystemError(fmt.Sprintf("records cannot be encoded sequentially in %s format", options.Format.String()))
+}
+
+func writeRecords(ctx context.Context, e recordEncoder, records RecordSet) error {
+	for i := range records {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		if err := e.Write(records[i]); err != nil {
+			return err
+		}
+	}
+	return e.Flush()
+}
+
+// csvWriter is implemented by the csv writer of go-text and the dsv writer.
+type csvWriter interface {
+	Write([]csv.Field) error
+	Flush() error
+}
+
+type csvEncoder struct {
+	w          csvWriter
+	fields     []csv.Field
+	encloseAll bool
+}
+
+func newCSVEncoder(fp io.Writer, header Header, options cmd.ExportOptions) (*csvEncoder, error) {
+	var w csvWriter
+
+	dialect := newDSVDialect(options.Delimiter, options.QuoteChar, options.EscapeStyle, options.CommentPrefix, options.TrimSpace)
+	if dialect.IsStandard() && len(options.LeadingLines) < 1 {
+		cw, err := csv.NewWriter(fp, options.LineBreak, options.Encoding)
+		if err != nil {
+			return nil, NewDataEncodingError(err.Error())
+		}
+		cw.Delimiter = options.Delimiter
+		w = cw
+	} else {
+		dw, err := dsv.NewWriter(fp, options.LineBreak, options.Encoding, dialect)
+		if err != nil {
+			return nil, NewDataEncodingError(err.Error())
+		}
+		for _, line := range options.LeadingLines {
+			if err := dw.WriteLine(line); err != nil {
+				return nil, NewSystemError(err.Error())
+			}
+		}
+		w = dw
+	}
+
+	e := &csvEncoder{
+		w:          w,
+		fields:     make([]csv.Field, len(header)),
+		encloseAll: options.EncloseAll,
+	}
+
+	if !options.WithoutHeader {
+		for i := range header {
+			e.fields[i] = csv.NewField(header[i].Column, options.EncloseAll)
+		}
+		if err := w.Write(e.fields); err != nil {
+			return nil, NewSystemError(err.Error())
+		}
+	}
+	return e, nil
+}
+
+func (e *csvEncoder) Write(record Record) error {
+	for i := range record {
+		str, effect, _ := ConvertFieldContents(record[i][0], false)
+		quote := false
+		if e.encloseAll && (effect == cmd.StringEffect || effect == cmd.DatetimeEffect) {
+			quote = true
+		}
+		e.fields[i] = csv.NewField(str, quote)
+	}
+	if err := e.w.Write(e.fields); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func (e *csvEncoder) Flush() error {
+	if err := e.w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+type fixedLengthEncoder struct {
+	w      *fixedlen.Writer
+	fields []fixedlen.Field
+}
+
+func newFixedLengthEncoder(fp io.Writer, header Header, options cmd.ExportOptions) (*fixedLengthEncoder, error) {
+	w, err := fixedlen.NewWriter(fp, options.DelimiterPositions, options.LineBreak, options.Encoding)
+	if err != nil {
+		return nil, NewDataEncodingError(err.Error())
+	}
+	w.SingleLine = options.SingleLine
+
+	e := &fixedLengthEncoder{
+		w:      w,
+		fields: make([]fixedlen.Field, len(header)),
+	}
+
+	if !options.WithoutHeader && !options.SingleLine {
+		for i := range header {
+			e.fields[i] = fixedlen.NewField(header[i].Column, text.NotAligned)
+		}
+		if err := w.Write(e.fields); err != nil {
+			return nil, NewDataEncodingError(err.Error())
+		}
+	}
+	return e, nil
+}
+
+func (e *fixedLengthEncoder) Write(record Record) error {
+	for i := range record {
+		str, _, a := ConvertFieldContents(record[i][0], false)
+		e.fields[i] = fixedlen.NewField(str, a)
+	}
+	if err := e.w.Write(e.fields); err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+	return nil
+}
+
+func (e *fixedLengthEncoder) Flush() error {
+	if err := e.w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+type ltsvEncoder struct {
+	w      *ltsv.Writer
+	fields []string
+}
+
+func newLTSVEncoder(fp io.Writer, header Header, options cmd.ExportOptions) (*ltsvEncoder, error) {
+	hfields := make([]string, len(header))
+	for i := range header {
+		hfields[i] = header[i].Column
+	}
+
+	w, err := ltsv.NewWriter(fp, hfields, options.LineBreak, options.Encoding)
+	if err != nil {
+		return nil, NewDataEncodingError(err.Error())
+	}
+
+	return &ltsvEncoder{
+		w:      w,
+		fields: make([]string, len(header)),
+	}, nil
+}
+
+func (e *ltsvEncoder) Write(record Record) error {
+	for i := range record {
+		e.fields[i], _, _ = ConvertFieldContents(record[i][0], false)
+	}
+	if err := e.w.Write(e.fields); err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+	return nil
+}
+
+func (e *ltsvEncoder) Flush() error {
+	if err := e.w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+type jsonlEncoder struct {
+	w         *bufio.Writer
+	e         *txjson.Encoder
+	pathes    []json.PathExpression
+	lineBreak string
+	row       []value.Primary
+	appended  bool
+}
+
+func newJsonlEncoder(fp io.Writer, header Header, options cmd.ExportOptions) (*jsonlEncoder, error) {
+	pathes, err := json.ParsePathes(header.TableColumnNames())
+	if err != nil {
+		return nil, NewDataEncodingError(err.Error())
+	}
+
+	e := txjson.NewEncoder()
+	e.EscapeType = options.JsonEscape
+	e.PrettyPrint = false
+
+	return &jsonlEncoder{
+		w:         bufio.NewWriter(fp),
+		e:         e,
+		pathes:    pathes,
+		lineBreak: options.LineBreak.Value(),
+		row:       make([]value.Primary, len(header)),
+	}, nil
+}
+
+func (e *jsonlEncoder) Write(record Record) error {
+	for i := range record {
+		e.row[i] = record[i][0]
+	}
+	data, err := json.ConvertRecordValueToJsonStructure(e.pathes, e.row)
+	if err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+
+	if e.appended {
+		if _, err := e.w.WriteString(e.lineBreak); err != nil {
+			return NewSystemError(err.Error())
+		}
+	} else {
+		e.appended = true
+	}
+
+	if _, err := e.w.WriteString(e.e.Encode(data)); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func (e *jsonlEncoder) Flush() error {
+	if err := e.w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+type xmlEncoder struct {
+	w      *xml.Writer
+	values []value.Primary
+}
+
+func newXmlEncoder(fp io.Writer, header Header, options cmd.ExportOptions) (*xmlEncoder, error) {
+	hfields := make([]string, len(header))
+	for i := range header {
+		hfields[i] = header[i].Column
+	}
+
+	w, err := xml.NewWriter(fp, options.XmlRootElement, options.XmlRowElement, hfields, options.LineBreak)
+	if err != nil {
+		return nil, NewSystemError(err.Error())
+	}
+
+	return &xmlEncoder{
+		w:      w,
+		values: make([]value.Primary, len(header)),
+	}, nil
+}
+
+func (e *xmlEncoder) Write(record Record) error {
+	for i := range record {
+		e.values[i] = record[i][0]
+	}
+	if err := e.w.Write(e.values); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func (e *xmlEncoder) Flush() error {
+	if err := e.w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+const htmlMetaCharset = "<meta charset=\"%s\">"
+
+var htmlDocumentHead = []string{
+	"<!DOCTYPE html>",
+	"<html>",
+	"<head>",
+	htmlMetaCharset,
+	"<title>Query Result</title>",
+	"<style>",
+	"table { border-collapse: collapse; }",
+	"th, td { border: 1px solid #ccc; padding: 0.25em 0.5em; white-space: pre-wrap; }",
+	"td.number { text-align: right; }",
+	"td.boolean, td.ternary, td.null { text-align: center; }",
+	"td.null { color: #999; }",
+	"</style>",
+	"</head>",
+	"<body>",
+}
+
+// htmlCharset returns the name of the encoding used in the charset declaration of an HTML document.
+func htmlCharset(enc text.Encoding) string {
+	switch enc {
+	case text.UTF16, text.UTF16BEM, text.UTF16LEM:
+		return "UTF-16"
+	case text.UTF16BE:
+		return "UTF-16BE"
+	case text.UTF16LE:
+		return "UTF-16LE"
+	case text.SJIS:
+		return "Shift_JIS"
+	default:
+		return "UTF-8"
+	}
+}
+
+// htmlEncoder writes records as the rows of an HTML table.
+// Each data cell has the class attribute that represents the type of the value,
+// such as "number", "string", "datetime" and "null".
+type htmlEncoder struct {
+	w          *bufio.Writer
+	lineBreak  string
+	standalone bool
+}
+
+func newHtmlEncoder(fp io.Writer, header Header, options cmd.ExportOptions) (*htmlEncoder, error) {
+	e := &htmlEncoder{
+		w:          bufio.NewWriter(fp),
+		lineBreak:  options.LineBreak.Value(),
+		standalone: options.HtmlStandalone,
+	}
+
+	if e.standalone {
+		for _, s := range htmlDocumentHead {
+			if s == htmlMetaCharset {
+				s = fmt.Sprintf(s, htmlCharset(options.Encoding))
+			}
+			_, _ = e.w.WriteString(s + e.lineBreak)
+		}
+	}
+
+	_, _ = e.w.WriteString("<table>")
+	if !options.WithoutHeader {
+		_, _ = e.w.WriteString(e.lineBreak + "  <thead>" + e.lineBreak + "    <tr>")
+		for i := range header {
+			_, _ = e.w.WriteString("<th>" + html.EscapeString(header[i].Column) + "</th>")
+		}
+		_, _ = e.w.WriteString("</tr>" + e.lineBreak + "  </thead>")
+	}
+	if _, err := e.w.WriteString(e.lineBreak + "  <tbody>"); err != nil {
+		return nil, NewSystemError(err.Error())
+	}
+	return e, nil
+}
+
+func (e *htmlEncoder) Write(record Record) error {
+	_, _ = e.w.WriteString(e.lineBreak + "    <tr>")
+	for i := range record {
+		str, effect, _ := ConvertFieldContents(record[i][0], true)
+		if effect == cmd.NoEffect {
+			_, _ = e.w.WriteString("<td>")
+		} else {
+			_, _ = e.w.WriteString("<td class=\"" + effect + "\">")
+		}
+		_, _ = e.w.WriteString(html.EscapeString(str) + "</td>")
+	}
+	if _, err := e.w.WriteString("</tr>"); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func (e *htmlEncoder) Flush() error {
+	_, _ = e.w.WriteString(e.lineBreak + "  </tbody>" + e.lineBreak + "</table>")
+	if e.standalone {
+		_, _ = e.w.WriteString(e.lineBreak + "</body>" + e.lineBreak + "</html>")
+	}
+	if err := e.w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
 func ConvertFieldContents(val value.Primary, forTextTable bool) (string, string, text.FieldAlignment) {
 	var s string
 	var effect = cmd.NoEffect
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
@@ -25,8 +26,11 @@ var encodeViewTests = []struct {
 	WriteAsSingleLine       bool
 	WithoutHeader           bool
 	EncloseAll              bool
+	QuoteChar               rune
+	LeadingLines            []string
 	JsonEscape              json.EscapeType
 	PrettyPrint             bool
+	HtmlStandalone          bool
 	UseColor                bool
 	Result                  string
 	Error                   string
@@ -281,6 +285,24 @@ var encodeViewTests = []struct {
 			"2.0123,\"2016-02-01T16:00:00.123456-07:00\",\"abcdef\"\r\n" +
 			"34567890,\" abcdefghijklmnopqrstuvwxyzabcdefg\nhi\"\"jk\n\",",
 	},
+	{
+		Name: "CSV with Quote Character and Leading Lines",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("it's")}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewString("a\"b")}),
+			},
+		},
+		Format:       cmd.CSV,
+		QuoteChar:    '\'',
+		LeadingLines: []string{"Report"},
+		EncloseAll:   true,
+		Result: "Report\n" +
+			"'c1','c2'\n" +
+			"1,'it''s'\n" +
+			"2,'a\"b'",
+	},
 	{
 		Name: "JSON",
 		View: &View{
@@ -411,6 +433,214 @@ var encodeViewTests = []struct {
 		Format: cmd.LTSV,
 		Error:  "data empty",
 	},
+	{
+		Name: "JSONL",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewNull(), value.NewString("abc\\def\n")}),
+			},
+		},
+		Format:     cmd.JSONL,
+		JsonEscape: json.HexDigits,
+		LineBreak:  text.CRLF,
+		Result: "{\"c1\":-1,\"c2\":null,\"c3\":true}\r\n" +
+			"{\"c1\":2.0123,\"c2\":null,\"c3\":\"abc\\u005cdef\\u000a\"}",
+	},
+	{
+		Name: "JSONL Data Empty",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{},
+		},
+		Format: cmd.JSONL,
+		Error:  "data empty",
+	},
+	{
+		Name: "YAML",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2.a", "c2.b", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(true), value.NewString("abc: def")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewNull(), value.NewString("true"), value.NewString("[1, \"a\"]")}),
+			},
+		},
+		Format:    cmd.YAML,
+		LineBreak: text.CRLF,
+		Result: "- c1: -1\r\n" +
+			"  c2:\r\n" +
+			"    a: null\r\n" +
+			"    b: true\r\n" +
+			"  c3: \"abc: def\"\r\n" +
+			"- c1: 2.0123\r\n" +
+			"  c2:\r\n" +
+			"    a: null\r\n" +
+			"    b: \"true\"\r\n" +
+			"  c3:\r\n" +
+			"    - 1\r\n" +
+			"    - a",
+	},
+	{
+		Name: "YAML Empty Result",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{},
+		},
+		Format: cmd.YAML,
+		Result: "[]",
+	},
+	{
+		Name: "XML",
+		View: &View{
+			Header: NewHeader("test", []string{"@id", "name", "c 3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a&b"), value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewNull(), value.NewTernary(ternary.UNKNOWN)}),
+			},
+		},
+		Format:    cmd.XML,
+		LineBreak: text.CRLF,
+		Result: "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\r\n" +
+			"<root>\r\n" +
+			"  <row id=\"1\">\r\n" +
+			"    <name>a&amp;b</name>\r\n" +
+			"    <c_3>true</c_3>\r\n" +
+			"  </row>\r\n" +
+			"  <row id=\"2\"/>\r\n" +
+			"</root>",
+	},
+	{
+		Name: "SQL",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a'b"), value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewInteger(2), value.NewNull(), value.NewTernary(ternary.UNKNOWN)}),
+			},
+		},
+		Format: cmd.SQL,
+		Result: "CREATE TABLE \"result\" (\n" +
+			"  \"c1\" BIGINT,\n" +
+			"  \"c2\" VARCHAR(3),\n" +
+			"  \"c3\" BOOLEAN\n" +
+			");\n" +
+			"INSERT INTO \"result\" (\"c1\", \"c2\", \"c3\") VALUES\n" +
+			"  (1, 'a''b', TRUE),\n" +
+			"  (2, NULL, NULL);",
+	},
+	{
+		Name: "SQL Without Header",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1)}),
+			},
+		},
+		Format:        cmd.SQL,
+		WithoutHeader: true,
+		Result: "INSERT INTO \"result\" (\"c1\") VALUES\n" +
+			"  (1);",
+	},
+	{
+		Name: "HTML",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2 & c3", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewString("<a>"), value.NewTernary(ternary.UNKNOWN)}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewNull(), value.NewDatetime(time.Date(2016, 2, 1, 16, 0, 0, 0, time.UTC))}),
+			},
+		},
+		Format:    cmd.HTML,
+		LineBreak: text.CRLF,
+		Result: "<table>\r\n" +
+			"  <thead>\r\n" +
+			"    <tr><th>c1</th><th>c2 &amp; c3</th><th>c3</th></tr>\r\n" +
+			"  </thead>\r\n" +
+			"  <tbody>\r\n" +
+			"    <tr><td class=\"number\">-1</td><td class=\"string\">&lt;a&gt;</td><td class=\"ternary\">UNKNOWN</td></tr>\r\n" +
+			"    <tr><td class=\"number\">2.0123</td><td class=\"null\">NULL</td><td class=\"datetime\">2016-02-01T16:00:00Z</td></tr>\r\n" +
+			"  </tbody>\r\n" +
+			"</table>",
+	},
+	{
+		Name: "HTML Standalone Without Header",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewBoolean(true)}),
+			},
+		},
+		Format:         cmd.HTML,
+		WithoutHeader:  true,
+		HtmlStandalone: true,
+		Result: "<!DOCTYPE html>\n" +
+			"<html>\n" +
+			"<head>\n" +
+			"<meta charset=\"UTF-8\">\n" +
+			"<title>Query Result</title>\n" +
+			"<style>\n" +
+			"table { border-collapse: collapse; }\n" +
+			"th, td { border: 1px solid #ccc; padding: 0.25em 0.5em; white-space: pre-wrap; }\n" +
+			"td.number { text-align: right; }\n" +
+			"td.boolean, td.ternary, td.null { text-align: center; }\n" +
+			"td.null { color: #999; }\n" +
+			"</style>\n" +
+			"</head>\n" +
+			"<body>\n" +
+			"<table>\n" +
+			"  <tbody>\n" +
+			"    <tr><td class=\"boolean\">true</td></tr>\n" +
+			"  </tbody>\n" +
+			"</table>\n" +
+			"</body>\n" +
+			"</html>",
+	},
+	{
+		Name: "HTML Standalone Charset",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewBoolean(true)}),
+			},
+		},
+		Format:         cmd.HTML,
+		WriteEncoding:  text.SJIS,
+		WithoutHeader:  true,
+		HtmlStandalone: true,
+		Result: "<!DOCTYPE html>\n" +
+			"<html>\n" +
+			"<head>\n" +
+			"<meta charset=\"Shift_JIS\">\n" +
+			"<title>Query Result</title>\n" +
+			"<style>\n" +
+			"table { border-collapse: collapse; }\n" +
+			"th, td { border: 1px solid #ccc; padding: 0.25em 0.5em; white-space: pre-wrap; }\n" +
+			"td.number { text-align: right; }\n" +
+			"td.boolean, td.ternary, td.null { text-align: center; }\n" +
+			"td.null { color: #999; }\n" +
+			"</style>\n" +
+			"</head>\n" +
+			"<body>\n" +
+			"<table>\n" +
+			"  <tbody>\n" +
+			"    <tr><td class=\"boolean\">true</td></tr>\n" +
+			"  </tbody>\n" +
+			"</table>\n" +
+			"</body>\n" +
+			"</html>",
+	},
+	{
+		Name: "XML Empty Result",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{},
+		},
+		Format: cmd.XML,
+		Result: "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+			"<root>\n" +
+			"</root>",
+	},
 	{
 		Name: "Fixed-Length Format Invalid Positions",
 		View: &View{
@@ -500,6 +730,9 @@ func TestEncodeView(t *testing.T) {
 		if v.WriteDelimiter == 0 {
 			v.WriteDelimiter = ','
 		}
+		if v.QuoteChar == 0 {
+			v.QuoteChar = '"'
+		}
 		TestTx.UseColor(v.UseColor)
 
 		options := TestTx.Flags.ExportOptions.Copy()
@@ -510,8 +743,11 @@ func TestEncodeView(t *testing.T) {
 		options.LineBreak = v.LineBreak
 		options.WithoutHeader = v.WithoutHeader
 		options.EncloseAll = v.EncloseAll
+		options.QuoteChar = v.QuoteChar
+		options.LeadingLines = v.LeadingLines
 		options.JsonEscape = v.JsonEscape
 		options.PrettyPrint = v.PrettyPrint
+		options.HtmlStandalone = v.HtmlStandalone
 		options.SingleLine = v.WriteAsSingleLine
 
 		buf.Reset()
@@ -29,6 +29,7 @@ const (
 	ErrMsgIO                                   = "%s"
 	ErrMsgCommit                               = "failed to commit: %s"
 	ErrMsgRollback                             = "failed to rollback: %s"
+	ErrMsgRecovery                             = "failed to recover interrupted commits: %s"
 	ErrMsgCannotDetectFileEncoding             = "cannot detect character encoding: %s"
 	ErrMsgFieldAmbiguous                       = "field %s is ambiguous"
 	ErrMsgFieldNotExist                        = "field %s does not exist"
@@ -49,6 +50,8 @@ const (
 	ErrMsgJsonQueryTooManyRecords              = "json query returns too many records, should return only one record"
 	ErrMsgLoadJson                             = "json loading error: %s"
 	ErrMsgEmptyJsonQuery                       = "json query is empty"
+	ErrMsgLoadYaml                             = "yaml loading error: %s"
+	ErrMsgLoadXml                              = "xml loading error: %s"
 	ErrMsgEmptyJsonTable                       = "json table is empty"
 	ErrMsgIncorrectLateralUsage                = "LATERAL cannot to be used in a RIGHT or FULL outer join"
 	ErrMsgInvalidTableObject                   = "invalid table object: %s"
@@ -127,6 +130,21 @@ const (
 	ErrMsgStatementReplaceValueNotSpecified    = "replace value for %s is not specified"
 	ErrMsgSelectIntoQueryFieldLengthNotMatch   = "select into query should return exactly %s"
 	ErrMsgSelectIntoQueryTooManyRecords        = "select into query returns too many records, should return only one record"
+	ErrMsgInvalidRegularExpression             = "%s"
+	ErrMsgReadOnlyFormat                       = "file %s cannot be updated because %s format is read-only"
+	ErrMsgReadOnlyGlobTable                    = "table %s cannot be updated because it is loaded from multiple files"
+	ErrMsgReadOnlyMalformedTable               = "file %s cannot be updated because %d malformed records were dropped while loading it"
+	ErrMsgGlobHeaderMismatch                   = "columns of file %s do not match columns of file %s"
+	ErrMsgEmptyDirectory                       = "directory %s has no files to load"
+	ErrMsgInvalidColumnType                    = "%s is not a valid column type, must be one of STRING|INTEGER|FLOAT|BOOLEAN|DATETIME"
+	ErrMsgColumnTypeMismatch                   = "value %s cannot be stored in column %s of type %s"
+	ErrMsgNotNullColumn                        = "column %s cannot be null"
+	ErrMsgOutfileInvalidFilePath               = "%s is a invalid file path"
+	ErrMsgInvalidOutfileOptionName             = "outfile option %s does not exist"
+	ErrMsgInvalidOutfileOptionValue            = "%s"
+	ErrMsgVersionNotAvailable                  = "versions of %s are not available"
+	ErrMsgInvalidVersion                       = "version %s is invalid, must be an integer or a datetime"
+	ErrMsgVersionNotExist                      = "version %s of %s does not exist"
 )
 
 type Error interface {
@@ -431,6 +449,16 @@ func NewRollbackError(expr parser.Expression, message string) error {
 	}
 }
 
+type RecoveryError struct {
+	*BaseError
+}
+
+func NewRecoveryError(message string) error {
+	return &RecoveryError{
+		NewBaseErrorWithPrefix("Recovery", fmt.Sprintf(ErrMsgRecovery, message), ReturnCodeIOError, ErrorRecovery),
+	}
+}
+
 type CannotDetectFileEncodingError struct {
 	*BaseError
 }
@@ -643,6 +671,26 @@ func NewLoadJsonError(expr parser.QueryExpression, message string) error {
 	}
 }
 
+type LoadYamlError struct {
+	*BaseError
+}
+
+func NewLoadYamlError(expr parser.QueryExpression, message string) error {
+	return &LoadYamlError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgLoadYaml, message), ReturnCodeApplicationError, ErrorLoadYaml),
+	}
+}
+
+type LoadXmlError struct {
+	*BaseError
+}
+
+func NewLoadXmlError(expr parser.QueryExpression, message string) error {
+	return &LoadXmlError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgLoadXml, message), ReturnCodeApplicationError, ErrorLoadXml),
+	}
+}
+
 type EmptyJsonQueryError struct {
 	*BaseError
 }
@@ -1451,6 +1499,156 @@ func NewSelectIntoQueryTooManyRecordsError(query parser.SelectQuery) error {
 	}
 }
 
+type InvalidRegularExpressionError struct {
+	*BaseError
+}
+
+func NewInvalidRegularExpressionError(expr parser.RegExp, message string) error {
+	return &InvalidRegularExpressionError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidRegularExpression, message), ReturnCodeApplicationError, ErrorInvalidRegularExpression),
+	}
+}
+
+type ReadOnlyFormatError struct {
+	*BaseError
+}
+
+func NewReadOnlyFormatError(table parser.Identifier, format string) error {
+	return &ReadOnlyFormatError{
+		NewBaseError(table, fmt.Sprintf(ErrMsgReadOnlyFormat, table, format), ReturnCodeApplicationError, ErrorReadOnlyFormat),
+	}
+}
+
+type ReadOnlyGlobTableError struct {
+	*BaseError
+}
+
+func NewReadOnlyGlobTableError(table parser.Identifier) error {
+	return &ReadOnlyGlobTableError{
+		NewBaseError(table, fmt.Sprintf(ErrMsgReadOnlyGlobTable, table), ReturnCodeApplicationError, ErrorReadOnlyGlobTable),
+	}
+}
+
+type ReadOnlyMalformedTableError struct {
+	*BaseError
+}
+
+func NewReadOnlyMalformedTableError(table parser.Identifier, records int) error {
+	return &ReadOnlyMalformedTableError{
+		NewBaseError(table, fmt.Sprintf(ErrMsgReadOnlyMalformedTable, table, records), ReturnCodeApplicationError, ErrorReadOnlyMalformedTable),
+	}
+}
+
+type GlobHeaderMismatchError struct {
+	*BaseError
+}
+
+type EmptyDirectoryError struct {
+	*BaseError
+}
+
+func NewEmptyDirectoryError(dir parser.Identifier) error {
+	return &EmptyDirectoryError{
+		NewBaseError(dir, fmt.Sprintf(ErrMsgEmptyDirectory, dir), ReturnCodeIOError, ErrorEmptyDirectory),
+	}
+}
+
+func NewGlobHeaderMismatchError(file parser.Identifier, baseFile parser.Identifier) error {
+	return &GlobHeaderMismatchError{
+		NewBaseError(file, fmt.Sprintf(ErrMsgGlobHeaderMismatch, file, baseFile), ReturnCodeApplicationError, ErrorGlobHeaderMismatch),
+	}
+}
+
+type InvalidColumnTypeError struct {
+	*BaseError
+}
+
+func NewInvalidColumnTypeError(columnType parser.Identifier) error {
+	return &InvalidColumnTypeError{
+		NewBaseError(columnType, fmt.Sprintf(ErrMsgInvalidColumnType, columnType.Literal), ReturnCodeApplicationError, ErrorInvalidColumnType),
+	}
+}
+
+type ColumnTypeMismatchError struct {
+	*BaseError
+}
+
+func NewColumnTypeMismatchError(expr parser.QueryExpression, val value.Primary, column string, columnType ColumnType) error {
+	return &ColumnTypeMismatchError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgColumnTypeMismatch, val, column, columnType), ReturnCodeApplicationError, ErrorColumnTypeMismatch),
+	}
+}
+
+type NotNullColumnError struct {
+	*BaseError
+}
+
+func NewNotNullColumnError(expr parser.QueryExpression, column string) error {
+	return &NotNullColumnError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgNotNullColumn, column), ReturnCodeApplicationError, ErrorNotNullColumn),
+	}
+}
+
+type OutfileInvalidFilePathError struct {
+	*BaseError
+}
+
+func NewOutfileInvalidFilePathError(expr parser.OutfileClause) error {
+	return &OutfileInvalidFilePathError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgOutfileInvalidFilePath, expr.Path), ReturnCodeApplicationError, ErrorOutfileInvalidFilePath),
+	}
+}
+
+type InvalidOutfileOptionNameError struct {
+	*BaseError
+}
+
+func NewInvalidOutfileOptionNameError(expr parser.OutfileOption) error {
+	return &InvalidOutfileOptionNameError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidOutfileOptionName, expr.Name), ReturnCodeApplicationError, ErrorInvalidOutfileOptionName),
+	}
+}
+
+type InvalidOutfileOptionValueError struct {
+	*BaseError
+}
+
+func NewInvalidOutfileOptionValueError(expr parser.OutfileOption, message string) error {
+	return &InvalidOutfileOptionValueError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidOutfileOptionValue, message), ReturnCodeApplicationError, ErrorInvalidOutfileOptionValue),
+	}
+}
+
+type VersionNotAvailableError struct {
+	*BaseError
+}
+
+func NewVersionNotAvailableError(table parser.QueryExpression) error {
+	return &VersionNotAvailableError{
+		NewBaseError(table, fmt.Sprintf(ErrMsgVersionNotAvailable, table), ReturnCodeApplicationError, ErrorVersionNotAvailable),
+	}
+}
+
+type InvalidVersionError struct {
+	*BaseError
+}
+
+func NewInvalidVersionError(expr parser.QueryExpression, version string) error {
+	return &InvalidVersionError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidVersion, version), ReturnCodeApplicationError, ErrorInvalidVersion),
+	}
+}
+
+type VersionNotExistError struct {
+	*BaseError
+}
+
+func NewVersionNotExistError(expr parser.QueryExpression, version string, path string) error {
+	return &VersionNotExistError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgVersionNotExist, version, path), ReturnCodeApplicationError, ErrorVersionNotExist),
+	}
+}
+
 func searchSelectClause(query parser.SelectQuery) parser.SelectClause {
 	return searchSelectClauseInSelectEntity(query.SelectEntity)
 }
@@ -34,6 +34,8 @@ const (
 	ErrorJsonQueryTooManyRecords              = 10701
 	ErrorLoadJson                             = 10702
 	ErrorEmptyJsonQuery                       = 10703
+	ErrorLoadYaml                             = 10704
+	ErrorLoadXml                              = 10705
 	ErrorEmptyJsonTable                       = 10801
 	ErrorIncorrectLateralUsage                = 10802
 	ErrorInvalidTableObject                   = 10901
@@ -107,6 +109,20 @@ const (
 	ErrorReplaceKeyNotSet                     = 13901
 	ErrorSelectIntoQueryFieldLengthNotMatch   = 14001
 	ErrorSelectIntoQueryTooManyRecords        = 14002
+	ErrorInvalidRegularExpression             = 14101
+	ErrorReadOnlyFormat                       = 14201
+	ErrorReadOnlyGlobTable                    = 14202
+	ErrorReadOnlyMalformedTable               = 14203
+	ErrorGlobHeaderMismatch                   = 14301
+	ErrorInvalidColumnType                    = 14401
+	ErrorColumnTypeMismatch                   = 14402
+	ErrorNotNullColumn                        = 14403
+	ErrorOutfileInvalidFilePath               = 14501
+	ErrorInvalidOutfileOptionName             = 14502
+	ErrorInvalidOutfileOptionValue            = 14503
+	ErrorVersionNotAvailable                  = 14601
+	ErrorInvalidVersion                       = 14602
+	ErrorVersionNotExist                      = 14603
 
 	//Incorrect Command Usage
 	ErrorIncorrectCommandUsage = 90020
@@ -126,10 +142,12 @@ const (
 	ErrorIO               = 90160
 	ErrorCommit           = 90171
 	ErrorRollback         = 90172
+	ErrorRecovery         = 90173
 	ErrorInvalidPath      = 90180
 	ErrorFileNotExist     = 90181
 	ErrorFileAlreadyExist = 90182
 	ErrorFileUnableToRead = 90183
+	ErrorEmptyDirectory   = 90184
 
 	//System Error
 	ErrorSystemError     = 90320
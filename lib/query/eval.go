@@ -44,6 +44,8 @@ func Evaluate(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpre
 		val, err = evalBetween(ctx, scope, expr.(parser.Between))
 	case parser.Like:
 		val, err = evalLike(ctx, scope, expr.(parser.Like))
+	case parser.RegExp:
+		val, err = evalRegExp(ctx, scope, expr.(parser.RegExp))
 	case parser.In:
 		val, err = evalIn(ctx, scope, expr.(parser.In))
 	case parser.Any:
@@ -127,6 +129,7 @@ func evaluateSequentialRoutine(ctx context.Context, scope *ReferenceScope, view
 
 func EvaluateSequentially(ctx context.Context, scope *ReferenceScope, view *View, fn func(*ReferenceScope, int) error) error {
 	gm := NewGoroutineTaskManager(view.Len(), -1, scope.Tx.Flags.CPU)
+	explainGoroutines(ctx, gm.Number)
 	if 1 < gm.Number {
 		for i := 0; i < gm.Number; i++ {
 			gm.Add()
@@ -494,6 +497,26 @@ func evalLike(ctx context.Context, scope *ReferenceScope, expr parser.Like) (val
 	return value.NewTernary(t), nil
 }
 
+func evalRegExp(ctx context.Context, scope *ReferenceScope, expr parser.RegExp) (value.Primary, error) {
+	lhs, err := Evaluate(ctx, scope, expr.LHS)
+	if err != nil {
+		return nil, err
+	}
+	pattern, err := Evaluate(ctx, scope, expr.Pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := MatchRegExp(lhs, pattern)
+	if err != nil {
+		return nil, NewInvalidRegularExpressionError(expr, err.Error())
+	}
+	if expr.IsNegated() {
+		t = ternary.Not(t)
+	}
+	return value.NewTernary(t), nil
+}
+
 func evalExists(ctx context.Context, scope *ReferenceScope, expr parser.Exists) (value.Primary, error) {
 	view, err := Select(ctx, scope, expr.Query.Query)
 	if err != nil {
@@ -2273,6 +2273,56 @@ var evaluateTests = []struct {
 		},
 		Error: "field notexist does not exist",
 	},
+	{
+		Name: "RegExp",
+		Expr: parser.RegExp{
+			LHS:     parser.NewStringValue("abcdefg"),
+			Pattern: parser.NewStringValue("^a.c"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "RegExp Negation",
+		Expr: parser.RegExp{
+			LHS:      parser.NewStringValue("abcdefg"),
+			Pattern:  parser.NewStringValue("^a.c"),
+			Negation: parser.Token{Token: parser.NOT, Literal: "not"},
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "RegExp Null",
+		Expr: parser.RegExp{
+			LHS:      parser.NewNullValue(),
+			Pattern:  parser.NewStringValue("^a.c"),
+			Negation: parser.Token{Token: parser.NOT, Literal: "not"},
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "RegExp LHS Error",
+		Expr: parser.RegExp{
+			LHS:     parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			Pattern: parser.NewStringValue("^a.c"),
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name: "RegExp Pattern Error",
+		Expr: parser.RegExp{
+			LHS:     parser.NewStringValue("abcdefg"),
+			Pattern: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name: "RegExp Invalid Pattern Error",
+		Expr: parser.RegExp{
+			LHS:     parser.NewStringValue("abcdefg"),
+			Pattern: parser.NewStringValue("(abc"),
+		},
+		Error: "invalid regular expression: missing closing ): `(abc`",
+	},
 	{
 		Name: "Exists",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
@@ -0,0 +1,509 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+type ExecutionPlanAttribute struct {
+	Label string
+	Value string
+}
+
+// ExecutionPlan represents a step of the processing of a select query.
+// Rows, Time and Loops are set only when the step has been actually executed.
+// If the step has been executed repeatedly, they are the totals of all the executions.
+type ExecutionPlan struct {
+	Operation  string
+	Attributes []ExecutionPlanAttribute
+	Children   []*ExecutionPlan
+
+	Analyzed bool
+	Rows     int
+	Time     time.Duration
+	Loops    int
+
+	analyze    bool
+	key        string
+	goroutines int
+}
+
+func NewExecutionPlan(operation string) *ExecutionPlan {
+	return &ExecutionPlan{
+		Operation: operation,
+	}
+}
+
+func (p *ExecutionPlan) AddAttribute(label string, value string) {
+	p.Attributes = append(p.Attributes, ExecutionPlanAttribute{Label: label, Value: value})
+}
+
+func (p *ExecutionPlan) AddChild(child *ExecutionPlan) {
+	p.Children = append(p.Children, child)
+}
+
+func (p *ExecutionPlan) Attribute(label string) (string, bool) {
+	for _, attr := range p.Attributes {
+		if attr.Label == label {
+			return attr.Value, true
+		}
+	}
+	return "", false
+}
+
+func (p *ExecutionPlan) setAttribute(label string, value string) {
+	for i := range p.Attributes {
+		if p.Attributes[i].Label == label {
+			p.Attributes[i].Value = value
+			return
+		}
+	}
+	p.AddAttribute(label, value)
+}
+
+// addGoroutines shows that the step can be processed in parallel.
+// The number of goroutines actually used is recorded when the step is executed.
+func (p *ExecutionPlan) addGoroutines(cpu int) {
+	if !p.analyze {
+		p.AddAttribute("Goroutines", fmt.Sprintf("up to %d", cpu))
+	}
+}
+
+func (p *ExecutionPlan) repeatedChild(operation string, key string) *ExecutionPlan {
+	for _, child := range p.Children {
+		if child.Operation == operation && 0 < len(child.key) && child.key == key {
+			return child
+		}
+	}
+	return nil
+}
+
+// executionStep is a step of the processing that is recorded in the execution plan while a select query is explained.
+// A nil step means that no query is being explained, and its methods do nothing.
+type executionStep struct {
+	plan    *ExecutionPlan
+	analyze bool
+	mtx     *sync.Mutex
+	start   time.Time
+}
+
+func executionStepFromContext(ctx context.Context) *executionStep {
+	if v := ctx.Value(ExecutionPlanContextKey); v != nil {
+		return v.(*executionStep)
+	}
+	return nil
+}
+
+// beginExecutionStep adds a step to the execution plan of the query being explained,
+// and returns the context in which the step is processed.
+func beginExecutionStep(ctx context.Context, operation string, describe func(plan *ExecutionPlan)) (context.Context, *executionStep) {
+	return beginStep(ctx, operation, "", describe)
+}
+
+// beginRepeatableExecutionStep adds a step that can be executed repeatedly, such as a subquery evaluated for each record.
+// The executions of the same step are recorded in one step, and the steps in it are recorded only for the first execution.
+func beginRepeatableExecutionStep(ctx context.Context, operation string, key string, describe func(plan *ExecutionPlan)) (context.Context, *executionStep) {
+	return beginStep(ctx, operation, key, describe)
+}
+
+func beginStep(ctx context.Context, operation string, key string, describe func(plan *ExecutionPlan)) (context.Context, *executionStep) {
+	parent := executionStepFromContext(ctx)
+	if parent == nil {
+		return ctx, nil
+	}
+
+	step := &executionStep{
+		analyze: parent.analyze,
+		mtx:     parent.mtx,
+		start:   time.Now(),
+	}
+	if parent.plan == nil {
+		return ctx, step
+	}
+
+	parent.mtx.Lock()
+	defer parent.mtx.Unlock()
+
+	if 0 < len(key) {
+		if plan := parent.plan.repeatedChild(operation, key); plan != nil {
+			step.plan = plan
+			return context.WithValue(ctx, ExecutionPlanContextKey, &executionStep{analyze: step.analyze, mtx: step.mtx}), step
+		}
+	}
+
+	plan := NewExecutionPlan(operation)
+	plan.analyze = parent.analyze
+	plan.key = key
+	if describe != nil {
+		describe(plan)
+	}
+	parent.plan.AddChild(plan)
+
+	step.plan = plan
+	return context.WithValue(ctx, ExecutionPlanContextKey, step), step
+}
+
+// Executes reports whether the step is actually executed.
+// It returns false only when the query is explained without ANALYZE.
+func (s *executionStep) Executes() bool {
+	return s == nil || s.analyze
+}
+
+func (s *executionStep) SetAttribute(label string, value string) {
+	if s == nil || s.plan == nil {
+		return
+	}
+	s.mtx.Lock()
+	s.plan.setAttribute(label, value)
+	s.mtx.Unlock()
+}
+
+// Finish records the number of records and the elapsed time of an execution of the step.
+func (s *executionStep) Finish(rows int) {
+	if s == nil || s.plan == nil || !s.analyze {
+		return
+	}
+	elapsed := time.Since(s.start)
+
+	s.mtx.Lock()
+	s.plan.Analyzed = true
+	s.plan.Rows += rows
+	s.plan.Time += elapsed
+	s.plan.Loops++
+	s.mtx.Unlock()
+}
+
+func explainAttribute(ctx context.Context, label string, value string) {
+	executionStepFromContext(ctx).SetAttribute(label, value)
+}
+
+// explainGoroutines records the largest number of goroutines used in the step being executed.
+func explainGoroutines(ctx context.Context, number int) {
+	step := executionStepFromContext(ctx)
+	if step == nil || step.plan == nil {
+		return
+	}
+
+	step.mtx.Lock()
+	if step.plan.goroutines < number {
+		step.plan.goroutines = number
+		step.plan.setAttribute("Goroutines", strconv.Itoa(number))
+	}
+	step.mtx.Unlock()
+}
+
+// executeStep performs fn as a step of the execution plan.
+// When the query is explained without ANALYZE, fn is not performed.
+func executeStep(ctx context.Context, operation string, describe func(plan *ExecutionPlan), view *View, fn func(ctx context.Context) error) error {
+	ctx, step := beginExecutionStep(ctx, operation, describe)
+	if !step.Executes() {
+		return nil
+	}
+
+	if err := fn(ctx); err != nil {
+		return err
+	}
+	step.Finish(view.RecordLen())
+	return nil
+}
+
+func Explain(ctx context.Context, scope *ReferenceScope, expr parser.Explain, streaming bool) (string, error) {
+	plan, err := ExplainSelectQuery(ctx, scope, expr.Query, expr.IsAnalyze(), streaming)
+	if err != nil {
+		return "", err
+	}
+
+	w := NewObjectWriter(scope.Tx)
+	writeExecutionPlan(w, plan)
+	w.Title1 = "Execution Plan"
+	if expr.IsAnalyze() {
+		w.Title2 = "(Analyzed)"
+	}
+	w.Title1Effect = cmd.IdentifierEffect
+	return "\n" + w.String() + "\n", nil
+}
+
+// ExplainSelectQuery builds the execution plan of a select query by recording the steps processed by Select.
+// If analyze is true, then the query is executed and the number of records and the elapsed time are recorded in each step.
+// Otherwise, the steps are recorded without loading any files.
+// If streaming is true, then a query that can be streamed is explained as it is processed by StreamSelect.
+func ExplainSelectQuery(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery, analyze bool, streaming bool) (*ExecutionPlan, error) {
+	root := &executionStep{
+		plan:    NewExecutionPlan(""),
+		analyze: analyze,
+		mtx:     &sync.Mutex{},
+	}
+	ctx = context.WithValue(ctx, ExecutionPlanContextKey, root)
+
+	var err error
+	streamed := false
+	if streaming {
+		if streamed, err = StreamSelect(ctx, scope, query, ioutil.Discard, scope.Tx.Flags.ExportOptions.Copy()); err == DataEmpty {
+			err = nil
+		}
+	}
+	if !streamed {
+		_, err = Select(ctx, scope, query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return root.plan.Children[0], nil
+}
+
+func describeSelectQuery(query parser.SelectQuery) func(plan *ExecutionPlan) {
+	return func(plan *ExecutionPlan) {
+		if query.IsForUpdate() {
+			plan.AddAttribute("Lock", "FOR UPDATE")
+		}
+	}
+}
+
+func describeTableName(tableName parser.Identifier) func(plan *ExecutionPlan) {
+	return func(plan *ExecutionPlan) {
+		if 0 < len(tableName.Literal) {
+			plan.AddAttribute("Table", tableName.Literal)
+		}
+	}
+}
+
+func describeFilter(clause parser.WhereClause, cpu int) func(plan *ExecutionPlan) {
+	return func(plan *ExecutionPlan) {
+		plan.AddAttribute("Condition", clause.Filter.String())
+		plan.addGoroutines(cpu)
+	}
+}
+
+func describeSelect(clause parser.SelectClause, cpu int) func(plan *ExecutionPlan) {
+	return func(plan *ExecutionPlan) {
+		plan.AddAttribute("Fields", listExpressions(clause.Fields))
+		if clause.IsDistinct() {
+			plan.AddAttribute("Distinct", "true")
+		}
+		plan.addGoroutines(cpu)
+	}
+}
+
+func describeOffset(clause parser.OffsetClause) func(plan *ExecutionPlan) {
+	return func(plan *ExecutionPlan) {
+		plan.AddAttribute("Value", clause.Value.String())
+	}
+}
+
+func describeLimit(clause parser.LimitClause) func(plan *ExecutionPlan) {
+	return func(plan *ExecutionPlan) {
+		s := clause.Value.String()
+		if clause.Percentage() {
+			s = s + " " + clause.Unit.String()
+		}
+		plan.AddAttribute("Value", s)
+		if clause.WithTies() {
+			plan.AddAttribute("With Ties", "true")
+		}
+	}
+}
+
+func describeJoin(join parser.Join, joinType int, lateral bool, cpu int) func(plan *ExecutionPlan) {
+	return func(plan *ExecutionPlan) {
+		typeStr := make([]string, 0, 3)
+		if !join.Natural.IsEmpty() {
+			typeStr = append(typeStr, parser.Token{Token: parser.NATURAL}.String())
+		}
+		if joinType == parser.OUTER {
+			typeStr = append(typeStr, parser.Token{Token: join.Direction.Token}.String())
+		}
+		typeStr = append(typeStr, parser.Token{Token: joinType}.String())
+		plan.AddAttribute("Type", strings.Join(typeStr, " "))
+
+		if lateral {
+			plan.Operation = "Lateral Join"
+		}
+		if join.Condition != nil {
+			plan.AddAttribute("Condition", join.Condition.String())
+		}
+		if !plan.analyze {
+			if lateral {
+				plan.AddAttribute("Strategy", "Nested Loop")
+			} else {
+				strategy := joinStrategy(join, joinType)
+				plan.AddAttribute("Strategy", strategy)
+				if strategy == "Hash Join" {
+					plan.AddAttribute("Build Side", joinBuildSide(join, joinType))
+				}
+			}
+		}
+		if !lateral {
+			plan.addGoroutines(cpu)
+		}
+	}
+}
+
+// plannedFileInfo returns the attributes of the file to be loaded without loading it.
+func plannedFileInfo(scope *ReferenceScope, tableIdentifier parser.Identifier, options cmd.ImportOptions) (*FileInfo, error) {
+	if fpath, ok := scope.LoadFilePath(tableIdentifier.Literal); ok {
+		if view, ok := scope.Tx.cachedViews.Load(fpath); ok {
+			return view.FileInfo, nil
+		}
+	}
+
+	fileInfo, err := NewFileInfo(tableIdentifier, scope.Tx.Flags.Repository, options, scope.Tx.Flags.ImportOptions.Format)
+	if err != nil {
+		return nil, err
+	}
+	if view, ok := scope.Tx.cachedViews.Load(fileInfo.Path); ok {
+		return view.FileInfo, nil
+	}
+
+	fileInfo.DelimiterPositions = options.DelimiterPositions
+	fileInfo.SingleLine = options.SingleLine
+	fileInfo.JsonQuery = cmd.TrimSpace(options.JsonQuery)
+	fileInfo.RowPath = options.RowPath
+	fileInfo.NoHeader = options.NoHeader
+	return fileInfo, nil
+}
+
+func explainFileInfo(step *executionStep, info *FileInfo) {
+	if step == nil {
+		return
+	}
+
+	if 0 < len(info.Path) {
+		step.SetAttribute("Path", info.Path)
+	}
+	step.SetAttribute("Format", info.Format.String())
+	switch info.Format {
+	case cmd.CSV, cmd.TSV:
+		if info.Delimiter == cmd.AutoDelimiter {
+			step.SetAttribute("Delimiter", cmd.DetectDelimiterAutomatically)
+		} else {
+			step.SetAttribute("Delimiter", "'"+cmd.EscapeString(string(info.Delimiter))+"'")
+		}
+	case cmd.FIXED:
+		dp := info.DelimiterPositions.String()
+		if info.SingleLine {
+			dp = "S" + dp
+		}
+		step.SetAttribute("Delimiter Positions", dp)
+	case cmd.JSON, cmd.YAML:
+		step.SetAttribute("JSON Query", info.JsonQuery)
+	case cmd.XML:
+		step.SetAttribute("Row Path", info.RowPath)
+	}
+	step.SetAttribute("Encoding", info.Encoding.String())
+	if info.Compression != file.NoCompression {
+		step.SetAttribute("Compression", info.Compression.String())
+	}
+	switch info.Format {
+	case cmd.CSV, cmd.TSV, cmd.FIXED:
+		step.SetAttribute("Header", strconv.FormatBool(!info.NoHeader))
+	}
+}
+
+// joinStrategy guesses the join strategy from the syntax of the join.
+// Whether the hash join is actually used depends on the fields that the condition refers to.
+func joinStrategy(join parser.Join, joinType int) string {
+	if joinType == parser.CROSS {
+		return "Nested Loop"
+	}
+	if !join.Natural.IsEmpty() {
+		return "Hash Join"
+	}
+	if join.Condition == nil {
+		return "Nested Loop"
+	}
+
+	condition := join.Condition.(parser.JoinCondition)
+	if condition.Using != nil || hasEquiJoinComparison(condition.On) {
+		return "Hash Join"
+	}
+	return "Nested Loop"
+}
+
+// joinBuildSide returns the side from which the hash table of a join is built.
+// The side of an inner join is decided by the numbers of records when the join is executed.
+func joinBuildSide(join parser.Join, joinType int) string {
+	if joinType != parser.OUTER {
+		return "Smaller View"
+	}
+	if join.Direction.Token == parser.RIGHT {
+		return "Left"
+	}
+	return "Right"
+}
+
+func hasEquiJoinComparison(expr parser.QueryExpression) bool {
+	switch expr.(type) {
+	case parser.Parentheses:
+		return hasEquiJoinComparison(expr.(parser.Parentheses).Expr)
+	case parser.Logic:
+		logic := expr.(parser.Logic)
+		if logic.Operator.Token != parser.AND {
+			return false
+		}
+		return hasEquiJoinComparison(logic.LHS) || hasEquiJoinComparison(logic.RHS)
+	case parser.Comparison:
+		comparison := expr.(parser.Comparison)
+		if comparison.Operator.Literal != "=" && comparison.Operator.Literal != "==" {
+			return false
+		}
+		return isFieldReference(comparison.LHS) && isFieldReference(comparison.RHS)
+	}
+	return false
+}
+
+func isFieldReference(expr parser.QueryExpression) bool {
+	switch expr.(type) {
+	case parser.FieldReference, parser.ColumnNumber:
+		return true
+	}
+	return false
+}
+
+func listExpressions(exprs []parser.QueryExpression) string {
+	s := make([]string, len(exprs))
+	for i, v := range exprs {
+		s[i] = v.String()
+	}
+	return strings.Join(s, ", ")
+}
+
+func writeExecutionPlan(w *ObjectWriter, plan *ExecutionPlan) {
+	w.WriteColorWithoutLineBreak(plan.Operation, cmd.ObjectEffect)
+	if plan.Analyzed {
+		w.WriteWithoutLineBreak("  (")
+		w.WriteColorWithoutLineBreak("Rows: ", cmd.LableEffect)
+		w.WriteColorWithoutLineBreak(strconv.Itoa(plan.Rows), cmd.NumberEffect)
+		w.WriteWithoutLineBreak(", ")
+		w.WriteColorWithoutLineBreak("Time: ", cmd.LableEffect)
+		w.WriteColorWithoutLineBreak(cmd.FormatNumber(plan.Time.Seconds(), 6, ".", ",", "")+" seconds", cmd.NumberEffect)
+		if 1 < plan.Loops {
+			w.WriteWithoutLineBreak(", ")
+			w.WriteColorWithoutLineBreak("Loops: ", cmd.LableEffect)
+			w.WriteColorWithoutLineBreak(strconv.Itoa(plan.Loops), cmd.NumberEffect)
+		}
+		w.WriteWithoutLineBreak(")")
+	}
+
+	w.BeginBlock()
+	for _, attr := range plan.Attributes {
+		w.NewLine()
+		w.WriteColorWithoutLineBreak(attr.Label+": ", cmd.LableEffect)
+		w.BeginSubBlock()
+		w.WriteWithAutoLineBreak(attr.Value)
+		w.EndSubBlock()
+	}
+	for _, child := range plan.Children {
+		w.NewLine()
+		writeExecutionPlan(w, child)
+	}
+	w.EndBlock()
+}
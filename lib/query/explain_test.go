@@ -0,0 +1,571 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var explainTests = []struct {
+	Name      string
+	Expr      parser.Explain
+	Streaming bool
+	Result    string
+	Error     string
+}{
+	{
+		Name: "Explain",
+		Expr: parser.Explain{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+					WhereClause: parser.WhereClause{
+						Filter: parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+							RHS:      parser.NewIntegerValueFromString("1"),
+							Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+						},
+					},
+				},
+				LimitClause: parser.LimitClause{
+					Type:  parser.Token{Token: parser.LIMIT, Literal: "limit"},
+					Value: parser.NewIntegerValueFromString("1"),
+				},
+			},
+		},
+		Result: "\n" +
+			strings.Repeat(" ", (calcExplainWidth("table1.csv", 9, 28)-14)/2) + "Execution Plan\n" +
+			strings.Repeat("-", calcExplainWidth("table1.csv", 9, 28)) + "\n" +
+			" Select Query\n" +
+			"     File Scan\n" +
+			"         Table: table1\n" +
+			"         Path: " + GetTestFilePath("table1.csv") + "\n" +
+			"         Format: CSV\n" +
+			"         Delimiter: ','\n" +
+			"         Encoding: AUTO\n" +
+			"         Header: true\n" +
+			"     Filter\n" +
+			"         Condition: column1 > 1\n" +
+			"         Goroutines: up to 2\n" +
+			"     Select\n" +
+			"         Fields: column1\n" +
+			"         Goroutines: up to 2\n" +
+			"     Limit\n" +
+			"         Value: 1\n",
+	},
+	{
+		Name: "Explain Join",
+		Expr: parser.Explain{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.AllColumns{}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Join{
+								Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+								JoinTable: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+								JoinType:  parser.Token{Token: parser.INNER, Literal: "inner"},
+								Condition: parser.JoinCondition{
+									On: parser.Comparison{
+										LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+										RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+										Operator: parser.Token{Token: '=', Literal: "="},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+		Result: "\n" +
+			strings.Repeat(" ", (calcExplainWidth("table2.csv", 13, 54)-14)/2) + "Execution Plan\n" +
+			strings.Repeat("-", calcExplainWidth("table2.csv", 13, 54)) + "\n" +
+			" Select Query\n" +
+			"     Join\n" +
+			"         Type: INNER\n" +
+			"         Condition: ON table1.column1 = table2.column3\n" +
+			"         Strategy: Hash Join\n" +
+			"         Build Side: Smaller View\n" +
+			"         Goroutines: up to 2\n" +
+			"         File Scan\n" +
+			"             Table: table1\n" +
+			"             Path: " + GetTestFilePath("table1.csv") + "\n" +
+			"             Format: CSV\n" +
+			"             Delimiter: ','\n" +
+			"             Encoding: AUTO\n" +
+			"             Header: true\n" +
+			"         File Scan\n" +
+			"             Table: table2\n" +
+			"             Path: " + GetTestFilePath("table2.csv") + "\n" +
+			"             Format: CSV\n" +
+			"             Delimiter: ','\n" +
+			"             Encoding: AUTO\n" +
+			"             Header: true\n" +
+			"     Select\n" +
+			"         Fields: *\n" +
+			"         Goroutines: up to 2\n",
+	},
+	{
+		Name: "Explain Streaming",
+		Expr: parser.Explain{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+				},
+				LimitClause: parser.LimitClause{
+					Type:  parser.Token{Token: parser.LIMIT, Literal: "limit"},
+					Value: parser.NewIntegerValueFromString("1"),
+				},
+			},
+		},
+		Streaming: true,
+		Result: "\n" +
+			strings.Repeat(" ", (calcExplainWidth("table1.csv", 9, 28)-14)/2) + "Execution Plan\n" +
+			strings.Repeat("-", calcExplainWidth("table1.csv", 9, 28)) + "\n" +
+			" Select Query\n" +
+			"     Streaming: true\n" +
+			"     File Scan\n" +
+			"         Table: table1\n" +
+			"         Path: " + GetTestFilePath("table1.csv") + "\n" +
+			"         Format: CSV\n" +
+			"         Delimiter: ','\n" +
+			"         Encoding: AUTO\n" +
+			"         Header: true\n" +
+			"     Limit\n" +
+			"         Value: 1\n" +
+			"     Select\n" +
+			"         Fields: column1\n" +
+			"         Goroutines: up to 2\n",
+	},
+	{
+		Name: "Explain Inline Table",
+		Expr: parser.Explain{
+			Query: parser.SelectQuery{
+				WithClause: parser.WithClause{
+					InlineTables: []parser.QueryExpression{
+						parser.InlineTable{
+							Name: parser.Identifier{Literal: "it"},
+							Query: parser.SelectQuery{
+								SelectEntity: parser.SelectEntity{
+									SelectClause: parser.SelectClause{
+										Fields: []parser.QueryExpression{
+											parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+										},
+									},
+									FromClause: parser.FromClause{
+										Tables: []parser.QueryExpression{
+											parser.Table{Object: parser.Identifier{Literal: "table1"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.AllColumns{}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "it"}},
+						},
+					},
+				},
+			},
+		},
+		Result: "\n" +
+			strings.Repeat(" ", (calcExplainWidth("table1.csv", 17, 40)-14)/2) + "Execution Plan\n" +
+			strings.Repeat("-", calcExplainWidth("table1.csv", 17, 40)) + "\n" +
+			" Select Query\n" +
+			"     Inline Table\n" +
+			"         Name: it\n" +
+			"         Select Query\n" +
+			"             File Scan\n" +
+			"                 Table: table1\n" +
+			"                 Path: " + GetTestFilePath("table1.csv") + "\n" +
+			"                 Format: CSV\n" +
+			"                 Delimiter: ','\n" +
+			"                 Encoding: AUTO\n" +
+			"                 Header: true\n" +
+			"             Select\n" +
+			"                 Fields: column1\n" +
+			"                 Goroutines: up to 2\n" +
+			"     Inline Table Scan\n" +
+			"         Table: it\n" +
+			"     Select\n" +
+			"         Fields: *\n" +
+			"         Goroutines: up to 2\n",
+	},
+	{
+		Name: "Explain File Does Not Exist Error",
+		Expr: parser.Explain{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.AllColumns{}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "notexist"}},
+						},
+					},
+				},
+			},
+		},
+		Error: "file notexist does not exist",
+	},
+}
+
+func TestExplain(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	TestTx.Flags.CPU = 2
+	ctx := context.Background()
+
+	for _, v := range explainTests {
+		_ = TestTx.ReleaseResources()
+		TestTx.Flags.ExportOptions.Format = cmd.TEXT
+		if v.Streaming {
+			TestTx.Flags.ExportOptions.Format = cmd.CSV
+		}
+
+		result, err := Explain(ctx, NewReferenceScope(TestTx), v.Expr, v.Streaming)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, v.Result)
+		}
+	}
+}
+
+var explainSelectQueryAnalyzeTests = []struct {
+	Name       string
+	Query      parser.SelectQuery
+	Streaming  bool
+	Operations []string
+	Rows       []int
+	Loops      []int
+}{
+	{
+		Name: "ExplainSelectQuery Analyze",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Join{
+							Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+							JoinTable: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+							JoinType:  parser.Token{Token: parser.CROSS, Literal: "cross"},
+						}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+					},
+				},
+			},
+			LimitClause: parser.LimitClause{
+				Type:  parser.Token{Token: parser.LIMIT, Literal: "limit"},
+				Value: parser.NewIntegerValueFromString("4"),
+			},
+		},
+		Operations: []string{"Select Query", "Join", "File Scan", "File Scan", "Filter", "Select", "Limit"},
+		Rows:       []int{4, 9, 3, 3, 6, 6, 4},
+	},
+	{
+		Name: "ExplainSelectQuery Analyze Set Operation",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectSet{
+				LHS: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+				},
+				Operator: parser.Token{Token: parser.UNION, Literal: "union"},
+				RHS: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table2"}},
+						},
+					},
+				},
+			},
+		},
+		Operations: []string{"Select Query", "Set Operation", "Select Entity", "File Scan", "Select", "Select Entity", "File Scan", "Select"},
+		Rows:       []int{4, 4, 3, 3, 3, 3, 3, 3},
+	},
+	{
+		Name: "ExplainSelectQuery Analyze Subquery",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.In{
+						LHS: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						Values: parser.Subquery{
+							Query: parser.SelectQuery{
+								SelectEntity: parser.SelectEntity{
+									SelectClause: parser.SelectClause{
+										Fields: []parser.QueryExpression{
+											parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}},
+										},
+									},
+									FromClause: parser.FromClause{
+										Tables: []parser.QueryExpression{
+											parser.Table{Object: parser.Identifier{Literal: "table2"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Operations: []string{"Select Query", "File Scan", "Filter", "Select Query", "File Scan", "Select", "Select"},
+		Rows:       []int{2, 3, 2, 9, 3, 3, 2},
+		Loops:      []int{1, 1, 1, 3, 1, 1, 1},
+	},
+	{
+		Name: "ExplainSelectQuery Analyze Streaming",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+					},
+				},
+			},
+			LimitClause: parser.LimitClause{
+				Type:  parser.Token{Token: parser.LIMIT, Literal: "limit"},
+				Value: parser.NewIntegerValueFromString("1"),
+			},
+		},
+		Streaming:  true,
+		Operations: []string{"Select Query", "File Scan", "Filter", "Limit", "Select"},
+		Rows:       []int{1, 3, 2, 1, 1},
+	},
+}
+
+func TestExplainSelectQuery_Analyze(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+
+	var flatten func(plan *ExecutionPlan, operations []string, rows []int, loops []int) ([]string, []int, []int)
+	flatten = func(plan *ExecutionPlan, operations []string, rows []int, loops []int) ([]string, []int, []int) {
+		operations = append(operations, plan.Operation)
+		rows = append(rows, plan.Rows)
+		loops = append(loops, plan.Loops)
+		for _, child := range plan.Children {
+			operations, rows, loops = flatten(child, operations, rows, loops)
+		}
+		return operations, rows, loops
+	}
+
+	for _, v := range explainSelectQueryAnalyzeTests {
+		_ = TestTx.ReleaseResources()
+		TestTx.Flags.ExportOptions.Format = cmd.TEXT
+		if v.Streaming {
+			TestTx.Flags.ExportOptions.Format = cmd.CSV
+		}
+
+		plan, err := ExplainSelectQuery(ctx, NewReferenceScope(TestTx), v.Query, true, v.Streaming)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		operations, rows, loops := flatten(plan, nil, nil, nil)
+		if !reflect.DeepEqual(operations, v.Operations) {
+			t.Errorf("%s: operations = %q, want %q", v.Name, operations, v.Operations)
+		}
+		if !reflect.DeepEqual(rows, v.Rows) {
+			t.Errorf("%s: rows = %v, want %v", v.Name, rows, v.Rows)
+		}
+		if v.Loops != nil && !reflect.DeepEqual(loops, v.Loops) {
+			t.Errorf("%s: loops = %v, want %v", v.Name, loops, v.Loops)
+		}
+	}
+}
+
+func TestExplainSelectQuery_JoinAttributes(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	TestTx.Flags.ExportOptions.Format = cmd.TEXT
+	ctx := context.Background()
+
+	query := parser.SelectQuery{
+		SelectEntity: parser.SelectEntity{
+			SelectClause: parser.SelectClause{
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.AllColumns{}},
+				},
+			},
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Join{
+						Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						JoinTable: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+						JoinType:  parser.Token{Token: parser.OUTER, Literal: "outer"},
+						Direction: parser.Token{Token: parser.LEFT, Literal: "left"},
+						Condition: parser.JoinCondition{
+							On: parser.Comparison{
+								LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+								RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+								Operator: parser.Token{Token: '=', Literal: "="},
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	joinAttributes := func(analyze bool) []ExecutionPlanAttribute {
+		_ = TestTx.ReleaseResources()
+		plan, err := ExplainSelectQuery(ctx, NewReferenceScope(TestTx), query, analyze, false)
+		if err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+
+		var attrs []ExecutionPlanAttribute
+		for _, child := range plan.Children {
+			if child.Operation != "Join" {
+				continue
+			}
+			for _, attr := range child.Attributes {
+				// The number of goroutines is estimated without ANALYZE.
+				if attr.Label == "Goroutines" {
+					attr.Value = ""
+				}
+				attrs = append(attrs, attr)
+			}
+		}
+		return attrs
+	}
+
+	expect := []ExecutionPlanAttribute{
+		{Label: "Type", Value: "LEFT OUTER"},
+		{Label: "Condition", Value: "ON table1.column1 = table2.column3"},
+		{Label: "Strategy", Value: "Hash Join"},
+		{Label: "Build Side", Value: "Right"},
+		{Label: "Goroutines", Value: ""},
+	}
+
+	planned := joinAttributes(false)
+	if !reflect.DeepEqual(planned, expect) {
+		t.Errorf("attributes without analyze = %v, want %v", planned, expect)
+	}
+	analyzed := joinAttributes(true)
+	if !reflect.DeepEqual(analyzed, expect) {
+		t.Errorf("attributes with analyze = %v, want %v", analyzed, expect)
+	}
+}
+
+func calcExplainWidth(fileName string, pathIndent int, w int) int {
+	pathLen := pathIndent + 6 + len(GetTestFilePath(fileName))
+	if w < pathLen {
+		w = pathLen
+	}
+	w++
+	if 75 < w {
+		w = 75
+	}
+	return w
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/dsv"
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 
@@ -25,6 +26,10 @@ const (
 	TableLineBreak          = "LINE_BREAK"
 	TableHeader             = "HEADER"
 	TableEncloseAll         = "ENCLOSE_ALL"
+	TableQuoteChar          = "QUOTE_CHAR"
+	TableEscapeStyle        = "ESCAPE_STYLE"
+	TableCommentPrefix      = "COMMENT_PREFIX"
+	TableTrimSpace          = "TRIM_SPACE"
 	TableJsonEscape         = "JSON_ESCAPE"
 	TablePrettyPrint        = "PRETTY_PRINT"
 )
@@ -45,6 +50,10 @@ var FileAttributeList = []string{
 	TableLineBreak,
 	TableHeader,
 	TableEncloseAll,
+	TableQuoteChar,
+	TableEscapeStyle,
+	TableCommentPrefix,
+	TableTrimSpace,
 	TableJsonEscape,
 	TablePrettyPrint,
 }
@@ -72,15 +81,42 @@ type FileInfo struct {
 	Delimiter          rune
 	DelimiterPositions fixedlen.DelimiterPositions
 	JsonQuery          string
+	SheetName          string
+	RowPath            string
+	ColumnTypes        []cmd.ColumnTypeHint
 	Encoding           text.Encoding
 	LineBreak          text.LineBreak
 	NoHeader           bool
 	EncloseAll         bool
 	JsonEscape         json.EscapeType
 	PrettyPrint        bool
+	Compression        file.Compression
 
 	SingleLine bool
 
+	// QuoteChar is the quote character of CSV. Zero means the double quotation mark.
+	QuoteChar     rune
+	EscapeStyle   cmd.EscapeStyle
+	CommentPrefix string
+	SkipLines     int
+	TrimSpace     bool
+
+	// LeadingLines holds the lines skipped at the beginning of the file.
+	// They are written back before the header when the file is updated.
+	LeadingLines []string
+
+	// OnParseError is the way to handle the records that cannot be parsed in CSV, TSV and JSON Lines.
+	OnParseError cmd.ParseErrorMode
+	// MalformedRecords holds the records dropped while loading the file until they are reported.
+	MalformedRecords []MalformedRecord
+
+	// Columns holds the names of the columns requested when the file was loaded.
+	// Nil means that all of the columns have been loaded.
+	Columns []string
+
+	// Schema holds the column types declared when the table was created.
+	Schema TableSchema
+
 	Handler *file.Handler
 
 	ForUpdate bool
@@ -88,6 +124,7 @@ type FileInfo struct {
 
 	restorePointHeader    Header
 	restorePointRecordSet RecordSet
+	restorePointSchema    TableSchema
 }
 
 func NewFileInfo(
@@ -106,18 +143,42 @@ func NewFileInfo(
 	switch format {
 	case cmd.TSV:
 		delimiter = '\t'
-	case cmd.JSON:
+	case cmd.JSON, cmd.JSONL, cmd.PARQUET, cmd.XLSX, cmd.YAML, cmd.XML:
 		encoding = text.UTF8
 	}
 
 	return &FileInfo{
-		Path:      fpath,
-		Format:    format,
-		Delimiter: delimiter,
-		Encoding:  encoding,
+		Path:          fpath,
+		Format:        format,
+		Delimiter:     delimiter,
+		Encoding:      encoding,
+		QuoteChar:     normalizeQuoteChar(options.QuoteChar),
+		EscapeStyle:   options.EscapeStyle,
+		CommentPrefix: options.CommentPrefix,
+		SkipLines:     options.SkipLines,
+		TrimSpace:     options.TrimSpace,
+		OnParseError:  options.OnParseError,
+		Compression:   file.CompressionFromExt(fpath),
 	}, nil
 }
 
+// HasColumns reports whether all of the columns that the names specify have been requested
+// when the file was loaded. Nil names means all of the columns.
+func (f *FileInfo) HasColumns(names []string) bool {
+	if f.Columns == nil {
+		return true
+	}
+	if names == nil {
+		return false
+	}
+	for _, name := range names {
+		if !InStrSliceWithCaseInsensitive(name, f.Columns) {
+			return false
+		}
+	}
+	return true
+}
+
 func (f *FileInfo) SetDelimiter(s string) error {
 	delimiter, err := cmd.ParseDelimiter(s)
 	if err != nil {
@@ -178,7 +239,7 @@ func (f *FileInfo) SetFormat(s string) error {
 	switch format {
 	case cmd.TSV:
 		delimiter = '\t'
-	case cmd.JSON:
+	case cmd.JSON, cmd.JSONL, cmd.XLSX, cmd.YAML, cmd.XML:
 		encoding = text.UTF8
 	}
 
@@ -196,10 +257,18 @@ func (f *FileInfo) SetEncoding(s string) error {
 	}
 
 	switch f.Format {
-	case cmd.JSON:
+	case cmd.JSON, cmd.JSONL:
 		if encoding != text.UTF8 {
 			return errors.New("json format is supported only UTF8")
 		}
+	case cmd.YAML:
+		if encoding != text.UTF8 {
+			return errors.New("yaml format is supported only UTF8")
+		}
+	case cmd.XML:
+		if encoding != text.UTF8 {
+			return errors.New("xml format is supported only UTF8")
+		}
 	}
 
 	if f.Encoding == encoding {
@@ -240,6 +309,70 @@ func (f *FileInfo) SetEncloseAll(b bool) error {
 	return nil
 }
 
+func (f *FileInfo) SetQuoteChar(s string) error {
+	quote, err := cmd.ParseQuoteChar(s)
+	if err != nil {
+		return err
+	}
+
+	quote = normalizeQuoteChar(quote)
+	if quote == f.QuoteChar {
+		return NewTableAttributeUnchangedError(f.Path)
+	}
+
+	f.QuoteChar = quote
+	return nil
+}
+
+func (f *FileInfo) SetEscapeStyle(s string) error {
+	style, err := cmd.ParseEscapeStyle(s)
+	if err != nil {
+		return err
+	}
+
+	if style == f.EscapeStyle {
+		return NewTableAttributeUnchangedError(f.Path)
+	}
+
+	f.EscapeStyle = style
+	return nil
+}
+
+func (f *FileInfo) SetCommentPrefix(s string) error {
+	if s == f.CommentPrefix {
+		return NewTableAttributeUnchangedError(f.Path)
+	}
+	f.CommentPrefix = s
+	return nil
+}
+
+func (f *FileInfo) SetTrimSpace(b bool) error {
+	if b == f.TrimSpace {
+		return NewTableAttributeUnchangedError(f.Path)
+	}
+	f.TrimSpace = b
+	return nil
+}
+
+func (f *FileInfo) quoteChar() rune {
+	if f.QuoteChar == 0 {
+		return '"'
+	}
+	return f.QuoteChar
+}
+
+func normalizeQuoteChar(quote rune) rune {
+	if quote == '"' {
+		return 0
+	}
+	return quote
+}
+
+// Dialect returns the dialect with which the CSV file is read and written.
+func (f *FileInfo) Dialect() dsv.Dialect {
+	return newDSVDialect(f.Delimiter, f.QuoteChar, f.EscapeStyle, f.CommentPrefix, f.TrimSpace)
+}
+
 func (f *FileInfo) SetJsonEscape(s string) error {
 	escape, err := cmd.ParseJsonEscapeType(s)
 	if err != nil {
@@ -266,6 +399,34 @@ func (f *FileInfo) IsFile() bool {
 	return f.ViewType == ViewTypeFile
 }
 
+// IsLoadedWith reports whether the file has been loaded with the options
+// that select a part of the file, such as a worksheet or elements.
+func (f *FileInfo) IsLoadedWith(options cmd.ImportOptions) bool {
+	return f.SheetName == options.SheetName && f.RowPath == options.RowPath &&
+		cmd.FormatColumnTypes(f.ColumnTypes) == cmd.FormatColumnTypes(options.ColumnTypes)
+}
+
+// IsAppendable reports whether records can be added to the file by writing them after the existing data.
+func (f *FileInfo) IsAppendable() bool {
+	switch f.Format {
+	case cmd.CSV, cmd.TSV, cmd.LTSV, cmd.JSONL:
+	case cmd.FIXED:
+		if f.DelimiterPositions == nil || f.SingleLine {
+			return false
+		}
+	default:
+		return false
+	}
+
+	switch f.Encoding {
+	case text.UTF8, text.SJIS:
+	default:
+		return false
+	}
+
+	return f.Compression == file.NoCompression && f.Columns == nil
+}
+
 func (f *FileInfo) IsTemporaryTable() bool {
 	return f.ViewType == ViewTypeTemporaryTable
 }
@@ -284,6 +445,11 @@ func (f *FileInfo) ExportOptions(tx *Transaction) cmd.ExportOptions {
 	ops.LineBreak = f.LineBreak
 	ops.WithoutHeader = f.NoHeader
 	ops.EncloseAll = f.EncloseAll
+	ops.QuoteChar = f.quoteChar()
+	ops.EscapeStyle = f.EscapeStyle
+	ops.CommentPrefix = f.CommentPrefix
+	ops.TrimSpace = f.TrimSpace
+	ops.LeadingLines = f.LeadingLines
 	ops.JsonEscape = f.JsonEscape
 	ops.PrettyPrint = f.PrettyPrint
 	return ops
@@ -300,21 +466,41 @@ func SearchFilePath(filename parser.Identifier, repository string, options cmd.I
 		fpath, err = SearchCSVFilePath(filename, repository)
 	case cmd.JSON:
 		fpath, err = SearchJsonFilePath(filename, repository)
+	case cmd.JSONL:
+		fpath, err = SearchJsonlFilePath(filename, repository)
 	case cmd.FIXED:
 		fpath, err = SearchFixedLengthFilePath(filename, repository)
 	case cmd.LTSV:
 		fpath, err = SearchLTSVFilePath(filename, repository)
+	case cmd.PARQUET:
+		fpath, err = SearchParquetFilePath(filename, repository)
+	case cmd.XLSX:
+		fpath, err = SearchXlsxFilePath(filename, repository)
+	case cmd.YAML:
+		fpath, err = SearchYamlFilePath(filename, repository)
+	case cmd.XML:
+		fpath, err = SearchXmlFilePath(filename, repository)
 	default: // AutoSelect
 		if fpath, err = SearchFilePathFromAllTypes(filename, repository); err == nil {
-			switch strings.ToLower(filepath.Ext(fpath)) {
+			switch strings.ToLower(filepath.Ext(file.TrimCompressionExt(fpath))) {
 			case cmd.CsvExt:
 				format = cmd.CSV
 			case cmd.TsvExt:
 				format = cmd.TSV
 			case cmd.JsonExt:
 				format = cmd.JSON
+			case cmd.JsonlExt, cmd.NdjsonExt:
+				format = cmd.JSONL
 			case cmd.LtsvExt:
 				format = cmd.LTSV
+			case cmd.ParquetExt:
+				format = cmd.PARQUET
+			case cmd.XlsxExt:
+				format = cmd.XLSX
+			case cmd.YamlExt, cmd.YmlExt:
+				format = cmd.YAML
+			case cmd.XmlExt:
+				format = cmd.XML
 			default:
 				format = defaultFormat
 			}
@@ -332,6 +518,10 @@ func SearchJsonFilePath(filename parser.Identifier, repository string) (string,
 	return SearchFilePathWithExtType(filename, repository, []string{cmd.JsonExt})
 }
 
+func SearchJsonlFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.JsonlExt, cmd.NdjsonExt})
+}
+
 func SearchFixedLengthFilePath(filename parser.Identifier, repository string) (string, error) {
 	return SearchFilePathWithExtType(filename, repository, []string{cmd.TextExt})
 }
@@ -340,8 +530,24 @@ func SearchLTSVFilePath(filename parser.Identifier, repository string) (string,
 	return SearchFilePathWithExtType(filename, repository, []string{cmd.LtsvExt, cmd.TextExt})
 }
 
+func SearchParquetFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.ParquetExt})
+}
+
+func SearchXlsxFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.XlsxExt})
+}
+
+func SearchYamlFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.YamlExt, cmd.YmlExt})
+}
+
+func SearchXmlFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.XmlExt})
+}
+
 func SearchFilePathFromAllTypes(filename parser.Identifier, repository string) (string, error) {
-	return SearchFilePathWithExtType(filename, repository, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.LtsvExt, cmd.TextExt})
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.JsonlExt, cmd.NdjsonExt, cmd.LtsvExt, cmd.ParquetExt, cmd.XlsxExt, cmd.YamlExt, cmd.YmlExt, cmd.XmlExt, cmd.TextExt})
 }
 
 func SearchFilePathWithExtType(filename parser.Identifier, repository string, extTypes []string) (string, error) {
@@ -365,6 +571,16 @@ func SearchFilePathWithExtType(filename parser.Identifier, repository string, ex
 				infoList = append(infoList, i)
 			}
 		}
+		if len(pathes) < 1 {
+			for _, ext := range append([]string{""}, extTypes...) {
+				for _, compressionExt := range file.CompressionExtList {
+					if i, err := os.Stat(fpath + ext + compressionExt); err == nil {
+						pathes = append(pathes, fpath+ext+compressionExt)
+						infoList = append(infoList, i)
+					}
+				}
+			}
+		}
 		switch {
 		case len(pathes) < 1:
 			return fpath, NewFileNotExistError(filename)
@@ -387,6 +603,56 @@ func SearchFilePathWithExtType(filename parser.Identifier, repository string, ex
 	return fpath, nil
 }
 
+// IsGlobPattern reports whether the file name is a glob pattern to match files.
+// A file name is not treated as a pattern if a file exists at the path.
+func IsGlobPattern(filename parser.Identifier, repository string) bool {
+	if !strings.ContainsAny(filename.Literal, "*?[") {
+		return false
+	}
+
+	fpath, err := CreateFilePath(filename, repository)
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(fpath)
+	return err != nil
+}
+
+// IsDirectoryPath reports whether the file name represents a directory.
+func IsDirectoryPath(filename parser.Identifier, repository string) bool {
+	fpath, err := CreateFilePath(filename, repository)
+	if err != nil {
+		return false
+	}
+	info, err := os.Stat(fpath)
+	return err == nil && info.IsDir()
+}
+
+// SearchFilePathsWithGlob returns the absolute paths of the files that match the pattern in lexical order.
+// Directories are not included.
+func SearchFilePathsWithGlob(pattern parser.Identifier, repository string) ([]string, error) {
+	fpath, err := CreateFilePath(pattern, repository)
+	if err != nil {
+		return nil, NewIOError(pattern, err.Error())
+	}
+
+	matches, err := filepath.Glob(fpath)
+	if err != nil {
+		return nil, NewInvalidPathError(pattern, pattern.Literal, err.Error())
+	}
+
+	pathes := make([]string, 0, len(matches))
+	for _, m := range matches {
+		if info, err := os.Stat(m); err == nil && !info.IsDir() {
+			pathes = append(pathes, m)
+		}
+	}
+	if len(pathes) < 1 {
+		return nil, NewFileNotExistError(pattern)
+	}
+	return pathes, nil
+}
+
 func NewFileInfoForCreate(filename parser.Identifier, repository string, delimiter rune, encoding text.Encoding) (*FileInfo, error) {
 	fpath, err := CreateFilePath(filename, repository)
 	if err != nil {
@@ -394,15 +660,27 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 	}
 
 	var format cmd.Format
-	switch strings.ToLower(filepath.Ext(fpath)) {
+	switch strings.ToLower(filepath.Ext(file.TrimCompressionExt(fpath))) {
 	case cmd.TsvExt:
 		delimiter = '\t'
 		format = cmd.TSV
 	case cmd.JsonExt:
 		encoding = text.UTF8
 		format = cmd.JSON
+	case cmd.JsonlExt, cmd.NdjsonExt:
+		encoding = text.UTF8
+		format = cmd.JSONL
 	case cmd.LtsvExt:
 		format = cmd.LTSV
+	case cmd.XlsxExt:
+		encoding = text.UTF8
+		format = cmd.XLSX
+	case cmd.YamlExt, cmd.YmlExt:
+		encoding = text.UTF8
+		format = cmd.YAML
+	case cmd.XmlExt:
+		encoding = text.UTF8
+		format = cmd.XML
 	case cmd.GfmExt:
 		format = cmd.GFM
 	case cmd.OrgExt:
@@ -412,10 +690,11 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 	}
 
 	return &FileInfo{
-		Path:      fpath,
-		Delimiter: delimiter,
-		Format:    format,
-		Encoding:  encoding,
+		Path:        fpath,
+		Delimiter:   delimiter,
+		Format:      format,
+		Encoding:    encoding,
+		Compression: file.CompressionFromExt(fpath),
 	}, nil
 }
 
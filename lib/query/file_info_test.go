@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 
 	"github.com/mithrandie/go-text"
@@ -50,6 +51,21 @@ var fileInfoTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:       "Compressed CSV with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table1_gz"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.UTF8,
+		Result: &FileInfo{
+			Path:        "table1_gz.csv.gz",
+			Delimiter:   ',',
+			Format:      cmd.CSV,
+			Encoding:    text.UTF8,
+			Compression: file.Gzip,
+		},
+	},
 	{
 		Name:       "TSV",
 		FilePath:   parser.Identifier{Literal: "table3"},
@@ -106,6 +122,48 @@ var fileInfoTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:       "JSONL with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table7"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.SJIS,
+		Result: &FileInfo{
+			Path:      "table7.jsonl",
+			Delimiter: ',',
+			Format:    cmd.JSONL,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "YAML with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table10"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.SJIS,
+		Result: &FileInfo{
+			Path:      "table10.yaml",
+			Delimiter: ',',
+			Format:    cmd.YAML,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "XML with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table11"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.SJIS,
+		Result: &FileInfo{
+			Path:      "table11.xml",
+			Delimiter: ',',
+			Format:    cmd.XML,
+			Encoding:  text.UTF8,
+		},
+	},
 	{
 		Name:       "LTSV",
 		FilePath:   parser.Identifier{Literal: "table6"},
@@ -222,6 +280,9 @@ func TestNewFileInfo(t *testing.T) {
 		if fileInfo.Format != v.Result.Format {
 			t.Errorf("%s: FileInfo.Format = %s, want %s", v.Name, fileInfo.Format, v.Result.Format)
 		}
+		if fileInfo.Compression != v.Result.Compression {
+			t.Errorf("%s: FileInfo.Compression = %s, want %s", v.Name, fileInfo.Compression, v.Result.Compression)
+		}
 	}
 }
 
@@ -270,6 +331,42 @@ var fileInfoForCreateTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:      "JSONL",
+		FilePath:  parser.Identifier{Literal: "table1.ndjson"},
+		Delimiter: ',',
+		Encoding:  text.SJIS,
+		Result: &FileInfo{
+			Path:      "table1.ndjson",
+			Delimiter: ',',
+			Format:    cmd.JSONL,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:      "YAML",
+		FilePath:  parser.Identifier{Literal: "table1.yml"},
+		Delimiter: ',',
+		Encoding:  text.SJIS,
+		Result: &FileInfo{
+			Path:      "table1.yml",
+			Delimiter: ',',
+			Format:    cmd.YAML,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:      "XML",
+		FilePath:  parser.Identifier{Literal: "table1.xml"},
+		Delimiter: ',',
+		Encoding:  text.SJIS,
+		Result: &FileInfo{
+			Path:      "table1.xml",
+			Delimiter: ',',
+			Format:    cmd.XML,
+			Encoding:  text.UTF8,
+		},
+	},
 	{
 		Name:      "LTSV",
 		FilePath:  parser.Identifier{Literal: "table1.ltsv"},
@@ -282,6 +379,19 @@ var fileInfoForCreateTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:      "Compressed TSV",
+		FilePath:  parser.Identifier{Literal: "table1.tsv.zst"},
+		Delimiter: ',',
+		Encoding:  text.UTF8,
+		Result: &FileInfo{
+			Path:        "table1.tsv.zst",
+			Delimiter:   '\t',
+			Format:      cmd.TSV,
+			Encoding:    text.UTF8,
+			Compression: file.Zstd,
+		},
+	},
 	{
 		Name:      "GFM",
 		FilePath:  parser.Identifier{Literal: "table1.md"},
@@ -340,5 +450,8 @@ func TestNewFileInfoForCreate(t *testing.T) {
 		if fileInfo.Format != v.Result.Format {
 			t.Errorf("%s: FileInfo.Format = %s, want %s", v.Name, fileInfo.Format, v.Result.Format)
 		}
+		if fileInfo.Compression != v.Result.Compression {
+			t.Errorf("%s: FileInfo.Compression = %s, want %s", v.Name, fileInfo.Compression, v.Result.Compression)
+		}
 	}
 }
@@ -12,6 +12,7 @@ import (
 	"hash"
 	"math"
 	"os/exec"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -24,6 +25,7 @@ import (
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/go-text"
+	txjson "github.com/mithrandie/go-text/json"
 	"github.com/mithrandie/ternary"
 )
 
@@ -83,6 +85,12 @@ var Functions = map[string]BuiltInFunction{
 	"INSTR":            Instr,
 	"LIST_ELEM":        ListElem,
 	"REPLACE":          ReplaceFn,
+	"REGEXP_MATCH":     RegExpMatch,
+	"REGEXP_FIND":      RegExpFind,
+	"REGEXP_SUBMATCH":  RegExpSubmatch,
+	"REGEXP_FIND_ALL":  RegExpFindAll,
+	"REGEXP_REPLACE":   RegExpReplace,
+	"REGEXP_SPLIT":     RegExpSplit,
 	"FORMAT":           Format,
 	"JSON_VALUE":       JsonValue,
 	"MD5":              Md5,
@@ -1070,6 +1078,120 @@ func ReplaceFn(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Pr
 	return value.NewString(r), nil
 }
 
+func regExpFunctionArgs(fn parser.Function, args []value.Primary, requiredLen int) (string, *regexp.Regexp, bool, error) {
+	if len(args) < requiredLen || requiredLen+1 < len(args) {
+		return "", nil, false, NewFunctionArgumentLengthError(fn, fn.Name, []int{requiredLen, requiredLen + 1})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return "", nil, false, nil
+	}
+	str := s.(*value.String).Raw()
+	value.Discard(s)
+
+	p := value.ToString(args[1])
+	if value.IsNull(p) {
+		return "", nil, false, nil
+	}
+	pattern := p.(*value.String).Raw()
+	value.Discard(p)
+
+	flags := ""
+	if requiredLen < len(args) {
+		f := value.ToString(args[requiredLen])
+		if !value.IsNull(f) {
+			flags = f.(*value.String).Raw()
+			value.Discard(f)
+		}
+	}
+
+	re, err := regExpCache.Get(pattern, flags)
+	if err != nil {
+		return "", nil, false, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+	}
+	return str, re, true, nil
+}
+
+func regExpResultArray(list []string) value.Primary {
+	array := make(txjson.Array, 0, len(list))
+	for _, v := range list {
+		array = append(array, txjson.String(v))
+	}
+	return value.NewString(array.Encode())
+}
+
+func RegExpMatch(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	str, re, ok, err := regExpFunctionArgs(fn, args, 2)
+	if err != nil || !ok {
+		return value.NewTernary(ternary.UNKNOWN), err
+	}
+	return value.NewTernary(ternary.ConvertFromBool(re.MatchString(str))), nil
+}
+
+func RegExpFind(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	str, re, ok, err := regExpFunctionArgs(fn, args, 2)
+	if err != nil || !ok {
+		return value.NewNull(), err
+	}
+
+	loc := re.FindStringIndex(str)
+	if loc == nil {
+		return value.NewNull(), nil
+	}
+	return value.NewString(str[loc[0]:loc[1]]), nil
+}
+
+func RegExpSubmatch(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	str, re, ok, err := regExpFunctionArgs(fn, args, 2)
+	if err != nil || !ok {
+		return value.NewNull(), err
+	}
+
+	list := re.FindStringSubmatch(str)
+	if list == nil {
+		return value.NewNull(), nil
+	}
+	return regExpResultArray(list), nil
+}
+
+func RegExpFindAll(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	str, re, ok, err := regExpFunctionArgs(fn, args, 2)
+	if err != nil || !ok {
+		return value.NewNull(), err
+	}
+
+	list := re.FindAllString(str, -1)
+	if list == nil {
+		return value.NewNull(), nil
+	}
+	return regExpResultArray(list), nil
+}
+
+func RegExpReplace(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	str, re, ok, err := regExpFunctionArgs(fn, args, 3)
+	if err != nil || !ok {
+		return value.NewNull(), err
+	}
+
+	r := value.ToString(args[2])
+	if value.IsNull(r) {
+		return value.NewNull(), nil
+	}
+	replacement := r.(*value.String).Raw()
+	value.Discard(r)
+
+	return value.NewString(re.ReplaceAllString(str, replacement)), nil
+}
+
+func RegExpSplit(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	str, re, ok, err := regExpFunctionArgs(fn, args, 2)
+	if err != nil || !ok {
+		return value.NewNull(), err
+	}
+	return regExpResultArray(re.Split(str, -1)), nil
+}
+
 func Format(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	if len(args) < 1 {
 		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least 1 argument")
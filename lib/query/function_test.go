@@ -1995,6 +1995,273 @@ func TestReplaceFn(t *testing.T) {
 	testFunction(t, ReplaceFn, replaceFnTests)
 }
 
+var regExpMatchTests = []functionTest{
+	{
+		Name: "RegExpMatch",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc123"),
+			value.NewString("^[a-z]+\\d+$"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "RegExpMatch Not Matched",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("ABC123"),
+			value.NewString("^[a-z]+\\d+$"),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "RegExpMatch with Flags",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("ABC123"),
+			value.NewString("^[a-z]+\\d+$"),
+			value.NewString("i"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "RegExpMatch String is Null",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("^[a-z]+\\d+$"),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "RegExpMatch Invalid Pattern Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc123"),
+			value.NewString("(abc"),
+		},
+		Error: "invalid regular expression: missing closing ): `(abc` for function regexp_match",
+	},
+	{
+		Name: "RegExpMatch Invalid Flag Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc123"),
+			value.NewString("abc"),
+			value.NewString("ix"),
+		},
+		Error: "invalid regular expression flag \"x\" for function regexp_match",
+	},
+	{
+		Name: "RegExpMatch Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc123"),
+		},
+		Error: "function regexp_match takes 2 or 3 arguments",
+	},
+}
+
+func TestRegExpMatch(t *testing.T) {
+	testFunction(t, RegExpMatch, regExpMatchTests)
+}
+
+var regExpFindTests = []functionTest{
+	{
+		Name: "RegExpFind",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewString("abc123def456"),
+			value.NewString("\\d+"),
+		},
+		Result: value.NewString("123"),
+	},
+	{
+		Name: "RegExpFind Not Found",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdef"),
+			value.NewString("\\d+"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpFind Pattern is Null",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewString("abc123def456"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestRegExpFind(t *testing.T) {
+	testFunction(t, RegExpFind, regExpFindTests)
+}
+
+var regExpSubmatchTests = []functionTest{
+	{
+		Name: "RegExpSubmatch",
+		Function: parser.Function{
+			Name: "regexp_submatch",
+		},
+		Args: []value.Primary{
+			value.NewString("user@example.com"),
+			value.NewString("^([^@]+)@(.+)$"),
+		},
+		Result: value.NewString("[\"user@example.com\",\"user\",\"example.com\"]"),
+	},
+	{
+		Name: "RegExpSubmatch Not Found",
+		Function: parser.Function{
+			Name: "regexp_submatch",
+		},
+		Args: []value.Primary{
+			value.NewString("example.com"),
+			value.NewString("^([^@]+)@(.+)$"),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestRegExpSubmatch(t *testing.T) {
+	testFunction(t, RegExpSubmatch, regExpSubmatchTests)
+}
+
+var regExpFindAllTests = []functionTest{
+	{
+		Name: "RegExpFindAll",
+		Function: parser.Function{
+			Name: "regexp_find_all",
+		},
+		Args: []value.Primary{
+			value.NewString("abc123def456"),
+			value.NewString("\\d+"),
+		},
+		Result: value.NewString("[\"123\",\"456\"]"),
+	},
+	{
+		Name: "RegExpFindAll Not Found",
+		Function: parser.Function{
+			Name: "regexp_find_all",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdef"),
+			value.NewString("\\d+"),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestRegExpFindAll(t *testing.T) {
+	testFunction(t, RegExpFindAll, regExpFindAllTests)
+}
+
+var regExpReplaceTests = []functionTest{
+	{
+		Name: "RegExpReplace",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("2020-01-31"),
+			value.NewString("(\\d+)-(\\d+)-(\\d+)"),
+			value.NewString("$3/$2/$1"),
+		},
+		Result: value.NewString("31/01/2020"),
+	},
+	{
+		Name: "RegExpReplace with Flags",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("Abc abc ABC"),
+			value.NewString("abc"),
+			value.NewString("x"),
+			value.NewString("i"),
+		},
+		Result: value.NewString("x x x"),
+	},
+	{
+		Name: "RegExpReplace Replacement is Null",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("b"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpReplace Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewString("b"),
+		},
+		Error: "function regexp_replace takes 3 or 4 arguments",
+	},
+}
+
+func TestRegExpReplace(t *testing.T) {
+	testFunction(t, RegExpReplace, regExpReplaceTests)
+}
+
+var regExpSplitTests = []functionTest{
+	{
+		Name: "RegExpSplit",
+		Function: parser.Function{
+			Name: "regexp_split",
+		},
+		Args: []value.Primary{
+			value.NewString("a, b;c  d"),
+			value.NewString("[,;\\s]+"),
+		},
+		Result: value.NewString("[\"a\",\"b\",\"c\",\"d\"]"),
+	},
+	{
+		Name: "RegExpSplit String is Null",
+		Function: parser.Function{
+			Name: "regexp_split",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("[,;\\s]+"),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestRegExpSplit(t *testing.T) {
+	testFunction(t, RegExpSplit, regExpSplitTests)
+}
+
 var formatTests = []functionTest{
 	{
 		Name: "Format",
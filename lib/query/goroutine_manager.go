@@ -165,6 +165,8 @@ func (m *GoroutineTaskManager) run(ctx context.Context, fn func(int) error, thId
 }
 
 func (m *GoroutineTaskManager) Run(ctx context.Context, fn func(int) error) error {
+	explainGoroutines(ctx, m.Number)
+
 	if 1 < m.Number {
 		for i := 0; i < m.Number; i++ {
 			m.Add()
@@ -10,6 +10,10 @@ import (
 
 const InternalIdColumn = "@__internal_id"
 
+// FileColumn is the name of the pseudo column that holds the path of the file
+// from which each record of a table loaded with a glob pattern was read.
+const FileColumn = "__FILE__"
+
 type HeaderField struct {
 	View         string
 	Column       string
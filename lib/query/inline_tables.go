@@ -19,11 +19,21 @@ func (it InlineTableMap) Set(ctx context.Context, scope *ReferenceScope, inlineT
 		scope.RecursiveTable = &inlineTable
 	}
 
+	ctx, step := beginExecutionStep(ctx, "Inline Table", func(plan *ExecutionPlan) {
+		plan.AddAttribute("Name", inlineTable.Name.Literal)
+		if inlineTable.IsRecursive() {
+			plan.AddAttribute("Recursive", "true")
+		}
+	})
+
 	view, err := Select(ctx, scope, inlineTable.Query)
 	scope.CloseCurrentNode()
 	if err != nil {
 		return err
 	}
+	if !step.Executes() {
+		return it.Store(inlineTable.Name, view)
+	}
 
 	err = view.Header.Update(inlineTable.Name.Literal, inlineTable.Fields)
 	if err != nil {
@@ -34,6 +44,7 @@ func (it InlineTableMap) Set(ctx context.Context, scope *ReferenceScope, inlineT
 	}
 
 	view.FileInfo = nil
+	step.Finish(view.RecordLen())
 	return it.Store(inlineTable.Name, view)
 }
 
@@ -1,8 +1,10 @@
 package query
 
 import (
+	"bytes"
 	"context"
 	"math"
+	"sort"
 	"sync"
 
 	"github.com/mithrandie/csvq/lib/parser"
@@ -106,6 +108,8 @@ func ParseJoinCondition(join parser.Join, view *View, joinView *View) (parser.Qu
 }
 
 func CrossJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView *View) error {
+	explainAttribute(ctx, "Strategy", "Nested Loop")
+
 	mergedHeader := view.Header.Merge(joinView.Header)
 	records := make(RecordSet, view.RecordLen()*joinView.RecordLen())
 
@@ -138,13 +142,38 @@ func InnerJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 
 	mergedHeader := view.Header.Merge(joinView.Header)
 
-	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), scope.Tx.Flags.CPU)
+	// The hash table is built from the smaller view, and the records of the other view are probed.
+	// When the left view is used to build the hash table, the joined records are sorted
+	// in the order of the left view afterwards so that the result does not depend on the sizes.
+	probeView, buildView := view, joinView
+	swapped := view.RecordLen() < joinView.RecordLen()
+	if swapped {
+		probeView, buildView = joinView, view
+	}
+
+	hashTable, err := newJoinHashTable(ctx, scope, condition, mergedHeader, probeView, buildView, swapped)
+	if err != nil {
+		return err
+	}
+	if hashTable == nil && swapped {
+		probeView, buildView = view, joinView
+		swapped = false
+	}
+	explainJoinStrategy(ctx, hashTable, swapped)
+
+	gm := NewGoroutineTaskManager(probeView.RecordLen(), CalcMinimumRequired(probeView.RecordLen(), buildView.RecordLen(), MinimumRequiredPerCPUCore), scope.Tx.Flags.CPU)
+	explainGoroutines(ctx, gm.Number)
 	recordsList := make([]RecordSet, gm.Number)
+	positionsList := make([][]joinedPosition, gm.Number)
 
 	var joinFn = func(thIdx int) {
 		ctx := ctx
 		start, end := gm.RecordRange(thIdx)
 		records := make(RecordSet, 0, end-start)
+		var positions []joinedPosition
+		if swapped {
+			positions = make([]joinedPosition, 0, end-start)
+		}
 		seqScope := scope.CreateScopeForRecordEvaluation(
 			&View{
 				Header:    mergedHeader,
@@ -153,9 +182,11 @@ func InnerJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 			0,
 		)
 
+		probe := hashTable.newProbe(buildView.RecordLen())
+
 	InnerJoinLoop:
 		for i := start; i < end; i++ {
-			for j := 0; j < joinView.RecordLen(); j++ {
+			for _, j := range probe.candidates(probeView.RecordSet[i]) {
 				if gm.HasError() {
 					break InnerJoinLoop
 				}
@@ -163,7 +194,12 @@ func InnerJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 					break InnerJoinLoop
 				}
 
-				mergedRecord := view.RecordSet[i].Merge(joinView.RecordSet[j], recordPool)
+				var mergedRecord Record
+				if swapped {
+					mergedRecord = buildView.RecordSet[j].Merge(probeView.RecordSet[i], recordPool)
+				} else {
+					mergedRecord = probeView.RecordSet[i].Merge(buildView.RecordSet[j], recordPool)
+				}
 				seqScope.Records[0].view.RecordSet[0] = mergedRecord
 
 				primary, e := Evaluate(ctx, seqScope, condition)
@@ -173,6 +209,9 @@ func InnerJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 				}
 				if primary.Ternary() == ternary.TRUE {
 					records = append(records, mergedRecord)
+					if swapped {
+						positions = append(positions, joinedPosition{left: j, right: i})
+					}
 				} else {
 					for i := range mergedRecord {
 						mergedRecord[i] = nil
@@ -183,6 +222,7 @@ func InnerJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 		}
 
 		recordsList[thIdx] = records
+		positionsList[thIdx] = positions
 
 		if 1 < gm.Number {
 			gm.Done()
@@ -206,12 +246,54 @@ func InnerJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 		return ConvertContextError(ctx.Err())
 	}
 
+	records := MergeRecordSetList(recordsList)
+	if swapped {
+		sortJoinedRecords(records, positionsList)
+	}
+
 	view.Header = mergedHeader
-	view.RecordSet = MergeRecordSetList(recordsList)
+	view.RecordSet = records
 	view.FileInfo = nil
 	return nil
 }
 
+type joinedPosition struct {
+	left  int
+	right int
+}
+
+// sortJoinedRecords sorts the records joined by probing the right view in the order of the left view,
+// then in the order of the right view.
+func sortJoinedRecords(records RecordSet, positionsList [][]joinedPosition) {
+	positions := make([]joinedPosition, 0, len(records))
+	for _, list := range positionsList {
+		positions = append(positions, list...)
+	}
+
+	sort.Sort(joinedRecords{records: records, positions: positions})
+}
+
+type joinedRecords struct {
+	records   RecordSet
+	positions []joinedPosition
+}
+
+func (r joinedRecords) Len() int {
+	return len(r.records)
+}
+
+func (r joinedRecords) Less(i, j int) bool {
+	if r.positions[i].left != r.positions[j].left {
+		return r.positions[i].left < r.positions[j].left
+	}
+	return r.positions[i].right < r.positions[j].right
+}
+
+func (r joinedRecords) Swap(i, j int) {
+	r.records[i], r.records[j] = r.records[j], r.records[i]
+	r.positions[i], r.positions[j] = r.positions[j], r.positions[i]
+}
+
 func OuterJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView *View, condition parser.QueryExpression, direction int) error {
 	if direction == parser.TokenUndefined {
 		direction = parser.LEFT
@@ -229,7 +311,14 @@ func OuterJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 		view, joinView = joinView, view
 	}
 
+	hashTable, err := newJoinHashTable(ctx, scope, condition, mergedHeader, view, joinView, direction == parser.RIGHT)
+	if err != nil {
+		return err
+	}
+	explainJoinStrategy(ctx, hashTable, direction == parser.RIGHT)
+
 	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), scope.Tx.Flags.CPU)
+	explainGoroutines(ctx, gm.Number)
 
 	recordsList := make([]RecordSet, gm.Number+1)
 	joinViewMatchesList := make([][]bool, gm.Number)
@@ -254,10 +343,12 @@ func OuterJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 			leftViewFieldLen = view.FieldLen()
 		}
 
+		probe := hashTable.newProbe(joinView.RecordLen())
+
 	OuterJoinLoop:
 		for i := start; i < end; i++ {
 			match := false
-			for j := 0; j < joinView.RecordLen(); j++ {
+			for _, j := range probe.candidates(view.RecordSet[i]) {
 				if gm.HasError() {
 					break OuterJoinLoop
 				}
@@ -394,3 +485,262 @@ func CalcMinimumRequired(i1 int, i2 int, defaultMinimumRequired int) int {
 	}
 	return int(math.Ceil(float64(i1) / math.Floor(float64(p)/float64(defaultMinimumRequired))))
 }
+
+type equiJoinKey struct {
+	viewIndex     int
+	joinViewIndex int
+	identical     bool
+}
+
+// extractEquiJoinKeys returns the pairs of fields compared with "=" or "==" in the conjuncts of the join condition.
+// Each pair consists of a field in the left view and a field in the right view.
+func extractEquiJoinKeys(condition parser.QueryExpression, mergedHeader Header, leftFieldLen int) []equiJoinKey {
+	switch expr := condition.(type) {
+	case parser.Parentheses:
+		return extractEquiJoinKeys(expr.Expr, mergedHeader, leftFieldLen)
+	case parser.Logic:
+		if expr.Operator.Token == parser.AND {
+			return append(extractEquiJoinKeys(expr.LHS, mergedHeader, leftFieldLen), extractEquiJoinKeys(expr.RHS, mergedHeader, leftFieldLen)...)
+		}
+	case parser.Comparison:
+		if expr.Operator.Literal != "=" && expr.Operator.Literal != "==" {
+			break
+		}
+
+		lidx, ok := joinFieldIndex(mergedHeader, expr.LHS)
+		if !ok {
+			break
+		}
+		ridx, ok := joinFieldIndex(mergedHeader, expr.RHS)
+		if !ok {
+			break
+		}
+
+		if leftFieldLen <= lidx {
+			lidx, ridx = ridx, lidx
+		}
+		if leftFieldLen <= lidx || ridx < leftFieldLen {
+			break
+		}
+
+		return []equiJoinKey{{
+			viewIndex:     lidx,
+			joinViewIndex: ridx - leftFieldLen,
+			identical:     expr.Operator.Literal == "==",
+		}}
+	}
+	return nil
+}
+
+func joinFieldIndex(header Header, expr parser.QueryExpression) (int, bool) {
+	switch expr.(type) {
+	case parser.FieldReference, parser.ColumnNumber:
+		idx, err := header.SearchIndex(expr)
+		return idx, err == nil
+	}
+	return -1, false
+}
+
+// joinHashTable is used to narrow down the records of the view used to build the table
+// that may satisfy the join condition.
+//
+// The relation "=" compares values after converting them to several types, so a value is
+// registered with a key for each type it can be converted to. Two values that are equal
+// have at least one key in common, but sharing a key does not always mean that they are equal.
+// The join condition is therefore always evaluated for the candidates, and the result of
+// a join is the same as that of a nested loop regardless of the strict-equal flag.
+type joinHashTable struct {
+	keys            []equiJoinKey
+	datetimeFormats []string
+	buckets         map[string][]int
+}
+
+func newJoinHashTable(ctx context.Context, scope *ReferenceScope, condition parser.QueryExpression, mergedHeader Header, view *View, joinView *View, swapped bool) (*joinHashTable, error) {
+	leftFieldLen := view.FieldLen()
+	if swapped {
+		leftFieldLen = joinView.FieldLen()
+	}
+
+	keys := extractEquiJoinKeys(condition, mergedHeader, leftFieldLen)
+	if len(keys) < 1 {
+		return nil, nil
+	}
+	if swapped {
+		for i := range keys {
+			keys[i].viewIndex, keys[i].joinViewIndex = keys[i].joinViewIndex, keys[i].viewIndex
+		}
+	}
+
+	ht := &joinHashTable{
+		keys:            keys,
+		datetimeFormats: scope.Tx.Flags.DatetimeFormat,
+		buckets:         make(map[string][]int, joinView.RecordLen()),
+	}
+
+	buf := GetComparisonKeysBuf()
+	defer PutComparisonkeysBuf(buf)
+
+	for i := range joinView.RecordSet {
+		if i&1023 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		for _, key := range ht.recordKeys(joinView.RecordSet[i], true, buf) {
+			ht.buckets[key] = append(ht.buckets[key], i)
+		}
+	}
+	return ht, nil
+}
+
+func explainJoinStrategy(ctx context.Context, hashTable *joinHashTable, buildLeft bool) {
+	if hashTable == nil {
+		explainAttribute(ctx, "Strategy", "Nested Loop")
+		return
+	}
+
+	explainAttribute(ctx, "Strategy", "Hash Join")
+	if buildLeft {
+		explainAttribute(ctx, "Build Side", "Left")
+	} else {
+		explainAttribute(ctx, "Build Side", "Right")
+	}
+}
+
+func (ht *joinHashTable) recordKeys(record Record, isJoinView bool, buf *bytes.Buffer) []string {
+	keys := []string{""}
+
+	for _, k := range ht.keys {
+		idx := k.viewIndex
+		if isJoinView {
+			idx = k.joinViewIndex
+		}
+
+		valueKeys := ht.valueKeys(record[idx][0], k.identical, buf)
+		if len(valueKeys) < 1 {
+			return nil
+		}
+
+		combined := make([]string, 0, len(keys)*len(valueKeys))
+		for _, key := range keys {
+			for _, vk := range valueKeys {
+				combined = append(combined, key+vk)
+			}
+		}
+		keys = combined
+	}
+
+	return keys
+}
+
+func (ht *joinHashTable) valueKeys(val value.Primary, identical bool, buf *bytes.Buffer) []string {
+	if value.IsNull(val) {
+		return nil
+	}
+
+	if identical {
+		if t, ok := val.(*value.Ternary); ok && t.Ternary() == ternary.UNKNOWN {
+			return nil
+		}
+
+		buf.Reset()
+		SerializeIdenticalKey(buf, val)
+		return []string{buf.String()}
+	}
+
+	keys := make([]string, 0, 4)
+
+	if i := value.ToInteger(val); !value.IsNull(i) {
+		buf.Reset()
+		serializeFloat(buf, value.Float64ToStr(float64(i.(*value.Integer).Raw())))
+		keys = append(keys, buf.String())
+		value.Discard(i)
+	} else if f := value.ToFloat(val); !value.IsNull(f) {
+		fv := f.(*value.Float).Raw()
+		if fv == 0 {
+			fv = 0
+		}
+		buf.Reset()
+		serializeFloat(buf, value.Float64ToStr(fv))
+		keys = append(keys, buf.String())
+		value.Discard(f)
+	}
+
+	if dt := value.ToDatetime(val, ht.datetimeFormats); !value.IsNull(dt) {
+		buf.Reset()
+		serializeDatetime(buf, dt.(*value.Datetime).Raw())
+		keys = append(keys, buf.String())
+		value.Discard(dt)
+	}
+
+	if b := value.ToBoolean(val); !value.IsNull(b) {
+		buf.Reset()
+		serializeBoolean(buf, b.(*value.Boolean).Raw())
+		keys = append(keys, buf.String())
+	}
+
+	if s, ok := val.(*value.String); ok {
+		buf.Reset()
+		serializeString(buf, s.Raw())
+		keys = append(keys, buf.String())
+	}
+
+	return keys
+}
+
+func (ht *joinHashTable) newProbe(recordLen int) *joinHashProbe {
+	probe := &joinHashProbe{
+		table: ht,
+	}
+
+	if ht == nil {
+		probe.all = make([]int, recordLen)
+		for i := range probe.all {
+			probe.all[i] = i
+		}
+	} else {
+		probe.buf = &bytes.Buffer{}
+	}
+	return probe
+}
+
+type joinHashProbe struct {
+	table   *joinHashTable
+	all     []int
+	buf     *bytes.Buffer
+	indices []int
+}
+
+// candidates returns the indices of the records in the view used to build the hash table that may be joined
+// with the record, in ascending order.
+func (p *joinHashProbe) candidates(record Record) []int {
+	if p.table == nil {
+		return p.all
+	}
+
+	keys := p.table.recordKeys(record, false, p.buf)
+	switch len(keys) {
+	case 0:
+		return nil
+	case 1:
+		return p.table.buckets[keys[0]]
+	}
+
+	p.indices = p.indices[:0]
+	for _, key := range keys {
+		p.indices = append(p.indices, p.table.buckets[key]...)
+	}
+	if len(p.indices) < 2 {
+		return p.indices
+	}
+
+	sort.Ints(p.indices)
+	n := 1
+	for i := 1; i < len(p.indices); i++ {
+		if p.indices[i] != p.indices[n-1] {
+			p.indices[n] = p.indices[i]
+			n++
+		}
+	}
+	p.indices = p.indices[:n]
+	return p.indices
+}
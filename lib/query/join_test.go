@@ -449,6 +449,108 @@ var innerJoinTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Inner Join with Values of Different Types",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewInteger(2),
+					value.NewString("str2"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewString("TRUE"),
+					value.NewString("str3"),
+				}),
+				NewRecordWithId(4, []value.Primary{
+					value.NewNull(),
+					value.NewString("str4"),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeaderWithId("table2", []string{"column1", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewFloat(1),
+					value.NewString("str11"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString(" 2 "),
+					value.NewString("str22"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewNull(),
+					value.NewString("str33"),
+				}),
+				NewRecordWithId(4, []value.Primary{
+					value.NewBoolean(true),
+					value.NewString("str44"),
+				}),
+			},
+		},
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+			Operator: parser.Token{Token: '=', Literal: "="},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: InternalIdColumn},
+				{View: "table2", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column3", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewInteger(1),
+					value.NewFloat(1),
+					value.NewString("str11"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewInteger(4),
+					value.NewBoolean(true),
+					value.NewString("str44"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewInteger(2),
+					value.NewString("str2"),
+					value.NewInteger(2),
+					value.NewString(" 2 "),
+					value.NewString("str22"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("TRUE"),
+					value.NewString("str3"),
+					value.NewInteger(1),
+					value.NewFloat(1),
+					value.NewString("str11"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("TRUE"),
+					value.NewString("str3"),
+					value.NewInteger(4),
+					value.NewBoolean(true),
+					value.NewString("str44"),
+				}),
+			},
+		},
+	},
 	{
 		Name: "Inner Join With No Condition",
 		View: &View{
@@ -523,6 +625,163 @@ var innerJoinTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Inner Join Building Hash Table from Left View",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(2),
+					value.NewString("str2"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeaderWithId("table2", []string{"column1", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str11"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewInteger(2),
+					value.NewString("str22"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str12"),
+				}),
+				NewRecordWithId(4, []value.Primary{
+					value.NewInteger(3),
+					value.NewString("str33"),
+				}),
+			},
+		},
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+			Operator: parser.Token{Token: '=', Literal: "="},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: InternalIdColumn},
+				{View: "table2", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column3", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewInteger(2),
+					value.NewString("str2"),
+					value.NewInteger(2),
+					value.NewInteger(2),
+					value.NewString("str22"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+					value.NewString("str11"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewInteger(3),
+					value.NewInteger(1),
+					value.NewString("str12"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Inner Join Building Hash Table from Left View in Multi Threading",
+		CPU:  2,
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(2),
+					value.NewString("str2"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeaderWithId("table2", []string{"column1", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str11"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewInteger(2),
+					value.NewString("str22"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str12"),
+				}),
+				NewRecordWithId(4, []value.Primary{
+					value.NewInteger(3),
+					value.NewString("str33"),
+				}),
+			},
+		},
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+			Operator: parser.Token{Token: '=', Literal: "="},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: InternalIdColumn},
+				{View: "table2", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column3", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewInteger(2),
+					value.NewString("str2"),
+					value.NewInteger(2),
+					value.NewInteger(2),
+					value.NewString("str22"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+					value.NewString("str11"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewInteger(3),
+					value.NewInteger(1),
+					value.NewString("str12"),
+				}),
+			},
+		},
+	},
 	{
 		Name: "Inner Join Filter Error",
 		View: &View{
@@ -851,6 +1110,95 @@ var outerJoinTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Right Outer Join with Identical Operator",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewInteger(2),
+					value.NewString("str2"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInteger(3),
+					value.NewString("str3"),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeaderWithId("table2", []string{"column1", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewFloat(2),
+					value.NewString("str22"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewInteger(3),
+					value.NewString("str33"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInteger(4),
+					value.NewString("str44"),
+				}),
+			},
+		},
+		Condition: parser.Logic{
+			LHS: parser.Parentheses{
+				Expr: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+					Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "=="},
+				},
+			},
+			RHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+				RHS:      parser.NewStringValue("str2"),
+				Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "<>"},
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "and"},
+		},
+		Direction: parser.RIGHT,
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: InternalIdColumn},
+				{View: "table2", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column3", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewInteger(1),
+					value.NewFloat(2),
+					value.NewString("str22"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewInteger(3),
+					value.NewString("str3"),
+					value.NewInteger(2),
+					value.NewInteger(3),
+					value.NewString("str33"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewInteger(3),
+					value.NewInteger(4),
+					value.NewString("str44"),
+				}),
+			},
+		},
+	},
 	{
 		Name: "Left Outer Join Filter Error",
 		View: &View{
@@ -117,6 +117,7 @@ func setup() {
 	}
 
 	cmd.TestTime = NowForTest
+	_ = TestTx.Flags.SetLocation(TestLocation)
 
 	TestDataDir = filepath.Join(GetWD(), "..", "..", "testdata", "csv")
 
@@ -130,13 +131,30 @@ func setup() {
 	_ = copyfile(filepath.Join(TestDir, "table_sjis.csv"), filepath.Join(TestDataDir, "table_sjis.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table_noheader.csv"), filepath.Join(TestDataDir, "table_noheader.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table_broken.csv"), filepath.Join(TestDataDir, "table_broken.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table_dialect.csv"), filepath.Join(TestDataDir, "table_dialect.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table1.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table1_bom.csv"), filepath.Join(TestDataDir, "table1_bom.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table1b.csv"), filepath.Join(TestDataDir, "table1b.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table1_gz.csv.gz"), filepath.Join(TestDataDir, "table1_gz.csv.gz"))
+	_ = copyfile(filepath.Join(TestDir, "table1_bz2.csv.bz2"), filepath.Join(TestDataDir, "table1_bz2.csv.bz2"))
 	_ = copyfile(filepath.Join(TestDir, "table2.csv"), filepath.Join(TestDataDir, "table2.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table4.csv"), filepath.Join(TestDataDir, "table4.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table5.csv"), filepath.Join(TestDataDir, "table5.csv"))
 	_ = copyfile(filepath.Join(TestDir, "group_table.csv"), filepath.Join(TestDataDir, "group_table.csv"))
+	_ = copyfile(filepath.Join(TestDir, "glob_1.csv"), filepath.Join(TestDataDir, "glob_1.csv"))
+	_ = copyfile(filepath.Join(TestDir, "glob_2.csv"), filepath.Join(TestDataDir, "glob_2.csv"))
+	_ = copyfile(filepath.Join(TestDir, "glob_x.csv"), filepath.Join(TestDataDir, "glob_x.csv"))
+
+	for _, p := range []string{
+		filepath.Join("partitioned", "_SUCCESS"),
+		filepath.Join("partitioned", "year=2024", "month=01", "part-0001.csv"),
+		filepath.Join("partitioned", "year=2025", "month=12", "part-0001.csv"),
+		filepath.Join("partitioned", "year=2026", "month=01", "part-0001.csv"),
+		filepath.Join("partitioned", "year=2026", "month=02", "part-0001.csv"),
+	} {
+		_ = os.MkdirAll(filepath.Dir(filepath.Join(TestDir, p)), 0755)
+		_ = copyfile(filepath.Join(TestDir, p), filepath.Join(TestDataDir, p))
+	}
 	_ = copyfile(filepath.Join(TestDir, "insert_query.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	_ = copyfile(filepath.Join(TestDir, "update_query.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	_ = copyfile(filepath.Join(TestDir, "delete_query.csv"), filepath.Join(TestDataDir, "table1.csv"))
@@ -144,6 +162,10 @@ func setup() {
 	_ = copyfile(filepath.Join(TestDir, "drop_columns.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	_ = copyfile(filepath.Join(TestDir, "rename_column.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	_ = copyfile(filepath.Join(TestDir, "updated_file_1.csv"), filepath.Join(TestDataDir, "table1.csv"))
+	_ = copyfile(filepath.Join(TestDir, "restore.csv"), filepath.Join(TestDataDir, "table1.csv"))
+	_ = os.Mkdir(filepath.Join(TestDir, file.HistoryDirName), 0755)
+	_ = copyfile(filepath.Join(TestDir, file.HistoryDirName, "restore.csv.20120201T000000.000000000"), filepath.Join(TestDataDir, "table2.csv"))
+	_ = copyfile(filepath.Join(TestDir, file.HistoryDirName, "restore.csv.20120202T000000.000000000"), filepath.Join(TestDataDir, "table1b.csv"))
 	_ = copyfile(filepath.Join(TestDir, "dup_name.csv"), filepath.Join(TestDataDir, "dup_name.csv"))
 
 	_ = copyfile(filepath.Join(TestDir, "table3.tsv"), filepath.Join(TestDataDir, "table3.tsv"))
@@ -152,6 +174,11 @@ func setup() {
 	_ = copyfile(filepath.Join(TestDir, "table.json"), filepath.Join(TestDataDir, "table.json"))
 	_ = copyfile(filepath.Join(TestDir, "table_h.json"), filepath.Join(TestDataDir, "table_h.json"))
 	_ = copyfile(filepath.Join(TestDir, "table_a.json"), filepath.Join(TestDataDir, "table_a.json"))
+	_ = copyfile(filepath.Join(TestDir, "table7.jsonl"), filepath.Join(TestDataDir, "table7.jsonl"))
+	_ = copyfile(filepath.Join(TestDir, "table10.yaml"), filepath.Join(TestDataDir, "table10.yaml"))
+	_ = copyfile(filepath.Join(TestDir, "table11.xml"), filepath.Join(TestDataDir, "table11.xml"))
+	_ = copyfile(filepath.Join(TestDir, "table8.parquet"), filepath.Join(TestDataDir, "table8.parquet"))
+	_ = copyfile(filepath.Join(TestDir, "table9.xlsx"), filepath.Join(TestDataDir, "table9.xlsx"))
 
 	_ = copyfile(filepath.Join(TestDir, "table6.ltsv"), filepath.Join(TestDataDir, "table6.ltsv"))
 	_ = copyfile(filepath.Join(TestDir, "table6_bom.ltsv"), filepath.Join(TestDataDir, "table6_bom.ltsv"))
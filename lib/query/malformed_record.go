@@ -0,0 +1,121 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/dsv"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
+)
+
+const QuarantineFileExtension = ".quarantine"
+
+// MalformedRecord is a record dropped while loading a file because it cannot be parsed.
+type MalformedRecord struct {
+	Line   int
+	Reason string
+	Text   string
+}
+
+// lenientRecordReader skips the records that the dsv reader cannot parse and holds them.
+type lenientRecordReader struct {
+	reader    RecordReader
+	malformed []MalformedRecord
+}
+
+func (r *lenientRecordReader) Read() ([]text.RawText, error) {
+	for {
+		record, err := r.reader.Read()
+		if e, ok := err.(*dsv.ParseError); ok {
+			r.malformed = append(r.malformed, MalformedRecord{
+				Line:   e.RecordLine,
+				Reason: e.Error(),
+				Text:   e.Record,
+			})
+			continue
+		}
+		return record, err
+	}
+}
+
+// QuarantineFilePath returns the path of the file to which the malformed records of the table are written.
+// The malformed records of the standard input are written to the repository.
+func QuarantineFilePath(fileInfo *FileInfo, repository string) string {
+	if fileInfo.IsStdin() {
+		return filepath.Join(repository, "stdin"+QuarantineFileExtension)
+	}
+	return fileInfo.Path + QuarantineFileExtension
+}
+
+// handleMalformedRecords counts the records dropped while loading the file and warns about them.
+// The records are also written to the quarantine file if the ON_PARSE_ERROR flag is QUARANTINE.
+func handleMalformedRecords(ctx context.Context, tx *Transaction, fileInfo *FileInfo, expr parser.QueryExpression) error {
+	if len(fileInfo.MalformedRecords) < 1 {
+		return nil
+	}
+
+	records := fileInfo.MalformedRecords
+	fileInfo.MalformedRecords = nil
+	tx.addSkippedRecords(len(records))
+
+	if fileInfo.OnParseError == cmd.QuarantineOnParseError {
+		fpath := QuarantineFilePath(fileInfo, tx.Flags.Repository)
+		if err := saveQuarantineFile(ctx, tx, fpath, records); err != nil {
+			return NewIOError(expr, err.Error())
+		}
+		tx.LogWarn(fmt.Sprintf("%d malformed records in %q are quarantined to %q.", len(records), fileInfo.Path, fpath), tx.Flags.Quiet)
+	} else {
+		tx.LogWarn(fmt.Sprintf("%d malformed records in %q are skipped.", len(records), fileInfo.Path), tx.Flags.Quiet)
+	}
+	return nil
+}
+
+// saveQuarantineFile writes the malformed records as CSV with the line numbers and the reasons.
+// The file is written with a file handler, so an existing file is replaced on commit of the handler.
+func saveQuarantineFile(ctx context.Context, tx *Transaction, fpath string, records []MalformedRecord) error {
+	buf := &bytes.Buffer{}
+	w, err := csv.NewWriter(buf, text.LF, text.UTF8)
+	if err != nil {
+		return err
+	}
+
+	if err = w.Write([]csv.Field{csv.NewField("line", false), csv.NewField("reason", false), csv.NewField("record", false)}); err != nil {
+		return err
+	}
+	for _, r := range records {
+		if err = w.Write([]csv.Field{csv.NewField(strconv.Itoa(r.Line), false), csv.NewField(r.Reason, false), csv.NewField(r.Text, true)}); err != nil {
+			return err
+		}
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	buf.WriteString(text.LF.Value())
+
+	var h *file.Handler
+	if file.Exists(fpath) {
+		h, err = file.NewHandlerForUpdate(ctx, tx.FileContainer, fpath, tx.WaitTimeout, tx.RetryDelay)
+	} else {
+		h, err = file.NewHandlerForCreate(tx.FileContainer, fpath)
+	}
+	if err != nil {
+		return err
+	}
+
+	fp, err := h.FileForUpdate()
+	if err == nil {
+		_, err = fp.Write(buf.Bytes())
+	}
+	if err != nil {
+		return appendCompositeError(err, tx.FileContainer.Close(h))
+	}
+	return tx.FileContainer.Commit(h)
+}
@@ -0,0 +1,166 @@
+package query
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var loadMalformedRecordsTests = []struct {
+	Name             string
+	Table            parser.QueryExpression
+	Stdin            string
+	ImportFormat     cmd.Format
+	OnParseError     cmd.ParseErrorMode
+	ExpectRecords    int
+	ExpectSkipped    int64
+	QuarantineFile   string
+	ExpectQuarantine string
+}{
+	{
+		Name:          "Skip Malformed Records in CSV",
+		Table:         parser.Identifier{Literal: "table_broken.csv"},
+		ImportFormat:  cmd.AutoSelect,
+		OnParseError:  cmd.SkipOnParseError,
+		ExpectRecords: 2,
+		ExpectSkipped: 1,
+	},
+	{
+		Name:           "Quarantine Malformed Records in JSON Lines from Stdin",
+		Table:          parser.Stdin{},
+		Stdin:          "{\"id\":1}\n[2]\n{\"id\":3}\n{\"id\":\n",
+		ImportFormat:   cmd.JSONL,
+		OnParseError:   cmd.QuarantineOnParseError,
+		ExpectRecords:  2,
+		ExpectSkipped:  2,
+		QuarantineFile: "stdin.quarantine",
+		ExpectQuarantine: "line,reason,record\n" +
+			"2,line 2: rows loaded from json lines must be objects,\"[2]\"\n" +
+			"4,\"line 4: line 1, column 6: unexpected termination\",\"{\"\"id\"\":\"\n",
+	},
+	{
+		Name:           "Quarantine Malformed Records in CSV",
+		Table:          parser.Identifier{Literal: "table_broken.csv"},
+		ImportFormat:   cmd.AutoSelect,
+		OnParseError:   cmd.QuarantineOnParseError,
+		ExpectRecords:  2,
+		ExpectSkipped:  1,
+		QuarantineFile: "table_broken.csv.quarantine",
+		ExpectQuarantine: "line,reason,record\n" +
+			"3,\"line 3, column 7: wrong number of fields in line\",\"2,str2,str2\"\n",
+	},
+	{
+		Name:           "Quarantine Malformed Records to Existing File",
+		Table:          parser.Identifier{Literal: "table_broken.csv"},
+		ImportFormat:   cmd.AutoSelect,
+		OnParseError:   cmd.QuarantineOnParseError,
+		ExpectRecords:  2,
+		ExpectSkipped:  1,
+		QuarantineFile: "table_broken.csv.quarantine",
+		ExpectQuarantine: "line,reason,record\n" +
+			"3,\"line 3, column 7: wrong number of fields in line\",\"2,str2,str2\"\n",
+	},
+}
+
+func TestLoadMalformedRecords(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.Session.SetStdin(os.Stdin)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+
+	for _, v := range loadMalformedRecordsTests {
+		_ = TestTx.ReleaseResources()
+		TestTx.Flags.ImportOptions.Format = v.ImportFormat
+		TestTx.Flags.ImportOptions.OnParseError = v.OnParseError
+		if 0 < len(v.Stdin) {
+			_ = TestTx.Session.SetStdin(NewInput(strings.NewReader(v.Stdin)))
+		}
+
+		view, err := LoadView(ctx, NewReferenceScope(TestTx).CreateNode(), []parser.QueryExpression{parser.Table{Object: v.Table}}, false, false)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		if view.RecordLen() != v.ExpectRecords {
+			t.Errorf("%s: record length = %d, want %d", v.Name, view.RecordLen(), v.ExpectRecords)
+		}
+		if TestTx.SkippedRecords() != v.ExpectSkipped {
+			t.Errorf("%s: skipped records = %d, want %d", v.Name, TestTx.SkippedRecords(), v.ExpectSkipped)
+		}
+
+		if 0 < len(v.QuarantineFile) {
+			fpath := filepath.Join(TestDir, v.QuarantineFile)
+			b, err := ioutil.ReadFile(fpath)
+			if err != nil {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+				continue
+			}
+			if string(b) != v.ExpectQuarantine {
+				t.Errorf("%s: quarantine file = %q, want %q", v.Name, string(b), v.ExpectQuarantine)
+			}
+			if file.Exists(file.TempFilePath(fpath)) || file.LockExists(fpath) {
+				t.Errorf("%s: management files for %s remain", v.Name, fpath)
+			}
+		}
+	}
+}
+
+func TestLoadMalformedRecordsForUpdate(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	TestTx.Flags.ImportOptions.OnParseError = cmd.SkipOnParseError
+	ctx := context.Background()
+	_ = TestTx.ReleaseResources()
+
+	table := parser.Table{Object: parser.Identifier{Literal: "table_broken.csv"}}
+	if _, err := LoadView(ctx, NewReferenceScope(TestTx).CreateNode(), []parser.QueryExpression{table}, false, false); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect := "file " + filepath.Join(TestDir, "table_broken.csv") + " cannot be updated because 1 malformed records were dropped while loading it"
+	_, err := LoadView(ctx, NewReferenceScope(TestTx).CreateNode(), []parser.QueryExpression{table}, true, false)
+	if err == nil {
+		t.Fatalf("no error, want error %q", expect)
+	}
+	if err.Error() != expect {
+		t.Errorf("error %q, want error %q", err, expect)
+	}
+}
+
+func TestLoadMalformedRecords_FailOnParseError(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+	_ = TestTx.ReleaseResources()
+
+	// The error reports the same position as the reason written to the quarantine file.
+	expect := "data parse error in file " + filepath.Join(TestDir, "table_broken.csv") + ": line 3, column 7: wrong number of fields in line"
+	table := parser.Table{Object: parser.Identifier{Literal: "table_broken.csv"}}
+	_, err := LoadView(ctx, NewReferenceScope(TestTx).CreateNode(), []parser.QueryExpression{table}, false, false)
+	if err == nil {
+		t.Fatalf("no error, want error %q", expect)
+	}
+	if err.Error() != expect {
+		t.Errorf("error %q, want error %q", err, expect)
+	}
+}
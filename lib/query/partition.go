@@ -0,0 +1,324 @@
+package query
+
+import (
+	"context"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+// HiveDefaultPartition is the directory name value that represents a null partition value.
+const HiveDefaultPartition = "__HIVE_DEFAULT_PARTITION__"
+
+type partitionedFile struct {
+	Path   string
+	Keys   []string
+	Values []value.Primary
+}
+
+func (f partitionedFile) value(key string) value.Primary {
+	for i := range f.Keys {
+		if strings.EqualFold(f.Keys[i], key) {
+			return f.Values[i]
+		}
+	}
+	return value.NewNull()
+}
+
+// parsePartition parses a directory name in the form of "key=value".
+func parsePartition(name string) (string, value.Primary, bool) {
+	idx := strings.Index(name, "=")
+	if idx < 1 {
+		return "", nil, false
+	}
+
+	key := name[:idx]
+	s := name[idx+1:]
+	if s == HiveDefaultPartition {
+		return key, value.NewNull(), true
+	}
+	if unescaped, err := url.PathUnescape(s); err == nil {
+		s = unescaped
+	}
+	return key, value.NewString(s), true
+}
+
+type partitionCondition struct {
+	Expr    parser.QueryExpression
+	Columns []string
+}
+
+// partitionPruner evaluates the conditions in a where clause that refer only to partition columns,
+// so that the directories whose partition values do not satisfy them are not read.
+type partitionPruner struct {
+	scope      *ReferenceScope
+	tableName  string
+	conditions []partitionCondition
+}
+
+func newPartitionPruner(scope *ReferenceScope, tableName string, filter parser.QueryExpression) *partitionPruner {
+	if filter == nil {
+		return nil
+	}
+
+	conditions := make([]partitionCondition, 0, 4)
+	for _, expr := range splitConjuncts(filter) {
+		c := &columnCollector{
+			names: make([]string, 0, 4),
+		}
+		c.collect(expr)
+		if c.unknown || len(c.names) < 1 {
+			continue
+		}
+		conditions = append(conditions, partitionCondition{Expr: expr, Columns: c.names})
+	}
+	if len(conditions) < 1 {
+		return nil
+	}
+
+	return &partitionPruner{
+		scope:      scope,
+		tableName:  tableName,
+		conditions: conditions,
+	}
+}
+
+func splitConjuncts(expr parser.QueryExpression) []parser.QueryExpression {
+	switch expr.(type) {
+	case parser.Parentheses:
+		return splitConjuncts(expr.(parser.Parentheses).Expr)
+	case parser.Logic:
+		logic := expr.(parser.Logic)
+		if logic.Operator.Token == parser.AND {
+			return append(splitConjuncts(logic.LHS), splitConjuncts(logic.RHS)...)
+		}
+	}
+	return []parser.QueryExpression{expr}
+}
+
+// Prune reports whether the partition can be skipped.
+// Only the conditions whose columns are all included in the keys are evaluated,
+// and the partition is not skipped if an evaluation fails.
+func (p *partitionPruner) Prune(ctx context.Context, keys []string, values []value.Primary) bool {
+	if p == nil || len(keys) < 1 {
+		return false
+	}
+
+	view := NewView()
+	view.Header = NewHeader(p.tableName, keys)
+	view.RecordSet = RecordSet{NewRecord(values)}
+	scope := p.scope.CreateScopeForRecordEvaluation(view, 0)
+
+	for _, cond := range p.conditions {
+		covered := true
+		for _, column := range cond.Columns {
+			if !InStrSliceWithCaseInsensitive(column, keys) {
+				covered = false
+				break
+			}
+		}
+		if !covered {
+			continue
+		}
+
+		if result, err := Evaluate(ctx, scope, cond.Expr); err == nil && result.Ternary() != ternary.TRUE {
+			return true
+		}
+	}
+	return false
+}
+
+// searchPartitionedFiles returns the files in the directory and its subdirectories in lexical order,
+// along with the partition values parsed from the subdirectory names in the form of "key=value".
+// Files and directories whose names start with "." or "_" are ignored.
+func searchPartitionedFiles(ctx context.Context, dir string, pruner *partitionPruner) ([]partitionedFile, error) {
+	files := make([]partitionedFile, 0, 10)
+
+	var walk func(string, []string, []value.Primary) error
+	walk = func(dirPath string, keys []string, values []value.Primary) error {
+		if ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		list, err := ioutil.ReadDir(dirPath)
+		if err != nil {
+			return err
+		}
+
+		for _, info := range list {
+			name := info.Name()
+			if strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				continue
+			}
+			fpath := filepath.Join(dirPath, name)
+
+			if !info.IsDir() {
+				files = append(files, partitionedFile{Path: fpath, Keys: keys, Values: values})
+				continue
+			}
+
+			subKeys := keys
+			subValues := values
+			if key, val, ok := parsePartition(name); ok && !InStrSliceWithCaseInsensitive(key, keys) {
+				subKeys = append(append(make([]string, 0, len(keys)+1), keys...), key)
+				subValues = append(append(make([]value.Primary, 0, len(values)+1), values...), val)
+				if pruner.Prune(ctx, subKeys, subValues) {
+					continue
+				}
+			}
+
+			if err = walk(fpath, subKeys, subValues); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err := walk(dir, nil, nil)
+	return files, err
+}
+
+// loadViewFromPartitionedDirectory loads all the files in the directory as one view.
+// The partition keys in the subdirectory names are added as columns, and the directories whose
+// partition values do not satisfy the where clause of the current query are not read.
+func loadViewFromPartitionedDirectory(
+	ctx context.Context,
+	scope *ReferenceScope,
+	dirIdent parser.Identifier,
+	tableName parser.Identifier,
+	options cmd.ImportOptions,
+) (*View, error) {
+	dirPath, err := CreateFilePath(dirIdent, scope.Tx.Flags.Repository)
+	if err != nil {
+		return nil, NewIOError(dirIdent, err.Error())
+	}
+
+	pruner := newPartitionPruner(scope, tableName.Literal, scope.partitionFilter)
+	files, err := searchPartitionedFiles(ctx, dirPath, pruner)
+	if err != nil {
+		if _, ok := err.(Error); !ok {
+			err = NewIOError(dirIdent, err.Error())
+		}
+		return nil, err
+	}
+
+	allPruned := false
+	if len(files) < 1 && pruner != nil {
+		// The columns are determined by the first file even if all the partitions are pruned.
+		if files, err = searchPartitionedFiles(ctx, dirPath, nil); err != nil {
+			if _, ok := err.(Error); !ok {
+				err = NewIOError(dirIdent, err.Error())
+			}
+			return nil, err
+		}
+		if 0 < len(files) {
+			files = files[:1]
+			allPruned = true
+		}
+	}
+	if len(files) < 1 {
+		return nil, NewEmptyDirectoryError(dirIdent)
+	}
+
+	views := make([]*View, 0, len(files))
+	keys := make([]string, 0, 4)
+	for _, f := range files {
+		fileIdent := dirIdent
+		fileIdent.Literal = f.Path
+
+		filePath, err := cacheViewFromFile(ctx, scope, fileIdent, false, options)
+		if err != nil {
+			return nil, err
+		}
+
+		pathIdent := parser.Identifier{Literal: filePath}
+		view, err := scope.Tx.cachedViews.Get(pathIdent)
+		if err != nil {
+			return nil, NewTableNotLoadedError(pathIdent)
+		}
+		views = append(views, view)
+
+		for _, key := range f.Keys {
+			if !InStrSliceWithCaseInsensitive(key, keys) {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	view, err := concatFileViews(dirIdent, views, options.GlobUnionByName)
+	if err != nil {
+		return nil, err
+	}
+	if allPruned {
+		view.RecordSet = view.RecordSet[:0]
+	}
+	if err = addPartitionColumns(view, dirIdent, keys, files); err != nil {
+		return nil, err
+	}
+
+	if err = scope.AddAlias(tableName, ""); err != nil {
+		return nil, err
+	}
+	if err = view.Header.Update(tableName.Literal, nil); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// addPartitionColumns inserts the partition columns before the pseudo column FileColumn,
+// which is the last field of the views created by concatFileViews.
+func addPartitionColumns(view *View, dirIdent parser.Identifier, keys []string, files []partitionedFile) error {
+	if len(keys) < 1 {
+		return nil
+	}
+
+	fileIdx := view.FieldLen() - 1
+	columns := view.Header[:fileIdx].TableColumnNames()
+	for _, key := range keys {
+		if InStrSliceWithCaseInsensitive(key, columns) {
+			keyIdent := dirIdent
+			keyIdent.Literal = key
+			return NewDuplicateFieldNameError(keyIdent)
+		}
+	}
+
+	partitions := make(map[string][]value.Primary, len(files))
+	for _, f := range files {
+		values := make([]value.Primary, len(keys))
+		for i, key := range keys {
+			values[i] = f.value(key)
+		}
+		partitions[f.Path] = values
+	}
+
+	header := make(Header, 0, view.FieldLen()+len(keys))
+	header = append(header, view.Header[:fileIdx]...)
+	for i, key := range keys {
+		header = append(header, HeaderField{
+			View:        view.Header[fileIdx].View,
+			Column:      key,
+			Number:      fileIdx + i + 1,
+			IsFromTable: true,
+		})
+	}
+	view.Header = append(header, view.Header[fileIdx])
+
+	for i, r := range view.RecordSet {
+		values := partitions[r[fileIdx][0].(*value.String).Raw()]
+
+		record := make(Record, 0, len(r)+len(keys))
+		record = append(record, r[:fileIdx]...)
+		for _, v := range values {
+			record = append(record, NewCell(v))
+		}
+		view.RecordSet[i] = append(record, r[fileIdx])
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var parsePartitionTests = []struct {
+	Name      string
+	Input     string
+	Key       string
+	Value     value.Primary
+	Partition bool
+}{
+	{
+		Name:      "Partition",
+		Input:     "year=2026",
+		Key:       "year",
+		Value:     value.NewString("2026"),
+		Partition: true,
+	},
+	{
+		Name:      "Escaped Value",
+		Input:     "time=10%3A30",
+		Key:       "time",
+		Value:     value.NewString("10:30"),
+		Partition: true,
+	},
+	{
+		Name:      "Default Partition",
+		Input:     "region=__HIVE_DEFAULT_PARTITION__",
+		Key:       "region",
+		Value:     value.NewNull(),
+		Partition: true,
+	},
+	{
+		Name:      "Empty Value",
+		Input:     "region=",
+		Key:       "region",
+		Value:     value.NewString(""),
+		Partition: true,
+	},
+	{
+		Name:  "Not Partition",
+		Input: "logs",
+	},
+	{
+		Name:  "Empty Key",
+		Input: "=2026",
+	},
+}
+
+func TestParsePartition(t *testing.T) {
+	for _, v := range parsePartitionTests {
+		key, val, ok := parsePartition(v.Input)
+		if ok != v.Partition {
+			t.Errorf("%s: partition = %t, want %t", v.Name, ok, v.Partition)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if key != v.Key {
+			t.Errorf("%s: key = %q, want %q", v.Name, key, v.Key)
+		}
+		if !reflect.DeepEqual(val, v.Value) {
+			t.Errorf("%s: value = %#v, want %#v", v.Name, val, v.Value)
+		}
+	}
+}
@@ -29,6 +29,7 @@ const (
 
 const StoringResultsContextKey = "sqr"
 const StatementReplaceValuesContextKey = "rv"
+const ExecutionPlanContextKey = "ep"
 
 func ContextForStoringResults(ctx context.Context) context.Context {
 	return context.WithValue(ctx, StoringResultsContextKey, true)
@@ -181,13 +182,22 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 	case parser.SelectQuery:
 		if selectEntity, ok := stmt.(parser.SelectQuery).SelectEntity.(parser.SelectEntity); ok && selectEntity.IntoClause != nil {
 			_, err = Select(ctx, proc.ReferenceScope, stmt.(parser.SelectQuery))
+		} else if stmt.(parser.SelectQuery).OutfileClause != nil {
+			info, e := SelectIntoOutfile(ctx, proc.ReferenceScope, stmt.(parser.SelectQuery))
+			if e == nil {
+				proc.Tx.uncommittedViews.SetForCreatedView(info)
+				proc.Log(fmt.Sprintf("file %q is created.", info.Path), proc.Tx.Flags.Quiet)
+			} else {
+				err = e
+			}
 		} else {
 			if proc.Tx.Flags.Stats {
 				proc.measurementStart = time.Now()
 			}
 
-			view, e := Select(ctx, proc.ReferenceScope, stmt.(parser.SelectQuery))
-			if e == nil {
+			if streamed, e := proc.streamSelect(ctx, stmt.(parser.SelectQuery)); streamed {
+				err = e
+			} else if view, e := Select(ctx, proc.ReferenceScope, stmt.(parser.SelectQuery)); e == nil {
 				var warnmsg string
 
 				proc.Tx.Session.mtx.Lock()
@@ -216,7 +226,8 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 							err = e
 						}
 					} else if !proc.Tx.Flags.ExportOptions.StripEndingLineBreak &&
-						!(proc.Tx.Session.OutFile() != nil && exportOptions.Format == cmd.FIXED && exportOptions.SingleLine) {
+						!(proc.Tx.Session.OutFile() != nil && exportOptions.Format == cmd.FIXED && exportOptions.SingleLine) &&
+						exportOptions.Format != cmd.XLSX {
 						_, err = writer.Write([]byte(proc.Tx.Flags.ExportOptions.LineBreak.Value()))
 					}
 				}
@@ -242,7 +253,7 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 		fileInfo, cnt, e := Insert(ctx, proc.ReferenceScope, stmt.(parser.InsertQuery))
 		if e == nil {
 			if 0 < cnt {
-				proc.Tx.uncommittedViews.SetForUpdatedView(fileInfo)
+				proc.Tx.uncommittedViews.SetForAppendedView(fileInfo, cnt)
 			}
 			proc.Log(fmt.Sprintf("%s inserted on %q.", FormatCount(cnt, "record"), fileInfo.Path), proc.Tx.Flags.Quiet)
 			if proc.storeResults {
@@ -371,6 +382,14 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 				err = e
 			}
 		}
+	case parser.Restore:
+		info, version, e := Restore(ctx, proc.ReferenceScope, stmt.(parser.Restore))
+		if e == nil {
+			proc.Tx.uncommittedViews.SetForUpdatedView(info)
+			proc.Log(fmt.Sprintf("Version %d is restored on %q.", version.Number, info.Path), proc.Tx.Flags.Quiet)
+		} else {
+			err = e
+		}
 	case parser.TransactionControl:
 		switch stmt.(parser.TransactionControl).Token {
 		case parser.COMMIT:
@@ -451,6 +470,14 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 		if printstr, err = ShowFields(ctx, proc.ReferenceScope, stmt.(parser.ShowFields)); err == nil {
 			proc.Log(printstr, false)
 		}
+	case parser.ShowVersions:
+		if printstr, err = ShowVersions(ctx, proc.ReferenceScope, stmt.(parser.ShowVersions)); err == nil {
+			proc.Log(printstr, false)
+		}
+	case parser.Explain:
+		if printstr, err = Explain(ctx, proc.ReferenceScope, stmt.(parser.Explain), proc.streamingWriter() != nil); err == nil {
+			proc.Log(printstr, false)
+		}
 	case parser.Syntax:
 		if printstr, err = Syntax(ctx, proc.ReferenceScope, stmt.(parser.Syntax)); err == nil {
 			proc.Log(printstr, false)
@@ -492,6 +519,44 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 	return flow, err
 }
 
+// streamingWriter returns the writer to which the results of select queries can be streamed.
+// It returns nil if the results must be held in views.
+func (proc *Processor) streamingWriter() io.Writer {
+	if proc.storeResults {
+		return nil
+	}
+
+	if proc.Tx.Session.OutFile() != nil {
+		return proc.Tx.Session.OutFile()
+	}
+	if _, ok := proc.Tx.Session.Stdout().(*Discard); !ok {
+		return proc.Tx.Session.Stdout()
+	}
+	return nil
+}
+
+func (proc *Processor) streamSelect(ctx context.Context, query parser.SelectQuery) (bool, error) {
+	writer := proc.streamingWriter()
+	if writer == nil {
+		return false, nil
+	}
+	writer = &syncWriter{mtx: proc.Tx.Session.mtx, w: writer}
+
+	exportOptions := proc.Tx.Flags.ExportOptions.Copy()
+	streamed, err := StreamSelect(ctx, proc.ReferenceScope, query, writer, exportOptions)
+	if !streamed {
+		return false, nil
+	}
+
+	if err == DataEmpty {
+		err = nil
+	} else if err == nil && !proc.Tx.Flags.ExportOptions.StripEndingLineBreak &&
+		!(proc.Tx.Session.OutFile() != nil && exportOptions.Format == cmd.FIXED && exportOptions.SingleLine) {
+		_, err = writer.Write([]byte(proc.Tx.Flags.ExportOptions.LineBreak.Value()))
+	}
+	return true, err
+}
+
 func (proc *Processor) IfStmt(ctx context.Context, stmt parser.If) (StatementFlow, error) {
 	stmts := make([]parser.ElseIf, 0, len(stmt.ElseIf)+1)
 	stmts = append(stmts, parser.ElseIf{
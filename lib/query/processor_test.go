@@ -665,6 +665,9 @@ var processorExecuteStatementTests = []struct {
 					ForUpdate: true,
 				},
 			},
+			Appended: map[string]int{
+				strings.ToUpper(GetTestFilePath("TABLE1.CSV")): 2,
+			},
 		},
 		Logs: fmt.Sprintf("2 records inserted on %q.\n", GetTestFilePath("table1.csv")),
 	},
@@ -700,6 +703,7 @@ var processorExecuteStatementTests = []struct {
 					ForUpdate: true,
 				},
 			},
+			Appended: map[string]int{},
 		},
 		Logs: fmt.Sprintf("1 record updated on %q.\n", GetTestFilePath("table1.csv")),
 	},
@@ -745,6 +749,7 @@ var processorExecuteStatementTests = []struct {
 					ForUpdate: true,
 				},
 			},
+			Appended: map[string]int{},
 		},
 		Logs: fmt.Sprintf("2 records replaced on %q.\n", GetTestFilePath("table1.csv")),
 	},
@@ -778,6 +783,7 @@ var processorExecuteStatementTests = []struct {
 					ForUpdate: true,
 				},
 			},
+			Appended: map[string]int{},
 		},
 		Logs: fmt.Sprintf("1 record deleted on %q.\n", GetTestFilePath("table1.csv")),
 	},
@@ -801,7 +807,8 @@ var processorExecuteStatementTests = []struct {
 					ForUpdate: true,
 				},
 			},
-			Updated: map[string]*FileInfo{},
+			Updated:  map[string]*FileInfo{},
+			Appended: map[string]int{},
 		},
 		Logs: fmt.Sprintf("file %q is created.\n", GetTestFilePath("newtable.csv")),
 	},
@@ -827,6 +834,7 @@ var processorExecuteStatementTests = []struct {
 					ForUpdate: true,
 				},
 			},
+			Appended: map[string]int{},
 		},
 		Logs: fmt.Sprintf("1 field added on %q.\n", GetTestFilePath("table1.csv")),
 	},
@@ -850,6 +858,7 @@ var processorExecuteStatementTests = []struct {
 					ForUpdate: true,
 				},
 			},
+			Appended: map[string]int{},
 		},
 		Logs: fmt.Sprintf("1 field dropped on %q.\n", GetTestFilePath("table1.csv")),
 	},
@@ -872,6 +881,7 @@ var processorExecuteStatementTests = []struct {
 					ForUpdate: true,
 				},
 			},
+			Appended: map[string]int{},
 		},
 		Logs: fmt.Sprintf("1 field renamed on %q.\n", GetTestFilePath("table1.csv")),
 	},
@@ -903,6 +913,7 @@ var processorExecuteStatementTests = []struct {
 					ForUpdate: true,
 				},
 			},
+			Appended: map[string]int{},
 		},
 		Logs: "\n" +
 			strings.Repeat(" ", (calcShowFieldsWidth("table1.csv", "table1.csv", 22)-(22+len("table1.csv")))/2) + "Attributes Updated in table1.csv\n" +
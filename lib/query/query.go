@@ -2,6 +2,9 @@ package query
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
 	"strings"
 	"sync/atomic"
 
@@ -9,6 +12,8 @@ import (
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
 )
 
 func FetchCursor(ctx context.Context, scope *ReferenceScope, name parser.Identifier, fetchPosition parser.FetchPosition, vars []parser.Variable) (bool, error) {
@@ -114,7 +119,11 @@ func Select(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery
 		intoVars = intoClause.Variables
 	}
 
+	ctx, step := beginRepeatableExecutionStep(ctx, "Select Query", query.String(), describeSelectQuery(query))
+
 	queryScope := scope.CreateNode()
+	queryScope.columnsToLoad = referencedColumns(query)
+	queryScope.partitionFilter = partitionFilter(query)
 
 	if query.WithClause != nil {
 		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
@@ -135,7 +144,13 @@ func Select(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery
 	}
 
 	if query.OrderByClause != nil {
-		if err := view.OrderBy(ctx, queryScope, query.OrderByClause.(parser.OrderByClause)); err != nil {
+		orderByClause := query.OrderByClause.(parser.OrderByClause)
+		if err := executeStep(ctx, "Sort", func(plan *ExecutionPlan) {
+			plan.AddAttribute("Keys", listExpressions(orderByClause.Items))
+			plan.addGoroutines(queryScope.Tx.Flags.CPU)
+		}, view, func(ctx context.Context) error {
+			return view.OrderBy(ctx, queryScope, orderByClause)
+		}); err != nil {
 			queryScope.CloseCurrentNode()
 			return nil, err
 		}
@@ -144,22 +159,35 @@ func Select(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery
 	if query.LimitClause != nil {
 		limitClause := query.LimitClause.(parser.LimitClause)
 		if limitClause.OffsetClause != nil {
-			if err := view.Offset(ctx, queryScope, limitClause.OffsetClause.(parser.OffsetClause)); err != nil {
+			offsetClause := limitClause.OffsetClause.(parser.OffsetClause)
+			if err := executeStep(ctx, "Offset", describeOffset(offsetClause), view, func(ctx context.Context) error {
+				return view.Offset(ctx, queryScope, offsetClause)
+			}); err != nil {
 				queryScope.CloseCurrentNode()
 				return nil, err
 			}
 		}
 
 		if !limitClause.Type.IsEmpty() {
-			if err := view.Limit(ctx, queryScope, limitClause); err != nil {
+			if err := executeStep(ctx, "Limit", describeLimit(limitClause), view, func(ctx context.Context) error {
+				return view.Limit(ctx, queryScope, limitClause)
+			}); err != nil {
 				queryScope.CloseCurrentNode()
 				return nil, err
 			}
 		}
 	}
 
+	if !step.Executes() {
+		queryScope.CloseCurrentNode()
+		return view, nil
+	}
+
 	err = view.Fix(ctx, queryScope.Tx.Flags)
 	queryScope.CloseCurrentNode()
+	if err == nil {
+		step.Finish(view.RecordLen())
+	}
 
 	if err == nil && intoVars != nil {
 		if view.FieldLen() != len(intoVars) {
@@ -201,24 +229,41 @@ func selectEntity(ctx context.Context, scope *ReferenceScope, expr parser.QueryE
 	}
 
 	if entity.WhereClause != nil {
-		if err := view.Where(ctx, scope, entity.WhereClause.(parser.WhereClause)); err != nil {
+		whereClause := entity.WhereClause.(parser.WhereClause)
+		if err := executeStep(ctx, "Filter", describeFilter(whereClause, scope.Tx.Flags.CPU), view, func(ctx context.Context) error {
+			return view.Where(ctx, scope, whereClause)
+		}); err != nil {
 			return nil, err
 		}
 	}
 
 	if entity.GroupByClause != nil {
-		if err := view.GroupBy(ctx, scope, entity.GroupByClause.(parser.GroupByClause)); err != nil {
+		groupByClause := entity.GroupByClause.(parser.GroupByClause)
+		if err := executeStep(ctx, "Group", func(plan *ExecutionPlan) {
+			plan.AddAttribute("Keys", listExpressions(groupByClause.Items))
+			plan.addGoroutines(scope.Tx.Flags.CPU)
+		}, view, func(ctx context.Context) error {
+			return view.GroupBy(ctx, scope, groupByClause)
+		}); err != nil {
 			return nil, err
 		}
 	}
 
 	if entity.HavingClause != nil {
-		if err := view.Having(ctx, scope, entity.HavingClause.(parser.HavingClause)); err != nil {
+		havingClause := entity.HavingClause.(parser.HavingClause)
+		if err := executeStep(ctx, "Having", func(plan *ExecutionPlan) {
+			plan.AddAttribute("Condition", havingClause.Filter.String())
+		}, view, func(ctx context.Context) error {
+			return view.Having(ctx, scope, havingClause)
+		}); err != nil {
 			return nil, err
 		}
 	}
 
-	if err := view.Select(ctx, scope, entity.SelectClause.(parser.SelectClause)); err != nil {
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	if err := executeStep(ctx, "Select", describeSelect(selectClause, scope.Tx.Flags.CPU), view, func(ctx context.Context) error {
+		return view.Select(ctx, scope, selectClause)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -230,20 +275,46 @@ func selectSetEntity(ctx context.Context, scope *ReferenceScope, expr parser.Que
 		return Select(ctx, scope, subquery.Query)
 	}
 
+	var step *executionStep
+	if _, ok := expr.(parser.SelectEntity); ok {
+		ctx, step = beginRepeatableExecutionStep(ctx, "Select Entity", expr.String(), nil)
+	}
+
 	view, err := selectEntity(ctx, scope, expr, forUpdate)
-	if err != nil {
-		return nil, err
+	if err != nil || !step.Executes() {
+		return view, err
+	}
+	if err = view.Fix(ctx, scope.Tx.Flags); err == nil {
+		step.Finish(view.RecordLen())
 	}
-	err = view.Fix(ctx, scope.Tx.Flags)
 	return view, err
 }
 
 func selectSet(ctx context.Context, scope *ReferenceScope, set parser.SelectSet, forUpdate bool) (*View, error) {
+	ctx, step := beginExecutionStep(ctx, "Set Operation", func(plan *ExecutionPlan) {
+		operator := set.Operator.String()
+		if !set.All.IsEmpty() {
+			operator = operator + " " + set.All.String()
+		}
+		plan.AddAttribute("Operator", operator)
+		if scope.RecursiveTable != nil {
+			plan.AddAttribute("Recursive", "true")
+		}
+	})
+
 	lview, err := selectSetEntity(ctx, scope, set.LHS, forUpdate)
 	if err != nil {
 		return nil, err
 	}
 
+	if !step.Executes() {
+		if scope.RecursiveTable != nil {
+			scope.RecursiveTmpView = lview
+		}
+		_, err = selectSetEntity(ctx, scope, set.RHS, forUpdate)
+		return lview, err
+	}
+
 	if scope.RecursiveTable != nil {
 		scope.RecursiveTmpView = nil
 		err := selectSetForRecursion(ctx, scope, lview, set, forUpdate)
@@ -276,7 +347,9 @@ func selectSet(ctx context.Context, scope *ReferenceScope, set parser.SelectSet,
 		}
 	}
 
-	err = lview.SelectAllColumns(ctx, scope)
+	if err = lview.SelectAllColumns(ctx, scope); err == nil {
+		step.Finish(lview.RecordLen())
+	}
 	return lview, err
 }
 
@@ -492,6 +565,12 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 				return nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
 			}
 			updatesList[viewref][internalId].Add(uint(fieldIdx))
+
+			if schema := viewsToUpdate[viewref].FileInfo.Schema; schema != nil {
+				if val, err = schema.ConvertValue(uset.Value, viewsToUpdate[viewref].Header[fieldIdx].Column, val, queryScope.Tx.Flags.DatetimeFormat); err != nil {
+					return nil, nil, err
+				}
+			}
 			viewsToUpdate[viewref].RecordSet[internalId][fieldIdx] = NewCell(val)
 		}
 	}
@@ -682,11 +761,19 @@ func CreateTable(ctx context.Context, scope *ReferenceScope, query parser.Create
 
 	var view *View
 
+	columns, schema, err := columnDefinitions(query.Fields)
+	if err != nil {
+		return nil, err
+	}
+
 	flags := queryScope.Tx.Flags
 	fileInfo, err := NewFileInfoForCreate(query.Table, flags.Repository, flags.ExportOptions.Delimiter, flags.ExportOptions.Encoding)
 	if err != nil {
 		return nil, err
 	}
+	if err = queryScope.Tx.recoverJournalsInDirectory(filepath.Dir(fileInfo.Path)); err != nil {
+		return nil, err
+	}
 	h, err := file.NewHandlerForCreate(queryScope.Tx.FileContainer, fileInfo.Path)
 	if err != nil {
 		query.Table.Literal = fileInfo.Path
@@ -706,20 +793,32 @@ func CreateTable(ctx context.Context, scope *ReferenceScope, query parser.Create
 			return nil, appendCompositeError(err, queryScope.Tx.FileContainer.Close(fileInfo.Handler))
 		}
 
-		if err = view.Header.Update(parser.FormatTableName(fileInfo.Path), query.Fields); err != nil {
+		if err = view.Header.Update(parser.FormatTableName(fileInfo.Path), columns); err != nil {
 			if _, ok := err.(*FieldLengthNotMatchError); ok {
 				err = NewTableFieldLengthError(query.Query.(parser.SelectQuery), query.Table, len(query.Fields))
 			}
 			return nil, appendCompositeError(err, queryScope.Tx.FileContainer.Close(fileInfo.Handler))
 		}
+
+		if schema != nil {
+			for i := range view.RecordSet {
+				for j := range view.Header {
+					p, err := schema.ConvertValue(columns[j], view.Header[j].Column, view.RecordSet[i][j][0], flags.DatetimeFormat)
+					if err != nil {
+						return nil, appendCompositeError(err, queryScope.Tx.FileContainer.Close(fileInfo.Handler))
+					}
+					view.RecordSet[i][j] = NewCell(p)
+				}
+			}
+		}
 	} else {
-		fields := make([]string, len(query.Fields))
-		fieldsMap := make(map[string]bool, len(query.Fields))
-		for i := range query.Fields {
-			lit := query.Fields[i].(parser.Identifier).Literal
+		fields := make([]string, len(columns))
+		fieldsMap := make(map[string]bool, len(columns))
+		for i := range columns {
+			lit := columns[i].(parser.Identifier).Literal
 			ulit := strings.ToUpper(lit)
 			if _, ok := fieldsMap[ulit]; ok {
-				err = NewDuplicateFieldNameError(query.Fields[i].(parser.Identifier))
+				err = NewDuplicateFieldNameError(columns[i].(parser.Identifier))
 				return nil, appendCompositeError(err, queryScope.Tx.FileContainer.Close(fileInfo.Handler))
 			}
 			fields[i] = lit
@@ -732,6 +831,7 @@ func CreateTable(ctx context.Context, scope *ReferenceScope, query parser.Create
 		}
 	}
 
+	fileInfo.Schema = schema
 	view.FileInfo = fileInfo
 
 	scope.Tx.cachedViews.Set(view)
@@ -739,6 +839,180 @@ func CreateTable(ctx context.Context, scope *ReferenceScope, query parser.Create
 	return view.FileInfo, nil
 }
 
+// columnDefinitions returns the column names and the declared column types in a CREATE TABLE statement.
+// The schema is nil if no column type is declared.
+func columnDefinitions(fields []parser.QueryExpression) ([]parser.QueryExpression, TableSchema, error) {
+	if fields == nil {
+		return nil, nil, nil
+	}
+
+	var schema TableSchema
+	columns := make([]parser.QueryExpression, len(fields))
+	for i := range fields {
+		def, ok := fields[i].(parser.ColumnDefinition)
+		if !ok {
+			columns[i] = fields[i]
+			continue
+		}
+
+		t, err := ParseColumnType(def.Type.Literal)
+		if err != nil {
+			return nil, nil, NewInvalidColumnTypeError(def.Type)
+		}
+		if schema == nil {
+			schema = make(TableSchema, 0, len(fields))
+		}
+		schema = append(schema, ColumnSchema{Name: def.Column.Literal, Type: t, NotNull: def.NotNull})
+		columns[i] = def.Column
+	}
+	return columns, schema, nil
+}
+
+// SelectIntoOutfile creates the file specified by the INTO OUTFILE clause of a select query.
+// The result set is written to the file when the transaction is committed.
+func SelectIntoOutfile(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery) (*FileInfo, error) {
+	outfile := query.OutfileClause.(parser.OutfileClause)
+	query.OutfileClause = nil
+
+	var fpath string
+	if ident, ok := outfile.Path.(parser.Identifier); ok {
+		fpath = ident.Literal
+	} else {
+		p, err := Evaluate(ctx, scope, outfile.Path)
+		if err != nil {
+			return nil, err
+		}
+		s := value.ToString(p)
+		if value.IsNull(s) {
+			return nil, NewOutfileInvalidFilePathError(outfile)
+		}
+		fpath = s.(*value.String).Raw()
+		value.Discard(s)
+	}
+
+	if len(fpath) < 1 {
+		return nil, NewOutfileInvalidFilePathError(outfile)
+	}
+
+	flags := scope.Tx.Flags
+	filename := parser.Identifier{BaseExpr: outfile.Path.GetBaseExpr(), Literal: fpath}
+	fileInfo, err := NewFileInfoForCreate(filename, flags.Repository, flags.ExportOptions.Delimiter, flags.ExportOptions.Encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := outfileExportOptions(ctx, scope, fileInfo, outfile.Options)
+	if err != nil {
+		return nil, err
+	}
+	fileInfo.Format = options.Format
+	fileInfo.Delimiter = options.Delimiter
+	fileInfo.DelimiterPositions = options.DelimiterPositions
+	fileInfo.SingleLine = options.SingleLine
+	fileInfo.Encoding = options.Encoding
+	fileInfo.JsonEscape = options.JsonEscape
+	fileInfo.LineBreak = options.LineBreak
+	fileInfo.EncloseAll = options.EncloseAll
+	fileInfo.NoHeader = options.WithoutHeader
+	fileInfo.PrettyPrint = options.PrettyPrint
+	fileInfo.ForUpdate = true
+
+	view, err := Select(ctx, scope, query)
+	if err != nil {
+		return nil, err
+	}
+	if err = view.Header.Update(parser.FormatTableName(fileInfo.Path), nil); err != nil {
+		return nil, err
+	}
+
+	if err = scope.Tx.recoverJournalsInDirectory(filepath.Dir(fileInfo.Path)); err != nil {
+		return nil, err
+	}
+	h, err := file.NewHandlerForCreate(scope.Tx.FileContainer, fileInfo.Path)
+	if err != nil {
+		filename.Literal = fileInfo.Path
+		return nil, ConvertFileHandlerError(err, filename)
+	}
+	fileInfo.Handler = h
+
+	view.FileInfo = fileInfo
+	scope.Tx.cachedViews.Set(view)
+
+	return view.FileInfo, nil
+}
+
+// outfileExportOptions returns the export options overwritten by the options in an INTO OUTFILE clause.
+// The format, the delimiter and the encoding not specified by the options are determined by the file extension.
+func outfileExportOptions(ctx context.Context, scope *ReferenceScope, fileInfo *FileInfo, options []parser.QueryExpression) (cmd.ExportOptions, error) {
+	ops := scope.Tx.Flags.ExportOptions.Copy()
+	ops.Format = fileInfo.Format
+	ops.Delimiter = fileInfo.Delimiter
+	ops.Encoding = fileInfo.Encoding
+
+	var delimiterOption *parser.OutfileOption
+	var encodingOption *parser.OutfileOption
+
+	for i := range options {
+		option := options[i].(parser.OutfileOption)
+
+		var s string
+		if ident, ok := option.Value.(parser.Identifier); ok {
+			s = ident.Literal
+		} else {
+			p, err := Evaluate(ctx, scope, option.Value)
+			if err != nil {
+				return ops, err
+			}
+			sv := value.ToString(p)
+			if value.IsNull(sv) {
+				return ops, NewInvalidOutfileOptionValueError(option, fmt.Sprintf("%s for %s is not allowed", option.Value, option.Name))
+			}
+			s = sv.(*value.String).Raw()
+			value.Discard(sv)
+		}
+
+		var err error
+		switch strings.ToUpper(option.Name.Literal) {
+		case TableFormat:
+			ops.Format, ops.JsonEscape, err = cmd.ParseFormat(s, ops.JsonEscape)
+		case TableEncoding:
+			ops.Encoding, err = cmd.ParseEncoding(s)
+			if err != nil || ops.Encoding == text.AUTO {
+				err = errors.New("encoding must be one of UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS")
+			}
+			encodingOption = &option
+		case TableDelimiter:
+			ops.Delimiter, err = cmd.ParseDelimiter(s)
+			delimiterOption = &option
+		case "WITHOUT":
+			if !strings.EqualFold(s, TableHeader) {
+				err = errors.New(fmt.Sprintf("%s for %s is not allowed", option.Value, option.Name))
+			}
+			ops.WithoutHeader = true
+		default:
+			return ops, NewInvalidOutfileOptionNameError(option)
+		}
+		if err != nil {
+			return ops, NewInvalidOutfileOptionValueError(option, err.Error())
+		}
+	}
+
+	switch ops.Format {
+	case cmd.TSV:
+		if delimiterOption == nil {
+			ops.Delimiter = '\t'
+		}
+	case cmd.JSON, cmd.JSONL, cmd.XLSX, cmd.YAML, cmd.XML:
+		if encodingOption == nil {
+			ops.Encoding = text.UTF8
+		} else if ops.Encoding != text.UTF8 {
+			return ops, NewInvalidOutfileOptionValueError(*encodingOption, fmt.Sprintf("%s format is supported only UTF8", strings.ToLower(ops.Format.String())))
+		}
+	}
+
+	return ops, nil
+}
+
 func AddColumns(ctx context.Context, scope *ReferenceScope, query parser.AddColumns) (*FileInfo, int, error) {
 	queryScope := scope.CreateNode()
 	defer queryScope.CloseCurrentNode()
@@ -928,6 +1202,7 @@ func RenameColumn(ctx context.Context, scope *ReferenceScope, query parser.Renam
 		return nil, err
 	}
 
+	view.FileInfo.Schema = view.FileInfo.Schema.Rename(view.Header[idx].Column, query.New.Literal)
 	view.Header[idx].Column = query.New.Literal
 
 	if !view.FileInfo.IsFile() {
@@ -939,6 +1214,105 @@ func RenameColumn(ctx context.Context, scope *ReferenceScope, query parser.Renam
 	return view.FileInfo, err
 }
 
+// Restore replaces the records of the table with the contents of a version kept in the history directory.
+func Restore(ctx context.Context, scope *ReferenceScope, query parser.Restore) (*FileInfo, file.Version, error) {
+	queryScope := scope.CreateNode()
+	defer queryScope.CloseCurrentNode()
+
+	queryScope.Tx.operationMutex.Lock()
+	defer queryScope.Tx.operationMutex.Unlock()
+
+	var version file.Version
+
+	view, err := LoadViewFromTableIdentifier(ctx, queryScope, query.Table, true, false)
+	if err != nil {
+		return nil, version, err
+	}
+	if !view.FileInfo.IsFile() {
+		return nil, version, NewVersionNotAvailableError(query.Table)
+	}
+
+	version, err = selectVersion(ctx, queryScope, query, view.FileInfo.Path)
+	if err != nil {
+		return nil, version, err
+	}
+
+	fp, err := file.OpenVersion(version)
+	if err != nil {
+		return nil, version, NewIOError(query.Table, err.Error())
+	}
+	defer func() {
+		_ = fp.Close()
+	}()
+
+	fileInfo := *view.FileInfo
+	restored, err := loadViewFromFile(ctx, scope.Tx.Flags, fp, &fileInfo, scope.Tx.Flags.ImportOptions.WithoutNull, query.Table)
+	if err != nil {
+		if _, ok := err.(Error); !ok {
+			err = NewDataParsingError(query.Table, version.Path, err.Error())
+		}
+		return nil, version, err
+	}
+
+	schema, err := LoadTableSchema(view.FileInfo.Path)
+	if err == nil {
+		err = applyTableSchema(restored, schema, scope.Tx.Flags.DatetimeFormat)
+	}
+	if err != nil {
+		return nil, version, NewDataParsingError(query.Table, version.Path, err.Error())
+	}
+
+	if 0 < len(fileInfo.MalformedRecords) {
+		return nil, version, NewReadOnlyMalformedTableError(parser.Identifier{BaseExpr: query.Table.GetBaseExpr(), Literal: view.FileInfo.Path}, len(fileInfo.MalformedRecords))
+	}
+
+	restored.FileInfo = view.FileInfo
+	scope.Tx.cachedViews.Set(restored)
+
+	return view.FileInfo, version, nil
+}
+
+// selectVersion returns the version specified by a version number or a datetime.
+// A datetime specifies the version that holds the contents of the file at the time,
+// that is the oldest version replaced after the time.
+func selectVersion(ctx context.Context, scope *ReferenceScope, query parser.Restore, path string) (file.Version, error) {
+	var version file.Version
+
+	p, err := Evaluate(ctx, scope, query.Version)
+	if err != nil {
+		return version, err
+	}
+
+	versions, err := file.Versions(path)
+	if err != nil {
+		return version, NewIOError(query.Table, err.Error())
+	}
+
+	if i := value.ToInteger(p); !value.IsNull(i) {
+		n := i.(*value.Integer).Raw()
+		value.Discard(i)
+
+		if n < 1 || int64(len(versions)) < n {
+			return version, NewVersionNotExistError(query.Version, query.Version.String(), path)
+		}
+		return versions[n-1], nil
+	}
+
+	if dt := value.ToDatetime(p, scope.Tx.Flags.DatetimeFormat); !value.IsNull(dt) {
+		t := dt.(*value.Datetime).Raw()
+		value.Discard(dt)
+
+		for i := len(versions) - 1; 0 <= i; i-- {
+			if versions[i].Time.After(t) {
+				return versions[i], nil
+			}
+		}
+		return version, NewVersionNotExistError(query.Version, query.Version.String(), path)
+	}
+
+	return version, NewInvalidVersionError(query.Version, query.Version.String())
+}
+
 func SetTableAttribute(ctx context.Context, scope *ReferenceScope, query parser.SetTableAttribute) (*FileInfo, string, error) {
 	var log string
 
@@ -969,7 +1343,8 @@ func SetTableAttribute(ctx context.Context, scope *ReferenceScope, query parser.
 	fileInfo := view.FileInfo
 	attr := strings.ToUpper(query.Attribute.Literal)
 	switch attr {
-	case TableDelimiter, TableDelimiterPositions, TableFormat, TableEncoding, TableLineBreak, TableJsonEscape:
+	case TableDelimiter, TableDelimiterPositions, TableFormat, TableEncoding, TableLineBreak, TableJsonEscape,
+		TableQuoteChar, TableEscapeStyle, TableCommentPrefix:
 		s := value.ToString(p)
 		if value.IsNull(s) {
 			return nil, log, NewTableAttributeValueNotAllowedFormatError(query)
@@ -987,9 +1362,15 @@ func SetTableAttribute(ctx context.Context, scope *ReferenceScope, query parser.
 			err = fileInfo.SetLineBreak(s.(*value.String).Raw())
 		case TableJsonEscape:
 			err = fileInfo.SetJsonEscape(s.(*value.String).Raw())
+		case TableQuoteChar:
+			err = fileInfo.SetQuoteChar(s.(*value.String).Raw())
+		case TableEscapeStyle:
+			err = fileInfo.SetEscapeStyle(s.(*value.String).Raw())
+		case TableCommentPrefix:
+			err = fileInfo.SetCommentPrefix(s.(*value.String).Raw())
 		}
 		value.Discard(s)
-	case TableHeader, TableEncloseAll, TablePrettyPrint:
+	case TableHeader, TableEncloseAll, TablePrettyPrint, TableTrimSpace:
 		b := value.ToBoolean(p)
 		if value.IsNull(b) {
 			return nil, log, NewTableAttributeValueNotAllowedFormatError(query)
@@ -1001,6 +1382,8 @@ func SetTableAttribute(ctx context.Context, scope *ReferenceScope, query parser.
 			err = fileInfo.SetEncloseAll(b.(*value.Boolean).Raw())
 		case TablePrettyPrint:
 			err = fileInfo.SetPrettyPrint(b.(*value.Boolean).Raw())
+		case TableTrimSpace:
+			err = fileInfo.SetTrimSpace(b.(*value.Boolean).Raw())
 		}
 	default:
 		return nil, log, NewInvalidTableAttributeNameError(query.Attribute)
@@ -507,6 +507,138 @@ var selectTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Select Glob Pattern Group By File",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "__FILE__"}}},
+						parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "glob_[0-9].csv"}, Alias: parser.Identifier{Literal: "t"}},
+					},
+				},
+				GroupByClause: parser.GroupByClause{
+					Items: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "__FILE__"}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{
+					View:        "t",
+					Column:      "__FILE__",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					Column:      "COUNT(*)",
+					Number:      2,
+					IsFromTable: true,
+				},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString(GetTestFilePath("glob_1.csv")),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString(GetTestFilePath("glob_2.csv")),
+					value.NewInteger(1),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Select Partitioned Directory",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "partitioned"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Logic{
+						LHS: parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "year"}},
+							RHS:      parser.NewIntegerValueFromString("2026"),
+							Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "="},
+						},
+						RHS: parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "amount"}},
+							RHS:      parser.NewIntegerValueFromString("200"),
+							Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+						},
+						Operator: parser.Token{Token: parser.AND, Literal: "and"},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "partitioned", Column: "id", Number: 1, IsFromTable: true},
+				{View: "partitioned", Column: "amount", Number: 2, IsFromTable: true},
+				{View: "partitioned", Column: "year", Number: 3, IsFromTable: true},
+				{View: "partitioned", Column: "month", Number: 4, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("300"),
+					value.NewString("2026"),
+					value.NewString("01"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("4"),
+					value.NewString("400"),
+					value.NewString("2026"),
+					value.NewString("02"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Select Partitioned Directory All Partitions Pruned",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "id"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "partitioned"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "month"}},
+						RHS:      parser.NewStringValue("03"),
+						Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "="},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "partitioned", Column: "id", Number: 1, IsFromTable: true},
+			},
+			RecordSet: []Record{},
+		},
+	},
 	{
 		Name: "Select Replace Fields",
 		Query: parser.SelectQuery{
@@ -3124,6 +3256,84 @@ var createTableTests = []struct {
 			},
 		}),
 	},
+	{
+		Name: "Create Table With Column Types",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.ColumnDefinition{Column: parser.Identifier{Literal: "column1"}, Type: parser.Identifier{Literal: "integer"}, NotNull: true},
+				parser.Identifier{Literal: "column2"},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewStringValue("1")},
+							parser.Field{Object: parser.NewStringValue("2")},
+						},
+					},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("create_table_1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+			Schema:    TableSchema{{Name: "column1", Type: IntegerColumn, NotNull: true}},
+		},
+		ViewCache: GenerateViewMap([]*View{
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("create_table_1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					ForUpdate: true,
+					Schema:    TableSchema{{Name: "column1", Type: IntegerColumn, NotNull: true}},
+				},
+				Header: NewHeader("create_table_1", []string{"column1", "column2"}),
+				RecordSet: RecordSet{
+					NewRecord([]value.Primary{
+						value.NewInteger(1),
+						value.NewString("2"),
+					}),
+				},
+			},
+		}),
+	},
+	{
+		Name: "Create Table With Column Types Conversion Error",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.ColumnDefinition{Column: parser.Identifier{Literal: "column1"}, Type: parser.Identifier{Literal: "integer"}},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewStringValue("a")},
+						},
+					},
+				},
+			},
+		},
+		Error: "value 'a' cannot be stored in column column1 of type INTEGER",
+	},
+	{
+		Name: "Create Table Invalid Column Type Error",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.ColumnDefinition{Column: parser.Identifier{Literal: "column1"}, Type: parser.Identifier{Literal: "varchar"}},
+			},
+		},
+		Error: "varchar is not a valid column type, must be one of STRING|INTEGER|FLOAT|BOOLEAN|DATETIME",
+	},
 	{
 		Name: "Create Table File Already Exist Error",
 		Query: parser.CreateTable{
@@ -3265,6 +3475,204 @@ func TestCreateTable(t *testing.T) {
 	}
 }
 
+var selectIntoOutfileTests = []struct {
+	Name       string
+	Query      parser.SelectQuery
+	ResultFile *FileInfo
+	Error      string
+}{
+	{
+		Name: "Select Into Outfile",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.NewIntegerValueFromString("1")},
+						parser.Field{Object: parser.NewIntegerValueFromString("2")},
+					},
+				},
+			},
+			OutfileClause: parser.OutfileClause{
+				Path: parser.NewStringValue("outfile_1.csv"),
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("outfile_1.csv"),
+			Format:    cmd.CSV,
+			Delimiter: ',',
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+	},
+	{
+		Name: "Select Into Outfile with Options",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.NewIntegerValueFromString("1")},
+					},
+				},
+			},
+			OutfileClause: parser.OutfileClause{
+				Path: parser.Identifier{Literal: "outfile_1.txt"},
+				Options: []parser.QueryExpression{
+					parser.OutfileOption{Name: parser.Identifier{Literal: "format"}, Value: parser.Identifier{Literal: "tsv"}},
+					parser.OutfileOption{Name: parser.Identifier{Literal: "encoding"}, Value: parser.NewStringValue("sjis")},
+					parser.OutfileOption{Name: parser.Identifier{Literal: "without"}, Value: parser.Identifier{Literal: "header"}},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("outfile_1.txt"),
+			Format:    cmd.TSV,
+			Delimiter: '\t',
+			Encoding:  text.SJIS,
+			LineBreak: text.LF,
+			NoHeader:  true,
+			ForUpdate: true,
+		},
+	},
+	{
+		Name: "Select Into Outfile Format JSON with Delimiter",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.NewIntegerValueFromString("1")},
+					},
+				},
+			},
+			OutfileClause: parser.OutfileClause{
+				Path: parser.NewStringValue("outfile_1.txt"),
+				Options: []parser.QueryExpression{
+					parser.OutfileOption{Name: parser.Identifier{Literal: "delimiter"}, Value: parser.NewStringValue(";")},
+					parser.OutfileOption{Name: parser.Identifier{Literal: "format"}, Value: parser.Identifier{Literal: "json"}},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("outfile_1.txt"),
+			Format:    cmd.JSON,
+			Delimiter: ';',
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+	},
+	{
+		Name: "Select Into Outfile Invalid File Path Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.NewIntegerValueFromString("1")},
+					},
+				},
+			},
+			OutfileClause: parser.OutfileClause{
+				Path: parser.NewNullValue(),
+			},
+		},
+		Error: "NULL is a invalid file path",
+	},
+	{
+		Name: "Select Into Outfile Invalid Option Name Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.NewIntegerValueFromString("1")},
+					},
+				},
+			},
+			OutfileClause: parser.OutfileClause{
+				Path: parser.NewStringValue("outfile_1.csv"),
+				Options: []parser.QueryExpression{
+					parser.OutfileOption{Name: parser.Identifier{Literal: "compression"}, Value: parser.Identifier{Literal: "gzip"}},
+				},
+			},
+		},
+		Error: "outfile option compression does not exist",
+	},
+	{
+		Name: "Select Into Outfile Invalid Option Value Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.NewIntegerValueFromString("1")},
+					},
+				},
+			},
+			OutfileClause: parser.OutfileClause{
+				Path: parser.NewStringValue("outfile_1.json"),
+				Options: []parser.QueryExpression{
+					parser.OutfileOption{Name: parser.Identifier{Literal: "encoding"}, Value: parser.Identifier{Literal: "sjis"}},
+				},
+			},
+		},
+		Error: "json format is supported only UTF8",
+	},
+	{
+		Name: "Select Into Outfile File Already Exist Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.NewIntegerValueFromString("1")},
+					},
+				},
+			},
+			OutfileClause: parser.OutfileClause{
+				Path: parser.NewStringValue("table1.csv"),
+			},
+		},
+		Error: fmt.Sprintf("file %s already exists", GetTestFilePath("table1.csv")),
+	},
+}
+
+func TestSelectIntoOutfile(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+
+	scope := NewReferenceScope(TestTx)
+	ctx := context.Background()
+	for _, v := range selectIntoOutfileTests {
+		_ = TestTx.ReleaseResources()
+
+		result, err := SelectIntoOutfile(ctx, scope, v.Query)
+
+		if result != nil {
+			_ = TestTx.FileContainer.Close(result.Handler)
+			result.Handler = nil
+		}
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if !reflect.DeepEqual(result, v.ResultFile) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.ResultFile)
+		}
+	}
+}
+
 var addColumnsTests = []struct {
 	Name         string
 	Query        parser.AddColumns
@@ -4108,6 +4516,215 @@ func TestRenameColumn(t *testing.T) {
 	}
 }
 
+var restoreTests = []struct {
+	Name      string
+	Query     parser.Restore
+	Result    *FileInfo
+	Version   int
+	ViewCache ViewMap
+	Error     string
+}{
+	{
+		Name: "Restore",
+		Query: parser.Restore{
+			Table:   parser.Identifier{Literal: "restore"},
+			Version: parser.NewIntegerValue(1),
+		},
+		Result: &FileInfo{
+			Path:      GetTestFilePath("restore.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+		Version: 1,
+		ViewCache: GenerateViewMap([]*View{
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("restore.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					ForUpdate: true,
+				},
+				Header: NewHeader("restore", []string{"column1", "column2b"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str2b"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("str3b"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("4"),
+						value.NewString("str4b"),
+					}),
+				},
+			},
+		}),
+	},
+	{
+		Name: "Restore To Datetime",
+		Query: parser.Restore{
+			Table:   parser.Identifier{Literal: "restore"},
+			Version: parser.NewStringValue("2012-01-31 12:00:00"),
+		},
+		Result: &FileInfo{
+			Path:      GetTestFilePath("restore.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+		Version: 2,
+		ViewCache: GenerateViewMap([]*View{
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("restore.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					ForUpdate: true,
+				},
+				Header: NewHeader("restore", []string{"column3", "column4"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str22"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("str33"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("4"),
+						value.NewString("str44"),
+					}),
+				},
+			},
+		}),
+	},
+	{
+		Name: "Restore To Datetime After All Versions",
+		Query: parser.Restore{
+			Table:   parser.Identifier{Literal: "restore"},
+			Version: parser.NewStringValue("2012-02-02 12:00:00"),
+		},
+		Error: fmt.Sprintf("version '2012-02-02 12:00:00' of %s does not exist", GetTestFilePath("restore.csv")),
+	},
+	{
+		Name: "Restore Version Does Not Exist Error",
+		Query: parser.Restore{
+			Table:   parser.Identifier{Literal: "restore"},
+			Version: parser.NewIntegerValue(3),
+		},
+		Error: fmt.Sprintf("version 3 of %s does not exist", GetTestFilePath("restore.csv")),
+	},
+	{
+		Name: "Restore Invalid Version Error",
+		Query: parser.Restore{
+			Table:   parser.Identifier{Literal: "restore"},
+			Version: parser.NewStringValue("abc"),
+		},
+		Error: "version 'abc' is invalid, must be an integer or a datetime",
+	},
+	{
+		Name: "Restore Temporary Table Error",
+		Query: parser.Restore{
+			Table:   parser.Identifier{Literal: "tmpview"},
+			Version: parser.NewIntegerValue(1),
+		},
+		Error: "versions of tmpview are not available",
+	},
+	{
+		Name: "Restore Load Error",
+		Query: parser.Restore{
+			Table:   parser.Identifier{Literal: "notexist"},
+			Version: parser.NewIntegerValue(1),
+		},
+		Error: "file notexist does not exist",
+	},
+}
+
+func TestRestore(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	TestTx.Flags.Quiet = false
+
+	scope := GenerateReferenceScope([]map[string]map[string]interface{}{
+		{
+			scopeNameTempTables: {
+				"TMPVIEW": &View{
+					Header: NewHeader("tmpview", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						NewRecord([]value.Primary{
+							value.NewString("1"),
+							value.NewString("str1"),
+						}),
+					},
+					FileInfo: &FileInfo{
+						Path:      "tmpview",
+						Delimiter: ',',
+						ViewType:  ViewTypeTemporaryTable,
+					},
+				},
+			},
+		},
+	}, nil, time.Time{}, nil)
+
+	ctx := context.Background()
+	for _, v := range restoreTests {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		result, version, err := Restore(ctx, scope, v.Query)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		TestTx.cachedViews.Range(func(key, value interface{}) bool {
+			view := value.(*View)
+			if view.FileInfo.Handler != nil {
+				if view.FileInfo.Path != view.FileInfo.Handler.Path() {
+					t.Errorf("file pointer = %q, want %q for %q", view.FileInfo.Handler.Path(), view.FileInfo.Path, v.Name)
+				}
+				_ = TestTx.FileContainer.Close(view.FileInfo.Handler)
+				view.FileInfo.Handler = nil
+			}
+			return true
+		})
+
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+		if version.Number != v.Version {
+			t.Errorf("%s: version = %d, want %d", v.Name, version.Number, v.Version)
+		}
+		if !SyncMapEqual(TestTx.cachedViews, v.ViewCache) {
+			t.Errorf("%s: view cache = %v, want %v", v.Name, TestTx.cachedViews, v.ViewCache)
+		}
+	}
+}
+
 var setTableAttributeTests = []struct {
 	Name   string
 	Query  parser.SetTableAttribute
@@ -4266,7 +4883,7 @@ var setTableAttributeTests = []struct {
 			Attribute: parser.Identifier{Literal: "format"},
 			Value:     parser.NewStringValue("invalid"),
 		},
-		Error: "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT",
+		Error: "format must be one of CSV|TSV|FIXED|JSON|LTSV|JSONL|XLSX|YAML|XML|SQL|HTML|GFM|ORG|TEXT",
 	},
 	{
 		Name: "Set Encoding to SJIS",
@@ -4386,6 +5003,83 @@ var setTableAttributeTests = []struct {
 			ForUpdate:  true,
 		},
 	},
+	{
+		Name: "Set QuoteChar",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table1.csv"},
+			Attribute: parser.Identifier{Literal: "quote_char"},
+			Value:     parser.NewStringValue("'"),
+		},
+		Expect: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			QuoteChar: '\'',
+			ForUpdate: true,
+		},
+	},
+	{
+		Name: "Set QuoteChar Error",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table1.csv"},
+			Attribute: parser.Identifier{Literal: "quote_char"},
+			Value:     parser.NewStringValue("''"),
+		},
+		Error: "quote character must be one character",
+	},
+	{
+		Name: "Set EscapeStyle to BACKSLASH",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table1.csv"},
+			Attribute: parser.Identifier{Literal: "escape_style"},
+			Value:     parser.NewStringValue("backslash"),
+		},
+		Expect: &FileInfo{
+			Path:        GetTestFilePath("table1.csv"),
+			Delimiter:   ',',
+			Format:      cmd.CSV,
+			Encoding:    text.UTF8,
+			LineBreak:   text.LF,
+			EscapeStyle: cmd.BackslashEscape,
+			ForUpdate:   true,
+		},
+	},
+	{
+		Name: "Set CommentPrefix",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table1.csv"},
+			Attribute: parser.Identifier{Literal: "comment_prefix"},
+			Value:     parser.NewStringValue("#"),
+		},
+		Expect: &FileInfo{
+			Path:          GetTestFilePath("table1.csv"),
+			Delimiter:     ',',
+			Format:        cmd.CSV,
+			Encoding:      text.UTF8,
+			LineBreak:     text.LF,
+			CommentPrefix: "#",
+			ForUpdate:     true,
+		},
+	},
+	{
+		Name: "Set TrimSpace to true",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table1.csv"},
+			Attribute: parser.Identifier{Literal: "trim_space"},
+			Value:     parser.NewStringValue("true"),
+		},
+		Expect: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			Format:    cmd.CSV,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			TrimSpace: true,
+			ForUpdate: true,
+		},
+	},
 	{
 		Name: "Set JsonEscape to HEX",
 		Query: parser.SetTableAttribute{
@@ -168,6 +168,14 @@ type ReferenceScope struct {
 	RecursiveTable   *parser.InlineTable
 	RecursiveTmpView *View
 	RecursiveCount   *int64
+
+	// columnsToLoad holds the names of the columns referred to by the current select query.
+	// It is used to load only the required columns from files in columnar formats.
+	columnsToLoad []string
+
+	// partitionFilter holds the filter of the where clause of the current select query.
+	// It is used to skip the partitions of a partitioned directory that cannot match the query.
+	partitionFilter parser.QueryExpression
 }
 
 func NewReferenceScope(tx *Transaction) *ReferenceScope {
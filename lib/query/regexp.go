@@ -0,0 +1,101 @@
+package query
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const RegExpCacheSize = 256
+
+var regExpCache = NewRegExpCache(RegExpCacheSize)
+
+// RegExpCache holds compiled regular expressions so that a pattern
+// evaluated for every record is compiled only once.
+// When the cache is full, the least recently used expression is evicted.
+type RegExpCache struct {
+	size  int
+	m     map[string]*list.Element
+	order *list.List
+	mtx   *sync.Mutex
+}
+
+type regExpCacheEntry struct {
+	key string
+	re  *regexp.Regexp
+}
+
+func NewRegExpCache(size int) *RegExpCache {
+	return &RegExpCache{
+		size:  size,
+		m:     make(map[string]*list.Element, size),
+		order: list.New(),
+		mtx:   &sync.Mutex{},
+	}
+}
+
+func (c *RegExpCache) Get(pattern string, flags string) (*regexp.Regexp, error) {
+	key := flags + ":" + pattern
+
+	if re, ok := c.load(key); ok {
+		return re, nil
+	}
+
+	re, err := CompileRegExp(pattern, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.m[key]; ok {
+		c.order.MoveToFront(e)
+		return e.Value.(*regExpCacheEntry).re, nil
+	}
+	c.m[key] = c.order.PushFront(&regExpCacheEntry{key: key, re: re})
+	if c.size < c.order.Len() {
+		e := c.order.Back()
+		c.order.Remove(e)
+		delete(c.m, e.Value.(*regExpCacheEntry).key)
+	}
+	return re, nil
+}
+
+func (c *RegExpCache) load(key string) (*regexp.Regexp, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	e, ok := c.m[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*regExpCacheEntry).re, true
+}
+
+// CompileRegExp compiles a pattern with flags.
+// Available flags are "i" (case-insensitive), "m" (multi-line mode),
+// "s" (let . match \n) and "U" (ungreedy).
+func CompileRegExp(pattern string, flags string) (*regexp.Regexp, error) {
+	for _, r := range flags {
+		switch r {
+		case 'i', 'm', 's', 'U':
+		default:
+			return nil, errors.New(fmt.Sprintf("invalid regular expression flag %q", string(r)))
+		}
+	}
+
+	if 0 < len(flags) {
+		pattern = "(?" + flags + ")" + pattern
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("invalid regular expression: %s", strings.TrimPrefix(err.Error(), "error parsing regexp: ")))
+	}
+	return re, nil
+}
@@ -0,0 +1,94 @@
+package query
+
+import (
+	"testing"
+)
+
+var regExpCacheGetTests = []struct {
+	Name    string
+	Pattern string
+	Flags   string
+	Input   string
+	Match   bool
+	Error   string
+}{
+	{
+		Name:    "RegExpCache Get",
+		Pattern: "^a.c$",
+		Input:   "abc",
+		Match:   true,
+	},
+	{
+		Name:    "RegExpCache Get with Flags",
+		Pattern: "^a.c$",
+		Flags:   "is",
+		Input:   "A\nC",
+		Match:   true,
+	},
+	{
+		Name:    "RegExpCache Get Invalid Flag Error",
+		Pattern: "^a.c$",
+		Flags:   "g",
+		Error:   "invalid regular expression flag \"g\"",
+	},
+	{
+		Name:    "RegExpCache Get Invalid Pattern Error",
+		Pattern: "[a-",
+		Error:   "invalid regular expression: missing closing ]: `[a-`",
+	},
+}
+
+func TestRegExpCache_Get(t *testing.T) {
+	cache := NewRegExpCache(2)
+
+	for _, v := range regExpCacheGetTests {
+		re, err := cache.Get(v.Pattern, v.Flags)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if re.MatchString(v.Input) != v.Match {
+			t.Errorf("%s: match = %t, want %t", v.Name, !v.Match, v.Match)
+		}
+
+		cached, _ := cache.Get(v.Pattern, v.Flags)
+		if cached != re {
+			t.Errorf("%s: compiled expression is not cached", v.Name)
+		}
+	}
+
+	_, _ = cache.Get("x", "")
+	if 2 < len(cache.m) {
+		t.Errorf("cache size = %d, want at most %d", len(cache.m), 2)
+	}
+}
+
+func TestRegExpCache_Eviction(t *testing.T) {
+	cache := NewRegExpCache(2)
+
+	a, _ := cache.Get("a", "")
+	b, _ := cache.Get("b", "")
+	if re, _ := cache.Get("a", ""); re != a {
+		t.Fatalf("compiled expression %q is not cached", "a")
+	}
+
+	// The least recently used expression is evicted.
+	_, _ = cache.Get("c", "")
+	if len(cache.m) != 2 || cache.order.Len() != 2 {
+		t.Errorf("cache size = %d, want %d", len(cache.m), 2)
+	}
+	if re, _ := cache.Get("a", ""); re != a {
+		t.Errorf("recently used expression %q is evicted", "a")
+	}
+	if re, _ := cache.Get("b", ""); re == b {
+		t.Errorf("least recently used expression %q is not evicted", "b")
+	}
+}
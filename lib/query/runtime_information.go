@@ -9,13 +9,14 @@ import (
 )
 
 const (
-	UncommittedInformation  = "UNCOMMITTED"
-	CreatedInformation      = "CREATED"
-	UpdatedInformation      = "UPDATED"
-	UpdatedViewsInformation = "UPDATED_VIEWS"
-	LoadedTablesInformation = "LOADED_TABLES"
-	WorkingDirectory        = "WORKING_DIRECTORY"
-	VersionInformation      = "VERSION"
+	UncommittedInformation    = "UNCOMMITTED"
+	CreatedInformation        = "CREATED"
+	UpdatedInformation        = "UPDATED"
+	UpdatedViewsInformation   = "UPDATED_VIEWS"
+	LoadedTablesInformation   = "LOADED_TABLES"
+	SkippedRecordsInformation = "SKIPPED_RECORDS"
+	WorkingDirectory          = "WORKING_DIRECTORY"
+	VersionInformation        = "VERSION"
 )
 
 var RuntimeInformatinList = []string{
@@ -24,6 +25,7 @@ var RuntimeInformatinList = []string{
 	UpdatedInformation,
 	UpdatedViewsInformation,
 	LoadedTablesInformation,
+	SkippedRecordsInformation,
 	WorkingDirectory,
 	VersionInformation,
 }
@@ -42,6 +44,8 @@ func GetRuntimeInformation(tx *Transaction, expr parser.RuntimeInformation) (val
 		p = value.NewInteger(int64(tx.uncommittedViews.CountUpdatedViews()))
 	case LoadedTablesInformation:
 		p = value.NewInteger(int64(tx.cachedViews.Len()))
+	case SkippedRecordsInformation:
+		p = value.NewInteger(tx.SkippedRecords())
 	case WorkingDirectory:
 		wd, err := os.Getwd()
 		if err != nil {
@@ -34,6 +34,10 @@ var getRuntimeInformationTests = []struct {
 		Input:  parser.RuntimeInformation{Name: "loaded_tables"},
 		Expect: value.NewInteger(4),
 	},
+	{
+		Input:  parser.RuntimeInformation{Name: "skipped_records"},
+		Expect: value.NewInteger(3),
+	},
 	{
 		Input:  parser.RuntimeInformation{Name: "working_directory"},
 		Expect: value.NewString(GetWD()),
@@ -52,6 +56,7 @@ func TestGetRuntimeInformation(t *testing.T) {
 	defer func() {
 		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
 		TestTx.uncommittedViews.Clean()
+		TestTx.skippedRecords = 0
 		initFlag(TestTx.Flags)
 	}()
 
@@ -61,6 +66,7 @@ func TestGetRuntimeInformation(t *testing.T) {
 		{FileInfo: &FileInfo{Path: "table3"}},
 		{FileInfo: &FileInfo{Path: "table4"}},
 	})
+	TestTx.skippedRecords = 3
 	TestTx.uncommittedViews = UncommittedViews{
 		mtx: &sync.RWMutex{},
 		Created: map[string]*FileInfo{
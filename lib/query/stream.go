@@ -0,0 +1,514 @@
+package query
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+)
+
+const streamingChunkSize = 10000
+
+// syncWriter serializes writes with other outputs of the session,
+// such as messages printed by user-defined functions during a stream.
+type syncWriter struct {
+	mtx *sync.Mutex
+	w   io.Writer
+}
+
+func (w *syncWriter) Write(p []byte) (int, error) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	return w.w.Write(p)
+}
+
+// StreamSelect executes a simple select query by reading, filtering, projecting and
+// encoding records chunk by chunk, so that the whole table is never held in memory.
+// When the query has a limit clause, reading the file is stopped as soon as enough
+// records have been written.
+//
+// The records of each chunk are written before the next chunk is read. If an error occurs
+// in a later chunk, for example when a function in the select clause or the where clause
+// fails for a record, the records of the preceding chunks have already been written to fp,
+// so the output is truncated at the end of the last chunk written.
+//
+// Only queries that select from a single CSV or TSV file without aggregation,
+// sorting, analytic functions or joins are streamed, and the result must be
+// written in a format that does not need all of the records to determine its layout.
+// The first return value reports whether the query has been processed. If it is false,
+// the query must be executed by Select.
+func StreamSelect(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery, fp io.Writer, options cmd.ExportOptions) (streamed bool, err error) {
+	if !isStreamableExportFormat(options) {
+		return false, nil
+	}
+
+	entity, table, ok := streamableSelectEntity(scope, query)
+	if !ok {
+		return false, nil
+	}
+	tableIdentifier := table.Object.(parser.Identifier)
+	tableName := table.Name()
+
+	if _, ok := scope.LoadFilePath(tableIdentifier.Literal); ok {
+		return false, nil
+	}
+
+	importOptions := scope.Tx.Flags.ImportOptions.Copy()
+	importOptions.Format = cmd.AutoSelect
+	if importOptions.ColumnTypes != nil || importOptions.OnParseError != cmd.FailOnParseError {
+		return false, nil
+	}
+
+	fileInfo, e := NewFileInfo(tableIdentifier, scope.Tx.Flags.Repository, importOptions, scope.Tx.Flags.ImportOptions.Format)
+	if e != nil {
+		return false, nil
+	}
+	if fileInfo.Format != cmd.CSV && fileInfo.Format != cmd.TSV {
+		return false, nil
+	}
+	if _, err := os.Stat(SchemaFilePath(fileInfo.Path)); err == nil {
+		return false, nil
+	}
+	if _, ok := scope.Tx.cachedViews.Load(fileInfo.Path); ok {
+		return false, nil
+	}
+
+	ctx, step := beginExecutionStep(ctx, "Select Query", func(plan *ExecutionPlan) {
+		plan.AddAttribute("Streaming", "true")
+	})
+	var beginChunkStep = func(operation string, describe func(plan *ExecutionPlan)) (context.Context, *executionStep) {
+		return beginRepeatableExecutionStep(ctx, operation, "chunk", describe)
+	}
+
+	var limitClause parser.LimitClause
+	if query.LimitClause != nil {
+		limitClause = query.LimitClause.(parser.LimitClause)
+	}
+	selectClause := entity.SelectClause.(parser.SelectClause)
+
+	if !step.Executes() {
+		fileInfo.NoHeader = importOptions.NoHeader
+		_, scanStep := beginChunkStep("File Scan", describeTableName(tableName))
+		explainFileInfo(scanStep, fileInfo)
+		if entity.WhereClause != nil {
+			beginChunkStep("Filter", describeFilter(entity.WhereClause.(parser.WhereClause), scope.Tx.Flags.CPU))
+		}
+		if limitClause.OffsetClause != nil {
+			beginChunkStep("Offset", describeOffset(limitClause.OffsetClause.(parser.OffsetClause)))
+		}
+		if !limitClause.Type.IsEmpty() {
+			beginChunkStep("Limit", describeLimit(limitClause))
+		}
+		beginChunkStep("Select", describeSelect(selectClause, scope.Tx.Flags.CPU))
+		return true, nil
+	}
+
+	queryScope := scope.CreateNode()
+	defer queryScope.CloseCurrentNode()
+
+	if err = scope.Tx.recoverJournalsInDirectory(filepath.Dir(fileInfo.Path)); err != nil {
+		return true, err
+	}
+
+	h, err := file.NewHandlerForRead(ctx, scope.Tx.FileContainer, fileInfo.Path, scope.Tx.WaitTimeout, scope.Tx.RetryDelay)
+	if err != nil {
+		pathIdent := tableIdentifier
+		pathIdent.Literal = fileInfo.Path
+		return true, ConvertFileHandlerError(err, pathIdent)
+	}
+	defer func() {
+		err = appendCompositeError(err, scope.Tx.FileContainer.Close(h))
+	}()
+
+	parsingError := func(err error) error {
+		if _, ok := err.(Error); !ok {
+			err = NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
+		}
+		return err
+	}
+
+	r, err := decompressFile(h.File(), fileInfo)
+	if err != nil {
+		return true, parsingError(err)
+	}
+	defer closeDecompressedFile(r)
+
+	enc, err := text.DetectInSpecifiedEncoding(r, fileInfo.Encoding)
+	if err != nil {
+		return true, NewCannotDetectFileEncodingError(tableIdentifier)
+	}
+
+	fileInfo.Encoding = enc
+	fileInfo.NoHeader = importOptions.NoHeader
+	if fileInfo.Delimiter == cmd.AutoDelimiter {
+		dialect, err := detectCSVDialect(r, fileInfo, scope.Tx.Flags.DatetimeFormat)
+		if err != nil {
+			return true, parsingError(err)
+		}
+		fileInfo.Delimiter = dialect.Delimiter
+		fileInfo.NoHeader = dialect.NoHeader
+	}
+
+	reader, err := newCSVReader(r, fileInfo, importOptions.WithoutNull)
+	if err != nil {
+		return true, parsingError(err)
+	}
+
+	var columns []string
+	if !fileInfo.NoHeader {
+		columns, err = reader.ReadHeader()
+		if err != nil && err != io.EOF {
+			return true, parsingError(err)
+		}
+	}
+
+	if err = queryScope.AddAlias(tableName, fileInfo.Path); err != nil {
+		return true, err
+	}
+
+	offset, limit, err := evalStreamingRange(ctx, queryScope, query.LimitClause)
+	if err != nil {
+		return true, err
+	}
+
+	var header Header
+	var encoder recordEncoder
+	skipped := 0
+	written := 0
+
+	for {
+		size := streamingChunkSize
+		if entity.WhereClause == nil && 0 <= limit && offset-skipped+limit-written < size {
+			size = offset - skipped + limit - written
+			if size < 1 {
+				size = 1
+			}
+		}
+
+		_, scanStep := beginChunkStep("File Scan", describeTableName(tableName))
+		records, eof, err := readRecordsInChunk(ctx, reader, size)
+		if err != nil {
+			return true, parsingError(err)
+		}
+		scanStep.Finish(len(records))
+
+		if header == nil {
+			if columns == nil {
+				fieldsPerRecord, _, _, _ := csvReaderState(reader)
+				columns = make([]string, fieldsPerRecord)
+				for i := 0; i < fieldsPerRecord; i++ {
+					columns[i] = "c" + strconv.Itoa(i+1)
+				}
+			}
+			header = NewHeader(parser.FormatTableName(fileInfo.Path), columns)
+			if !strings.EqualFold(parser.FormatTableName(fileInfo.Path), tableName.Literal) {
+				if err = header.Update(tableName.Literal, nil); err != nil {
+					return true, err
+				}
+			}
+			explainFileInfo(scanStep, fileInfo)
+		}
+
+		view := NewView()
+		view.Header = header.Copy()
+		view.RecordSet = records
+
+		if entity.WhereClause != nil {
+			whereClause := entity.WhereClause.(parser.WhereClause)
+			filterCtx, filterStep := beginChunkStep("Filter", describeFilter(whereClause, scope.Tx.Flags.CPU))
+			if 0 < view.RecordLen() {
+				if err = view.Where(filterCtx, queryScope, whereClause); err != nil {
+					return true, err
+				}
+			}
+			filterStep.Finish(view.RecordLen())
+		}
+
+		if limitClause.OffsetClause != nil {
+			_, offsetStep := beginChunkStep("Offset", describeOffset(limitClause.OffsetClause.(parser.OffsetClause)))
+			if skipped < offset {
+				n := offset - skipped
+				if view.RecordLen() < n {
+					n = view.RecordLen()
+				}
+				view.RecordSet = view.RecordSet[n:]
+				skipped += n
+			}
+			offsetStep.Finish(view.RecordLen())
+		}
+
+		if !limitClause.Type.IsEmpty() {
+			_, limitStep := beginChunkStep("Limit", describeLimit(limitClause))
+			if 0 <= limit && limit-written < view.RecordLen() {
+				view.RecordSet = view.RecordSet[:limit-written]
+			}
+			limitStep.Finish(view.RecordLen())
+		}
+
+		if 0 < view.RecordLen() {
+			selectCtx, selectStep := beginChunkStep("Select", describeSelect(selectClause, scope.Tx.Flags.CPU))
+			if err = view.Select(selectCtx, queryScope, selectClause); err != nil {
+				return true, err
+			}
+			if err = view.Fix(selectCtx, queryScope.Tx.Flags); err != nil {
+				return true, err
+			}
+			selectStep.Finish(view.RecordLen())
+
+			if encoder == nil {
+				if encoder, err = newRecordEncoder(fp, view.Header, options); err != nil {
+					return true, err
+				}
+			}
+			if err = writeRecords(ctx, encoder, view.RecordSet); err != nil {
+				return true, err
+			}
+			written += view.RecordLen()
+		}
+
+		if eof || (0 <= limit && limit <= written) {
+			break
+		}
+	}
+
+	if encoder == nil {
+		view := NewView()
+		view.Header = header.Copy()
+		view.RecordSet = RecordSet{}
+
+		if err = view.Select(ctx, queryScope, selectClause); err != nil {
+			return true, err
+		}
+		if err = view.Fix(ctx, queryScope.Tx.Flags); err != nil {
+			return true, err
+		}
+		_, err = EncodeView(ctx, fp, view, options, scope.Tx.Palette)
+	}
+
+	if err == nil || err == DataEmpty {
+		step.Finish(written)
+	}
+	return true, err
+}
+
+func isStreamableExportFormat(options cmd.ExportOptions) bool {
+	switch options.Format {
+	case cmd.CSV, cmd.TSV, cmd.LTSV, cmd.JSONL, cmd.XML, cmd.HTML:
+		return true
+	case cmd.FIXED:
+		return options.DelimiterPositions != nil
+	}
+	return false
+}
+
+func streamableSelectEntity(scope *ReferenceScope, query parser.SelectQuery) (parser.SelectEntity, parser.Table, bool) {
+	var entity parser.SelectEntity
+	var table parser.Table
+
+	if query.WithClause != nil || query.OrderByClause != nil || query.IsForUpdate() {
+		return entity, table, false
+	}
+	if query.LimitClause != nil && query.LimitClause.(parser.LimitClause).Percentage() {
+		return entity, table, false
+	}
+
+	entity, ok := query.SelectEntity.(parser.SelectEntity)
+	if !ok || entity.IntoClause != nil || entity.FromClause == nil || entity.GroupByClause != nil || entity.HavingClause != nil {
+		return entity, table, false
+	}
+
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	if selectClause.IsDistinct() {
+		return entity, table, false
+	}
+
+	tables := entity.FromClause.(parser.FromClause).Tables
+	if len(tables) != 1 {
+		return entity, table, false
+	}
+	if table, ok = tables[0].(parser.Table); !ok || !table.Lateral.IsEmpty() {
+		return entity, table, false
+	}
+	tableIdentifier, ok := table.Object.(parser.Identifier)
+	if !ok {
+		return entity, table, false
+	}
+	if scope.RecursiveTable != nil || scope.InlineTableExists(tableIdentifier) || scope.TemporaryTableExists(tableIdentifier.Literal) {
+		return entity, table, false
+	}
+
+	for _, f := range selectClause.Fields {
+		if !isStreamableExpression(scope, f.(parser.Field).Object) {
+			return entity, table, false
+		}
+	}
+	if entity.WhereClause != nil && !isStreamableExpression(scope, entity.WhereClause.(parser.WhereClause).Filter) {
+		return entity, table, false
+	}
+	return entity, table, true
+}
+
+// isStreamableExpression reports whether the expression can be evaluated for each record
+// independently of the other records.
+// Subqueries are evaluated with their own views, so their contents are not inspected.
+func isStreamableExpression(scope *ReferenceScope, expr parser.QueryExpression) bool {
+	if expr == nil {
+		return true
+	}
+
+	switch expr.(type) {
+	case parser.PrimitiveType, parser.FieldReference, parser.ColumnNumber, parser.AllColumns,
+		parser.Variable, parser.EnvironmentVariable, parser.RuntimeInformation, parser.Flag,
+		parser.CursorStatus, parser.CursorAttrebute, parser.Placeholder,
+		parser.Subquery, parser.Exists:
+		return true
+	case parser.Parentheses:
+		return isStreamableExpression(scope, expr.(parser.Parentheses).Expr)
+	case parser.RowValue:
+		return isStreamableExpression(scope, expr.(parser.RowValue).Value)
+	case parser.ValueList:
+		return areStreamableExpressions(scope, expr.(parser.ValueList).Values)
+	case parser.RowValueList:
+		return areStreamableExpressions(scope, expr.(parser.RowValueList).RowValues)
+	case parser.Arithmetic:
+		e := expr.(parser.Arithmetic)
+		return isStreamableExpression(scope, e.LHS) && isStreamableExpression(scope, e.RHS)
+	case parser.UnaryArithmetic:
+		return isStreamableExpression(scope, expr.(parser.UnaryArithmetic).Operand)
+	case parser.Concat:
+		return areStreamableExpressions(scope, expr.(parser.Concat).Items)
+	case parser.Comparison:
+		e := expr.(parser.Comparison)
+		return isStreamableExpression(scope, e.LHS) && isStreamableExpression(scope, e.RHS)
+	case parser.Is:
+		e := expr.(parser.Is)
+		return isStreamableExpression(scope, e.LHS) && isStreamableExpression(scope, e.RHS)
+	case parser.Between:
+		e := expr.(parser.Between)
+		return isStreamableExpression(scope, e.LHS) && isStreamableExpression(scope, e.Low) && isStreamableExpression(scope, e.High)
+	case parser.In:
+		e := expr.(parser.In)
+		return isStreamableExpression(scope, e.LHS) && isStreamableExpression(scope, e.Values)
+	case parser.Any:
+		e := expr.(parser.Any)
+		return isStreamableExpression(scope, e.LHS) && isStreamableExpression(scope, e.Values)
+	case parser.All:
+		e := expr.(parser.All)
+		return isStreamableExpression(scope, e.LHS) && isStreamableExpression(scope, e.Values)
+	case parser.Like:
+		e := expr.(parser.Like)
+		return isStreamableExpression(scope, e.LHS) && isStreamableExpression(scope, e.Pattern)
+	case parser.RegExp:
+		e := expr.(parser.RegExp)
+		return isStreamableExpression(scope, e.LHS) && isStreamableExpression(scope, e.Pattern)
+	case parser.Logic:
+		e := expr.(parser.Logic)
+		return isStreamableExpression(scope, e.LHS) && isStreamableExpression(scope, e.RHS)
+	case parser.UnaryLogic:
+		return isStreamableExpression(scope, expr.(parser.UnaryLogic).Operand)
+	case parser.CaseExpr:
+		e := expr.(parser.CaseExpr)
+		return isStreamableExpression(scope, e.Value) && areStreamableExpressions(scope, e.When) && isStreamableExpression(scope, e.Else)
+	case parser.CaseExprWhen:
+		e := expr.(parser.CaseExprWhen)
+		return isStreamableExpression(scope, e.Condition) && isStreamableExpression(scope, e.Result)
+	case parser.CaseExprElse:
+		return isStreamableExpression(scope, expr.(parser.CaseExprElse).Result)
+	case parser.Function:
+		e := expr.(parser.Function)
+		name := strings.ToUpper(e.Name)
+		if _, ok := Functions[name]; !ok && name != "CALL" && name != "NOW" && name != "JSON_OBJECT" {
+			udfn, err := scope.GetFunction(e, name)
+			if err != nil || udfn.IsAggregate {
+				return false
+			}
+		}
+		return areStreamableExpressions(scope, e.Args)
+	}
+	return false
+}
+
+func areStreamableExpressions(scope *ReferenceScope, exprs []parser.QueryExpression) bool {
+	for _, expr := range exprs {
+		if !isStreamableExpression(scope, expr) {
+			return false
+		}
+	}
+	return true
+}
+
+func evalStreamingRange(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression) (int, int, error) {
+	offset := 0
+	limit := -1
+
+	if expr == nil {
+		return offset, limit, nil
+	}
+	clause := expr.(parser.LimitClause)
+
+	if clause.OffsetClause != nil {
+		offsetClause := clause.OffsetClause.(parser.OffsetClause)
+		val, err := Evaluate(ctx, scope, offsetClause.Value)
+		if err != nil {
+			return offset, limit, err
+		}
+		number := value.ToInteger(val)
+		if value.IsNull(number) {
+			return offset, limit, NewInvalidOffsetNumberError(offsetClause)
+		}
+		offset = int(number.(*value.Integer).Raw())
+		value.Discard(number)
+
+		if offset < 0 {
+			offset = 0
+		}
+	}
+
+	if !clause.Type.IsEmpty() {
+		val, err := Evaluate(ctx, scope, clause.Value)
+		if err != nil {
+			return offset, limit, err
+		}
+		number := value.ToInteger(val)
+		if value.IsNull(number) {
+			return offset, limit, NewInvalidLimitNumberError(clause)
+		}
+		limit = int(number.(*value.Integer).Raw())
+		value.Discard(number)
+
+		if limit < 0 {
+			limit = 0
+		}
+	}
+
+	return offset, limit, nil
+}
+
+func readRecordsInChunk(ctx context.Context, reader RecordReader, size int) (RecordSet, bool, error) {
+	records := make(RecordSet, 0, size)
+	for i := 0; i < size; i++ {
+		if i&15 == 0 && ctx.Err() != nil {
+			return nil, false, ConvertContextError(ctx.Err())
+		}
+
+		row, err := reader.Read()
+		if err == io.EOF {
+			return records, true, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		records = append(records, convertRawTextToRecord(row))
+	}
+	return records, false, nil
+}
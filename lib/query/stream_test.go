@@ -0,0 +1,380 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var streamSelectTests = []struct {
+	Name     string
+	Query    parser.SelectQuery
+	NoHeader bool
+	Format   cmd.Format
+	Streamed bool
+	Result   string
+	Error    string
+}{
+	{
+		Name: "StreamSelect",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}, As: parser.Token{Token: parser.AS, Literal: "as"}, Alias: parser.Identifier{Literal: "id"}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+					},
+				},
+			},
+		},
+		Streamed: true,
+		Result: "column2,id\n" +
+			"str2,2\n" +
+			"str3,3",
+	},
+	{
+		Name: "StreamSelect All Columns with Table Alias",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+						parser.Field{Object: parser.Function{
+							Name: "upper",
+							Args: []parser.QueryExpression{
+								parser.FieldReference{View: parser.Identifier{Literal: "t"}, Column: parser.Identifier{Literal: "column2"}},
+							},
+						}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
+					},
+				},
+			},
+		},
+		Streamed: true,
+		Result: "column1,column2,UPPER(t.column2)\n" +
+			"1,str1,STR1\n" +
+			"2,str2,STR2\n" +
+			"3,str3,STR3",
+	},
+	{
+		Name: "StreamSelect with Offset and Limit",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+			LimitClause: parser.LimitClause{
+				Type:  parser.Token{Token: parser.LIMIT, Literal: "limit"},
+				Value: parser.NewIntegerValueFromString("1"),
+				OffsetClause: parser.OffsetClause{
+					Value: parser.NewIntegerValueFromString("1"),
+				},
+			},
+		},
+		Streamed: true,
+		Result: "column1,column2\n" +
+			"2,str2",
+	},
+	{
+		Name: "StreamSelect with Zero Limit and No Header",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table_noheader"}},
+					},
+				},
+			},
+			LimitClause: parser.LimitClause{
+				Type:  parser.Token{Token: parser.LIMIT, Literal: "limit"},
+				Value: parser.NewIntegerValueFromString("0"),
+			},
+		},
+		NoHeader: true,
+		Streamed: true,
+		Result:   "c1,c2",
+	},
+	{
+		Name: "StreamSelect Empty Result in LTSV",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.NewTernaryValueFromString("false"),
+				},
+			},
+		},
+		Format:   cmd.LTSV,
+		Streamed: true,
+		Error:    "data empty",
+	},
+	{
+		Name: "StreamSelect Records in LTSV",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+			LimitClause: parser.LimitClause{
+				Type:  parser.Token{Token: parser.LIMIT, Literal: "limit"},
+				Value: parser.NewIntegerValueFromString("2"),
+			},
+		},
+		Format:   cmd.LTSV,
+		Streamed: true,
+		Result: "column1:1\tcolumn2:str1\n" +
+			"column1:2\tcolumn2:str2",
+	},
+	{
+		Name: "StreamSelect Field Does Not Exist Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Streamed: true,
+		Error:    "field notexist does not exist",
+	},
+	{
+		Name: "StreamSelect Invalid Limit Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+			LimitClause: parser.LimitClause{
+				Type:  parser.Token{Token: parser.LIMIT, Literal: "limit"},
+				Value: parser.NewStringValue("str"),
+			},
+		},
+		Streamed: true,
+		Error:    "limit number of records 'str' is not an integer value",
+	},
+	{
+		Name: "StreamSelect Not Streamed with Order By Clause",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+			OrderByClause: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				},
+			},
+		},
+		Streamed: false,
+	},
+	{
+		Name: "StreamSelect Not Streamed with Aggregate Function",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Streamed: false,
+	},
+	{
+		Name: "StreamSelect Not Streamed with Text Format",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Format:   cmd.TEXT,
+		Streamed: false,
+	},
+}
+
+func TestStreamSelect(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		TestTx.uncommittedViews.Clean()
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+
+	for _, v := range streamSelectTests {
+		_ = TestTx.ReleaseResources()
+		TestTx.Flags.ImportOptions.NoHeader = v.NoHeader
+
+		options := cmd.NewExportOptions()
+		options.Format = v.Format
+
+		buf := &bytes.Buffer{}
+		streamed, err := StreamSelect(ctx, NewReferenceScope(TestTx), v.Query, buf, options)
+		if streamed != v.Streamed {
+			t.Errorf("%s: streamed = %t, want %t", v.Name, streamed, v.Streamed)
+		}
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if buf.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, buf.String(), v.Result)
+		}
+	}
+}
+
+func TestStreamSelect_ErrorInLaterChunk(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		TestTx.uncommittedViews.Clean()
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+
+	// RAND(1, column1) fails only for the last record, which is read in the second chunk.
+	fpath := GetTestFilePath("stream_error.csv")
+	_ = ioutil.WriteFile(fpath, []byte("column1\n"+strings.Repeat("2\n", streamingChunkSize)+"1\n"), 0644)
+	defer func() { _ = os.Remove(fpath) }()
+
+	query := parser.SelectQuery{
+		SelectEntity: parser.SelectEntity{
+			SelectClause: parser.SelectClause{
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.Function{
+						Name: "rand",
+						Args: []parser.QueryExpression{
+							parser.NewIntegerValueFromString("1"),
+							parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						},
+					}},
+				},
+			},
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Identifier{Literal: "stream_error"}},
+				},
+			},
+		},
+	}
+
+	options := cmd.NewExportOptions()
+	options.Format = cmd.CSV
+
+	buf := &bytes.Buffer{}
+	streamed, err := StreamSelect(ctx, NewReferenceScope(TestTx), query, buf, options)
+	if !streamed {
+		t.Fatal("streamed = false, want true")
+	}
+	expectErr := "the second argument must be greater than the first argument for function rand"
+	if err == nil {
+		t.Fatalf("no error, want error %q", expectErr)
+	}
+	if err.Error() != expectErr {
+		t.Errorf("error %q, want error %q", err.Error(), expectErr)
+	}
+
+	// The records of the first chunk have already been written when the error occurs.
+	if lines := strings.Count(buf.String(), "\n"); lines != streamingChunkSize {
+		t.Errorf("written lines = %d, want %d", lines, streamingChunkSize)
+	}
+}
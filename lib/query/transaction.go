@@ -5,9 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -33,6 +36,13 @@ type Transaction struct {
 	cachedViews      ViewMap
 	uncommittedViews UncommittedViews
 
+	// skippedRecords is the number of malformed records dropped while loading files in the transaction.
+	skippedRecords int64
+
+	// recoveredDirectories holds the directories in which interrupted commits have been recovered.
+	recoveredDirectories map[string]bool
+	journalMutex         *sync.Mutex
+
 	operationMutex   *sync.Mutex
 	viewLoadingMutex *sync.Mutex
 	stdinIsLocked    bool
@@ -61,23 +71,25 @@ func NewTransaction(ctx context.Context, defaultWaitTimeout time.Duration, retry
 	palette.Disable()
 
 	return &Transaction{
-		Session:            session,
-		Environment:        environment,
-		Palette:            palette,
-		Flags:              flags,
-		WaitTimeout:        file.DefaultWaitTimeout,
-		RetryDelay:         file.DefaultRetryDelay,
-		FileContainer:      file.NewContainer(),
-		cachedViews:        NewViewMap(),
-		uncommittedViews:   NewUncommittedViews(),
-		operationMutex:     &sync.Mutex{},
-		viewLoadingMutex:   &sync.Mutex{},
-		stdinIsLocked:      false,
-		flagMutex:          &sync.RWMutex{},
-		PreparedStatements: NewPreparedStatementMap(),
-		SelectedViews:      nil,
-		AffectedRows:       0,
-		AutoCommit:         false,
+		Session:              session,
+		Environment:          environment,
+		Palette:              palette,
+		Flags:                flags,
+		WaitTimeout:          file.DefaultWaitTimeout,
+		RetryDelay:           file.DefaultRetryDelay,
+		FileContainer:        file.NewContainer(),
+		cachedViews:          NewViewMap(),
+		uncommittedViews:     NewUncommittedViews(),
+		recoveredDirectories: make(map[string]bool),
+		journalMutex:         &sync.Mutex{},
+		operationMutex:       &sync.Mutex{},
+		viewLoadingMutex:     &sync.Mutex{},
+		stdinIsLocked:        false,
+		flagMutex:            &sync.RWMutex{},
+		PreparedStatements:   NewPreparedStatementMap(),
+		SelectedViews:        nil,
+		AffectedRows:         0,
+		AutoCommit:           false,
 	}, nil
 }
 
@@ -101,7 +113,7 @@ func (tx *Transaction) UseColor(useColor bool) {
 	tx.Flags.SetColor(useColor)
 }
 
-func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr parser.Expression) error {
+func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr parser.Expression) (err error) {
 	tx.operationMutex.Lock()
 	defer tx.operationMutex.Unlock()
 
@@ -122,16 +134,31 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 				return NewSystemError(err.Error())
 			}
 
-			if _, err := EncodeView(ctx, fp, view, fileinfo.ExportOptions(tx), tx.Palette); err != nil {
+			w, err := file.NewCompressionWriter(fp, fileinfo.Compression)
+			if err != nil {
+				return NewCommitError(expr, err.Error())
+			}
+
+			if _, err := EncodeView(ctx, w, view, fileinfo.ExportOptions(tx), tx.Palette); err != nil {
 				return NewCommitError(expr, err.Error())
 			}
 
-			if !tx.Flags.ExportOptions.StripEndingLineBreak && !(fileinfo.Format == cmd.FIXED && fileinfo.SingleLine) {
-				if _, err := fp.Write([]byte(tx.Flags.ExportOptions.LineBreak.Value())); err != nil {
+			if !tx.Flags.ExportOptions.StripEndingLineBreak && !(fileinfo.Format == cmd.FIXED && fileinfo.SingleLine) && fileinfo.Format != cmd.XLSX {
+				if _, err := w.Write([]byte(tx.Flags.ExportOptions.LineBreak.Value())); err != nil {
 					return NewCommitError(expr, err.Error())
 				}
 			}
 
+			if err := w.Close(); err != nil {
+				return NewCommitError(expr, err.Error())
+			}
+
+			view.FileInfo.Schema = view.FileInfo.Schema.Filter(view.Header)
+			if view.FileInfo.Schema != nil {
+				if err := WriteTableSchema(view.FileInfo.Handler, view.FileInfo.Path, view.FileInfo.Schema); err != nil {
+					return NewCommitError(expr, err.Error())
+				}
+			}
 			createFileInfo = append(createFileInfo, view.FileInfo)
 		}
 	}
@@ -139,6 +166,17 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 	if 0 < len(updatedFiles) {
 		for _, fileinfo := range updatedFiles {
 			view, _ := tx.cachedViews.Get(parser.Identifier{Literal: fileinfo.Path})
+			if tx.Flags.KeepVersions {
+				view.FileInfo.Handler.KeepVersions(tx.Flags.MaxVersions)
+			}
+
+			if n, ok := tx.uncommittedViews.AppendedRecords(fileinfo); ok && view.FileInfo.IsAppendable() {
+				if err := tx.appendRecords(ctx, view, n); err != nil {
+					return NewCommitError(expr, err.Error())
+				}
+				updateFileInfo = append(updateFileInfo, view.FileInfo)
+				continue
+			}
 
 			fp, _ := view.FileInfo.Handler.FileForUpdate()
 			if err := fp.Truncate(0); err != nil {
@@ -148,24 +186,67 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 				return NewSystemError(err.Error())
 			}
 
-			if _, err := EncodeView(ctx, fp, view, fileinfo.ExportOptions(tx), tx.Palette); err != nil {
+			w, err := file.NewCompressionWriter(fp, fileinfo.Compression)
+			if err != nil {
 				return NewCommitError(expr, err.Error())
 			}
 
-			if !tx.Flags.ExportOptions.StripEndingLineBreak && !(fileinfo.Format == cmd.FIXED && fileinfo.SingleLine) {
-				if _, err := fp.Write([]byte(tx.Flags.ExportOptions.LineBreak.Value())); err != nil {
+			if _, err := EncodeView(ctx, w, view, fileinfo.ExportOptions(tx), tx.Palette); err != nil {
+				return NewCommitError(expr, err.Error())
+			}
+
+			if !tx.Flags.ExportOptions.StripEndingLineBreak && !(fileinfo.Format == cmd.FIXED && fileinfo.SingleLine) && fileinfo.Format != cmd.XLSX {
+				if _, err := w.Write([]byte(tx.Flags.ExportOptions.LineBreak.Value())); err != nil {
 					return NewCommitError(expr, err.Error())
 				}
 			}
 
+			if err := w.Close(); err != nil {
+				return NewCommitError(expr, err.Error())
+			}
+
+			view.FileInfo.Schema = view.FileInfo.Schema.Filter(view.Header)
+			if view.FileInfo.Schema != nil {
+				if err := WriteTableSchema(view.FileInfo.Handler, view.FileInfo.Path, view.FileInfo.Schema); err != nil {
+					return NewCommitError(expr, err.Error())
+				}
+			}
 			updateFileInfo = append(updateFileInfo, view.FileInfo)
 		}
 	}
 
+	var journal *file.Journal
+	committed := false
+	if 0 < len(createFileInfo)+len(updateFileInfo) {
+		j, err := beginJournal(createFileInfo, updateFileInfo)
+		if err != nil {
+			return NewCommitError(expr, err.Error())
+		}
+		journal = j
+		// If the process is terminated in the middle of the commit, the journal is left and the commit is finished on recovery.
+		// If the commit fails before any file is committed, the journal and the data that have not been committed are discarded.
+		// Once a file has been committed, the commit cannot be rolled back, so the journal is left to finish the rest of the commit on recovery.
+		defer func() {
+			if journal == nil {
+				return
+			}
+			if committed {
+				if e := journal.Close(); e != nil {
+					err = appendCompositeError(err, NewCommitError(expr, e.Error()))
+				}
+				tx.forgetRecoveredDirectories(append(createFileInfo, updateFileInfo...))
+				tx.LogWarn(fmt.Sprintf("Commit: the commit is not completed. The rest of the commit recorded in %q is finished on recovery.", journal.Path()), tx.Flags.Quiet)
+			} else if e := journal.Discard(); e != nil {
+				err = appendCompositeError(err, NewCommitError(expr, e.Error()))
+			}
+		}()
+	}
+
 	for _, f := range createFileInfo {
 		if err := tx.FileContainer.Commit(f.Handler); err != nil {
 			return NewCommitError(expr, err.Error())
 		}
+		committed = true
 		tx.uncommittedViews.Unset(f)
 		tx.LogNotice(fmt.Sprintf("Commit: file %q is created.", f.Path), tx.Flags.Quiet)
 	}
@@ -173,10 +254,18 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 		if err := tx.FileContainer.Commit(f.Handler); err != nil {
 			return NewCommitError(expr, err.Error())
 		}
+		committed = true
 		tx.uncommittedViews.Unset(f)
 		tx.LogNotice(fmt.Sprintf("Commit: file %q is updated.", f.Path), tx.Flags.Quiet)
 	}
 
+	if journal != nil {
+		if err := journal.Finish(); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
+		journal = nil
+	}
+
 	msglist := scope.StoreTemporaryTable(tx.Session, tx.uncommittedViews.UncommittedTempViews())
 	if 0 < len(msglist) {
 		tx.LogNotice(strings.Join(msglist, "\n"), tx.quietForTemporaryViews(expr))
@@ -189,6 +278,122 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 	return nil
 }
 
+// beginJournal writes the journal of the files to be committed next to the files.
+func beginJournal(createFileInfo []*FileInfo, updateFileInfo []*FileInfo) (*file.Journal, error) {
+	handlers := make([]*file.Handler, 0, len(createFileInfo)+len(updateFileInfo))
+	for _, f := range createFileInfo {
+		handlers = append(handlers, f.Handler)
+	}
+	for _, f := range updateFileInfo {
+		handlers = append(handlers, f.Handler)
+	}
+	return file.BeginJournal(handlers)
+}
+
+// RecoverJournals finishes or rolls back the commits that were interrupted in the repository.
+// Interrupted commits in the other directories are recovered when the files in the directories are loaded.
+func (tx *Transaction) RecoverJournals() error {
+	dir := tx.Flags.Repository
+	if len(dir) < 1 {
+		wd, err := os.Getwd()
+		if err != nil {
+			return NewRecoveryError(err.Error())
+		}
+		dir = wd
+	}
+
+	tx.journalMutex.Lock()
+	defer tx.journalMutex.Unlock()
+	return tx.recoverJournals(dir)
+}
+
+// recoverJournalsInDirectory finishes or rolls back the commits that were interrupted in the directory
+// of the files to be loaded. Each directory is processed only once.
+func (tx *Transaction) recoverJournalsInDirectory(dir string) error {
+	tx.journalMutex.Lock()
+	defer tx.journalMutex.Unlock()
+
+	if tx.recoveredDirectories[dir] {
+		return nil
+	}
+	return tx.recoverJournals(dir)
+}
+
+// forgetRecoveredDirectories makes the directories of the files processed again on the next load,
+// so that a commit left to be finished on recovery is finished once the files are released.
+func (tx *Transaction) forgetRecoveredDirectories(list []*FileInfo) {
+	tx.journalMutex.Lock()
+	for _, f := range list {
+		delete(tx.recoveredDirectories, filepath.Dir(f.Path))
+	}
+	tx.journalMutex.Unlock()
+}
+
+func (tx *Transaction) recoverJournals(dir string) error {
+	list, err := file.RecoverJournals(dir)
+	for _, j := range list {
+		if j.Committed {
+			tx.LogNotice(fmt.Sprintf("Recovery: interrupted commit recorded in %q is finished.", j.Path), tx.Flags.Quiet)
+		} else {
+			tx.LogNotice(fmt.Sprintf("Recovery: interrupted commit recorded in %q is rolled back.", j.Path), tx.Flags.Quiet)
+		}
+	}
+	if err != nil {
+		return NewRecoveryError(err.Error())
+	}
+	tx.recoveredDirectories[dir] = true
+	return nil
+}
+
+// appendRecords writes the last n records of the view to be appended to the end of the file on commit.
+func (tx *Transaction) appendRecords(ctx context.Context, view *View, n int) error {
+	fileinfo := view.FileInfo
+
+	fi, err := fileinfo.Handler.File().Stat()
+	if err != nil {
+		return err
+	}
+
+	fp, err := fileinfo.Handler.FileForAppend()
+	if err != nil {
+		return err
+	}
+
+	if 0 < fi.Size() {
+		last := make([]byte, 1)
+		if _, err := fileinfo.Handler.File().ReadAt(last, fi.Size()-1); err != nil {
+			return err
+		}
+		if last[0] != '\n' && last[0] != '\r' {
+			if _, err := fp.Write([]byte(fileinfo.LineBreak.Value())); err != nil {
+				return err
+			}
+		}
+	}
+
+	appended := &View{
+		Header:    view.Header,
+		RecordSet: view.RecordSet[view.RecordLen()-n:],
+		FileInfo:  fileinfo,
+	}
+
+	options := fileinfo.ExportOptions(tx)
+	if 0 < fi.Size() {
+		options.WithoutHeader = true
+		options.LeadingLines = nil
+	}
+	if _, err := EncodeView(ctx, fp, appended, options, tx.Palette); err != nil {
+		return err
+	}
+
+	if !tx.Flags.ExportOptions.StripEndingLineBreak {
+		if _, err := fp.Write([]byte(tx.Flags.ExportOptions.LineBreak.Value())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (tx *Transaction) Rollback(scope *ReferenceScope, expr parser.Expression) error {
 	tx.operationMutex.Lock()
 	defer tx.operationMutex.Unlock()
@@ -229,6 +434,7 @@ func (tx *Transaction) ReleaseResources() error {
 	if err := tx.cachedViews.Clean(tx.FileContainer); err != nil {
 		return err
 	}
+	atomic.StoreInt64(&tx.skippedRecords, 0)
 	if err := tx.FileContainer.CloseAll(); err != nil {
 		return err
 	}
@@ -236,6 +442,14 @@ func (tx *Transaction) ReleaseResources() error {
 	return nil
 }
 
+func (tx *Transaction) addSkippedRecords(n int) {
+	atomic.AddInt64(&tx.skippedRecords, int64(n))
+}
+
+func (tx *Transaction) SkippedRecords() int64 {
+	return atomic.LoadInt64(&tx.skippedRecords)
+}
+
 func (tx *Transaction) ReleaseResourcesWithErrors() error {
 	var errs []error
 	if err := tx.cachedViews.CleanWithErrors(tx.FileContainer); err != nil {
@@ -382,6 +596,18 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.KeepVersionsFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetKeepVersions(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.MaxVersionsFlag:
+		if i, ok := value.(int64); ok {
+			tx.Flags.SetMaxVersions(i)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.ImportFormatFlag:
 		if s, ok := value.(string); ok {
 			err = tx.Flags.SetImportFormat(s)
@@ -424,6 +650,54 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.GlobUnionByNameFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetGlobUnionByName(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.ColumnTypesFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetColumnTypes(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.QuoteCharFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetQuoteChar(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.EscapeStyleFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetEscapeStyle(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.CommentPrefixFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetCommentPrefix(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.SkipLinesFlag:
+		if i, ok := value.(int64); ok {
+			tx.Flags.SetSkipLines(i)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.TrimSpaceFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetTrimSpace(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.OnParseErrorFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetOnParseError(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.FormatFlag:
 		if s, ok := value.(string); ok {
 			err = tx.Flags.SetFormat(s, outFile)
@@ -478,6 +752,36 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.XmlRootElementFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetXmlRootElement(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.XmlRowElementFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetXmlRowElement(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.SqlTableNameFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetSqlTableName(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.SqlDialectFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetSqlDialect(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case cmd.HtmlStandaloneFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetHtmlStandalone(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.StripEndingLineBreakFlag:
 		if b, ok := value.(bool); ok {
 			tx.Flags.SetStripEndingLineBreak(b)
@@ -567,10 +871,18 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 		val = value.NewBoolean(tx.Flags.StrictEqual)
 	case cmd.WaitTimeoutFlag:
 		val = value.NewFloat(tx.Flags.WaitTimeout)
+	case cmd.KeepVersionsFlag:
+		val = value.NewBoolean(tx.Flags.KeepVersions)
+	case cmd.MaxVersionsFlag:
+		val = value.NewInteger(int64(tx.Flags.MaxVersions))
 	case cmd.ImportFormatFlag:
 		val = value.NewString(tx.Flags.ImportOptions.Format.String())
 	case cmd.DelimiterFlag:
-		val = value.NewString(string(tx.Flags.ImportOptions.Delimiter))
+		if tx.Flags.ImportOptions.Delimiter == cmd.AutoDelimiter {
+			val = value.NewString(cmd.DetectDelimiterAutomatically)
+		} else {
+			val = value.NewString(string(tx.Flags.ImportOptions.Delimiter))
+		}
 	case cmd.DelimiterPositionsFlag:
 		s := fixedlen.DelimiterPositions(tx.Flags.ImportOptions.DelimiterPositions).String()
 		if tx.Flags.ImportOptions.SingleLine {
@@ -585,6 +897,22 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 		val = value.NewBoolean(tx.Flags.ImportOptions.NoHeader)
 	case cmd.WithoutNullFlag:
 		val = value.NewBoolean(tx.Flags.ImportOptions.WithoutNull)
+	case cmd.GlobUnionByNameFlag:
+		val = value.NewBoolean(tx.Flags.ImportOptions.GlobUnionByName)
+	case cmd.ColumnTypesFlag:
+		val = value.NewString(cmd.FormatColumnTypes(tx.Flags.ImportOptions.ColumnTypes))
+	case cmd.QuoteCharFlag:
+		val = value.NewString(string(tx.Flags.ImportOptions.QuoteChar))
+	case cmd.EscapeStyleFlag:
+		val = value.NewString(tx.Flags.ImportOptions.EscapeStyle.String())
+	case cmd.CommentPrefixFlag:
+		val = value.NewString(tx.Flags.ImportOptions.CommentPrefix)
+	case cmd.SkipLinesFlag:
+		val = value.NewInteger(int64(tx.Flags.ImportOptions.SkipLines))
+	case cmd.TrimSpaceFlag:
+		val = value.NewBoolean(tx.Flags.ImportOptions.TrimSpace)
+	case cmd.OnParseErrorFlag:
+		val = value.NewString(tx.Flags.ImportOptions.OnParseError.String())
 	case cmd.FormatFlag:
 		val = value.NewString(tx.Flags.ExportOptions.Format.String())
 	case cmd.ExportEncodingFlag:
@@ -607,6 +935,16 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 		val = value.NewString(cmd.JsonEscapeTypeToString(tx.Flags.ExportOptions.JsonEscape))
 	case cmd.PrettyPrintFlag:
 		val = value.NewBoolean(tx.Flags.ExportOptions.PrettyPrint)
+	case cmd.XmlRootElementFlag:
+		val = value.NewString(tx.Flags.ExportOptions.XmlRootElement)
+	case cmd.XmlRowElementFlag:
+		val = value.NewString(tx.Flags.ExportOptions.XmlRowElement)
+	case cmd.SqlTableNameFlag:
+		val = value.NewString(tx.Flags.ExportOptions.SqlTableName)
+	case cmd.SqlDialectFlag:
+		val = value.NewString(tx.Flags.ExportOptions.SqlDialect.String())
+	case cmd.HtmlStandaloneFlag:
+		val = value.NewBoolean(tx.Flags.ExportOptions.HtmlStandalone)
 	case cmd.StripEndingLineBreakFlag:
 		val = value.NewBoolean(tx.Flags.ExportOptions.StripEndingLineBreak)
 	case cmd.EastAsianEncodingFlag:
@@ -1,9 +1,13 @@
 package query
 
 import (
+	"compress/bzip2"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -224,6 +228,279 @@ func TestTransaction_Commit(t *testing.T) {
 	if expectedUpdatedContents != string(updatedContents) {
 		t.Errorf("updated contents = %q, want %q", string(updatedContents), expectedUpdatedContents)
 	}
+
+	// Append Only
+	TestTx.Flags.ExportOptions.StripEndingLineBreak = false
+	uh, _ = file.NewHandlerForUpdate(context.Background(), TestTx.FileContainer, GetTestFilePath("updated_file_1.csv"), TestTx.WaitTimeout, TestTx.RetryDelay)
+	TestTx.cachedViews = GenerateViewMap([]*View{
+		{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("update1"),
+					value.NewString("update2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("4"),
+					value.NewString("str4"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      GetTestFilePath("updated_file_1.csv"),
+				Handler:   uh,
+				Encoding:  text.UTF8,
+				Format:    cmd.CSV,
+				Delimiter: ',',
+				LineBreak: text.LF,
+			},
+		},
+	})
+
+	TestTx.uncommittedViews = UncommittedViews{
+		mtx:     &sync.RWMutex{},
+		Created: map[string]*FileInfo{},
+		Updated: map[string]*FileInfo{
+			strings.ToUpper(GetTestFilePath("updated_file_1.csv")): {
+				Path:      GetTestFilePath("updated_file_1.csv"),
+				Handler:   uh,
+				Encoding:  text.UTF8,
+				Format:    cmd.CSV,
+				Delimiter: ',',
+				LineBreak: text.LF,
+			},
+		},
+		Appended: map[string]int{
+			strings.ToUpper(GetTestFilePath("updated_file_1.csv")): 1,
+		},
+	}
+
+	err = TestTx.Commit(context.Background(), NewReferenceScope(tx), parser.TransactionControl{Token: parser.COMMIT})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+
+	expectedUpdatedContents = "column1,column2\n1,str1\nupdate1,update2\n3,str3\n4,str4\n"
+	updatedContents, err = ioutil.ReadFile(GetTestFilePath("updated_file_1.csv"))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+
+	if expectedUpdatedContents != string(updatedContents) {
+		t.Errorf("appended contents = %q, want %q", string(updatedContents), expectedUpdatedContents)
+	}
+
+	// Compressed File
+	ch, _ = file.NewHandlerForCreate(TestTx.FileContainer, GetTestFilePath("created_file_2.csv.gz"))
+	TestTx.cachedViews = GenerateViewMap([]*View{
+		{
+			Header: NewHeader("created_file_2", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:        GetTestFilePath("created_file_2.csv.gz"),
+				Handler:     ch,
+				Encoding:    text.UTF8,
+				Format:      cmd.CSV,
+				Delimiter:   ',',
+				LineBreak:   text.LF,
+				Compression: file.Gzip,
+			},
+		},
+	})
+
+	TestTx.uncommittedViews = UncommittedViews{
+		mtx: &sync.RWMutex{},
+		Created: map[string]*FileInfo{
+			strings.ToUpper(GetTestFilePath("created_file_2.csv.gz")): {
+				Path:        GetTestFilePath("created_file_2.csv.gz"),
+				Handler:     ch,
+				Encoding:    text.UTF8,
+				Format:      cmd.CSV,
+				Delimiter:   ',',
+				LineBreak:   text.LF,
+				Compression: file.Gzip,
+			},
+		},
+		Updated: map[string]*FileInfo{},
+	}
+
+	err = TestTx.Commit(context.Background(), NewReferenceScope(tx), parser.TransactionControl{Token: parser.COMMIT})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+
+	expectedCreatedContents = "column1,column2\n1,str1\n"
+	fp, err := os.Open(GetTestFilePath("created_file_2.csv.gz"))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+	defer func() {
+		_ = fp.Close()
+	}()
+	r, err := gzip.NewReader(fp)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+	createdContents, err = ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+
+	if expectedCreatedContents != string(createdContents) {
+		t.Errorf("created contents = %q, want %q", string(createdContents), expectedCreatedContents)
+	}
+}
+
+func TestTransaction_CommitBzip2(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		TestTx.uncommittedViews.Clean()
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+
+	target := GetTestFilePath("updated_file_bz2.csv.bz2")
+	_ = copyfile(target, filepath.Join(TestDataDir, "table1_bz2.csv.bz2"))
+
+	statements, _, err := parser.Parse("UPDATE `updated_file_bz2.csv.bz2` SET column2 = 'updated' WHERE column1 = 2; COMMIT;", "", nil, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+	if _, err = NewProcessor(TestTx).Execute(context.Background(), statements); err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+
+	fp, err := os.Open(target)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+	defer func() {
+		_ = fp.Close()
+	}()
+	if c, _ := file.DetectCompression(fp); c != file.Bzip2 {
+		t.Errorf("compression = %s, want %s", c, file.Bzip2)
+	}
+	contents, err := ioutil.ReadAll(bzip2.NewReader(fp))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+
+	expectedContents := "column1,column2\n1,str1\n2,updated\n3,str3\n"
+	if expectedContents != string(contents) {
+		t.Errorf("updated contents = %q, want %q", string(contents), expectedContents)
+	}
+}
+
+func TestTransaction_CommitFailure(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		TestTx.uncommittedViews.Clean()
+		TestTx.Session.SetStdout(NewDiscard())
+		initFlag(TestTx.Flags)
+	}()
+
+	dir := filepath.Join(TestDir, "commit_failure")
+	_ = os.Mkdir(dir, 0755)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	createdPath := filepath.Join(dir, "created.csv")
+	updatedPath := filepath.Join(dir, "updated.csv")
+	_ = ioutil.WriteFile(updatedPath, []byte("column1\nold\n"), 0644)
+
+	ch, _ := file.NewHandlerForCreate(TestTx.FileContainer, createdPath)
+	uh, _ := file.NewHandlerForUpdate(context.Background(), TestTx.FileContainer, updatedPath, TestTx.WaitTimeout, TestTx.RetryDelay)
+
+	createdInfo := &FileInfo{
+		Path:      createdPath,
+		Handler:   ch,
+		Encoding:  text.UTF8,
+		Format:    cmd.CSV,
+		Delimiter: ',',
+		LineBreak: text.LF,
+	}
+	updatedInfo := &FileInfo{
+		Path:      updatedPath,
+		Handler:   uh,
+		Encoding:  text.UTF8,
+		Format:    cmd.CSV,
+		Delimiter: ',',
+		LineBreak: text.LF,
+	}
+
+	TestTx.cachedViews = GenerateViewMap([]*View{
+		{
+			Header:    NewHeader("created", []string{"column1"}),
+			RecordSet: RecordSet{},
+			FileInfo:  createdInfo,
+		},
+		{
+			Header: NewHeader("updated", []string{"column1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("new")}),
+			},
+			FileInfo: updatedInfo,
+		},
+	})
+	TestTx.uncommittedViews = UncommittedViews{
+		mtx:     &sync.RWMutex{},
+		Created: map[string]*FileInfo{strings.ToUpper(createdPath): createdInfo},
+		Updated: map[string]*FileInfo{strings.ToUpper(updatedPath): updatedInfo},
+	}
+
+	// The file to be updated is replaced with a directory that cannot be removed,
+	// so that the commit fails after the created file has been committed.
+	_ = os.Remove(updatedPath)
+	_ = os.Mkdir(updatedPath, 0755)
+	_ = ioutil.WriteFile(filepath.Join(updatedPath, "obstacle"), nil, 0644)
+
+	err := TestTx.Commit(context.Background(), NewReferenceScope(TestTx), parser.TransactionControl{Token: parser.COMMIT})
+	if err == nil {
+		t.Fatal("no error, want error")
+	}
+
+	journals, _ := filepath.Glob(filepath.Join(dir, file.JournalFilePrefix+".*"+file.JournalFileSuffix))
+	if len(journals) != 1 {
+		t.Fatalf("%d journals are left, want 1", len(journals))
+	}
+
+	_ = TestTx.Rollback(NewReferenceScope(TestTx), nil)
+	_ = os.RemoveAll(updatedPath)
+
+	if _, err := file.RecoverJournals(dir); err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+
+	if !file.Exists(createdPath) {
+		t.Errorf("file %s does not exist", createdPath)
+	}
+	expectedContents := "column1\nnew\n"
+	contents, err := ioutil.ReadFile(updatedPath)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+	if expectedContents != string(contents) {
+		t.Errorf("updated contents = %q, want %q", string(contents), expectedContents)
+	}
+	if file.Exists(journals[0]) {
+		t.Errorf("journal %s remains", journals[0])
+	}
 }
 
 func TestTransaction_Rollback(t *testing.T) {
@@ -265,3 +542,88 @@ func TestTransaction_Rollback(t *testing.T) {
 		t.Errorf("Rollback: log = %q, want %q", log, expect)
 	}
 }
+
+func TestTransaction_RecoverJournals(t *testing.T) {
+	defer func() {
+		TestTx.Session.SetStdout(NewDiscard())
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.SetQuiet(false)
+	TestTx.Flags.Repository = TestDir
+
+	target := GetTestFilePath("recovered_file.csv")
+	_ = ioutil.WriteFile(target, []byte("column1\nold\n"), 0644)
+	_ = ioutil.WriteFile(file.TempFilePath(target), []byte("column1\nnew\n"), 0644)
+	journalPath := file.JournalFilePath(TestDir)
+	_ = ioutil.WriteFile(journalPath, []byte(fmt.Sprintf("UPDATE %q %q\nCOMMIT\n", file.TempFilePath(target), target)), 0644)
+
+	expect := fmt.Sprintf("Recovery: interrupted commit recorded in %q is finished.\n", journalPath)
+
+	out := NewOutput()
+	TestTx.Session.SetStdout(out)
+
+	if err := TestTx.RecoverJournals(); err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+
+	log := out.String()
+	if log != expect {
+		t.Errorf("RecoverJournals: log = %q, want %q", log, expect)
+	}
+
+	expectedContents := "column1\nnew\n"
+	contents, err := ioutil.ReadFile(target)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+	if expectedContents != string(contents) {
+		t.Errorf("recovered contents = %q, want %q", string(contents), expectedContents)
+	}
+	if file.Exists(journalPath) {
+		t.Errorf("journal %s remains", journalPath)
+	}
+}
+
+func TestTransaction_RecoverJournalsInDirectory(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		TestTx.Session.SetStdout(NewDiscard())
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.SetQuiet(false)
+	TestTx.Flags.Repository = TestDir
+
+	dir := filepath.Join(TestDir, "recovery")
+	_ = os.Mkdir(dir, 0755)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	target := filepath.Join(dir, "recovered_in_directory.csv")
+	_ = ioutil.WriteFile(target, []byte("column1\nold\n"), 0644)
+	_ = ioutil.WriteFile(file.TempFilePath(target), []byte("column1\nnew\n"), 0644)
+	journalPath := file.JournalFilePath(dir)
+	_ = ioutil.WriteFile(journalPath, []byte(fmt.Sprintf("UPDATE %q %q\nCOMMIT\n", file.TempFilePath(target), target)), 0644)
+
+	expect := fmt.Sprintf("Recovery: interrupted commit recorded in %q is finished.\n", journalPath)
+
+	out := NewOutput()
+	TestTx.Session.SetStdout(out)
+
+	view, err := LoadViewFromTableIdentifier(context.Background(), NewReferenceScope(TestTx).CreateNode(), parser.Identifier{Literal: target}, false, false)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err.Error())
+	}
+
+	if log := out.String(); log != expect {
+		t.Errorf("log = %q, want %q", log, expect)
+	}
+	if s := view.RecordSet[0][0][0].(*value.String).Raw(); s != "new" {
+		t.Errorf("loaded value = %q, want %q", s, "new")
+	}
+	if file.Exists(journalPath) {
+		t.Errorf("journal %s remains", journalPath)
+	}
+}
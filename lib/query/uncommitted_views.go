@@ -9,13 +9,18 @@ type UncommittedViews struct {
 	mtx     *sync.RWMutex
 	Created map[string]*FileInfo
 	Updated map[string]*FileInfo
+
+	// Appended holds the number of records inserted to the updated files
+	// that have not been changed by any other operations.
+	Appended map[string]int
 }
 
 func NewUncommittedViews() UncommittedViews {
 	return UncommittedViews{
-		mtx:     &sync.RWMutex{},
-		Created: make(map[string]*FileInfo),
-		Updated: make(map[string]*FileInfo),
+		mtx:      &sync.RWMutex{},
+		Created:  make(map[string]*FileInfo),
+		Updated:  make(map[string]*FileInfo),
+		Appended: make(map[string]int),
 	}
 }
 
@@ -38,6 +43,7 @@ func (m *UncommittedViews) SetForUpdatedView(fileInfo *FileInfo) {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
+	delete(m.Appended, ufpath)
 	if _, ok := m.Created[ufpath]; !ok {
 		if _, ok := m.Updated[ufpath]; !ok {
 			m.Updated[ufpath] = fileInfo
@@ -45,6 +51,35 @@ func (m *UncommittedViews) SetForUpdatedView(fileInfo *FileInfo) {
 	}
 }
 
+// SetForAppendedView marks the view as updated by inserting records at the end.
+// The inserted records are counted while the view is changed by insertions only.
+func (m *UncommittedViews) SetForAppendedView(fileInfo *FileInfo, insertedRecords int) {
+	ufpath := strings.ToUpper(fileInfo.Path)
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if _, ok := m.Created[ufpath]; ok {
+		return
+	}
+	if _, ok := m.Updated[ufpath]; !ok {
+		m.Updated[ufpath] = fileInfo
+		m.Appended[ufpath] = insertedRecords
+	} else if _, ok := m.Appended[ufpath]; ok {
+		m.Appended[ufpath] += insertedRecords
+	}
+}
+
+// AppendedRecords returns the number of records inserted to the file.
+// The second return value is false if the file has been changed by other operations than insertions.
+func (m *UncommittedViews) AppendedRecords(fileInfo *FileInfo) (int, bool) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	n, ok := m.Appended[strings.ToUpper(fileInfo.Path)]
+	return n, ok
+}
+
 func (m *UncommittedViews) Unset(fileInfo *FileInfo) {
 	ufpath := strings.ToUpper(fileInfo.Path)
 
@@ -53,6 +88,7 @@ func (m *UncommittedViews) Unset(fileInfo *FileInfo) {
 
 	if _, ok := m.Updated[ufpath]; ok {
 		delete(m.Updated, ufpath)
+		delete(m.Appended, ufpath)
 		return
 	}
 
@@ -71,6 +107,9 @@ func (m *UncommittedViews) Clean() {
 	for k := range m.Created {
 		delete(m.Created, k)
 	}
+	for k := range m.Appended {
+		delete(m.Appended, k)
+	}
 }
 
 func (m *UncommittedViews) UncommittedFiles() (map[string]*FileInfo, map[string]*FileInfo) {
@@ -89,6 +89,48 @@ func TestUncommittedViewMap_SetForUpdatedView(t *testing.T) {
 	}
 }
 
+func TestUncommittedViewMap_SetForAppendedView(t *testing.T) {
+	m := &UncommittedViews{
+		mtx: &sync.RWMutex{},
+		Created: map[string]*FileInfo{
+			"PRE_CREATED.TXT": {Path: "pre_created.txt"},
+		},
+		Updated: map[string]*FileInfo{
+			"PRE_UPDATED.TXT": {Path: "pre_updated.txt"},
+		},
+		Appended: map[string]int{},
+	}
+
+	info := &FileInfo{
+		Path: "append.txt",
+	}
+
+	m.SetForAppendedView(info, 2)
+	m.SetForAppendedView(info, 3)
+	if n, ok := m.AppendedRecords(info); !ok || n != 5 {
+		t.Errorf("appended records = %d, %t, want %d, %t", n, ok, 5, true)
+	}
+
+	m.SetForAppendedView(preCreatedFileInfo, 1)
+	if _, ok := m.AppendedRecords(preCreatedFileInfo); ok {
+		t.Errorf("appended records for created view is set")
+	}
+
+	m.SetForAppendedView(preUpdatedFileInfo, 1)
+	if _, ok := m.AppendedRecords(preUpdatedFileInfo); ok {
+		t.Errorf("appended records for updated view is set")
+	}
+
+	m.SetForUpdatedView(info)
+	m.SetForAppendedView(info, 1)
+	if _, ok := m.AppendedRecords(info); ok {
+		t.Errorf("appended records for updated view is set")
+	}
+	if _, ok := m.Updated["APPEND.TXT"]; !ok {
+		t.Errorf("view is not set for updated view")
+	}
+}
+
 func TestUncommittedViewMap_Unset(t *testing.T) {
 	m := &UncommittedViews{
 		mtx: &sync.RWMutex{},
@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"math"
 	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -17,11 +18,14 @@ import (
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/json"
+	"github.com/mithrandie/csvq/lib/parquet"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+	"github.com/mithrandie/csvq/lib/xlsx"
+	"github.com/mithrandie/csvq/lib/xml"
+	"github.com/mithrandie/csvq/lib/yaml"
 
 	"github.com/mithrandie/go-text"
-	"github.com/mithrandie/go-text/csv"
 	"github.com/mithrandie/go-text/fixedlen"
 	"github.com/mithrandie/go-text/ltsv"
 	"github.com/mithrandie/ternary"
@@ -108,152 +112,14 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 
 	switch table.Object.(type) {
 	case parser.Dual:
+		_, step := beginExecutionStep(ctx, "Dual", nil)
 		view = loadDualView()
+		step.Finish(view.RecordLen())
 	case parser.TableObject:
 		tableObject := table.Object.(parser.TableObject)
-		options := scope.Tx.Flags.ImportOptions.Copy()
-
-		var felem value.Primary
-		if tableObject.FormatElement != nil {
-			p, err := Evaluate(ctx, scope, tableObject.FormatElement)
-			if err != nil {
-				return nil, err
-			}
-			felem = value.ToString(p)
-			defer value.Discard(felem)
-		}
-
-		encodingIdx := 0
-		noHeaderIdx := 1
-		withoutNullIdx := 2
-
-		switch tableObject.Type.Token {
-		case parser.CSV:
-			if felem == nil {
-				return nil, NewTableObjectInvalidArgumentError(tableObject, "delimiter is not specified")
-			}
-			if value.IsNull(felem) {
-				return nil, NewTableObjectInvalidDelimiterError(tableObject, tableObject.FormatElement.String())
-			}
-			s := felem.(*value.String).Raw()
-			d := []rune(s)
-			if 1 != len(d) {
-				return nil, NewTableObjectInvalidDelimiterError(tableObject, tableObject.FormatElement.String())
-			}
-			if 3 < len(tableObject.Args) {
-				return nil, NewTableObjectArgumentsLengthError(tableObject, 5)
-			}
-			options.Delimiter = d[0]
-			if options.Delimiter == '\t' {
-				options.Format = cmd.TSV
-			} else {
-				options.Format = cmd.CSV
-			}
-		case parser.FIXED:
-			if felem == nil {
-				return nil, NewTableObjectInvalidArgumentError(tableObject, "delimiter positions are not specified")
-			}
-			if value.IsNull(felem) {
-				return nil, NewTableObjectInvalidDelimiterPositionsError(tableObject, tableObject.FormatElement.String())
-			}
-			s := felem.(*value.String).Raw()
-
-			var positions []int
-			if !strings.EqualFold("SPACES", s) {
-				if strings.HasPrefix(s, "s[") || strings.HasPrefix(s, "S[") {
-					options.SingleLine = true
-					s = s[1:]
-				}
-				err = gojson.Unmarshal([]byte(s), &positions)
-				if err != nil {
-					return nil, NewTableObjectInvalidDelimiterPositionsError(tableObject, tableObject.FormatElement.String())
-				}
-			}
-			if 3 < len(tableObject.Args) {
-				return nil, NewTableObjectArgumentsLengthError(tableObject, 5)
-			}
-			options.DelimiterPositions = positions
-			options.Format = cmd.FIXED
-		case parser.JSON:
-			if felem == nil {
-				return nil, NewTableObjectInvalidArgumentError(tableObject, "json query is not specified")
-			}
-			if value.IsNull(felem) {
-				return nil, NewTableObjectInvalidJsonQueryError(tableObject, tableObject.FormatElement.String())
-			}
-			if 0 < len(tableObject.Args) {
-				return nil, NewTableObjectJsonArgumentsLengthError(tableObject, 2)
-			}
-			options.JsonQuery = felem.(*value.String).Raw()
-			options.Format = cmd.JSON
-			options.Encoding = text.UTF8
-		case parser.LTSV:
-			if 2 < len(tableObject.Args) {
-				return nil, NewTableObjectJsonArgumentsLengthError(tableObject, 3)
-			}
-			options.Format = cmd.LTSV
-			withoutNullIdx, noHeaderIdx = noHeaderIdx, withoutNullIdx
-		default:
-			return nil, NewInvalidTableObjectError(tableObject, tableObject.Type.Literal)
-		}
-
-		args := make([]value.Primary, 3)
-		defer func() {
-			for i := range args {
-				if args[i] != nil {
-					value.Discard(args[i])
-				}
-			}
-		}()
-
-		for i, a := range tableObject.Args {
-			if pt, ok := a.(parser.PrimitiveType); ok && value.IsNull(pt.Value) {
-				continue
-			}
-
-			var p value.Primary = value.NewNull()
-			if fr, ok := a.(parser.FieldReference); ok {
-				a = parser.NewStringValue(fr.Column.Literal)
-			}
-			if pv, err := Evaluate(ctx, scope, a); err == nil {
-				p = pv
-			}
-
-			switch i {
-			case encodingIdx:
-				v := value.ToString(p)
-				if !value.IsNull(v) {
-					args[i] = v
-				} else {
-					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a encoding value: %s", tableObject.Args[encodingIdx].String()))
-				}
-			case noHeaderIdx:
-				v := value.ToBoolean(p)
-				if !value.IsNull(v) {
-					args[i] = v
-				} else {
-					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a no-header value: %s", tableObject.Args[noHeaderIdx].String()))
-				}
-			case withoutNullIdx:
-				v := value.ToBoolean(p)
-				if !value.IsNull(v) {
-					args[i] = v
-				} else {
-					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a without-null value: %s", tableObject.Args[withoutNullIdx].String()))
-				}
-			}
-		}
-
-		if args[encodingIdx] != nil {
-			if options.Encoding, err = cmd.ParseEncoding(args[0].(*value.String).Raw()); err != nil {
-				return nil, NewTableObjectInvalidArgumentError(tableObject, err.Error())
-			}
-		}
-		if args[noHeaderIdx] != nil {
-			options.NoHeader = args[noHeaderIdx].(*value.Boolean).Raw()
-		}
-		if args[withoutNullIdx] != nil {
-			options.WithoutNull = args[withoutNullIdx].(*value.Boolean).Raw()
+		options, err := tableObjectImportOptions(ctx, scope, tableObject)
+		if err != nil {
+			return nil, err
 		}
 
 		view, err = loadObject(
@@ -287,12 +153,17 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 		}
 	case parser.Join:
 		join := table.Object.(parser.Join)
-		view, err = loadView(ctx, scope, join.Table, forUpdate, useInternalId)
+		t, isTable := join.JoinTable.(parser.Table)
+		lateral := isTable && !t.Lateral.IsEmpty()
+
+		joinCtx, step := beginExecutionStep(ctx, "Join", describeJoin(join, resolveJoinType(join), lateral, scope.Tx.Flags.CPU))
+
+		view, err = loadView(joinCtx, scope, join.Table, forUpdate, useInternalId)
 		if err != nil {
 			return nil, err
 		}
 
-		if t, ok := join.JoinTable.(parser.Table); ok && !t.Lateral.IsEmpty() {
+		if lateral {
 			switch join.Direction.Token {
 			case parser.RIGHT, parser.FULL:
 				return nil, NewIncorrectLateralUsageError(t)
@@ -301,11 +172,16 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			joinTableName := t.Name()
 
 			subquery := t.Object.(parser.Subquery)
+			if !step.Executes() {
+				_, err = Select(joinCtx, scope, subquery.Query)
+				return view, err
+			}
+
 			var hfields Header
 			resultSetList := make([]RecordSet, view.RecordLen())
 
-			if err := EvaluateSequentially(ctx, scope, view, func(seqScope *ReferenceScope, rIdx int) error {
-				appliedView, err := Select(ctx, seqScope, subquery.Query)
+			if err := EvaluateSequentially(joinCtx, scope, view, func(seqScope *ReferenceScope, rIdx int) error {
+				appliedView, err := Select(joinCtx, seqScope, subquery.Query)
 				if err != nil {
 					return err
 				}
@@ -319,7 +195,7 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 				calcView := NewView()
 				calcView.Header = view.Header.Copy()
 				calcView.RecordSet = RecordSet{view.RecordSet[rIdx].Copy()}
-				if err = joinViews(ctx, scope, calcView, appliedView, join); err != nil {
+				if err = joinViews(joinCtx, scope, calcView, appliedView, join); err != nil {
 					return err
 				}
 
@@ -341,25 +217,38 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			view.RecordSet = resultSet
 
 		} else {
-			joinView, err := loadView(ctx, scope, join.JoinTable, forUpdate, useInternalId)
+			joinView, err := loadView(joinCtx, scope, join.JoinTable, forUpdate, useInternalId)
 			if err != nil {
 				return nil, err
 			}
+			if !step.Executes() {
+				return view, nil
+			}
 
-			if err = joinViews(ctx, scope, view, joinView, join); err != nil {
+			if err = joinViews(joinCtx, scope, view, joinView, join); err != nil {
 				return nil, err
 			}
 		}
+		step.Finish(view.RecordLen())
 
 	case parser.JsonQuery:
+		jsonQuery := table.Object.(parser.JsonQuery)
+
+		ctx, step := beginExecutionStep(ctx, "JSON Table Scan", func(plan *ExecutionPlan) {
+			describeTableName(tableName)(plan)
+			plan.AddAttribute("JSON Query", jsonQuery.Query.String())
+			plan.AddAttribute("Source", jsonQuery.JsonText.String())
+		})
+		if !step.Executes() {
+			return NewView(), nil
+		}
+
 		if 0 < len(tableName.Literal) {
 			if err := scope.AddAlias(tableName, ""); err != nil {
 				return nil, err
 			}
 		}
 
-		jsonQuery := table.Object.(parser.JsonQuery)
-
 		queryValue, err := Evaluate(ctx, scope, jsonQuery.Query)
 		if err != nil {
 			return nil, err
@@ -387,7 +276,9 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			defer func() {
 				err = appendCompositeError(err, scope.Tx.FileContainer.Close(h))
 			}()
-			reader = h.File()
+			if reader, err = decompressFile(h.File(), &FileInfo{Compression: file.CompressionFromExt(fpath)}); err != nil {
+				return nil, NewDataParsingError(jsonPath, fpath, err.Error())
+			}
 		} else {
 			jsonTextValue, err := Evaluate(ctx, scope, jsonQuery.JsonText)
 			if err != nil {
@@ -419,13 +310,18 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			}
 			return nil, err
 		}
+		step.Finish(view.RecordLen())
 
 	case parser.Subquery:
 		subquery := table.Object.(parser.Subquery)
+
+		ctx, step := beginExecutionStep(ctx, "Subquery", describeTableName(tableName))
+
 		view, err = Select(ctx, scope, subquery.Query)
-		if err != nil {
-			return nil, err
+		if err != nil || !step.Executes() {
+			return view, err
 		}
+		step.Finish(view.RecordLen())
 
 		if 0 < len(tableName.Literal) {
 			if err := scope.AddAlias(tableName, ""); err != nil {
@@ -441,22 +337,23 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 	return view, err
 }
 
+func resolveJoinType(join parser.Join) int {
+	if join.JoinType.IsEmpty() {
+		if join.Direction.IsEmpty() {
+			return parser.INNER
+		}
+		return parser.OUTER
+	}
+	return join.JoinType.Token
+}
+
 func joinViews(ctx context.Context, scope *ReferenceScope, view *View, joinView *View, join parser.Join) error {
 	condition, includeFields, excludeFields, err := ParseJoinCondition(join, view, joinView)
 	if err != nil {
 		return err
 	}
 
-	joinType := join.JoinType.Token
-	if join.JoinType.IsEmpty() {
-		if join.Direction.IsEmpty() {
-			joinType = parser.INNER
-		} else {
-			joinType = parser.OUTER
-		}
-	}
-
-	switch joinType {
+	switch resolveJoinType(join) {
 	case parser.CROSS:
 		if err = CrossJoin(ctx, scope, view, joinView); err != nil {
 			return err
@@ -546,6 +443,9 @@ func loadStdin(ctx context.Context, scope *ReferenceScope, fileInfo *FileInfo, s
 		if err != nil {
 			return nil, err
 		}
+		if err = handleMalformedRecords(ctx, scope.Tx, view.FileInfo, stdin); err != nil {
+			return nil, err
+		}
 		scope.Global().temporaryTables.Set(view)
 	}
 
@@ -579,6 +479,289 @@ func loadStdin(ctx context.Context, scope *ReferenceScope, fileInfo *FileInfo, s
 	return view, nil
 }
 
+func tableObjectImportOptions(ctx context.Context, scope *ReferenceScope, tableObject parser.TableObject) (options cmd.ImportOptions, err error) {
+	options = scope.Tx.Flags.ImportOptions.Copy()
+
+	var felem value.Primary
+	if tableObject.FormatElement != nil {
+		p, err := Evaluate(ctx, scope, tableObject.FormatElement)
+		if err != nil {
+			return options, err
+		}
+		felem = value.ToString(p)
+		defer value.Discard(felem)
+	}
+
+	encodingIdx := 0
+	noHeaderIdx := 1
+	withoutNullIdx := 2
+	columnTypesIdx := 3
+	sheetNameIdx := -1
+	rowPathIdx := -1
+	quoteCharIdx := -1
+	escapeStyleIdx := -1
+	commentPrefixIdx := -1
+	skipLinesIdx := -1
+	trimSpaceIdx := -1
+
+	switch tableObject.Type.Token {
+	case parser.CSV:
+		if felem == nil {
+			return options, NewTableObjectInvalidArgumentError(tableObject, "delimiter is not specified")
+		}
+		if value.IsNull(felem) {
+			return options, NewTableObjectInvalidDelimiterError(tableObject, tableObject.FormatElement.String())
+		}
+		s := felem.(*value.String).Raw()
+		d := []rune(s)
+		if strings.EqualFold(cmd.DetectDelimiterAutomatically, s) {
+			d = []rune{cmd.AutoDelimiter}
+		} else if 1 != len(d) {
+			return options, NewTableObjectInvalidDelimiterError(tableObject, tableObject.FormatElement.String())
+		}
+		if 9 < len(tableObject.Args) {
+			return options, NewTableObjectArgumentsLengthError(tableObject, 11)
+		}
+		options.Delimiter = d[0]
+		if options.Delimiter == '\t' {
+			options.Format = cmd.TSV
+		} else {
+			options.Format = cmd.CSV
+		}
+		quoteCharIdx, escapeStyleIdx, commentPrefixIdx, skipLinesIdx, trimSpaceIdx = 4, 5, 6, 7, 8
+	case parser.FIXED:
+		if felem == nil {
+			return options, NewTableObjectInvalidArgumentError(tableObject, "delimiter positions are not specified")
+		}
+		if value.IsNull(felem) {
+			return options, NewTableObjectInvalidDelimiterPositionsError(tableObject, tableObject.FormatElement.String())
+		}
+		s := felem.(*value.String).Raw()
+
+		var positions []int
+		if !strings.EqualFold("SPACES", s) {
+			if strings.HasPrefix(s, "s[") || strings.HasPrefix(s, "S[") {
+				options.SingleLine = true
+				s = s[1:]
+			}
+			err = gojson.Unmarshal([]byte(s), &positions)
+			if err != nil {
+				return options, NewTableObjectInvalidDelimiterPositionsError(tableObject, tableObject.FormatElement.String())
+			}
+		}
+		if 4 < len(tableObject.Args) {
+			return options, NewTableObjectArgumentsLengthError(tableObject, 6)
+		}
+		options.DelimiterPositions = positions
+		options.Format = cmd.FIXED
+	case parser.JSON:
+		if felem == nil {
+			return options, NewTableObjectInvalidArgumentError(tableObject, "json query is not specified")
+		}
+		if value.IsNull(felem) {
+			return options, NewTableObjectInvalidJsonQueryError(tableObject, tableObject.FormatElement.String())
+		}
+		if 0 < len(tableObject.Args) {
+			return options, NewTableObjectJsonArgumentsLengthError(tableObject, 2)
+		}
+		options.JsonQuery = felem.(*value.String).Raw()
+		options.Format = cmd.JSON
+		options.Encoding = text.UTF8
+	case parser.JSONL:
+		if felem != nil || 0 < len(tableObject.Args) {
+			return options, NewTableObjectJsonArgumentsLengthError(tableObject, 1)
+		}
+		options.Format = cmd.JSONL
+		options.Encoding = text.UTF8
+	case parser.LTSV:
+		if 3 < len(tableObject.Args) {
+			return options, NewTableObjectJsonArgumentsLengthError(tableObject, 4)
+		}
+		options.Format = cmd.LTSV
+		withoutNullIdx, noHeaderIdx, columnTypesIdx = noHeaderIdx, -1, withoutNullIdx
+	case parser.XLSX:
+		if felem != nil || 3 < len(tableObject.Args) {
+			return options, NewTableObjectArgumentsLengthError(tableObject, 4)
+		}
+		options.Format = cmd.XLSX
+		options.Encoding = text.UTF8
+		sheetNameIdx, encodingIdx = encodingIdx, sheetNameIdx
+		columnTypesIdx = -1
+	case parser.YAML:
+		if felem != nil && value.IsNull(felem) {
+			return options, NewTableObjectInvalidJsonQueryError(tableObject, tableObject.FormatElement.String())
+		}
+		if 0 < len(tableObject.Args) {
+			return options, NewTableObjectJsonArgumentsLengthError(tableObject, 2)
+		}
+		options.JsonQuery = ""
+		if felem != nil {
+			options.JsonQuery = felem.(*value.String).Raw()
+		}
+		options.Format = cmd.YAML
+		options.Encoding = text.UTF8
+	case parser.XML:
+		if felem != nil || 1 < len(tableObject.Args) {
+			return options, NewTableObjectArgumentsLengthError(tableObject, 2)
+		}
+		options.Format = cmd.XML
+		options.Encoding = text.UTF8
+		rowPathIdx, encodingIdx = encodingIdx, rowPathIdx
+		columnTypesIdx = -1
+	default:
+		return options, NewInvalidTableObjectError(tableObject, tableObject.Type.Literal)
+	}
+
+	args := make([]value.Primary, 9)
+	defer func() {
+		for i := range args {
+			if args[i] != nil {
+				value.Discard(args[i])
+			}
+		}
+	}()
+
+	for i, a := range tableObject.Args {
+		if pt, ok := a.(parser.PrimitiveType); ok && value.IsNull(pt.Value) {
+			continue
+		}
+
+		var p value.Primary = value.NewNull()
+		if fr, ok := a.(parser.FieldReference); ok {
+			a = parser.NewStringValue(fr.Column.Literal)
+		}
+		if pv, err := Evaluate(ctx, scope, a); err == nil {
+			p = pv
+		}
+
+		switch i {
+		case rowPathIdx:
+			v := value.ToString(p)
+			if !value.IsNull(v) {
+				args[i] = v
+			} else {
+				return options, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a row path: %s", tableObject.Args[rowPathIdx].String()))
+			}
+		case sheetNameIdx:
+			v := value.ToString(p)
+			if !value.IsNull(v) {
+				args[i] = v
+			} else {
+				return options, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a sheet name: %s", tableObject.Args[sheetNameIdx].String()))
+			}
+		case encodingIdx:
+			v := value.ToString(p)
+			if !value.IsNull(v) {
+				args[i] = v
+			} else {
+				return options, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a encoding value: %s", tableObject.Args[encodingIdx].String()))
+			}
+		case noHeaderIdx:
+			v := value.ToBoolean(p)
+			if !value.IsNull(v) {
+				args[i] = v
+			} else {
+				return options, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a no-header value: %s", tableObject.Args[noHeaderIdx].String()))
+			}
+		case withoutNullIdx:
+			v := value.ToBoolean(p)
+			if !value.IsNull(v) {
+				args[i] = v
+			} else {
+				return options, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a without-null value: %s", tableObject.Args[withoutNullIdx].String()))
+			}
+		case columnTypesIdx:
+			v := value.ToString(p)
+			if !value.IsNull(v) {
+				args[i] = v
+			} else {
+				return options, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a column-types value: %s", tableObject.Args[columnTypesIdx].String()))
+			}
+		case quoteCharIdx:
+			v := value.ToString(p)
+			if !value.IsNull(v) {
+				args[i] = v
+			} else {
+				return options, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a quote-char value: %s", tableObject.Args[quoteCharIdx].String()))
+			}
+		case escapeStyleIdx:
+			v := value.ToString(p)
+			if !value.IsNull(v) {
+				args[i] = v
+			} else {
+				return options, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a escape-style value: %s", tableObject.Args[escapeStyleIdx].String()))
+			}
+		case commentPrefixIdx:
+			v := value.ToString(p)
+			if !value.IsNull(v) {
+				args[i] = v
+			} else {
+				return options, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a comment-prefix value: %s", tableObject.Args[commentPrefixIdx].String()))
+			}
+		case skipLinesIdx:
+			v := value.ToInteger(p)
+			if !value.IsNull(v) {
+				args[i] = v
+			} else {
+				return options, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a skip-lines value: %s", tableObject.Args[skipLinesIdx].String()))
+			}
+		case trimSpaceIdx:
+			v := value.ToBoolean(p)
+			if !value.IsNull(v) {
+				args[i] = v
+			} else {
+				return options, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a trim-space value: %s", tableObject.Args[trimSpaceIdx].String()))
+			}
+		}
+	}
+
+	if 0 <= sheetNameIdx && args[sheetNameIdx] != nil {
+		options.SheetName = args[sheetNameIdx].(*value.String).Raw()
+	}
+	if 0 <= rowPathIdx && args[rowPathIdx] != nil {
+		options.RowPath = args[rowPathIdx].(*value.String).Raw()
+	}
+	if 0 <= encodingIdx && args[encodingIdx] != nil {
+		if options.Encoding, err = cmd.ParseEncoding(args[encodingIdx].(*value.String).Raw()); err != nil {
+			return options, NewTableObjectInvalidArgumentError(tableObject, err.Error())
+		}
+	}
+	if 0 <= noHeaderIdx && args[noHeaderIdx] != nil {
+		options.NoHeader = args[noHeaderIdx].(*value.Boolean).Raw()
+	}
+	if args[withoutNullIdx] != nil {
+		options.WithoutNull = args[withoutNullIdx].(*value.Boolean).Raw()
+	}
+	if 0 <= columnTypesIdx && args[columnTypesIdx] != nil {
+		if options.ColumnTypes, err = cmd.ParseColumnTypes(args[columnTypesIdx].(*value.String).Raw()); err != nil {
+			return options, NewTableObjectInvalidArgumentError(tableObject, err.Error())
+		}
+	}
+	if 0 <= quoteCharIdx && args[quoteCharIdx] != nil {
+		if options.QuoteChar, err = cmd.ParseQuoteChar(args[quoteCharIdx].(*value.String).Raw()); err != nil {
+			return options, NewTableObjectInvalidArgumentError(tableObject, err.Error())
+		}
+	}
+	if 0 <= escapeStyleIdx && args[escapeStyleIdx] != nil {
+		if options.EscapeStyle, err = cmd.ParseEscapeStyle(args[escapeStyleIdx].(*value.String).Raw()); err != nil {
+			return options, NewTableObjectInvalidArgumentError(tableObject, err.Error())
+		}
+	}
+	if 0 <= commentPrefixIdx && args[commentPrefixIdx] != nil {
+		options.CommentPrefix = args[commentPrefixIdx].(*value.String).Raw()
+	}
+	if 0 <= skipLinesIdx && args[skipLinesIdx] != nil {
+		if options.SkipLines = int(args[skipLinesIdx].(*value.Integer).Raw()); options.SkipLines < 0 {
+			options.SkipLines = 0
+		}
+	}
+	if 0 <= trimSpaceIdx && args[trimSpaceIdx] != nil {
+		options.TrimSpace = args[trimSpaceIdx].(*value.Boolean).Raw()
+	}
+
+	return options, nil
+}
+
 func loadObject(
 	ctx context.Context,
 	scope *ReferenceScope,
@@ -587,7 +770,14 @@ func loadObject(
 	forUpdate bool,
 	useInternalId bool,
 	options cmd.ImportOptions,
-) (*View, error) {
+) (view *View, err error) {
+	var step *executionStep
+	defer func() {
+		if err == nil {
+			step.Finish(view.RecordLen())
+		}
+	}()
+
 	if stdin, ok := tableExpr.(parser.Stdin); ok {
 		if options.Format == cmd.AutoSelect {
 			options.Format = scope.Tx.Flags.ImportOptions.Format
@@ -600,18 +790,37 @@ func loadObject(
 			DelimiterPositions: options.DelimiterPositions,
 			SingleLine:         options.SingleLine,
 			JsonQuery:          options.JsonQuery,
+			SheetName:          options.SheetName,
+			RowPath:            options.RowPath,
+			ColumnTypes:        options.ColumnTypes,
 			Encoding:           options.Encoding,
 			LineBreak:          scope.Tx.Flags.ExportOptions.LineBreak,
 			NoHeader:           options.NoHeader,
+			QuoteChar:          normalizeQuoteChar(options.QuoteChar),
+			EscapeStyle:        options.EscapeStyle,
+			CommentPrefix:      options.CommentPrefix,
+			SkipLines:          options.SkipLines,
+			TrimSpace:          options.TrimSpace,
+			OnParseError:       options.OnParseError,
 			ViewType:           ViewTypeStdin,
 		}
+
+		ctx, step = beginExecutionStep(ctx, "Stdin Scan", describeTableName(tableName))
+		if !step.Executes() {
+			explainFileInfo(step, fileInfo)
+			return NewView(), nil
+		}
 		return loadStdin(ctx, scope, fileInfo, stdin, tableName, forUpdate, useInternalId)
 	}
 
 	tableIdentifier := tableExpr.(parser.Identifier)
 
 	if scope.RecursiveTable != nil && strings.EqualFold(tableIdentifier.Literal, scope.RecursiveTable.Name.Literal) && scope.RecursiveTmpView != nil {
-		view := scope.RecursiveTmpView.Copy()
+		if ctx, step = beginExecutionStep(ctx, "Recursive Table Scan", describeTableName(tableName)); !step.Executes() {
+			return NewView(), nil
+		}
+
+		view = scope.RecursiveTmpView.Copy()
 		if !strings.EqualFold(scope.RecursiveTable.Name.Literal, tableName.Literal) {
 			if err := view.Header.Update(tableName.Literal, nil); err != nil {
 				return nil, err
@@ -621,11 +830,15 @@ func loadObject(
 	}
 
 	if scope.InlineTableExists(tableIdentifier) {
+		if ctx, step = beginExecutionStep(ctx, "Inline Table Scan", describeTableName(tableName)); !step.Executes() {
+			return NewView(), nil
+		}
+
 		if err := scope.AddAlias(tableName, ""); err != nil {
 			return nil, err
 		}
 
-		view, _ := scope.GetInlineTable(tableIdentifier)
+		view, _ = scope.GetInlineTable(tableIdentifier)
 		if !strings.EqualFold(tableIdentifier.Literal, tableName.Literal) {
 			if err := view.Header.Update(tableName.Literal, nil); err != nil {
 				return nil, err
@@ -636,12 +849,14 @@ func loadObject(
 
 	filePath := tableIdentifier.Literal
 	if scope.TemporaryTableExists(filePath) {
+		if ctx, step = beginExecutionStep(ctx, "Temporary Table Scan", describeTableName(tableName)); !step.Executes() {
+			return NewView(), nil
+		}
+
 		if err := scope.AddAlias(tableName, filePath); err != nil {
 			return nil, err
 		}
 
-		var view *View
-		var err error
 		pathIdent := parser.Identifier{Literal: filePath}
 		if useInternalId {
 			if view, err = scope.GetTemporaryTableWithInternalId(ctx, pathIdent, scope.Tx.Flags); err != nil {
@@ -662,7 +877,42 @@ func loadObject(
 		return view, nil
 	}
 
-	filePath, err := cacheViewFromFile(
+	if IsGlobPattern(tableIdentifier, scope.Tx.Flags.Repository) {
+		if forUpdate || useInternalId {
+			return nil, NewReadOnlyGlobTableError(tableIdentifier)
+		}
+		if ctx, step = beginExecutionStep(ctx, "Glob File Scan", func(plan *ExecutionPlan) {
+			describeTableName(tableName)(plan)
+			plan.AddAttribute("Pattern", tableIdentifier.Literal)
+		}); !step.Executes() {
+			return NewView(), nil
+		}
+		return loadViewFromGlobPattern(ctx, scope, tableIdentifier, tableName, options)
+	}
+
+	if IsDirectoryPath(tableIdentifier, scope.Tx.Flags.Repository) {
+		if forUpdate || useInternalId {
+			return nil, NewReadOnlyGlobTableError(tableIdentifier)
+		}
+		if ctx, step = beginExecutionStep(ctx, "Partitioned Directory Scan", func(plan *ExecutionPlan) {
+			describeTableName(tableName)(plan)
+			plan.AddAttribute("Directory", tableIdentifier.Literal)
+		}); !step.Executes() {
+			return NewView(), nil
+		}
+		return loadViewFromPartitionedDirectory(ctx, scope, tableIdentifier, tableName, options)
+	}
+
+	if ctx, step = beginExecutionStep(ctx, "File Scan", describeTableName(tableName)); !step.Executes() {
+		fileInfo, err := plannedFileInfo(scope, tableIdentifier, options)
+		if err != nil {
+			return nil, err
+		}
+		explainFileInfo(step, fileInfo)
+		return NewView(), nil
+	}
+
+	filePath, err = cacheViewFromFile(
 		ctx,
 		scope,
 		tableIdentifier,
@@ -673,7 +923,6 @@ func loadObject(
 		return nil, err
 	}
 
-	var view *View
 	pathIdent := parser.Identifier{Literal: filePath}
 	if useInternalId {
 		if view, err = scope.Tx.cachedViews.GetWithInternalId(ctx, pathIdent, scope.Tx.Flags); err != nil {
@@ -697,6 +946,136 @@ func loadObject(
 			return nil, err
 		}
 	}
+	explainFileInfo(step, view.FileInfo)
+	return view, nil
+}
+
+// loadViewFromGlobPattern loads all the files that match the pattern and concatenates them into one view.
+// The pseudo column FileColumn, which is not included in the table columns, holds the path of each file.
+func loadViewFromGlobPattern(
+	ctx context.Context,
+	scope *ReferenceScope,
+	pattern parser.Identifier,
+	tableName parser.Identifier,
+	options cmd.ImportOptions,
+) (*View, error) {
+	pathes, err := SearchFilePathsWithGlob(pattern, scope.Tx.Flags.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]*View, 0, len(pathes))
+	for _, p := range pathes {
+		fileIdent := pattern
+		fileIdent.Literal = p
+
+		filePath, err := cacheViewFromFile(ctx, scope, fileIdent, false, options)
+		if err != nil {
+			return nil, err
+		}
+
+		pathIdent := parser.Identifier{Literal: filePath}
+		view, err := scope.Tx.cachedViews.Get(pathIdent)
+		if err != nil {
+			return nil, NewTableNotLoadedError(pathIdent)
+		}
+		views = append(views, view)
+	}
+
+	view, err := concatFileViews(pattern, views, options.GlobUnionByName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = scope.AddAlias(tableName, ""); err != nil {
+		return nil, err
+	}
+	if err = view.Header.Update(tableName.Literal, nil); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+// concatFileViews concatenates the records of the views loaded from files.
+// The columns of all the views must match the columns of the first view unless unionByName is true.
+// If unionByName is true, the columns are unioned by their names and the missing fields are filled with nulls.
+func concatFileViews(pattern parser.Identifier, views []*View, unionByName bool) (*View, error) {
+	columns := views[0].Header.TableColumnNames()
+	indices := make([][]int, len(views))
+
+	for i := 1; i < len(views); i++ {
+		names := views[i].Header.TableColumnNames()
+
+		if !unionByName {
+			match := len(names) == len(columns)
+			for j := 0; match && j < len(names); j++ {
+				match = strings.EqualFold(names[j], columns[j])
+			}
+			if !match {
+				fileIdent := pattern
+				fileIdent.Literal = views[i].FileInfo.Path
+				baseIdent := pattern
+				baseIdent.Literal = views[0].FileInfo.Path
+				return nil, NewGlobHeaderMismatchError(fileIdent, baseIdent)
+			}
+			continue
+		}
+
+		for _, name := range names {
+			if !InStrSliceWithCaseInsensitive(name, columns) {
+				columns = append(columns, name)
+			}
+		}
+	}
+
+	if unionByName {
+		for i := range views {
+			names := views[i].Header.TableColumnNames()
+			indices[i] = make([]int, len(columns))
+			for j := range columns {
+				indices[i][j] = -1
+				for k := range names {
+					if strings.EqualFold(names[k], columns[j]) {
+						indices[i][j] = k
+						break
+					}
+				}
+			}
+		}
+	}
+
+	recordLen := 0
+	for i := range views {
+		recordLen = recordLen + views[i].RecordLen()
+	}
+
+	records := make(RecordSet, 0, recordLen)
+	for i := range views {
+		filePath := value.NewString(views[i].FileInfo.Path)
+
+		for _, r := range views[i].RecordSet {
+			record := make(Record, len(columns)+1)
+			for j := range columns {
+				switch {
+				case indices[i] == nil:
+					record[j] = r[j]
+				case indices[i][j] < 0:
+					record[j] = NewCell(value.NewNull())
+				default:
+					record[j] = r[indices[i][j]]
+				}
+			}
+			record[len(columns)] = NewCell(filePath)
+			records = append(records, record)
+		}
+	}
+
+	view := NewView()
+	view.Header = append(NewHeader(parser.FormatTableName(pattern.Literal), columns), HeaderField{
+		View:   parser.FormatTableName(pattern.Literal),
+		Column: FileColumn,
+	})
+	view.RecordSet = records
 	return view, nil
 }
 
@@ -720,31 +1099,46 @@ func cacheViewFromFile(
 	}
 
 	view, ok := scope.Tx.cachedViews.Load(filePath)
-	if !ok || (forUpdate && !view.FileInfo.ForUpdate) {
+	if !ok || (forUpdate && !view.FileInfo.ForUpdate) || !view.FileInfo.HasColumns(scope.columnsToLoad) || !view.FileInfo.IsLoadedWith(options) {
 		fileInfo, err := NewFileInfo(tableIdentifier, scope.Tx.Flags.Repository, options, scope.Tx.Flags.ImportOptions.Format)
 		if err != nil {
 			return filePath, err
 		}
 		filePath = fileInfo.Path
 
+		if forUpdate && (fileInfo.Format == cmd.PARQUET || fileInfo.Format == cmd.XLSX || fileInfo.Format == cmd.XML) {
+			tableIdentifier.Literal = fileInfo.Path
+			return filePath, NewReadOnlyFormatError(tableIdentifier, fileInfo.Format.String())
+		}
+
 		view, ok = scope.Tx.cachedViews.Load(filePath)
-		if !ok || (forUpdate && !view.FileInfo.ForUpdate) {
+		if !ok || (forUpdate && !view.FileInfo.ForUpdate) || !view.FileInfo.HasColumns(scope.columnsToLoad) || !view.FileInfo.IsLoadedWith(options) {
 			fileInfo.DelimiterPositions = options.DelimiterPositions
 			fileInfo.SingleLine = options.SingleLine
 			fileInfo.JsonQuery = cmd.TrimSpace(options.JsonQuery)
+			fileInfo.SheetName = options.SheetName
+			fileInfo.RowPath = options.RowPath
+			fileInfo.ColumnTypes = options.ColumnTypes
 			fileInfo.LineBreak = scope.Tx.Flags.ExportOptions.LineBreak
 			fileInfo.NoHeader = options.NoHeader
 			fileInfo.EncloseAll = scope.Tx.Flags.ExportOptions.EncloseAll
 			fileInfo.JsonEscape = scope.Tx.Flags.ExportOptions.JsonEscape
 
-			if ok {
+			if ok && view.FileInfo.IsLoadedWith(options) {
 				fileInfo = view.FileInfo
 			}
+			if fileInfo.Format == cmd.PARQUET {
+				fileInfo.Columns = scope.columnsToLoad
+			}
 
 			if err = scope.Tx.cachedViews.Dispose(scope.Tx.FileContainer, fileInfo.Path); err != nil {
 				return filePath, err
 			}
 
+			if err = scope.Tx.recoverJournalsInDirectory(filepath.Dir(fileInfo.Path)); err != nil {
+				return filePath, err
+			}
+
 			var fp *os.File
 			if forUpdate {
 				h, err := file.NewHandlerForUpdate(ctx, scope.Tx.FileContainer, fileInfo.Path, scope.Tx.WaitTimeout, scope.Tx.RetryDelay)
@@ -773,6 +1167,27 @@ func cacheViewFromFile(
 				}
 				return filePath, appendCompositeError(err, scope.Tx.FileContainer.Close(fileInfo.Handler))
 			}
+
+			schema, err := LoadTableSchema(fileInfo.Path)
+			if err == nil {
+				err = applyTableSchema(loadView, schema, scope.Tx.Flags.DatetimeFormat)
+			}
+			if err != nil {
+				err = NewDataParsingError(tableIdentifier, fileInfo.Path, err.Error())
+				return filePath, appendCompositeError(err, scope.Tx.FileContainer.Close(fileInfo.Handler))
+			}
+
+			if forUpdate && 0 < len(loadView.FileInfo.MalformedRecords) {
+				tableIdentifier.Literal = fileInfo.Path
+				err = NewReadOnlyMalformedTableError(tableIdentifier, len(loadView.FileInfo.MalformedRecords))
+				loadView.FileInfo.MalformedRecords = nil
+				return filePath, appendCompositeError(err, scope.Tx.FileContainer.Close(fileInfo.Handler))
+			}
+
+			if err = handleMalformedRecords(ctx, scope.Tx, loadView.FileInfo, tableIdentifier); err != nil {
+				return filePath, appendCompositeError(err, scope.Tx.FileContainer.Close(fileInfo.Handler))
+			}
+
 			loadView.FileInfo.ForUpdate = forUpdate
 			scope.Tx.cachedViews.Set(loadView)
 		}
@@ -784,18 +1199,34 @@ func cacheViewFromFile(
 }
 
 func loadViewFromFile(ctx context.Context, flags *cmd.Flags, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
+	fp, err := decompressFile(fp, fileInfo)
+	if err != nil {
+		return nil, err
+	}
+	defer closeDecompressedFile(fp)
+
 	switch fileInfo.Format {
 	case cmd.FIXED:
-		return loadViewFromFixedLengthTextFile(ctx, fp, fileInfo, withoutNull, expr)
+		return loadViewFromFixedLengthTextFile(ctx, flags, fp, fileInfo, withoutNull, expr)
 	case cmd.LTSV:
 		return loadViewFromLTSVFile(ctx, flags, fp, fileInfo, withoutNull, expr)
 	case cmd.JSON:
 		return loadViewFromJsonFile(fp, fileInfo, expr)
-	}
-	return loadViewFromCSVFile(ctx, fp, fileInfo, withoutNull, expr)
+	case cmd.JSONL:
+		return loadViewFromJsonlFile(fp, fileInfo, expr)
+	case cmd.YAML:
+		return loadViewFromYamlFile(fp, fileInfo, expr)
+	case cmd.XML:
+		return loadViewFromXmlFile(fp, fileInfo, expr)
+	case cmd.PARQUET:
+		return loadViewFromParquetFile(ctx, fp, fileInfo)
+	case cmd.XLSX:
+		return loadViewFromXlsxFile(fp, fileInfo, withoutNull)
+	}
+	return loadViewFromCSVFile(ctx, flags, fp, fileInfo, withoutNull, expr)
 }
 
-func loadViewFromFixedLengthTextFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
+func loadViewFromFixedLengthTextFile(ctx context.Context, flags *cmd.Flags, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
 	enc, err := text.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
 	if err != nil {
 		return nil, NewCannotDetectFileEncodingError(expr)
@@ -846,7 +1277,8 @@ func loadViewFromFixedLengthTextFile(ctx context.Context, fp io.ReadSeeker, file
 		}
 	}
 
-	records, err := readRecordSet(ctx, reader, fileSize(fp))
+	converter := newFieldConverter(fileInfo.ColumnTypes, flags.DatetimeFormat, headerOrDefaultColumnNames(header))
+	records, err := readRecordSet(ctx, reader, fileSize(fp), converter)
 	if err != nil {
 		return nil, err
 	}
@@ -869,19 +1301,29 @@ func loadViewFromFixedLengthTextFile(ctx context.Context, fp io.ReadSeeker, file
 	return view, nil
 }
 
-func loadViewFromCSVFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
+func loadViewFromCSVFile(ctx context.Context, flags *cmd.Flags, fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
 	enc, err := text.DetectInSpecifiedEncoding(fp, fileInfo.Encoding)
 	if err != nil {
 		return nil, NewCannotDetectFileEncodingError(expr)
 	}
 	fileInfo.Encoding = enc
 
-	reader, err := csv.NewReader(fp, fileInfo.Encoding)
+	if fileInfo.Delimiter == cmd.AutoDelimiter {
+		dialect, err := detectCSVDialect(fp, fileInfo, flags.DatetimeFormat)
+		if err != nil {
+			return nil, err
+		}
+		fileInfo.Delimiter = dialect.Delimiter
+		fileInfo.NoHeader = dialect.NoHeader
+		if fileInfo.Delimiter == '\t' {
+			fileInfo.Format = cmd.TSV
+		}
+	}
+
+	reader, err := newCSVReader(fp, fileInfo, withoutNull)
 	if err != nil {
 		return nil, err
 	}
-	reader.Delimiter = fileInfo.Delimiter
-	reader.WithoutNull = withoutNull
 
 	var header []string
 	if !fileInfo.NoHeader {
@@ -891,22 +1333,34 @@ func loadViewFromCSVFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileIn
 		}
 	}
 
-	records, err := readRecordSet(ctx, reader, fileSize(fp))
+	var recordReader RecordReader = reader
+	if fileInfo.OnParseError != cmd.FailOnParseError {
+		recordReader = &lenientRecordReader{reader: reader}
+	}
+
+	converter := newFieldConverter(fileInfo.ColumnTypes, flags.DatetimeFormat, headerOrDefaultColumnNames(header))
+	records, err := readRecordSet(ctx, recordReader, fileSize(fp), converter)
 	if err != nil {
 		return nil, err
 	}
+	if r, ok := recordReader.(*lenientRecordReader); ok {
+		fileInfo.MalformedRecords = r.malformed
+	}
+
+	fieldsPerRecord, lineBreak, enclosedAll, skippedLines := csvReaderState(reader)
 
 	if header == nil {
-		header = make([]string, reader.FieldsPerRecord)
-		for i := 0; i < reader.FieldsPerRecord; i++ {
+		header = make([]string, fieldsPerRecord)
+		for i := 0; i < fieldsPerRecord; i++ {
 			header[i] = "c" + strconv.Itoa(i+1)
 		}
 	}
 
-	if reader.DetectedLineBreak != "" {
-		fileInfo.LineBreak = reader.DetectedLineBreak
+	if lineBreak != "" {
+		fileInfo.LineBreak = lineBreak
 	}
-	fileInfo.EncloseAll = reader.EnclosedAll
+	fileInfo.EncloseAll = enclosedAll
+	fileInfo.LeadingLines = skippedLines
 
 	view := NewView()
 	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), header)
@@ -928,7 +1382,10 @@ func loadViewFromLTSVFile(ctx context.Context, flags *cmd.Flags, fp io.ReadSeeke
 	}
 	reader.WithoutNull = withoutNull
 
-	records, err := readRecordSet(ctx, reader, fileSize(fp))
+	converter := newFieldConverter(fileInfo.ColumnTypes, flags.DatetimeFormat, func(int) []string {
+		return reader.Header.Fields()
+	})
+	records, err := readRecordSet(ctx, reader, fileSize(fp), converter)
 	if err != nil {
 		return nil, err
 	}
@@ -958,6 +1415,30 @@ func loadViewFromLTSVFile(ctx context.Context, flags *cmd.Flags, fp io.ReadSeeke
 	return view, nil
 }
 
+// decompressFile detects the compression of fp by its magic bytes if the extension does not tell it,
+// and returns a reader of the decompressed data.
+// If fp is compressed, the returned reader is a file.DecompressionReader that must be closed.
+func decompressFile(fp io.ReadSeeker, fileInfo *FileInfo) (io.ReadSeeker, error) {
+	if fileInfo.Compression == file.NoCompression {
+		c, err := file.DetectCompression(fp)
+		if err != nil {
+			return nil, err
+		}
+		fileInfo.Compression = c
+	}
+	if fileInfo.Compression == file.NoCompression {
+		return fp, nil
+	}
+
+	return file.NewDecompressionReadSeeker(fp, fileInfo.Compression)
+}
+
+func closeDecompressedFile(fp io.ReadSeeker) {
+	if d, ok := fp.(*file.DecompressionReader); ok {
+		_ = d.Close()
+	}
+}
+
 func fileSize(fp io.ReadSeeker) int64 {
 	if f, ok := fp.(*os.File); ok {
 		if fi, err := f.Stat(); err == nil {
@@ -967,10 +1448,11 @@ func fileSize(fp io.ReadSeeker) int64 {
 	return 0
 }
 
-func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64) (RecordSet, error) {
+func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64, converter *fieldConverter) (RecordSet, error) {
 	var err error
+	var convErr error
 	recordSet := make(RecordSet, 0, fileLoadingPreparedRecordSetCap)
-	rowch := make(chan []text.RawText, fileLoadingBuffer)
+	rowch := make(chan rawRecord, fileLoadingBuffer)
 	pos := 0
 
 	wg := sync.WaitGroup{}
@@ -982,13 +1464,13 @@ func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64) (Re
 			if !ok {
 				break
 			}
-			record := make(Record, len(row))
-			for i, v := range row {
-				if v == nil {
-					record[i] = NewCell(value.NewNull())
-				} else {
-					record[i] = NewCell(value.NewString(string(v)))
-				}
+			if convErr != nil {
+				continue
+			}
+
+			record := convertRawTextToRecord(row.fields)
+			if convErr = converter.Convert(len(recordSet)+1, record, row.columns); convErr != nil {
+				continue
 			}
 
 			if 0 < fileSize && len(recordSet) == fileLoadingPreparedRecordSetCap && int64(pos) < fileSize {
@@ -1027,7 +1509,7 @@ func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64) (Re
 				}
 			}
 
-			rowch <- row
+			rowch <- rawRecord{fields: row, columns: converter.Columns(len(row))}
 			i++
 		}
 		close(rowch)
@@ -1036,9 +1518,24 @@ func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64) (Re
 
 	wg.Wait()
 
+	if err == nil {
+		err = convErr
+	}
 	return recordSet, err
 }
 
+func convertRawTextToRecord(row []text.RawText) Record {
+	record := make(Record, len(row))
+	for i, v := range row {
+		if v == nil {
+			record[i] = NewCell(value.NewNull())
+		} else {
+			record[i] = NewCell(value.NewString(string(v)))
+		}
+	}
+	return record
+}
+
 func loadViewFromJsonFile(fp io.Reader, fileInfo *FileInfo, expr parser.QueryExpression) (*View, error) {
 	jsonText, err := ioutil.ReadAll(fp)
 	if err != nil {
@@ -1065,6 +1562,201 @@ func loadViewFromJsonFile(fp io.Reader, fileInfo *FileInfo, expr parser.QueryExp
 	return view, nil
 }
 
+func loadViewFromJsonlFile(fp io.Reader, fileInfo *FileInfo, expr parser.QueryExpression) (*View, error) {
+	headerLabels, rows, escapeType, invalidLines, err := json.LoadLinesTable(fp, fileInfo.OnParseError != cmd.FailOnParseError)
+	if err != nil {
+		return nil, NewLoadJsonError(expr, err.Error())
+	}
+	for _, e := range invalidLines {
+		fileInfo.MalformedRecords = append(fileInfo.MalformedRecords, MalformedRecord{
+			Line:   e.Line,
+			Reason: e.Error(),
+			Text:   e.Text,
+		})
+	}
+
+	records := make(RecordSet, len(rows))
+	for i := range rows {
+		records[i] = NewRecord(rows[i])
+	}
+
+	fileInfo.Encoding = text.UTF8
+	fileInfo.JsonEscape = escapeType
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), headerLabels)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+func loadViewFromYamlFile(fp io.Reader, fileInfo *FileInfo, expr parser.QueryExpression) (*View, error) {
+	yamlText, err := ioutil.ReadAll(fp)
+	if err != nil {
+		return nil, NewIOError(expr, err.Error())
+	}
+
+	headerLabels, rows, err := yaml.LoadTable(fileInfo.JsonQuery, string(yamlText))
+	if err != nil {
+		return nil, NewLoadYamlError(expr, err.Error())
+	}
+
+	records := make(RecordSet, len(rows))
+	for i := range rows {
+		records[i] = NewRecord(rows[i])
+	}
+
+	fileInfo.Encoding = text.UTF8
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), headerLabels)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+func loadViewFromXmlFile(fp io.Reader, fileInfo *FileInfo, expr parser.QueryExpression) (*View, error) {
+	headerLabels, rows, err := xml.LoadTable(fileInfo.RowPath, fp)
+	if err != nil {
+		return nil, NewLoadXmlError(expr, err.Error())
+	}
+
+	records := make(RecordSet, len(rows))
+	for i := range rows {
+		records[i] = NewRecord(rows[i])
+	}
+
+	fileInfo.Encoding = text.UTF8
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), headerLabels)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+func loadViewFromParquetFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileInfo) (*View, error) {
+	if _, ok := fp.(*file.DecompressionReader); ok {
+		// Parquet files are read from the footer, so compressed files are decompressed in memory.
+		data, err := ioutil.ReadAll(fp)
+		if err != nil {
+			return nil, err
+		}
+		fp = bytes.NewReader(data)
+	}
+
+	reader, err := parquet.NewReader(fp)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]*parquet.Column, 0, len(reader.Columns()))
+	for _, c := range reader.Columns() {
+		if fileInfo.Columns == nil || InStrSliceWithCaseInsensitive(c.Name, fileInfo.Columns) {
+			columns = append(columns, c)
+		}
+	}
+
+	header := make([]string, len(columns))
+	values := make([][]value.Primary, len(columns))
+	for i, c := range columns {
+		if ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		header[i] = c.Name
+		if values[i], err = reader.ReadColumn(c); err != nil {
+			return nil, err
+		}
+	}
+
+	records := make(RecordSet, reader.NumRows())
+	for i := range records {
+		record := make(Record, len(columns))
+		for j := range columns {
+			record[j] = NewCell(values[j][i])
+		}
+		records[i] = record
+	}
+
+	fileInfo.Encoding = text.UTF8
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+func loadViewFromXlsxFile(fp io.ReadSeeker, fileInfo *FileInfo, withoutNull bool) (*View, error) {
+	ra, ok := fp.(io.ReaderAt)
+	if !ok {
+		data, err := ioutil.ReadAll(fp)
+		if err != nil {
+			return nil, err
+		}
+		r := bytes.NewReader(data)
+		ra, fp = r, r
+	}
+	size, err := fp.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := xlsx.NewReader(ra, size)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := reader.ReadSheet(fileInfo.SheetName)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldLen := 0
+	if 0 < len(rows) {
+		fieldLen = len(rows[0])
+	}
+
+	header := make([]string, fieldLen)
+	if !fileInfo.NoHeader && 0 < len(rows) {
+		for i, p := range rows[0] {
+			if p != nil {
+				header[i], _, _ = ConvertFieldContents(p, false)
+			}
+		}
+		rows = rows[1:]
+	}
+	for i := range header {
+		if len(header[i]) < 1 {
+			header[i] = "c" + strconv.Itoa(i+1)
+		}
+	}
+
+	records := make(RecordSet, len(rows))
+	for i, row := range rows {
+		record := make(Record, fieldLen)
+		for j, p := range row {
+			if p == nil || value.IsNull(p) {
+				if withoutNull {
+					p = value.NewString("")
+				} else {
+					p = value.NewNull()
+				}
+			}
+			record[j] = NewCell(p)
+		}
+		records[i] = record
+	}
+
+	fileInfo.Encoding = text.UTF8
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
 func loadDualView() *View {
 	return &View{
 		Header:    NewEmptyHeader(1),
@@ -1140,6 +1832,7 @@ func (view *View) group(ctx context.Context, scope *ReferenceScope, items []pars
 	}
 
 	gm := NewGoroutineTaskManager(view.RecordLen(), -1, scope.Tx.Flags.CPU)
+	explainGoroutines(ctx, gm.Number)
 	groupsList := make([]map[string][]int, gm.Number)
 	groupKeyCnt := make(map[string]int, 20)
 	groupKeys := make([]string, 0, 20)
@@ -1818,7 +2511,7 @@ func (view *View) InsertValues(ctx context.Context, scope *ReferenceScope, field
 	if err != nil {
 		return 0, err
 	}
-	return view.insert(ctx, fields, recordValues)
+	return view.insert(ctx, scope.Tx.Flags, fields, recordValues)
 }
 
 func (view *View) InsertFromQuery(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, query parser.SelectQuery) (int, error) {
@@ -1826,7 +2519,7 @@ func (view *View) InsertFromQuery(ctx context.Context, scope *ReferenceScope, fi
 	if err != nil {
 		return 0, err
 	}
-	return view.insert(ctx, fields, recordValues)
+	return view.insert(ctx, scope.Tx.Flags, fields, recordValues)
 }
 
 func (view *View) ReplaceValues(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, list []parser.QueryExpression, keys []parser.QueryExpression) (int, error) {
@@ -1890,7 +2583,7 @@ func (view *View) convertResultSetToRecordValues(ctx context.Context, scope *Ref
 	return recordValues, nil
 }
 
-func (view *View) convertRecordValuesToRecordSet(ctx context.Context, fields []parser.QueryExpression, recordValues [][]value.Primary) (RecordSet, error) {
+func (view *View) convertRecordValuesToRecordSet(ctx context.Context, flags *cmd.Flags, fields []parser.QueryExpression, recordValues [][]value.Primary) (RecordSet, error) {
 	var valueIndex = func(i int, list []int) int {
 		for j, v := range list {
 			if i == v {
@@ -1918,19 +2611,30 @@ func (view *View) convertRecordValuesToRecordSet(ctx context.Context, fields []p
 
 		record := make(Record, view.FieldLen())
 		for j := 0; j < view.FieldLen(); j++ {
+			var p value.Primary
+			var expr parser.QueryExpression
 			if recordIndices[j] < 0 {
-				record[j] = NewCell(value.NewNull())
+				p = value.NewNull()
+				expr = parser.Identifier{Literal: view.Header[j].Column}
 			} else {
-				record[j] = NewCell(values[recordIndices[j]])
+				p = values[recordIndices[j]]
+				expr = fields[recordIndices[j]]
+			}
+
+			if view.FileInfo != nil && view.FileInfo.Schema != nil {
+				if p, err = view.FileInfo.Schema.ConvertValue(expr, view.Header[j].Column, p, flags.DatetimeFormat); err != nil {
+					return nil, err
+				}
 			}
+			record[j] = NewCell(p)
 		}
 		records[i] = record
 	}
 	return records, nil
 }
 
-func (view *View) insert(ctx context.Context, fields []parser.QueryExpression, recordValues [][]value.Primary) (int, error) {
-	records, err := view.convertRecordValuesToRecordSet(ctx, fields, recordValues)
+func (view *View) insert(ctx context.Context, flags *cmd.Flags, fields []parser.QueryExpression, recordValues [][]value.Primary) (int, error) {
+	records, err := view.convertRecordValuesToRecordSet(ctx, flags, fields, recordValues)
 	if err != nil {
 		return 0, err
 	}
@@ -1970,7 +2674,7 @@ func (view *View) replace(ctx context.Context, flags *cmd.Flags, fields []parser
 		}
 	}
 
-	records, err := view.convertRecordValuesToRecordSet(ctx, fields, recordValues)
+	records, err := view.convertRecordValuesToRecordSet(ctx, flags, fields, recordValues)
 	if err != nil {
 		return 0, err
 	}
@@ -2271,11 +2975,13 @@ func (view *View) InternalRecordId(ref string, recordIndex int) (int, error) {
 func (view *View) CreateRestorePoint() {
 	view.FileInfo.restorePointRecordSet = view.RecordSet.Copy()
 	view.FileInfo.restorePointHeader = view.Header.Copy()
+	view.FileInfo.restorePointSchema = view.FileInfo.Schema
 }
 
 func (view *View) Restore() {
 	view.RecordSet = view.FileInfo.restorePointRecordSet.Copy()
 	view.Header = view.FileInfo.restorePointHeader.Copy()
+	view.FileInfo.Schema = view.FileInfo.restorePointSchema
 }
 
 func (view *View) FieldLen() int {
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 
@@ -31,6 +32,7 @@ var viewLoadTests = []struct {
 	DelimiterPositions []int
 	SingleLine         bool
 	JsonQuery          string
+	GlobUnionByName    bool
 	Scope              *ReferenceScope
 	Result             *View
 	ResultScope        *ReferenceScope
@@ -102,6 +104,45 @@ var viewLoadTests = []struct {
 			}},
 		}, time.Time{}, nil),
 	},
+	{
+		Name: "LoadView Compressed File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1_gz"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1_gz", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:        "table1_gz.csv.gz",
+				Delimiter:   ',',
+				Encoding:    text.UTF8,
+				LineBreak:   text.LF,
+				Compression: file.Gzip,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE1_GZ": strings.ToUpper(GetTestFilePath("table1_gz.csv.gz")),
+			}},
+		}, time.Time{}, nil),
+	},
 	{
 		Name: "LoadView File ForUpdate",
 		From: parser.FromClause{
@@ -492,6 +533,66 @@ var viewLoadTests = []struct {
 		JsonQuery:    "key{",
 		Error:        "json loading error: column 4: unexpected termination",
 	},
+	{
+		Name: "LoadView Yaml From Stdin",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:        "key:\n  - column1: 1\n    column2: str1\n  - column1: 2\n    column3: true\n",
+		ImportFormat: cmd.YAML,
+		JsonQuery:    "key",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewBoolean(true),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "STDIN",
+				Delimiter: ',',
+				JsonQuery: "key",
+				Format:    cmd.YAML,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView Yaml From Stdin Syntax Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:        "key:\n  - column1: [1, 2\n",
+		ImportFormat: cmd.YAML,
+		JsonQuery:    "key",
+		Error:        "yaml loading error: line 3, column 1: flow sequence is not terminated",
+	},
 	{
 		Name:         "LoadView Fixed-Length Text File",
 		ImportFormat: cmd.FIXED,
@@ -724,6 +825,219 @@ var viewLoadTests = []struct {
 			}},
 		}, time.Time{}, nil),
 	},
+	{
+		Name: "LoadView TableObject From CSV File with Automatic Delimiter",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue("auto"),
+						Path:          parser.Identifier{Literal: "table_noheader"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table_noheader.csv",
+				Delimiter: ',',
+				Format:    cmd.CSV,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				NoHeader:  true,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table_noheader.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From CSV File with Dialect Options",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(";"),
+						Path:          parser.Identifier{Literal: "table_dialect"},
+						Args: []parser.QueryExpression{
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewStringValue("'"),
+							parser.NewStringValue("double"),
+							parser.NewStringValue("#"),
+							parser.NewIntegerValue(2),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"id", "name"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str;1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("it's"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:          "table_dialect.csv",
+				Delimiter:     ';',
+				Format:        cmd.CSV,
+				Encoding:      text.UTF8,
+				LineBreak:     text.LF,
+				QuoteChar:     '\'',
+				CommentPrefix: "#",
+				SkipLines:     2,
+				LeadingLines:  []string{"Sales Report", "Generated: 2012-02-03"},
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table_dialect.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From CSV File with Invalid Quote Character",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(";"),
+						Path:          parser.Identifier{Literal: "table_dialect"},
+						Args: []parser.QueryExpression{
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewNullValue(),
+							parser.NewStringValue("''"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid argument for csv: quote character must be one character",
+	},
+	{
+		Name: "LoadView TableObject From CSV File with Column Types",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table1"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("UTF8"),
+							parser.NewTernaryValueFromString("false"),
+							parser.NewTernaryValueFromString("false"),
+							parser.NewStringValue("column1:integer"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:        "table1.csv",
+				Delimiter:   ',',
+				Format:      cmd.CSV,
+				Encoding:    text.UTF8,
+				LineBreak:   text.LF,
+				ColumnTypes: []cmd.ColumnTypeHint{{Column: "column1", Type: "INTEGER"}},
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table1.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From CSV File with Column Types Conversion Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table1"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("UTF8"),
+							parser.NewTernaryValueFromString("false"),
+							parser.NewTernaryValueFromString("false"),
+							parser.NewStringValue("column2:integer"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: fmt.Sprintf("data parse error in file %s: record 1: value 'str1' in column column2 cannot be converted to INTEGER", GetTestFilePath("table1.csv")),
+	},
+	{
+		Name: "LoadView TableObject From CSV File Invalid Column Types",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table1"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("UTF8"),
+							parser.NewTernaryValueFromString("false"),
+							parser.NewTernaryValueFromString("false"),
+							parser.NewStringValue("column1"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid argument for csv: column type \"column1\" must be in the form of column:type",
+	},
 	{
 		Name: "LoadView TableObject From TSV File",
 		From: parser.FromClause{
@@ -843,6 +1157,12 @@ var viewLoadTests = []struct {
 							parser.NewStringValue("SJIS"),
 							parser.NewTernaryValueFromString("true"),
 							parser.NewTernaryValueFromString("true"),
+							parser.NewStringValue("id:integer"),
+							parser.NewStringValue("\""),
+							parser.NewStringValue("DOUBLE"),
+							parser.NewStringValue("#"),
+							parser.NewIntegerValue(0),
+							parser.NewTernaryValueFromString("false"),
 							parser.NewStringValue("extra"),
 						},
 					},
@@ -850,7 +1170,7 @@ var viewLoadTests = []struct {
 				},
 			},
 		},
-		Error: "table object csv takes at most 5 arguments",
+		Error: "table object csv takes at most 11 arguments",
 	},
 	{
 		Name: "LoadView TableObject From CSV File 3rd Argument Error",
@@ -1127,6 +1447,7 @@ var viewLoadTests = []struct {
 							parser.NewStringValue("SJIS"),
 							parser.NewTernaryValueFromString("true"),
 							parser.NewTernaryValueFromString("true"),
+							parser.NewStringValue("id:integer"),
 							parser.NewStringValue("extra"),
 						},
 					},
@@ -1134,7 +1455,7 @@ var viewLoadTests = []struct {
 				},
 			},
 		},
-		Error: "table object fixed takes at most 5 arguments",
+		Error: "table object fixed takes at most 6 arguments",
 	},
 	{
 		Name: "LoadView TableObject From Json File",
@@ -1215,251 +1536,868 @@ var viewLoadTests = []struct {
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"JT": strings.ToUpper(GetTestFilePath("table_h.json")),
+				"JT": strings.ToUpper(GetTestFilePath("table_h.json")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From JsonA File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
+						FormatElement: parser.NewStringValue("{}"),
+						Path:          parser.Identifier{Literal: "table_a"},
+					},
+					Alias: parser.Identifier{Literal: "jt"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("jt", []string{"item1", "item2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("value1"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("value2"),
+					value.NewInteger(2),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:       "table_a.json",
+				Delimiter:  ',',
+				JsonQuery:  "{}",
+				Format:     cmd.JSON,
+				Encoding:   text.UTF8,
+				LineBreak:  text.LF,
+				JsonEscape: json.AllWithHexDigits,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"JT": strings.ToUpper(GetTestFilePath("table_a.json")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From Json File FormatElement Is Not Specified",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.JSON, Literal: "json"},
+						Path: parser.Identifier{Literal: "table"},
+					},
+					Alias: parser.Identifier{Literal: "jt"},
+				},
+			},
+		},
+		Error: "invalid argument for json: json query is not specified",
+	},
+	{
+		Name: "LoadView TableObject From Json File FormatElement is Null",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
+						FormatElement: parser.NewNullValue(),
+						Path:          parser.Identifier{Literal: "table"},
+					},
+					Alias: parser.Identifier{Literal: "jt"},
+				},
+			},
+		},
+		Error: "invalid json query: NULL",
+	},
+	{
+		Name: "LoadView Table Object From Json File Path Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
+						FormatElement: parser.NewStringValue("{}"),
+						Path:          parser.Identifier{Literal: "notexist"},
+					},
+					Alias: parser.Identifier{Literal: "jt"},
+				},
+			},
+		},
+		Error: "file notexist does not exist",
+	},
+	{
+		Name: "LoadView TableObject From LTSV File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
+						Path: parser.Identifier{Literal: "table6"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"f1", "f2", "f3", "f4"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("value1"),
+					value.NewString("value2"),
+					value.NewString("value3"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("value4"),
+					value.NewString("value5"),
+					value.NewNull(),
+					value.NewString("value6"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table6.ltsv",
+				Delimiter: ',',
+				Format:    cmd.LTSV,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table6.ltsv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From LTSV File Without Null",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
+						Path: parser.Identifier{Literal: "table6"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("UTF8"),
+							parser.NewTernaryValueFromString("true"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"f1", "f2", "f3", "f4"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("value1"),
+					value.NewString("value2"),
+					value.NewString("value3"),
+					value.NewString(""),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("value4"),
+					value.NewString("value5"),
+					value.NewString(""),
+					value.NewString("value6"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table6.ltsv",
+				Delimiter: ',',
+				Format:    cmd.LTSV,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table6.ltsv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From LTSV File with Column Types Conversion Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
+						Path: parser.Identifier{Literal: "table6"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("UTF8"),
+							parser.NewTernaryValueFromString("false"),
+							parser.NewStringValue("f4:integer"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: fmt.Sprintf("data parse error in file %s: record 2: value 'value6' in column f4 cannot be converted to INTEGER", GetTestFilePath("table6.ltsv")),
+	},
+	{
+		Name: "LoadView TableObject From LTSV File with UTF-8 BOM",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
+						Path: parser.Identifier{Literal: "table6_bom"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"f1", "f2", "f3", "f4"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("value1"),
+					value.NewString("value2"),
+					value.NewString("value3"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("value4"),
+					value.NewString("value5"),
+					value.NewNull(),
+					value.NewString("value6"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table6_bom.ltsv",
+				Delimiter: ',',
+				Format:    cmd.LTSV,
+				Encoding:  text.UTF8M,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table6_bom.ltsv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From LTSV File Arguments Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
+						Path: parser.Identifier{Literal: "table6"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("UTF8"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewStringValue("id:integer"),
+							parser.NewStringValue("extra"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "table object ltsv takes exactly 4 arguments",
+	},
+	{
+		Name: "LoadView TableObject From JSONL File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.JSONL, Literal: "jsonl"},
+						Path: parser.Identifier{Literal: "table7"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("str2"),
+					value.NewBoolean(true),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewString("str3"),
+					value.NewNull(),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table7.jsonl",
+				Delimiter: ',',
+				Format:    cmd.JSONL,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table7.jsonl")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From JSONL File Arguments Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.JSONL, Literal: "jsonl"},
+						Path: parser.Identifier{Literal: "table7"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("UTF8"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "table object jsonl takes exactly 1 arguments",
+	},
+	{
+		Name: "LoadView TableObject From Yaml File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.YAML, Literal: "yaml"},
+						FormatElement: parser.NewStringValue("items"),
+						Path:          parser.Identifier{Literal: "table10"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"item_id", "name", "price", "stock"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("apple"),
+					value.NewFloat(1.5),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("banana"),
+					value.NewNull(),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("cherry"),
+					value.NewInteger(2),
+					value.NewNull(),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table10.yaml",
+				Delimiter: ',',
+				JsonQuery: "items",
+				Format:    cmd.YAML,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table10.yaml")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From Yaml File Not Sequence Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.YAML, Literal: "yaml"},
+						Path: parser.Identifier{Literal: "table10"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "yaml loading error: yaml document root must be a sequence",
+	},
+	{
+		Name: "LoadView TableObject From Yaml File Arguments Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.YAML, Literal: "yaml"},
+						FormatElement: parser.NewStringValue("items"),
+						Path:          parser.Identifier{Literal: "table10"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("UTF8"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "table object yaml takes exactly 2 arguments",
+	},
+	{
+		Name: "LoadView TableObject From Xml File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.XML, Literal: "xml"},
+						Path: parser.Identifier{Literal: "table11.xml"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("catalog/items/item"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"@id", "name", "price"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("apple"),
+					value.NewString("1.5"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("banana"),
+					value.NewNull(),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table11.xml",
+				Delimiter: ',',
+				RowPath:   "catalog/items/item",
+				Format:    cmd.XML,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table11.xml")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From Xml File Invalid Row Path Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.XML, Literal: "xml"},
+						Path: parser.Identifier{Literal: "table11.xml"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("items/1item"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "xml loading error: invalid row path \"items/1item\"",
+	},
+	{
+		Name: "LoadView TableObject From Xml File Arguments Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.XML, Literal: "xml"},
+						Path: parser.Identifier{Literal: "table11.xml"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("catalog/items/item"),
+							parser.NewStringValue("UTF8"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "table object xml takes at most 2 arguments",
+	},
+	{
+		Name: "LoadView From Parquet File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table8.parquet"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table8", []string{"id", "name", "score", "flag", "created", "day"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewFloat(1.5),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+					value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewFloat(2.25),
+					value.NewBoolean(false),
+					value.NewDatetime(time.Date(2012, 2, 4, 9, 18, 15, 123000000, GetTestLocation())),
+					value.NewDatetime(time.Date(2012, 2, 4, 0, 0, 0, 0, GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("str3"),
+					value.NewNull(),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2012, 2, 5, 9, 18, 15, 0, GetTestLocation())),
+					value.NewDatetime(time.Date(2012, 2, 5, 0, 0, 0, 0, GetTestLocation())),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table8.parquet",
+				Delimiter: ',',
+				Format:    cmd.PARQUET,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE8": strings.ToUpper(GetTestFilePath("table8.parquet")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView Parquet File ForUpdate Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table8.parquet"},
+				},
+			},
+		},
+		ForUpdate: true,
+		Error:     "file " + GetTestFilePath("table8.parquet") + " cannot be updated because PARQUET format is read-only",
+	},
+	{
+		Name: "LoadView From Xlsx File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table9.xlsx"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table9", []string{"id", "name", "score", "flag", "created", "day"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewFloat(1.5),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+					value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewFloat(2.25),
+					value.NewBoolean(false),
+					value.NewDatetime(time.Date(2012, 2, 4, 9, 18, 15, 0, GetTestLocation())),
+					value.NewDatetime(time.Date(2012, 2, 4, 0, 0, 0, 0, GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("str3"),
+					value.NewNull(),
+					value.NewBoolean(true),
+					value.NewDatetime(time.Date(2012, 2, 5, 9, 18, 15, 0, GetTestLocation())),
+					value.NewDatetime(time.Date(2012, 2, 5, 0, 0, 0, 0, GetTestLocation())),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table9.xlsx",
+				Delimiter: ',',
+				Format:    cmd.XLSX,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE9": strings.ToUpper(GetTestFilePath("table9.xlsx")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView TableObject From JsonA File",
+		Name: "LoadView TableObject From Xlsx File With Sheet Name",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.TableObject{
-						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
-						FormatElement: parser.NewStringValue("{}"),
-						Path:          parser.Identifier{Literal: "table_a"},
+						Type: parser.Token{Token: parser.XLSX, Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "table9"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("Items"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewTernaryValueFromString("true"),
+						},
 					},
-					Alias: parser.Identifier{Literal: "jt"},
+					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
 		Result: &View{
-			Header: NewHeader("jt", []string{"item1", "item2"}),
+			Header: NewHeader("t", []string{"c1", "c2", "c3"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("value1"),
-					value.NewInteger(1),
+					value.NewString("a"),
+					value.NewString(""),
+					value.NewInteger(10),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("value2"),
-					value.NewInteger(2),
+					value.NewString("b"),
+					value.NewString("x"),
+					value.NewString(""),
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:       "table_a.json",
-				Delimiter:  ',',
-				JsonQuery:  "{}",
-				Format:     cmd.JSON,
-				Encoding:   text.UTF8,
-				LineBreak:  text.LF,
-				JsonEscape: json.AllWithHexDigits,
+				Path:      "table9.xlsx",
+				Delimiter: ',',
+				Format:    cmd.XLSX,
+				SheetName: "Items",
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				NoHeader:  true,
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"JT": strings.ToUpper(GetTestFilePath("table_a.json")),
+				"T": strings.ToUpper(GetTestFilePath("table9.xlsx")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView TableObject From Json File FormatElement Is Not Specified",
+		Name: "LoadView TableObject From Xlsx File Sheet Not Exist Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.TableObject{
-						Type: parser.Token{Token: parser.JSON, Literal: "json"},
-						Path: parser.Identifier{Literal: "table"},
+						Type: parser.Token{Token: parser.XLSX, Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "table9.xlsx"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("notexist"),
+						},
 					},
-					Alias: parser.Identifier{Literal: "jt"},
+					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Error: "invalid argument for json: json query is not specified",
+		Error: "data parse error in file " + GetTestFilePath("table9.xlsx") + ": worksheet \"notexist\" does not exist",
 	},
 	{
-		Name: "LoadView TableObject From Json File FormatElement is Null",
+		Name: "LoadView TableObject From Xlsx File Arguments Length Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.TableObject{
-						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
-						FormatElement: parser.NewNullValue(),
-						Path:          parser.Identifier{Literal: "table"},
+						Type: parser.Token{Token: parser.XLSX, Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "table9.xlsx"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("Sheet1"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewTernaryValueFromString("true"),
+						},
 					},
-					Alias: parser.Identifier{Literal: "jt"},
+					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Error: "invalid json query: NULL",
+		Error: "table object xlsx takes at most 4 arguments",
 	},
 	{
-		Name: "LoadView Table Object From Json File Path Error",
+		Name: "LoadView Xlsx File ForUpdate Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.TableObject{
-						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
-						FormatElement: parser.NewStringValue("{}"),
-						Path:          parser.Identifier{Literal: "notexist"},
-					},
-					Alias: parser.Identifier{Literal: "jt"},
+					Object: parser.Identifier{Literal: "table9.xlsx"},
 				},
 			},
 		},
-		Error: "file notexist does not exist",
+		ForUpdate: true,
+		Error:     "file " + GetTestFilePath("table9.xlsx") + " cannot be updated because XLSX format is read-only",
 	},
 	{
-		Name: "LoadView TableObject From LTSV File",
+		Name: "LoadView Xml File ForUpdate Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.TableObject{
-						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
-						Path: parser.Identifier{Literal: "table6"},
-					},
-					Alias: parser.Identifier{Literal: "t"},
+					Object: parser.Identifier{Literal: "table11.xml"},
+				},
+			},
+		},
+		ForUpdate: true,
+		Error:     "file " + GetTestFilePath("table11.xml") + " cannot be updated because XML format is read-only",
+	},
+	{
+		Name: "LoadView Glob Pattern",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "glob_[0-9].csv"},
+					Alias:  parser.Identifier{Literal: "t"},
 				},
 			},
 		},
 		Result: &View{
-			Header: NewHeader("t", []string{"f1", "f2", "f3", "f4"}),
+			Header: append(NewHeader("t", []string{"id", "name"}), HeaderField{View: "t", Column: FileColumn}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("value1"),
-					value.NewString("value2"),
-					value.NewString("value3"),
-					value.NewNull(),
+					value.NewString("1"),
+					value.NewString("a"),
+					value.NewString(GetTestFilePath("glob_1.csv")),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("value4"),
-					value.NewString("value5"),
-					value.NewNull(),
-					value.NewString("value6"),
+					value.NewString("2"),
+					value.NewString("b"),
+					value.NewString(GetTestFilePath("glob_1.csv")),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("c"),
+					value.NewString(GetTestFilePath("glob_2.csv")),
 				}),
-			},
-			FileInfo: &FileInfo{
-				Path:      "table6.ltsv",
-				Delimiter: ',',
-				Format:    cmd.LTSV,
-				Encoding:  text.UTF8,
-				LineBreak: text.LF,
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"T": strings.ToUpper(GetTestFilePath("table6.ltsv")),
+				"T": "",
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView TableObject From LTSV File Without Null",
+		Name: "LoadView Glob Pattern Union By Name",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.TableObject{
-						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
-						Path: parser.Identifier{Literal: "table6"},
-						Args: []parser.QueryExpression{
-							parser.NewStringValue("UTF8"),
-							parser.NewTernaryValueFromString("true"),
-						},
-					},
-					Alias: parser.Identifier{Literal: "t"},
+					Object: parser.Identifier{Literal: "glob_*.csv"},
+					Alias:  parser.Identifier{Literal: "t"},
 				},
 			},
 		},
+		GlobUnionByName: true,
 		Result: &View{
-			Header: NewHeader("t", []string{"f1", "f2", "f3", "f4"}),
+			Header: append(NewHeader("t", []string{"id", "name", "price"}), HeaderField{View: "t", Column: FileColumn}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("value1"),
-					value.NewString("value2"),
-					value.NewString("value3"),
-					value.NewString(""),
+					value.NewString("1"),
+					value.NewString("a"),
+					value.NewNull(),
+					value.NewString(GetTestFilePath("glob_1.csv")),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("value4"),
-					value.NewString("value5"),
-					value.NewString(""),
-					value.NewString("value6"),
+					value.NewString("2"),
+					value.NewString("b"),
+					value.NewNull(),
+					value.NewString(GetTestFilePath("glob_1.csv")),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("c"),
+					value.NewNull(),
+					value.NewString(GetTestFilePath("glob_2.csv")),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewString("d"),
+					value.NewString("100"),
+					value.NewString(GetTestFilePath("glob_x.csv")),
 				}),
-			},
-			FileInfo: &FileInfo{
-				Path:      "table6.ltsv",
-				Delimiter: ',',
-				Format:    cmd.LTSV,
-				Encoding:  text.UTF8,
-				LineBreak: text.LF,
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"T": strings.ToUpper(GetTestFilePath("table6.ltsv")),
+				"T": "",
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView TableObject From LTSV File with UTF-8 BOM",
+		Name: "LoadView Glob Pattern Header Mismatch Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.TableObject{
-						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
-						Path: parser.Identifier{Literal: "table6_bom"},
-					},
-					Alias: parser.Identifier{Literal: "t"},
+					Object: parser.Identifier{Literal: "glob_*.csv"},
 				},
 			},
 		},
-		Result: &View{
-			Header: NewHeader("t", []string{"f1", "f2", "f3", "f4"}),
-			RecordSet: []Record{
-				NewRecord([]value.Primary{
-					value.NewString("value1"),
-					value.NewString("value2"),
-					value.NewString("value3"),
-					value.NewNull(),
-				}),
-				NewRecord([]value.Primary{
-					value.NewString("value4"),
-					value.NewString("value5"),
-					value.NewNull(),
-					value.NewString("value6"),
-				}),
+		Error: "columns of file " + GetTestFilePath("glob_x.csv") + " do not match columns of file " + GetTestFilePath("glob_1.csv"),
+	},
+	{
+		Name: "LoadView Glob Pattern Not Match Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "notexist_*.csv"},
+				},
 			},
-			FileInfo: &FileInfo{
-				Path:      "table6_bom.ltsv",
-				Delimiter: ',',
-				Format:    cmd.LTSV,
-				Encoding:  text.UTF8M,
-				LineBreak: text.LF,
+		},
+		Error: "file notexist_*.csv does not exist",
+	},
+	{
+		Name: "LoadView Glob Pattern ForUpdate Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "glob_*.csv"},
+				},
 			},
 		},
-		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
-			{scopeNameAliases: {
-				"T": strings.ToUpper(GetTestFilePath("table6_bom.ltsv")),
-			}},
-		}, time.Time{}, nil),
+		ForUpdate: true,
+		Error:     "table glob_*.csv cannot be updated because it is loaded from multiple files",
 	},
 	{
-		Name: "LoadView TableObject From LTSV File Arguments Length Error",
+		Name: "LoadView Partitioned Directory Header Mismatch Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.TableObject{
-						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
-						Path: parser.Identifier{Literal: "table6"},
-						Args: []parser.QueryExpression{
-							parser.NewStringValue("UTF8"),
-							parser.NewTernaryValueFromString("true"),
-							parser.NewStringValue("extra"),
-						},
-					},
-					Alias: parser.Identifier{Literal: "t"},
+					Object: parser.Identifier{Literal: "partitioned"},
+				},
+			},
+		},
+		Error: "columns of file " + GetTestFilePath(filepath.Join("partitioned", "year=2025", "month=12", "part-0001.csv")) +
+			" do not match columns of file " + GetTestFilePath(filepath.Join("partitioned", "year=2024", "month=01", "part-0001.csv")),
+	},
+	{
+		Name: "LoadView Partitioned Directory ForUpdate Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "partitioned"},
 				},
 			},
 		},
-		Error: "table object ltsv takes exactly 3 arguments",
+		ForUpdate: true,
+		Error:     "table partitioned cannot be updated because it is loaded from multiple files",
 	},
 	{
 		Name: "LoadView TableObject Invalid Object Type",
@@ -2738,6 +3676,7 @@ func TestView_Load(t *testing.T) {
 		TestTx.Flags.ImportOptions.SingleLine = v.SingleLine
 		TestTx.Flags.ImportOptions.JsonQuery = v.JsonQuery
 		TestTx.Flags.ImportOptions.NoHeader = v.NoHeader
+		TestTx.Flags.ImportOptions.GlobUnionByName = v.GlobUnionByName
 		if v.Encoding != text.AUTO {
 			TestTx.Flags.ImportOptions.Encoding = v.Encoding
 		} else {
@@ -2795,6 +3734,9 @@ func TestView_Load(t *testing.T) {
 			if view.FileInfo.NoHeader != v.Result.FileInfo.NoHeader {
 				t.Errorf("%s: FileInfo.NoHeader = %t, want %t", v.Name, view.FileInfo.NoHeader, v.Result.FileInfo.NoHeader)
 			}
+			if view.FileInfo.Compression != v.Result.FileInfo.Compression {
+				t.Errorf("%s: FileInfo.Compression = %s, want %s", v.Name, view.FileInfo.Compression, v.Result.FileInfo.Compression)
+			}
 			if view.FileInfo.PrettyPrint != v.Result.FileInfo.PrettyPrint {
 				t.Errorf("%s: FileInfo.PrettyPrint = %t, want %t", v.Name, view.FileInfo.PrettyPrint, v.Result.FileInfo.PrettyPrint)
 			}
@@ -2830,6 +3772,45 @@ func TestView_Load(t *testing.T) {
 	}
 }
 
+func TestView_LoadXlsxSheets(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+
+	ctx := context.Background()
+
+	load := func(args ...parser.QueryExpression) []string {
+		view, err := LoadView(ctx, NewReferenceScope(TestTx).CreateNode(), []parser.QueryExpression{
+			parser.Table{
+				Object: parser.TableObject{
+					Type: parser.Token{Token: parser.XLSX, Literal: "xlsx"},
+					Path: parser.Identifier{Literal: "table9.xlsx"},
+					Args: args,
+				},
+				Alias: parser.Identifier{Literal: "t"},
+			},
+		}, false, false)
+		if err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		return view.Header.TableColumnNames()
+	}
+
+	expect := []string{"a", "c2", "10"}
+	if result := load(parser.NewStringValue("Items")); !reflect.DeepEqual(result, expect) {
+		t.Errorf("fields = %v, want %v", result, expect)
+	}
+
+	expect = []string{"id", "name", "score", "flag", "created", "day"}
+	if result := load(); !reflect.DeepEqual(result, expect) {
+		t.Errorf("fields = %v, want %v", result, expect)
+	}
+}
+
 func TestNewViewFromGroupedRecord(t *testing.T) {
 	fr := ReferenceRecord{
 		view: &View{
@@ -5647,6 +6628,7 @@ func TestView_Offset(t *testing.T) {
 
 var viewInsertValuesTests = []struct {
 	Name        string
+	Schema      TableSchema
 	Fields      []parser.QueryExpression
 	ValuesList  []parser.QueryExpression
 	Result      *View
@@ -5750,6 +6732,40 @@ var viewInsertValuesTests = []struct {
 		},
 		Error: "field notexist does not exist",
 	},
+	{
+		Name:   "InsertValues Column Type Mismatch Error",
+		Schema: TableSchema{{Name: "column1", Type: IntegerColumn}},
+		Fields: []parser.QueryExpression{
+			parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+		},
+		ValuesList: []parser.QueryExpression{
+			parser.RowValue{
+				Value: parser.ValueList{
+					Values: []parser.QueryExpression{
+						parser.NewStringValue("a"),
+					},
+				},
+			},
+		},
+		Error: "value 'a' cannot be stored in column column1 of type INTEGER",
+	},
+	{
+		Name:   "InsertValues Not Null Column Error",
+		Schema: TableSchema{{Name: "column2", Type: StringColumn, NotNull: true}},
+		Fields: []parser.QueryExpression{
+			parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+		},
+		ValuesList: []parser.QueryExpression{
+			parser.RowValue{
+				Value: parser.ValueList{
+					Values: []parser.QueryExpression{
+						parser.NewIntegerValueFromString("3"),
+					},
+				},
+			},
+		},
+		Error: "column column2 cannot be null",
+	},
 }
 
 func TestView_InsertValues(t *testing.T) {
@@ -5770,6 +6786,11 @@ func TestView_InsertValues(t *testing.T) {
 	scope := NewReferenceScope(TestTx)
 	ctx := context.Background()
 	for _, v := range viewInsertValuesTests {
+		view.FileInfo = nil
+		if v.Schema != nil {
+			view.FileInfo = &FileInfo{Schema: v.Schema}
+		}
+
 		cnt, err := view.InsertValues(ctx, scope, v.Fields, v.ValuesList)
 		if err != nil {
 			if len(v.Error) < 1 {
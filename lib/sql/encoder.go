@@ -0,0 +1,302 @@
+// Package sql writes tables as SQL scripts that create and populate the tables.
+package sql
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/ternary"
+)
+
+// DefaultBatchSize is the default number of records that are written in an INSERT statement.
+const DefaultBatchSize = 100
+
+const indent = "  "
+
+type columnType int
+
+const (
+	nullType columnType = iota
+	integerType
+	floatType
+	booleanType
+	datetimeType
+	stringType
+)
+
+func merge(t1 columnType, t2 columnType) columnType {
+	switch {
+	case t1 == t2 || t2 == nullType:
+		return t1
+	case t1 == nullType:
+		return t2
+	case (t1 == integerType && t2 == floatType) || (t1 == floatType && t2 == integerType):
+		return floatType
+	}
+	return stringType
+}
+
+func typeOf(p value.Primary) columnType {
+	switch p.(type) {
+	case *value.String:
+		return stringType
+	case *value.Integer:
+		return integerType
+	case *value.Float:
+		return floatType
+	case *value.Boolean:
+		return booleanType
+	case *value.Ternary:
+		if p.(*value.Ternary).Ternary() != ternary.UNKNOWN {
+			return booleanType
+		}
+	case *value.Datetime:
+		return datetimeType
+	}
+	return nullType
+}
+
+// Encoder writes a CREATE TABLE statement and INSERT statements.
+//
+// The column types of the CREATE TABLE statement are determined by the types of the values
+// in each column. Integers and floats are written as floats if both of them are in a column,
+// and the values in a column that has values of other different types are written as strings.
+type Encoder struct {
+	Dialect       cmd.SqlDialect
+	LineBreak     text.LineBreak
+	BatchSize     int
+	WithoutCreate bool
+
+	table     string
+	lineBreak string
+}
+
+// NewEncoder returns an encoder that writes the statements for the table
+// in ANSI SQL using LF as the line break.
+func NewEncoder(table string) *Encoder {
+	return &Encoder{
+		Dialect:   cmd.AnsiSql,
+		LineBreak: text.LF,
+		BatchSize: DefaultBatchSize,
+		table:     table,
+	}
+}
+
+// Encode writes the statements that create the table with the columns and insert the records.
+func (e *Encoder) Encode(w io.Writer, header []string, records [][]value.Primary) error {
+	e.lineBreak = e.LineBreak.Value()
+	batchSize := e.BatchSize
+	if batchSize < 1 {
+		batchSize = DefaultBatchSize
+	}
+
+	types := make([]columnType, len(header))
+	for _, record := range records {
+		for i := range types {
+			types[i] = merge(types[i], typeOf(record[i]))
+		}
+	}
+
+	columns := make([]string, len(header))
+	for i := range header {
+		columns[i] = e.quoteIdentifier(header[i])
+	}
+	table := e.quoteIdentifier(e.table)
+
+	bw := bufio.NewWriter(w)
+	statements := 0
+
+	if !e.WithoutCreate {
+		_, _ = bw.WriteString("CREATE TABLE " + table + " (")
+		for i := range columns {
+			if 0 < i {
+				_ = bw.WriteByte(',')
+			}
+			_, _ = bw.WriteString(e.lineBreak + indent + columns[i] + " " + e.typeName(types[i], i, records))
+		}
+		_, _ = bw.WriteString(e.lineBreak + ");")
+		statements++
+	}
+
+	for i := range records {
+		if i%batchSize == 0 {
+			if 0 < i {
+				_ = bw.WriteByte(';')
+			}
+			if 0 < statements {
+				_, _ = bw.WriteString(e.lineBreak)
+			}
+			_, _ = bw.WriteString("INSERT INTO " + table + " (" + strings.Join(columns, ", ") + ") VALUES")
+			statements++
+		} else {
+			_ = bw.WriteByte(',')
+		}
+
+		_, _ = bw.WriteString(e.lineBreak + indent + "(")
+		for j := range records[i] {
+			if 0 < j {
+				_, _ = bw.WriteString(", ")
+			}
+			_, _ = bw.WriteString(e.literal(records[i][j], types[j]))
+		}
+		_ = bw.WriteByte(')')
+	}
+	if 0 < len(records) {
+		_ = bw.WriteByte(';')
+	}
+
+	return bw.Flush()
+}
+
+func (e *Encoder) typeName(t columnType, idx int, records [][]value.Primary) string {
+	switch t {
+	case integerType:
+		if e.Dialect == cmd.SQLite {
+			return "INTEGER"
+		}
+		return "BIGINT"
+	case floatType:
+		switch e.Dialect {
+		case cmd.MySql:
+			return "DOUBLE"
+		case cmd.SQLite:
+			return "REAL"
+		}
+		return "DOUBLE PRECISION"
+	case booleanType:
+		return "BOOLEAN"
+	case datetimeType:
+		switch e.Dialect {
+		case cmd.MySql:
+			return "DATETIME(6)"
+		case cmd.SQLite:
+			return "TEXT"
+		}
+		return "TIMESTAMP WITH TIME ZONE"
+	}
+
+	if e.Dialect != cmd.AnsiSql {
+		return "TEXT"
+	}
+
+	length := 1
+	for _, record := range records {
+		if s, ok := e.stringValue(record[idx]); ok {
+			if l := utf8.RuneCountInString(s); length < l {
+				length = l
+			}
+		}
+	}
+	return "VARCHAR(" + strconv.Itoa(length) + ")"
+}
+
+func (e *Encoder) literal(p value.Primary, t columnType) string {
+	if t == stringType {
+		if s, ok := e.stringValue(p); ok {
+			return e.quoteString(s)
+		}
+		return "NULL"
+	}
+
+	switch p.(type) {
+	case *value.Integer:
+		return p.(*value.Integer).String()
+	case *value.Float:
+		f := p.(*value.Float).Raw()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			if e.Dialect == cmd.PostgreSql {
+				return e.quoteString(postgresFloat(f))
+			}
+			return "NULL"
+		}
+		return p.(*value.Float).String()
+	case *value.Boolean:
+		return e.booleanLiteral(p.(*value.Boolean).Raw())
+	case *value.Ternary:
+		if tr := p.(*value.Ternary).Ternary(); tr != ternary.UNKNOWN {
+			return e.booleanLiteral(tr.ParseBool())
+		}
+	case *value.Datetime:
+		s := e.quoteString(e.formatDatetime(p.(*value.Datetime).Raw()))
+		if e.Dialect == cmd.AnsiSql {
+			s = "TIMESTAMP " + s
+		}
+		return s
+	}
+	return "NULL"
+}
+
+// stringValue returns the string representation of the value, and reports whether the value is not null.
+func (e *Encoder) stringValue(p value.Primary) (string, bool) {
+	switch p.(type) {
+	case *value.String:
+		return p.(*value.String).Raw(), true
+	case *value.Integer:
+		return p.(*value.Integer).String(), true
+	case *value.Float:
+		return p.(*value.Float).String(), true
+	case *value.Boolean:
+		return p.(*value.Boolean).String(), true
+	case *value.Ternary:
+		if t := p.(*value.Ternary).Ternary(); t != ternary.UNKNOWN {
+			return strconv.FormatBool(t.ParseBool()), true
+		}
+	case *value.Datetime:
+		return e.formatDatetime(p.(*value.Datetime).Raw()), true
+	}
+	return "", false
+}
+
+func (e *Encoder) booleanLiteral(b bool) string {
+	if e.Dialect == cmd.SQLite {
+		if b {
+			return "1"
+		}
+		return "0"
+	}
+
+	if b {
+		return "TRUE"
+	}
+	return "FALSE"
+}
+
+func (e *Encoder) formatDatetime(t time.Time) string {
+	if e.Dialect == cmd.MySql {
+		return t.Format("2006-01-02 15:04:05.999999")
+	}
+	return t.Format("2006-01-02 15:04:05.999999999-07:00")
+}
+
+func postgresFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	}
+	return "NaN"
+}
+
+func (e *Encoder) quoteIdentifier(s string) string {
+	if e.Dialect == cmd.MySql {
+		return "`" + strings.Replace(s, "`", "``", -1) + "`"
+	}
+	return "\"" + strings.Replace(s, "\"", "\"\"", -1) + "\""
+}
+
+func (e *Encoder) quoteString(s string) string {
+	if e.Dialect == cmd.MySql {
+		s = strings.Replace(s, "\\", "\\\\", -1)
+	}
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
@@ -0,0 +1,148 @@
+package sql
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/ternary"
+)
+
+var encoderEncodeTests = []struct {
+	Name          string
+	Dialect       cmd.SqlDialect
+	LineBreak     text.LineBreak
+	BatchSize     int
+	WithoutCreate bool
+	Table         string
+	Header        []string
+	Records       [][]value.Primary
+	Expect        string
+}{
+	{
+		Name:   "ANSI",
+		Table:  "result",
+		Header: []string{"id", "name", "price", "active", "updated"},
+		Records: [][]value.Primary{
+			{value.NewInteger(1), value.NewString("it's"), value.NewInteger(10), value.NewBoolean(true), value.NewDatetime(time.Date(2020, 1, 2, 3, 4, 5, 123000000, time.UTC))},
+			{value.NewInteger(2), value.NewNull(), value.NewFloat(1.5), value.NewTernary(ternary.UNKNOWN), value.NewNull()},
+		},
+		Expect: "CREATE TABLE \"result\" (\n" +
+			"  \"id\" BIGINT,\n" +
+			"  \"name\" VARCHAR(4),\n" +
+			"  \"price\" DOUBLE PRECISION,\n" +
+			"  \"active\" BOOLEAN,\n" +
+			"  \"updated\" TIMESTAMP WITH TIME ZONE\n" +
+			");\n" +
+			"INSERT INTO \"result\" (\"id\", \"name\", \"price\", \"active\", \"updated\") VALUES\n" +
+			"  (1, 'it''s', 10, TRUE, TIMESTAMP '2020-01-02 03:04:05.123+00:00'),\n" +
+			"  (2, NULL, 1.5, NULL, NULL);",
+	},
+	{
+		Name:   "MySQL",
+		Table:  "my`table",
+		Header: []string{"id", "path", "updated"},
+		Records: [][]value.Primary{
+			{value.NewInteger(1), value.NewString("C:\\tmp"), value.NewDatetime(time.Date(2020, 1, 2, 3, 4, 5, 123000000, time.UTC))},
+		},
+		Dialect: cmd.MySql,
+		Expect: "CREATE TABLE `my``table` (\n" +
+			"  `id` BIGINT,\n" +
+			"  `path` TEXT,\n" +
+			"  `updated` DATETIME(6)\n" +
+			");\n" +
+			"INSERT INTO `my``table` (`id`, `path`, `updated`) VALUES\n" +
+			"  (1, 'C:\\\\tmp', '2020-01-02 03:04:05.123');",
+	},
+	{
+		Name:   "PostgreSQL",
+		Table:  "result",
+		Header: []string{"f", "mixed"},
+		Records: [][]value.Primary{
+			{value.NewFloat(math.Inf(1)), value.NewInteger(1)},
+			{value.NewFloat(math.NaN()), value.NewString("a")},
+		},
+		Dialect: cmd.PostgreSql,
+		Expect: "CREATE TABLE \"result\" (\n" +
+			"  \"f\" DOUBLE PRECISION,\n" +
+			"  \"mixed\" TEXT\n" +
+			");\n" +
+			"INSERT INTO \"result\" (\"f\", \"mixed\") VALUES\n" +
+			"  ('Infinity', '1'),\n" +
+			"  ('NaN', 'a');",
+	},
+	{
+		Name:   "SQLite",
+		Table:  "result",
+		Header: []string{"id", "flag", "empty"},
+		Records: [][]value.Primary{
+			{value.NewInteger(1), value.NewBoolean(true), value.NewNull()},
+			{value.NewInteger(2), value.NewBoolean(false), value.NewNull()},
+			{value.NewInteger(3), value.NewTernary(ternary.TRUE), value.NewNull()},
+		},
+		Dialect:   cmd.SQLite,
+		LineBreak: text.CRLF,
+		BatchSize: 2,
+		Expect: "CREATE TABLE \"result\" (\r\n" +
+			"  \"id\" INTEGER,\r\n" +
+			"  \"flag\" BOOLEAN,\r\n" +
+			"  \"empty\" TEXT\r\n" +
+			");\r\n" +
+			"INSERT INTO \"result\" (\"id\", \"flag\", \"empty\") VALUES\r\n" +
+			"  (1, 1, NULL),\r\n" +
+			"  (2, 0, NULL);\r\n" +
+			"INSERT INTO \"result\" (\"id\", \"flag\", \"empty\") VALUES\r\n" +
+			"  (3, 1, NULL);",
+	},
+	{
+		Name:          "Without Create",
+		Table:         "result",
+		Header:        []string{"id"},
+		WithoutCreate: true,
+		Records: [][]value.Primary{
+			{value.NewInteger(1)},
+		},
+		Expect: "INSERT INTO \"result\" (\"id\") VALUES\n" +
+			"  (1);",
+	},
+	{
+		Name:    "Empty Records",
+		Table:   "result",
+		Header:  []string{"id"},
+		Records: [][]value.Primary{},
+		Expect: "CREATE TABLE \"result\" (\n" +
+			"  \"id\" VARCHAR(1)\n" +
+			");",
+	},
+}
+
+func TestEncoder_Encode(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	for _, v := range encoderEncodeTests {
+		buf.Reset()
+
+		e := NewEncoder(v.Table)
+		e.Dialect = v.Dialect
+		if v.LineBreak != "" {
+			e.LineBreak = v.LineBreak
+		}
+		if 0 < v.BatchSize {
+			e.BatchSize = v.BatchSize
+		}
+		e.WithoutCreate = v.WithoutCreate
+
+		if err := e.Encode(buf, v.Header, v.Records); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if buf.String() != v.Expect {
+			t.Errorf("%s: result = %q, expect = %q", v.Name, buf.String(), v.Expect)
+		}
+	}
+}
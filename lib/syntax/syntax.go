@@ -145,10 +145,15 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "table_object",
 						Group: []Grammar{
-							{Function{Name: "CSV", Args: []Element{String("delimiter"), Link("table_identifier"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null")}}}},
-							{Function{Name: "FIXED", Args: []Element{String("delimiter_positions"), Link("table_identifier"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null")}}}},
+							{Function{Name: "CSV", Args: []Element{String("delimiter"), Link("table_identifier"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null"), String("column_types"), String("quote_char"), String("escape_style"), String("comment_prefix"), Integer("skip_lines"), Boolean("trim_space")}}}},
+							{Function{Name: "FIXED", Args: []Element{String("delimiter_positions"), Link("table_identifier"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null"), String("column_types")}}}},
 							{Function{Name: "JSON", Args: []Element{String("json_query"), Link("table_identifier")}}},
-							{Function{Name: "LTSV", Args: []Element{Link("table_identifier"), Option{String("encoding"), Boolean("without_null")}}}},
+							{Function{Name: "JSONL", Args: []Element{Link("table_identifier")}}},
+							{Function{Name: "LTSV", Args: []Element{Link("table_identifier"), Option{String("encoding"), Boolean("without_null"), String("column_types")}}}},
+							{Function{Name: "XLSX", Args: []Element{Link("table_identifier"), Option{String("sheet_name"), Boolean("no_header"), Boolean("without_null")}}}},
+							{Function{Name: "YAML", Args: []Element{Link("table_identifier")}}},
+							{Function{Name: "YAML", Args: []Element{String("json_query"), Link("table_identifier")}}},
+							{Function{Name: "XML", Args: []Element{Link("table_identifier"), Option{String("row_path")}}}},
 						},
 					},
 					{
@@ -261,6 +266,30 @@ var CsvqSyntax = []Expression{
 					},
 				},
 			},
+			{
+				Label: "INTO OUTFILE Clause",
+				Grammar: []Definition{
+					{
+						Name: "select_into_outfile_statement",
+						Group: []Grammar{
+							{Link("select_query"), Keyword("INTO"), Keyword("OUTFILE"), String("file_path"), Option{Link("outfile_option")}},
+						},
+						Description: Description{
+							Template: "The file is created when the transaction is committed. If %s is not specified, the format is determined by the file extension.",
+							Values:   []Element{Keyword("FORMAT")},
+						},
+					},
+					{
+						Name: "outfile_option",
+						Group: []Grammar{
+							{Keyword("FORMAT"), String("format")},
+							{Keyword("ENCODING"), String("encoding")},
+							{Keyword("DELIMITER"), String("delimiter")},
+							{Keyword("WITHOUT"), Keyword("HEADER")},
+						},
+					},
+				},
+			},
 		},
 	},
 	{
@@ -347,8 +376,23 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "create_table_statement",
 				Group: []Grammar{
-					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Parentheses{ContinuousOption{Identifier("column_name")}}},
-					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Option{Keyword("AS")}, Link("select_query")},
+					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Parentheses{ContinuousOption{Link("typed_column")}}},
+					{Keyword("CREATE"), Keyword("TABLE"), Identifier("file_path"), Option{Parentheses{ContinuousOption{Link("typed_column")}}}, Option{Keyword("AS")}, Link("select_query")},
+				},
+			},
+			{
+				Name: "typed_column",
+				Group: []Grammar{
+					{Identifier("column_name"), Option{Link("column_type"), Option{Keyword("NOT"), Keyword("NULL")}}},
+				},
+			},
+			{
+				Name: "column_type",
+				Group: []Grammar{
+					{AnyOne{Keyword("STRING"), Keyword("INTEGER"), Keyword("FLOAT"), Keyword("BOOLEAN"), Keyword("DATETIME")}},
+				},
+				Description: Description{
+					Template: "Declared column types are stored in a hidden file named \".<file name>.schema\" in the same directory, and the values are validated on INSERT, UPDATE and REPLACE.",
 				},
 			},
 		},
@@ -701,6 +745,18 @@ var CsvqSyntax = []Expression{
 					{Keyword("ROLLBACK")},
 				},
 			},
+			{
+				Name: "restore_statement",
+				Group: []Grammar{
+					{Keyword("RESTORE"), Identifier("table_name"), Keyword("TO"), Keyword("VERSION"), Integer("version_number")},
+					{Keyword("RESTORE"), Identifier("table_name"), Keyword("TO"), Keyword("VERSION"), Datetime("datetime")},
+				},
+				Description: Description{
+					Template: "Replace the records of a table with a version kept by %s. " +
+						"%s specifies the version that holds the contents of the file at the time.",
+					Values: []Element{Flag("@@KEEP_VERSIONS"), Datetime("datetime")},
+				},
+			},
 		},
 	},
 	{
@@ -771,6 +827,27 @@ var CsvqSyntax = []Expression{
 					Template: "Show fields in a table or a view.",
 				},
 			},
+			{
+				Name: "show_versions",
+				Group: []Grammar{
+					{Keyword("SHOW"), Keyword("VERSIONS"), Keyword("OF"), Identifier("table_name")},
+				},
+				Description: Description{
+					Template: "Show versions of a table kept by %s. Versions are numbered from 1 in order from the newest.",
+					Values:   []Element{Flag("@@KEEP_VERSIONS")},
+				},
+			},
+			{
+				Name: "explain",
+				Group: []Grammar{
+					{Keyword("EXPLAIN"), Option{Keyword("ANALYZE")}, Link("select_query")},
+				},
+				Description: Description{
+					Template: "Show the execution plan of a select query. " +
+						"If %s is specified, the query is executed and the number of records and the elapsed time of each step are shown.",
+					Values: []Element{Keyword("ANALYZE")},
+				},
+			},
 			{
 				Name: "chdir",
 				Group: []Grammar{
@@ -1015,9 +1092,13 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Limit of the waiting time in seconds to wait for locked files to be released.\n" +
 				"%s  <type::%s>\n" +
+				"  > Keep the previous contents of updated files as versions.\n" +
+				"%s  <type::%s>\n" +
+				"  > Maximum number of versions kept for each file. 0 means no limit.\n" +
+				"%s  <type::%s>\n" +
 				"  > Default format to load files.\n" +
 				"%s  <type::%s>\n" +
-				"  > Field delimiter for CSV.\n" +
+				"  > Field delimiter for CSV, or \"AUTO\" to detect it from the file.\n" +
 				"%s  <type::%s>\n" +
 				"  > Delimiter positions for Fixed-Length Format.\n" +
 				"%s  <type::%s>\n" +
@@ -1029,6 +1110,22 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Parse empty fields as empty strings.\n" +
 				"%s  <type::%s>\n" +
+				"  > Union the columns of files matched by a glob pattern or in a partitioned directory by their names.\n" +
+				"%s  <type::%s>\n" +
+				"  > Types to which fields are converted when loading CSV, TSV, FIXED and LTSV in the form of \"column:type[,column:type...]\".\n" +
+				"%s  <type::%s>\n" +
+				"  > Quote character for CSV.\n" +
+				"%s  <type::%s>\n" +
+				"  > Escape style of quote characters in CSV, DOUBLE or BACKSLASH.\n" +
+				"%s  <type::%s>\n" +
+				"  > Prefix of comment lines to be ignored in CSV.\n" +
+				"%s  <type::%s>\n" +
+				"  > Number of lines to be skipped at the beginning of CSV.\n" +
+				"%s  <type::%s>\n" +
+				"  > Trim spaces around unquoted fields in CSV.\n" +
+				"%s  <type::%s>\n" +
+				"  > How to handle records that cannot be parsed. One of FAIL, SKIP and QUARANTINE.\n" +
+				"%s  <type::%s>\n" +
 				"  > Strip line break from the end of files and query results.\n" +
 				"%s  <type::%s>\n" +
 				"  > %s of query results.\n" +
@@ -1049,6 +1146,16 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Make JSON output easier to read in query results.\n" +
 				"%s  <type::%s>\n" +
+				"  > Name of the root element in XML query results.\n" +
+				"%s  <type::%s>\n" +
+				"  > Name of the elements that represent records in XML query results.\n" +
+				"%s  <type::%s>\n" +
+				"  > Table name in SQL query results.\n" +
+				"%s  <type::%s>\n" +
+				"  > %s of SQL query results.\n" +
+				"%s  <type::%s>\n" +
+				"  > Write HTML query results as a complete document.\n" +
+				"%s  <type::%s>\n" +
 				"  > Count ambiguous characters as fullwidth.\n" +
 				"%s  <type::%s>\n" +
 				"  > Count diacritical signs as halfwidth.\n" +
@@ -1070,6 +1177,8 @@ var CsvqSyntax = []Expression{
 				Flag("@@ANSI_QUOTES"), String("boolean"),
 				Flag("@@STRICT_EQUAL"), String("boolean"),
 				Flag("@@WAIT_TIMEOUT"), Float("float"),
+				Flag("@@KEEP_VERSIONS"), Boolean("boolean"),
+				Flag("@@MAX_VERSIONS"), Integer("integer"),
 				Flag("@@IMPORT_FORMAT"), String("string"),
 				Flag("@@DELIMITER"), String("string"),
 				Flag("@@DELIMITER_POSITIONS"), String("string"),
@@ -1077,6 +1186,14 @@ var CsvqSyntax = []Expression{
 				Flag("@@ENCODING"), String("string"), Link("Encoding"),
 				Flag("@@NO_HEADER"), Boolean("boolean"),
 				Flag("@@WITHOUT_NULL"), Boolean("boolean"),
+				Flag("@@GLOB_UNION_BY_NAME"), Boolean("boolean"),
+				Flag("@@COLUMN_TYPES"), String("string"),
+				Flag("@@QUOTE_CHAR"), String("string"),
+				Flag("@@ESCAPE_STYLE"), String("string"),
+				Flag("@@COMMENT_PREFIX"), String("string"),
+				Flag("@@SKIP_LINES"), Integer("integer"),
+				Flag("@@TRIM_SPACE"), Boolean("boolean"),
+				Flag("@@ON_PARSE_ERROR"), String("string"),
 				Flag("@@STRIP_ENDING_LINE_BREAK"), Boolean("boolean"),
 				Flag("@@FORMAT"), String("string"), Link("Format"),
 				Flag("@@WRITE_ENCODING"), String("string"), Link("Encoding"),
@@ -1087,6 +1204,11 @@ var CsvqSyntax = []Expression{
 				Flag("@@ENCLOSE_ALL"), Boolean("boolean"),
 				Flag("@@JSON_ESCAPE"), String("string"), Link("Json Escape Type"),
 				Flag("@@PRETTY_PRINT"), Boolean("boolean"),
+				Flag("@@XML_ROOT_ELEMENT"), String("string"),
+				Flag("@@XML_ROW_ELEMENT"), String("string"),
+				Flag("@@SQL_TABLE_NAME"), String("string"),
+				Flag("@@SQL_DIALECT"), String("string"), Link("Sql Dialect"),
+				Flag("@@HTML_STANDALONE"), Boolean("boolean"),
 				Flag("@@EAST_ASIAN_ENCODING"), Boolean("boolean"),
 				Flag("@@COUNT_DIACRITICAL_SIGN"), Boolean("boolean"),
 				Flag("@@COUNT_FORMAT_CODE"), Boolean("boolean"),
@@ -1158,6 +1280,8 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Number of loaded tables.\n" +
 				"%s  <type::%s>\n" +
+				"  > Number of malformed records skipped while loading tables.\n" +
+				"%s  <type::%s>\n" +
 				"  > Current working directory.\n" +
 				"%s  <type::%s>\n" +
 				"  > Version of csvq.\n" +
@@ -1168,6 +1292,7 @@ var CsvqSyntax = []Expression{
 				Variable("@#UPDATED"), Integer("integer"),
 				Variable("@#UPDATED_VIEWS"), Integer("integer"),
 				Variable("@#LOADED_TABLES"), Integer("integer"),
+				Variable("@#SKIPPED_RECORDS"), Integer("integer"),
 				Variable("@#WORKING_DIRECTORY"), String("string"),
 				Variable("@#VERSION"), String("string"),
 			},
@@ -1274,6 +1399,7 @@ var CsvqSyntax = []Expression{
 						"  |            | BETWEEN             | n/a           |\n" +
 						"  |            | IN                  | n/a           |\n" +
 						"  |            | LIKE                | n/a           |\n" +
+						"  |            | REGEXP              | n/a           |\n" +
 						"  |          6 | NOT                 | Right-to-Left |\n" +
 						"  |          7 | AND                 | Left-to-Right |\n" +
 						"  |          8 | OR                  | Left-to-Right |\n" +
@@ -1396,6 +1522,17 @@ var CsvqSyntax = []Expression{
 							Values: []Element{String("str"), String("pattern"), String("str"), Ternary("UNKNOWN"), String("pattern"), Token("%")},
 						},
 					},
+					{
+						Name: "regexp",
+						Group: []Grammar{
+							{String("str"), Option{Keyword("NOT")}, Keyword("REGEXP"), String("pattern")},
+							{String("str"), Option{Keyword("NOT")}, Keyword("RLIKE"), String("pattern")},
+						},
+						Description: Description{
+							Template: "Check if %s matches the regular expression %s. If %s or %s is null, then returns %s.",
+							Values:   []Element{String("str"), String("pattern"), String("str"), String("pattern"), Ternary("UNKNOWN")},
+						},
+					},
 					{
 						Name: "in",
 						Group: []Grammar{
@@ -2184,6 +2321,65 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the string that is replaced all occurrences of %s with %s in %s.", Values: []Element{String("old"), String("new"), String("str")}},
 					},
+					{
+						Name: "regexp_match",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_MATCH", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("ternary")}},
+						},
+						Description: Description{Template: "Returns TRUE if %s matches the regular expression %s, otherwise returns FALSE.", Values: []Element{String("str"), String("pattern")}},
+					},
+					{
+						Name: "regexp_find",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_FIND", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the first substring of %s that matches the regular expression %s, or null if there is no match.", Values: []Element{String("str"), String("pattern")}},
+					},
+					{
+						Name: "regexp_submatch",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_SUBMATCH", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns a json array of the first substring of %s that matches the regular expression %s and the substrings matched by the groups in the expression, or null if there is no match.", Values: []Element{String("str"), String("pattern")}},
+					},
+					{
+						Name: "regexp_find_all",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_FIND_ALL", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns a json array of all substrings of %s that match the regular expression %s, or null if there is no match.", Values: []Element{String("str"), String("pattern")}},
+					},
+					{
+						Name: "regexp_replace",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_REPLACE", Args: []Element{String("str"), String("pattern"), String("replacement"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the string that is replaced all substrings matching the regular expression %s with %s in %s. " +
+							"In %s, $n or ${n} represents the substring matched by the n-th group, and ${name} represents the substring matched by the named group.", Values: []Element{String("pattern"), String("replacement"), String("str"), String("replacement")}},
+					},
+					{
+						Name: "regexp_split",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_SPLIT", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns a json array of the substrings of %s separated by the regular expression %s.", Values: []Element{String("str"), String("pattern")}},
+					},
+					{
+						Name: "regular_expression_flags",
+						Description: Description{
+							Template: "" +
+								"```\n" +
+								"  +------+--------------------------------------------------+\n" +
+								"  | flag | description                                      |\n" +
+								"  +------+--------------------------------------------------+\n" +
+								"  | i    | Case-insensitive                                 |\n" +
+								"  | m    | Multi-line mode: ^ and $ match at line breaks    |\n" +
+								"  | s    | Let . match \\n                                   |\n" +
+								"  | U    | Ungreedy: swap meaning of x* and x*?, x+ and x+? |\n" +
+								"  +------+--------------------------------------------------+\n" +
+								"```",
+						},
+					},
 					{
 						Name: "format",
 						Group: []Grammar{
@@ -2904,17 +3100,17 @@ var CsvqSyntax = []Expression{
 				Name: "Reserved Words",
 				Description: Description{
 					Template: "" +
-						"ABSOLUTE ADD AFTER AGGREGATE ALTER ALL AND ANY AS ASC AVG BEFORE BEGIN " +
+						"ABSOLUTE ADD AFTER AGGREGATE ALTER ALL ANALYZE AND ANY AS ASC AVG BEFORE BEGIN " +
 						"BETWEEN BREAK BY CASE CHDIR CLOSE COMMIT CONTINUE COUNT CREATE CROSS " +
 						"CUME_DIST CURRENT CURSOR DECLARE DEFAULT DELETE DENSE_RANK DESC DISPOSE " +
-						"DISTINCT DO DROP DUAL ECHO ELSE ELSEIF END EXCEPT EXECUTE EXISTS " +
+						"DISTINCT DO DROP DUAL ECHO ELSE ELSEIF END EXCEPT EXECUTE EXISTS EXPLAIN " +
 						"EXIT FALSE FETCH FIRST FIRST_VALUE FOLLOWING FOR FROM FULL FUNCTION " +
 						"GROUP HAVING IF IGNORE IN INNER INSERT INTERSECT INTO IS JOIN " +
 						"JSON_AGG JSON_OBJECT JSON_ROW JSON_TABLE LAG LAST LAST_VALUE LATERAL LEAD " +
 						"LEFT LIKE LIMIT LISTAGG MAX MEDIAN MIN NATURAL NEXT NOT NTH_VALUE " +
 						"NTILE NULL OFFSET ON ONLY OPEN OR ORDER OUTER OVER PARTITION PERCENT " +
-						"PERCENT_RANK PRECEDING PREPARE PRINT PRINTF PRIOR PWD RANGE RANK RECURSIVE " +
-						"RELATIVE RELOAD REMOVE RENAME REPLACE RETURN RIGHT ROLLBACK ROW ROW_NUMBER " +
+						"PERCENT_RANK PRECEDING PREPARE PRINT PRINTF PRIOR PWD RANGE RANK RECURSIVE REGEXP " +
+						"RELATIVE RELOAD REMOVE RENAME REPLACE RETURN RIGHT RLIKE ROLLBACK ROW ROW_NUMBER " +
 						"SELECT SEPARATOR SET SHOW SOURCE STDEV STDEVP STDIN SUBSTRING SUM SYNTAX TABLE " +
 						"THEN TO TRIGGER TRUE " +
 						"UNBOUNDED UNION UNKNOWN UNSET UPDATE USING VALUES VAR VARP VIEW WHEN WHERE " +
@@ -3118,18 +3314,25 @@ var CsvqSyntax = []Expression{
 				Description: Description{
 					Template: "" +
 						"```\n" +
-						"+-------+------------------------------------------+\n" +
-						"| Value |                  Format                  |\n" +
-						"+-------+------------------------------------------+\n" +
-						"| CSV   | Character separated values               |\n" +
-						"| TSV   | Tab separated values                     |\n" +
-						"| FIXED | Fixed-Length Format                      |\n" +
-						"| JSON  | JSON Format                              |\n" +
-						"| LTSV  | Labeled Tab-separated Values             |\n" +
-						"| GFM   | Text Table for GitHub Flavored Markdown  |\n" +
-						"| ORG   | Text Table for Emacs Org-mode            |\n" +
-						"| TEXT  | Text Table for console                   |\n" +
-						"+-------+------------------------------------------+\n" +
+						"+---------+------------------------------------------+\n" +
+						"|  Value  |                  Format                  |\n" +
+						"+---------+------------------------------------------+\n" +
+						"| CSV     | Character separated values               |\n" +
+						"| TSV     | Tab separated values                     |\n" +
+						"| FIXED   | Fixed-Length Format                      |\n" +
+						"| JSON    | JSON Format                              |\n" +
+						"| JSONL   | JSON Lines                               |\n" +
+						"| LTSV    | Labeled Tab-separated Values             |\n" +
+						"| PARQUET | Apache Parquet (import only)             |\n" +
+						"| XLSX    | Excel Workbook                           |\n" +
+						"| YAML    | YAML Format                              |\n" +
+						"| XML     | XML Format                               |\n" +
+						"| SQL     | SQL Statements (export only)             |\n" +
+						"| HTML    | HTML Table (export only)                 |\n" +
+						"| GFM     | Text Table for GitHub Flavored Markdown  |\n" +
+						"| ORG     | Text Table for Emacs Org-mode            |\n" +
+						"| TEXT    | Text Table for console                   |\n" +
+						"+---------+------------------------------------------+\n" +
 						"```",
 				},
 			},
@@ -3168,6 +3371,22 @@ var CsvqSyntax = []Expression{
 						"```",
 				},
 			},
+			{
+				Name: "Sql Dialect",
+				Description: Description{
+					Template: "" +
+						"```\n" +
+						"+------------+---------------------------------------------------+\n" +
+						"|   Value    |                    Description                    |\n" +
+						"+------------+---------------------------------------------------+\n" +
+						"| ANSI       | Standard SQL                                      |\n" +
+						"| MYSQL      | Backquoted identifiers and escaped backslashes    |\n" +
+						"| POSTGRESQL | Infinity and NaN written as strings               |\n" +
+						"| SQLITE     | Booleans written as 1 and 0                       |\n" +
+						"+------------+---------------------------------------------------+\n" +
+						"```",
+				},
+			},
 			{
 				Name: "Timezone",
 				Description: Description{
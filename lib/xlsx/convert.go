@@ -0,0 +1,150 @@
+package xlsx
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+)
+
+var (
+	epoch1900 = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	epoch1904 = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+const millisecondsPerDay = 86400000
+
+// maxDateSerial is the serial number of the day after 9999-12-31, the last date that spreadsheets can represent.
+const maxDateSerial = 2958466
+
+// builtInDateFormats is the set of the built-in number format ids that represent dates or times.
+var builtInDateFormats = map[int]bool{
+	14: true, 15: true, 16: true, 17: true, 18: true, 19: true, 20: true, 21: true, 22: true,
+	27: true, 28: true, 29: true, 30: true, 31: true, 32: true, 33: true, 34: true, 35: true, 36: true,
+	45: true, 46: true, 47: true,
+	50: true, 51: true, 52: true, 53: true, 54: true, 55: true, 56: true, 57: true, 58: true,
+}
+
+// isDateFormatCode reports whether the custom number format code represents dates or times.
+func isDateFormatCode(code string) bool {
+	section := code
+	inQuotes := false
+	for i, c := range code {
+		if c == '"' {
+			inQuotes = !inQuotes
+		} else if c == ';' && !inQuotes {
+			section = code[:i]
+			break
+		}
+	}
+
+	runes := []rune(section)
+	for i := 0; i < len(runes); i++ {
+		switch runes[i] {
+		case '"':
+			for i++; i < len(runes) && runes[i] != '"'; i++ {
+			}
+		case '\\', '_', '*':
+			i++
+		case '[':
+			start := i + 1
+			for i++; i < len(runes) && runes[i] != ']'; i++ {
+			}
+			if start < i {
+				switch strings.ToLower(string(runes[start:i]))[0] {
+				case 'h', 'm', 's':
+					return true
+				}
+			}
+		case 'y', 'Y', 'm', 'M', 'd', 'D', 'h', 'H', 's', 'S':
+			return true
+		case 'e', 'E':
+			if i+1 < len(runes) && (runes[i+1] == '+' || runes[i+1] == '-') {
+				return false
+			}
+		}
+	}
+	return false
+}
+
+// serialToTime returns the time represented by a serial date number.
+func serialToTime(serial float64, date1904 bool) time.Time {
+	base := epoch1900
+	if date1904 {
+		base = epoch1904
+	} else if serial < 61 {
+		// Excel treats 1900 as a leap year, so serial numbers before 1900-03-01 are shifted by a day.
+		base = base.AddDate(0, 0, 1)
+	}
+
+	ms := int64(math.Round(serial * millisecondsPerDay))
+	days := ms / millisecondsPerDay
+	t := base.AddDate(0, 0, int(days)).Add(time.Duration(ms%millisecondsPerDay) * time.Millisecond)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), cmd.GetLocation())
+}
+
+// timeToSerial returns the serial date number of the wall clock time of t in the 1900 date system.
+func timeToSerial(t time.Time) float64 {
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	serial := float64(wall.Sub(epoch1900)) / float64(24*time.Hour)
+	if wall.Before(time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		serial = serial - 1
+	}
+	return serial
+}
+
+// formatTimeOfDay returns the time of a serial number less than 1 in the form of "15:04:05".
+func formatTimeOfDay(serial float64) string {
+	t := epoch1900.Add(time.Duration(math.Round(serial*millisecondsPerDay)) * time.Millisecond)
+	if t.Nanosecond() == 0 {
+		return t.Format("15:04:05")
+	}
+	return t.Format("15:04:05.999")
+}
+
+// columnIndex returns the zero-based column index of a cell reference such as "B3".
+func columnIndex(ref string) (int, error) {
+	idx := 0
+	n := 0
+	for _, c := range ref {
+		if 'a' <= c && c <= 'z' {
+			c = c - 'a' + 'A'
+		}
+		if c < 'A' || 'Z' < c {
+			break
+		}
+		idx = idx*26 + int(c-'A'+1)
+		n++
+	}
+	if n < 1 || 3 < n {
+		return 0, errors.New(fmt.Sprintf("invalid cell reference %q", ref))
+	}
+	return idx - 1, nil
+}
+
+// columnName returns the column name of a zero-based column index, such as "A" or "AB".
+func columnName(idx int) string {
+	var b []byte
+	for idx++; 0 < idx; idx = (idx - 1) / 26 {
+		b = append([]byte{byte('A' + (idx-1)%26)}, b...)
+	}
+	return string(b)
+}
+
+var escapedCharacterRe = regexp.MustCompile("_x([0-9A-Fa-f]{4})_")
+
+// unescape decodes characters that are escaped in the form of "_xHHHH_".
+func unescape(s string) string {
+	if !strings.Contains(s, "_x") {
+		return s
+	}
+	return escapedCharacterRe.ReplaceAllStringFunc(s, func(m string) string {
+		code, _ := strconv.ParseUint(m[2:6], 16, 16)
+		return string(rune(code))
+	})
+}
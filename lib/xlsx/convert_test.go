@@ -0,0 +1,97 @@
+package xlsx
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+)
+
+var isDateFormatCodeTests = []struct {
+	Code   string
+	Result bool
+}{
+	{Code: "General", Result: false},
+	{Code: "0.00", Result: false},
+	{Code: "0.00E+00", Result: false},
+	{Code: "#,##0;[Red]-#,##0", Result: false},
+	{Code: "\"day\" 0", Result: false},
+	{Code: "yyyy/mm/dd", Result: true},
+	{Code: "[$-409]h:mm AM/PM", Result: true},
+	{Code: "[h]:mm", Result: true},
+	{Code: "\\d 0", Result: false},
+}
+
+func TestIsDateFormatCode(t *testing.T) {
+	for _, v := range isDateFormatCodeTests {
+		result := isDateFormatCode(v.Code)
+		if result != v.Result {
+			t.Errorf("%q: result = %t, want %t", v.Code, result, v.Result)
+		}
+	}
+}
+
+var serialToTimeTests = []struct {
+	Serial   float64
+	Date1904 bool
+	Result   time.Time
+}{
+	{Serial: 1, Result: time.Date(1900, 1, 1, 0, 0, 0, 0, cmd.GetLocation())},
+	{Serial: 59, Result: time.Date(1900, 2, 28, 0, 0, 0, 0, cmd.GetLocation())},
+	{Serial: 61, Result: time.Date(1900, 3, 1, 0, 0, 0, 0, cmd.GetLocation())},
+	{Serial: 40942.387673611114, Result: time.Date(2012, 2, 3, 9, 18, 15, 0, cmd.GetLocation())},
+	{Serial: 40942.5000015, Result: time.Date(2012, 2, 3, 12, 0, 0, 130000000, cmd.GetLocation())},
+	{Serial: 39480, Date1904: true, Result: time.Date(2012, 2, 3, 0, 0, 0, 0, cmd.GetLocation())},
+}
+
+func TestSerialToTime(t *testing.T) {
+	for _, v := range serialToTimeTests {
+		result := serialToTime(v.Serial, v.Date1904)
+		if !result.Equal(v.Result) {
+			t.Errorf("%v: result = %s, want %s", v.Serial, result, v.Result)
+		}
+		if v.Date1904 {
+			continue
+		}
+		if serial := timeToSerial(result); 1e-6 < math.Abs(serial-v.Serial) {
+			t.Errorf("%v: serial = %v, want %v", v.Serial, serial, v.Serial)
+		}
+	}
+}
+
+var columnIndexTests = []struct {
+	Ref    string
+	Result int
+	Error  string
+}{
+	{Ref: "A1", Result: 0},
+	{Ref: "Z10", Result: 25},
+	{Ref: "AA3", Result: 26},
+	{Ref: "XFD1", Result: 16383},
+	{Ref: "1", Error: "invalid cell reference \"1\""},
+}
+
+func TestColumnIndex(t *testing.T) {
+	for _, v := range columnIndexTests {
+		result, err := columnIndex(v.Ref)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%q: unexpected error %q", v.Ref, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%q: error %q, want error %q", v.Ref, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%q: no error, want error %q", v.Ref, v.Error)
+			continue
+		}
+		if result != v.Result {
+			t.Errorf("%q: result = %d, want %d", v.Ref, result, v.Result)
+		}
+		if name := columnName(result); name+v.Ref[len(name):] != v.Ref {
+			t.Errorf("%d: column name = %q", result, name)
+		}
+	}
+}
@@ -0,0 +1,390 @@
+// Package xlsx reads and writes worksheets of Office Open XML spreadsheet files.
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const (
+	relTypeOfficeDocument = "/officeDocument"
+	relTypeWorksheet      = "/worksheet"
+	relTypeSharedStrings  = "/sharedStrings"
+	relTypeStyles         = "/styles"
+)
+
+type relationship struct {
+	ID     string `xml:"Id,attr"`
+	Type   string `xml:"Type,attr"`
+	Target string `xml:"Target,attr"`
+}
+
+type relationships struct {
+	Relationships []relationship `xml:"Relationship"`
+}
+
+type workbook struct {
+	WorkbookPr struct {
+		Date1904 string `xml:"date1904,attr"`
+	} `xml:"workbookPr"`
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		ID   string `xml:"id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type richText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (rt richText) String() string {
+	if len(rt.Runs) < 1 {
+		return unescape(rt.T)
+	}
+	var b strings.Builder
+	b.WriteString(rt.T)
+	for _, r := range rt.Runs {
+		b.WriteString(r.T)
+	}
+	return unescape(b.String())
+}
+
+type sharedStringTable struct {
+	Items []richText `xml:"si"`
+}
+
+type styleSheet struct {
+	NumFmts []struct {
+		ID   int    `xml:"numFmtId,attr"`
+		Code string `xml:"formatCode,attr"`
+	} `xml:"numFmts>numFmt"`
+	CellXfs []struct {
+		NumFmtID int `xml:"numFmtId,attr"`
+	} `xml:"cellXfs>xf"`
+}
+
+type cell struct {
+	Ref    string    `xml:"r,attr"`
+	Type   string    `xml:"t,attr"`
+	Style  int       `xml:"s,attr"`
+	Value  *string   `xml:"v"`
+	Inline *richText `xml:"is"`
+}
+
+type row struct {
+	Cells []cell `xml:"c"`
+}
+
+type worksheet struct {
+	Rows []row `xml:"sheetData>row"`
+}
+
+type sheet struct {
+	name string
+	path string
+}
+
+// Reader reads worksheets from a spreadsheet file.
+type Reader struct {
+	files         map[string]*zip.File
+	sheets        []sheet
+	sharedStrings []string
+	dateStyles    []bool
+	date1904      bool
+}
+
+// NewReader returns a reader for the spreadsheet file read from r, which has the given size in bytes.
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, errors.New("not a xlsx file")
+	}
+
+	reader := &Reader{
+		files: make(map[string]*zip.File, len(zr.File)),
+	}
+	for _, f := range zr.File {
+		reader.files[strings.ToLower(f.Name)] = f
+	}
+
+	workbookPath := "xl/workbook.xml"
+	var rootRels relationships
+	if ok, err := reader.decode("_rels/.rels", &rootRels); err != nil {
+		return nil, err
+	} else if ok {
+		for _, rel := range rootRels.Relationships {
+			if strings.HasSuffix(rel.Type, relTypeOfficeDocument) {
+				workbookPath = resolvePath("", rel.Target)
+				break
+			}
+		}
+	}
+
+	var wb workbook
+	if ok, err := reader.decode(workbookPath, &wb); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, errors.New("workbook is not found")
+	}
+	reader.date1904 = wb.WorkbookPr.Date1904 == "1" || strings.EqualFold(wb.WorkbookPr.Date1904, "true")
+
+	dir := path.Dir(workbookPath)
+	targets := make(map[string]string)
+	sharedStringsPath := path.Join(dir, "sharedStrings.xml")
+	stylesPath := path.Join(dir, "styles.xml")
+
+	var wbRels relationships
+	if _, err := reader.decode(path.Join(dir, "_rels", path.Base(workbookPath)+".rels"), &wbRels); err != nil {
+		return nil, err
+	}
+	for _, rel := range wbRels.Relationships {
+		switch {
+		case strings.HasSuffix(rel.Type, relTypeWorksheet):
+			targets[rel.ID] = resolvePath(dir, rel.Target)
+		case strings.HasSuffix(rel.Type, relTypeSharedStrings):
+			sharedStringsPath = resolvePath(dir, rel.Target)
+		case strings.HasSuffix(rel.Type, relTypeStyles):
+			stylesPath = resolvePath(dir, rel.Target)
+		}
+	}
+
+	reader.sheets = make([]sheet, 0, len(wb.Sheets))
+	for _, s := range wb.Sheets {
+		if p, ok := targets[s.ID]; ok {
+			reader.sheets = append(reader.sheets, sheet{name: s.Name, path: p})
+		}
+	}
+
+	var sst sharedStringTable
+	if _, err := reader.decode(sharedStringsPath, &sst); err != nil {
+		return nil, err
+	}
+	reader.sharedStrings = make([]string, len(sst.Items))
+	for i, item := range sst.Items {
+		reader.sharedStrings[i] = item.String()
+	}
+
+	var styles styleSheet
+	if _, err := reader.decode(stylesPath, &styles); err != nil {
+		return nil, err
+	}
+	customFormats := make(map[int]string, len(styles.NumFmts))
+	for _, f := range styles.NumFmts {
+		customFormats[f.ID] = f.Code
+	}
+	reader.dateStyles = make([]bool, len(styles.CellXfs))
+	for i, xf := range styles.CellXfs {
+		if code, ok := customFormats[xf.NumFmtID]; ok {
+			reader.dateStyles[i] = isDateFormatCode(code)
+		} else {
+			reader.dateStyles[i] = builtInDateFormats[xf.NumFmtID]
+		}
+	}
+
+	return reader, nil
+}
+
+// SheetNames returns the names of the worksheets in the order of the workbook.
+func (r *Reader) SheetNames() []string {
+	names := make([]string, len(r.sheets))
+	for i, s := range r.sheets {
+		names[i] = s.name
+	}
+	return names
+}
+
+// ReadSheet returns the rows of the worksheet specified by the name.
+// If the name is empty, the first worksheet is read.
+//
+// Each row has the same number of values. Empty cells are represented by nil,
+// and rows that have no cells are skipped.
+func (r *Reader) ReadSheet(name string) ([][]value.Primary, error) {
+	s, err := r.findSheet(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var ws worksheet
+	if ok, err := r.decode(s.path, &ws); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, errors.New(fmt.Sprintf("worksheet %q is not found", s.name))
+	}
+
+	rows := make([][]value.Primary, 0, len(ws.Rows))
+	width := 0
+	for _, wr := range ws.Rows {
+		values := make([]value.Primary, 0, len(wr.Cells))
+		for _, c := range wr.Cells {
+			idx := len(values)
+			if 0 < len(c.Ref) {
+				if idx, err = columnIndex(c.Ref); err != nil {
+					return nil, err
+				}
+				if idx < len(values) {
+					return nil, errors.New(fmt.Sprintf("cell %s is out of order", c.Ref))
+				}
+			}
+			for len(values) < idx {
+				values = append(values, nil)
+			}
+
+			p, err := r.cellValue(c)
+			if err != nil {
+				return nil, errors.New(fmt.Sprintf("cell %s: %s", c.Ref, err.Error()))
+			}
+			values = append(values, p)
+		}
+
+		for len(values) > 0 && values[len(values)-1] == nil {
+			values = values[:len(values)-1]
+		}
+		if len(values) < 1 {
+			continue
+		}
+		if width < len(values) {
+			width = len(values)
+		}
+		rows = append(rows, values)
+	}
+
+	for i := range rows {
+		for len(rows[i]) < width {
+			rows[i] = append(rows[i], nil)
+		}
+	}
+	return rows, nil
+}
+
+func (r *Reader) findSheet(name string) (sheet, error) {
+	if len(r.sheets) < 1 {
+		return sheet{}, errors.New("workbook has no worksheets")
+	}
+	if len(name) < 1 {
+		return r.sheets[0], nil
+	}
+	for _, s := range r.sheets {
+		if s.name == name {
+			return s, nil
+		}
+	}
+	for _, s := range r.sheets {
+		if strings.EqualFold(s.name, name) {
+			return s, nil
+		}
+	}
+	return sheet{}, errors.New(fmt.Sprintf("worksheet %q does not exist", name))
+}
+
+func (r *Reader) cellValue(c cell) (value.Primary, error) {
+	switch c.Type {
+	case "inlineStr":
+		if c.Inline == nil {
+			return nil, nil
+		}
+		return value.NewString(c.Inline.String()), nil
+	}
+
+	if c.Value == nil {
+		return nil, nil
+	}
+	v := *c.Value
+
+	switch c.Type {
+	case "s":
+		i, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil || i < 0 || len(r.sharedStrings) <= i {
+			return nil, errors.New(fmt.Sprintf("invalid shared string index %q", v))
+		}
+		return value.NewString(r.sharedStrings[i]), nil
+	case "str":
+		return value.NewString(unescape(v)), nil
+	case "b":
+		return value.NewBoolean(strings.TrimSpace(v) == "1"), nil
+	case "e":
+		return value.NewNull(), nil
+	case "d":
+		t, err := parseISO8601(strings.TrimSpace(v))
+		if err != nil {
+			return nil, err
+		}
+		return value.NewDatetime(t), nil
+	}
+
+	v = strings.TrimSpace(v)
+	if len(v) < 1 {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("invalid number %q", v))
+	}
+
+	if 0 <= c.Style && c.Style < len(r.dateStyles) && r.dateStyles[c.Style] {
+		if !(0 <= f && f < maxDateSerial) {
+			return nil, errors.New(fmt.Sprintf("invalid date %q", v))
+		}
+		if f < 1 {
+			return value.NewString(formatTimeOfDay(f)), nil
+		}
+		return value.NewDatetime(serialToTime(f, r.date1904)), nil
+	}
+
+	if !strings.ContainsAny(v, ".eE") {
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return value.NewInteger(i), nil
+		}
+	}
+	return value.NewFloat(f), nil
+}
+
+// decode unmarshals the xml file in the package, and reports whether the file exists.
+func (r *Reader) decode(name string, v interface{}) (bool, error) {
+	f, ok := r.files[strings.ToLower(name)]
+	if !ok {
+		return false, nil
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return true, err
+	}
+	defer func() { _ = rc.Close() }()
+
+	if err = xml.NewDecoder(rc).Decode(v); err != nil {
+		return true, errors.New(fmt.Sprintf("%s: %s", f.Name, err.Error()))
+	}
+	return true, nil
+}
+
+func resolvePath(dir string, target string) string {
+	if strings.HasPrefix(target, "/") {
+		return strings.TrimPrefix(target, "/")
+	}
+	return path.Join(dir, target)
+}
+
+func parseISO8601(s string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			if layout == time.RFC3339Nano {
+				return t.In(cmd.GetLocation()), nil
+			}
+			return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), cmd.GetLocation()), nil
+		}
+	}
+	return time.Time{}, errors.New(fmt.Sprintf("invalid date %q", s))
+}
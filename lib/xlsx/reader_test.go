@@ -0,0 +1,423 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func openTestFile(t *testing.T, name string) (*os.File, int64) {
+	wdir, _ := os.Getwd()
+	fp, err := os.Open(filepath.Join(wdir, "..", "..", "testdata", "csv", name))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	fi, err := fp.Stat()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	return fp, fi.Size()
+}
+
+func TestNewReader(t *testing.T) {
+	fp, size := openTestFile(t, "table9.xlsx")
+	defer func() { _ = fp.Close() }()
+
+	r, err := NewReader(fp, size)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect := []string{"Sheet1", "Items"}
+	if !reflect.DeepEqual(r.SheetNames(), expect) {
+		t.Errorf("sheet names = %v, want %v", r.SheetNames(), expect)
+	}
+}
+
+func TestNewReader_InvalidFile(t *testing.T) {
+	fp, size := openTestFile(t, "table1.csv")
+	defer func() { _ = fp.Close() }()
+
+	_, err := NewReader(fp, size)
+	if err == nil {
+		t.Fatalf("no error, want error %q", "not a xlsx file")
+	}
+	if err.Error() != "not a xlsx file" {
+		t.Errorf("error %q, want error %q", err.Error(), "not a xlsx file")
+	}
+}
+
+var readerReadSheetTests = []struct {
+	Name   string
+	Result [][]value.Primary
+	Error  string
+}{
+	{
+		Name: "",
+		Result: [][]value.Primary{
+			{
+				value.NewString("id"),
+				value.NewString("name"),
+				value.NewString("score"),
+				value.NewString("flag"),
+				value.NewString("created"),
+				value.NewString("day"),
+			},
+			{
+				value.NewInteger(1),
+				value.NewString("str1"),
+				value.NewFloat(1.5),
+				value.NewBoolean(true),
+				value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, cmd.GetLocation())),
+				value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, cmd.GetLocation())),
+			},
+			{
+				value.NewInteger(2),
+				nil,
+				value.NewFloat(2.25),
+				value.NewBoolean(false),
+				value.NewDatetime(time.Date(2012, 2, 4, 9, 18, 15, 0, cmd.GetLocation())),
+				value.NewDatetime(time.Date(2012, 2, 4, 0, 0, 0, 0, cmd.GetLocation())),
+			},
+			{
+				value.NewInteger(3),
+				value.NewString("str3"),
+				value.NewNull(),
+				value.NewBoolean(true),
+				value.NewDatetime(time.Date(2012, 2, 5, 9, 18, 15, 0, cmd.GetLocation())),
+				value.NewDatetime(time.Date(2012, 2, 5, 0, 0, 0, 0, cmd.GetLocation())),
+			},
+		},
+	},
+	{
+		Name: "items",
+		Result: [][]value.Primary{
+			{
+				value.NewString("a"),
+				nil,
+				value.NewInteger(10),
+			},
+			{
+				value.NewString("b"),
+				value.NewString("x"),
+				nil,
+			},
+		},
+	},
+	{
+		Name:  "notexist",
+		Error: "worksheet \"notexist\" does not exist",
+	},
+}
+
+func TestReader_ReadSheet(t *testing.T) {
+	fp, size := openTestFile(t, "table9.xlsx")
+	defer func() { _ = fp.Close() }()
+
+	r, err := NewReader(fp, size)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	for _, v := range readerReadSheetTests {
+		result, err := r.ReadSheet(v.Name)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%q: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%q: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%q: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%q: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func newTestPackage(parts map[string]string, omit []string) ([]byte, error) {
+	contents := map[string]string{
+		"[Content_Types].xml":        contentTypesXml,
+		"_rels/.rels":                rootRelsXml,
+		"xl/_rels/workbook.xml.rels": workbookRelsXml,
+		"xl/workbook.xml":            workbookXmlHead + "Sheet1" + workbookXmlTail,
+		"xl/styles.xml":              stylesXml,
+		"xl/worksheets/sheet1.xml":   worksheetXmlHead + worksheetXmlTail,
+	}
+	for name, content := range parts {
+		contents[name] = content
+	}
+	for _, name := range omit {
+		delete(contents, name)
+	}
+
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for name, content := range contents {
+		fw, err := zw.Create(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = fw.Write([]byte(content)); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func worksheetXml(rows string) string {
+	return worksheetXmlHead + rows + worksheetXmlTail
+}
+
+var readerMalformedPackageTests = []struct {
+	Name   string
+	Parts  map[string]string
+	Omit   []string
+	Result [][]value.Primary
+	Error  string
+}{
+	{
+		Name:  "Workbook Not Found",
+		Omit:  []string{"xl/workbook.xml"},
+		Error: "workbook is not found",
+	},
+	{
+		Name: "Truncated Workbook",
+		Parts: map[string]string{
+			"xl/workbook.xml": workbookXmlHead + "Sheet1",
+		},
+		Error: "xl/workbook.xml: XML syntax error on line 2: unexpected EOF",
+	},
+	{
+		Name: "Truncated Relationships",
+		Parts: map[string]string{
+			"_rels/.rels": "<Relationships><Relationship Id=",
+		},
+		Error: "_rels/.rels: XML syntax error on line 1: unexpected EOF",
+	},
+	{
+		Name: "Truncated Shared Strings",
+		Parts: map[string]string{
+			"xl/sharedStrings.xml": "<sst><si><t>abc</t>",
+		},
+		Error: "xl/sharedStrings.xml: XML syntax error on line 1: unexpected EOF",
+	},
+	{
+		Name: "Invalid Styles",
+		Parts: map[string]string{
+			"xl/styles.xml": "<styleSheet><cellXfs><xf numFmtId=\"x\"/></cellXfs></styleSheet>",
+		},
+		Error: "xl/styles.xml: strconv.ParseInt: parsing \"x\": invalid syntax",
+	},
+	{
+		Name: "No Worksheets",
+		Parts: map[string]string{
+			"xl/workbook.xml": "<workbook><sheets></sheets></workbook>",
+		},
+		Error: "workbook has no worksheets",
+	},
+	{
+		Name:  "Worksheet Not Found",
+		Omit:  []string{"xl/worksheets/sheet1.xml"},
+		Error: "worksheet \"Sheet1\" is not found",
+	},
+	{
+		Name: "Truncated Worksheet",
+		Parts: map[string]string{
+			"xl/worksheets/sheet1.xml": worksheetXmlHead + "<row><c r=\"A1\"><v>1</v>",
+		},
+		Error: "xl/worksheets/sheet1.xml: XML syntax error on line 2: unexpected EOF",
+	},
+	{
+		Name: "Invalid Cell Reference",
+		Parts: map[string]string{
+			"xl/worksheets/sheet1.xml": worksheetXml("<row><c r=\"1A\"><v>1</v></c></row>"),
+		},
+		Error: "invalid cell reference \"1A\"",
+	},
+	{
+		Name: "Too Long Column Name",
+		Parts: map[string]string{
+			"xl/worksheets/sheet1.xml": worksheetXml("<row><c r=\"AAAA1\"><v>1</v></c></row>"),
+		},
+		Error: "invalid cell reference \"AAAA1\"",
+	},
+	{
+		Name: "Cells Out of Order",
+		Parts: map[string]string{
+			"xl/worksheets/sheet1.xml": worksheetXml("<row><c r=\"B1\"><v>1</v></c><c r=\"A1\"><v>2</v></c></row>"),
+		},
+		Error: "cell A1 is out of order",
+	},
+	{
+		Name: "Invalid Shared String Index",
+		Parts: map[string]string{
+			"xl/worksheets/sheet1.xml": worksheetXml("<row><c r=\"A1\" t=\"s\"><v>5</v></c></row>"),
+		},
+		Error: "cell A1: invalid shared string index \"5\"",
+	},
+	{
+		Name: "Negative Shared String Index",
+		Parts: map[string]string{
+			"xl/sharedStrings.xml":     "<sst><si><t>abc</t></si></sst>",
+			"xl/worksheets/sheet1.xml": worksheetXml("<row><c r=\"A1\" t=\"s\"><v>-1</v></c></row>"),
+		},
+		Error: "cell A1: invalid shared string index \"-1\"",
+	},
+	{
+		Name: "Invalid Number",
+		Parts: map[string]string{
+			"xl/worksheets/sheet1.xml": worksheetXml("<row><c r=\"A1\"><v>abc</v></c></row>"),
+		},
+		Error: "cell A1: invalid number \"abc\"",
+	},
+	{
+		Name: "Invalid ISO 8601 Date",
+		Parts: map[string]string{
+			"xl/worksheets/sheet1.xml": worksheetXml("<row><c r=\"A1\" t=\"d\"><v>2012-02-30</v></c></row>"),
+		},
+		Error: "cell A1: invalid date \"2012-02-30\"",
+	},
+	{
+		Name: "Negative Date Serial Number",
+		Parts: map[string]string{
+			"xl/worksheets/sheet1.xml": worksheetXml("<row><c r=\"A1\" s=\"1\"><v>-1</v></c></row>"),
+		},
+		Error: "cell A1: invalid date \"-1\"",
+	},
+	{
+		Name: "Too Large Date Serial Number",
+		Parts: map[string]string{
+			"xl/worksheets/sheet1.xml": worksheetXml("<row><c r=\"A1\" s=\"1\"><v>1e300</v></c></row>"),
+		},
+		Error: "cell A1: invalid date \"1e300\"",
+	},
+	{
+		Name: "NaN Date Serial Number",
+		Parts: map[string]string{
+			"xl/worksheets/sheet1.xml": worksheetXml("<row><c r=\"A1\" s=\"1\"><v>NaN</v></c></row>"),
+		},
+		Error: "cell A1: invalid date \"NaN\"",
+	},
+	{
+		Name: "Style Index Out of Range",
+		Parts: map[string]string{
+			"xl/worksheets/sheet1.xml": worksheetXml("<row><c r=\"A1\" s=\"99\"><v>1</v></c><c r=\"B1\" s=\"-1\"><v>2</v></c></row>"),
+		},
+		Result: [][]value.Primary{
+			{value.NewInteger(1), value.NewInteger(2)},
+		},
+	},
+	{
+		Name: "Cells without Values",
+		Parts: map[string]string{
+			"xl/worksheets/sheet1.xml": worksheetXml("<row><c r=\"A1\" t=\"inlineStr\"/><c r=\"B1\" t=\"s\"/><c r=\"C1\"><v> </v></c></row><row><c r=\"B2\"><v>1</v></c></row>"),
+		},
+		Result: [][]value.Primary{
+			{nil, value.NewInteger(1)},
+		},
+	},
+}
+
+func TestReader_MalformedPackage(t *testing.T) {
+	for _, v := range readerMalformedPackageTests {
+		src, err := newTestPackage(v.Parts, v.Omit)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		var result [][]value.Primary
+		r, err := NewReader(bytes.NewReader(src), int64(len(src)))
+		if err == nil {
+			result, err = r.ReadSheet("")
+		}
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+// readAll reads all of the worksheets in src, and returns an error instead of panicking.
+func readAll(src []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	r, err := NewReader(bytes.NewReader(src), int64(len(src)))
+	if err != nil {
+		return err
+	}
+	for _, name := range r.SheetNames() {
+		if _, err = r.ReadSheet(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestReader_TruncatedFile(t *testing.T) {
+	fp, _ := openTestFile(t, "table9.xlsx")
+	src, err := ioutil.ReadAll(fp)
+	_ = fp.Close()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	for i := 0; i < len(src); i++ {
+		if err := readAll(src[:i]); err == nil {
+			t.Errorf("no error with %d bytes, want error", i)
+		} else if strings.HasPrefix(err.Error(), "panic") {
+			t.Errorf("%s with %d bytes", err, i)
+		}
+	}
+}
+
+func TestReader_CorruptedFile(t *testing.T) {
+	fp, _ := openTestFile(t, "table9.xlsx")
+	src, err := ioutil.ReadAll(fp)
+	_ = fp.Close()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	for i := 0; i < len(src); i++ {
+		for _, b := range []byte{0x00, 0x7f, 0xff} {
+			corrupted := append([]byte{}, src...)
+			corrupted[i] = b
+			if err := readAll(corrupted); err != nil && strings.HasPrefix(err.Error(), "panic") {
+				t.Errorf("%s with byte %d replaced by %#x", err, i, b)
+			}
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const (
+	contentTypesXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+		`</Types>`
+
+	rootRelsXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+
+	workbookRelsXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+		`</Relationships>`
+
+	workbookXmlHead = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="`
+	workbookXmlTail = `" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+	// The style index 1 is used for datetime values.
+	stylesXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy\-mm\-dd\ hh:mm:ss"/></numFmts>` +
+		`<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>` +
+		`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+		`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+		`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+		`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+		`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/></cellXfs>` +
+		`</styleSheet>`
+
+	worksheetXmlHead = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	worksheetXmlTail = `</sheetData></worksheet>`
+)
+
+// minSerialTime is the earliest time that can be represented by a serial date number.
+var minSerialTime = time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Writer writes rows to a spreadsheet file that has a single worksheet.
+type Writer struct {
+	zw        *zip.Writer
+	w         *bufio.Writer
+	sheetName string
+	rowNum    int
+}
+
+// NewWriter returns a writer that writes a spreadsheet file to w.
+// The worksheet is named by the sheetName.
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	zw := zip.NewWriter(w)
+	fw, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &Writer{
+		zw:        zw,
+		w:         bufio.NewWriter(fw),
+		sheetName: sheetName,
+	}
+	if _, err = writer.w.WriteString(worksheetXmlHead); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// Write writes a row of the values.
+func (w *Writer) Write(values []value.Primary) error {
+	w.rowNum++
+	rowRef := strconv.Itoa(w.rowNum)
+
+	_, _ = w.w.WriteString(`<row r="` + rowRef + `">`)
+	for i, p := range values {
+		ref := columnName(i) + rowRef
+		switch p.(type) {
+		case *value.String:
+			w.writeString(ref, p.(*value.String).Raw())
+		case *value.Integer:
+			w.writeNumber(ref, p.(*value.Integer).String(), false)
+		case *value.Float:
+			f := p.(*value.Float).Raw()
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				w.writeString(ref, p.(*value.Float).String())
+			} else {
+				w.writeNumber(ref, strconv.FormatFloat(f, 'g', -1, 64), false)
+			}
+		case *value.Boolean:
+			w.writeBoolean(ref, p.(*value.Boolean).Raw())
+		case *value.Ternary:
+			if t := p.(*value.Ternary).Ternary(); t != ternary.UNKNOWN {
+				w.writeBoolean(ref, t.ParseBool())
+			}
+		case *value.Datetime:
+			t := p.(*value.Datetime).Raw()
+			if t.Before(minSerialTime) {
+				w.writeString(ref, t.Format(time.RFC3339Nano))
+			} else {
+				w.writeNumber(ref, strconv.FormatFloat(timeToSerial(t), 'f', -1, 64), true)
+			}
+		}
+	}
+	_, err := w.w.WriteString(`</row>`)
+	return err
+}
+
+func (w *Writer) writeString(ref string, s string) {
+	_, _ = w.w.WriteString(`<c r="` + ref + `" t="inlineStr"><is><t xml:space="preserve">`)
+	_ = xml.EscapeText(w.w, []byte(s))
+	_, _ = w.w.WriteString(`</t></is></c>`)
+}
+
+func (w *Writer) writeNumber(ref string, s string, isDatetime bool) {
+	style := ""
+	if isDatetime {
+		style = ` s="1"`
+	}
+	_, _ = w.w.WriteString(`<c r="` + ref + `"` + style + `><v>` + s + `</v></c>`)
+}
+
+func (w *Writer) writeBoolean(ref string, b bool) {
+	v := "0"
+	if b {
+		v = "1"
+	}
+	_, _ = w.w.WriteString(`<c r="` + ref + `" t="b"><v>` + v + `</v></c>`)
+}
+
+// Close writes the rest of the parts of the spreadsheet file.
+// It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if _, err := w.w.WriteString(worksheetXmlTail); err != nil {
+		return err
+	}
+	if err := w.w.Flush(); err != nil {
+		return err
+	}
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{name: "[Content_Types].xml", content: contentTypesXml},
+		{name: "_rels/.rels", content: rootRelsXml},
+		{name: "xl/_rels/workbook.xml.rels", content: workbookRelsXml},
+		{name: "xl/workbook.xml", content: workbookXmlHead + escapeAttr(w.sheetName) + workbookXmlTail},
+		{name: "xl/styles.xml", content: stylesXml},
+	}
+	for _, part := range parts {
+		fw, err := w.zw.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err = io.WriteString(fw, part.content); err != nil {
+			return err
+		}
+	}
+	return w.zw.Close()
+}
+
+func escapeAttr(s string) string {
+	var b bytes.Buffer
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
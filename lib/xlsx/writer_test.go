@@ -0,0 +1,71 @@
+package xlsx
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+func TestWriter(t *testing.T) {
+	rows := [][]value.Primary{
+		{
+			value.NewString("c1"),
+			value.NewString("c2"),
+			value.NewString("c3"),
+		},
+		{
+			value.NewInteger(1),
+			value.NewString("a < b & \"c\""),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, cmd.GetLocation())),
+		},
+		{
+			value.NewFloat(1.25),
+			value.NewNull(),
+			value.NewTernary(ternary.FALSE),
+		},
+	}
+	expect := [][]value.Primary{
+		rows[0],
+		rows[1],
+		{
+			value.NewFloat(1.25),
+			nil,
+			value.NewBoolean(false),
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, "Result & Data")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	for _, row := range rows {
+		if err = w.Write(row); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(r.SheetNames(), []string{"Result & Data"}) {
+		t.Errorf("sheet names = %v, want %v", r.SheetNames(), []string{"Result & Data"})
+	}
+	result, err := r.ReadSheet("")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+}
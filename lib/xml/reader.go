@@ -0,0 +1,211 @@
+// Package xml loads tables from XML documents and writes tables as XML documents.
+package xml
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/json"
+	"github.com/mithrandie/csvq/lib/value"
+
+	txjson "github.com/mithrandie/go-text/json"
+)
+
+const (
+	// AttributePrefix is prepended to attribute names to make column names.
+	AttributePrefix = "@"
+
+	// TextKey is the column name of the text content of elements that have attributes or child elements.
+	TextKey = "#text"
+)
+
+// RowPath represents the location of the elements that are loaded as records.
+//
+// A path is a sequence of element names separated by slashes from the root element,
+// such as "catalog/book". An asterisk matches any element.
+// A path that starts with "//" matches elements at any depth, such as "//book".
+type RowPath struct {
+	steps      []string
+	descendant bool
+}
+
+// ParseRowPath parses a row path. An empty string means the child elements of the root element.
+func ParseRowPath(s string) (RowPath, error) {
+	str := strings.TrimSpace(s)
+	if len(str) < 1 {
+		return RowPath{steps: []string{"*", "*"}}, nil
+	}
+
+	p := RowPath{}
+	if strings.HasPrefix(str, "//") {
+		p.descendant = true
+		str = str[2:]
+	} else {
+		str = strings.TrimPrefix(str, "/")
+	}
+
+	p.steps = strings.Split(str, "/")
+	for _, step := range p.steps {
+		if step != "*" && !IsValidName(step) {
+			return p, errors.New(fmt.Sprintf("invalid row path %q", s))
+		}
+	}
+	return p, nil
+}
+
+func (p RowPath) match(stack []string) bool {
+	if len(stack) < len(p.steps) || (!p.descendant && len(stack) != len(p.steps)) {
+		return false
+	}
+
+	stack = stack[len(stack)-len(p.steps):]
+	for i, step := range p.steps {
+		if step != "*" && step != stack[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type node struct {
+	attrs    []xml.Attr
+	children []*node
+	name     string
+	text     strings.Builder
+}
+
+func newNode(elem xml.StartElement) *node {
+	attrs := make([]xml.Attr, 0, len(elem.Attr))
+	for _, attr := range elem.Attr {
+		if attr.Name.Space == "xmlns" || (len(attr.Name.Space) < 1 && attr.Name.Local == "xmlns") {
+			continue
+		}
+		attrs = append(attrs, attr)
+	}
+
+	return &node{
+		name:  elem.Name.Local,
+		attrs: attrs,
+	}
+}
+
+func (n *node) isLeaf() bool {
+	return len(n.attrs) < 1 && len(n.children) < 1
+}
+
+// structure returns the text of the element if the element has neither attributes nor child elements,
+// otherwise returns the object.
+func (n *node) structure() txjson.Structure {
+	if n.isLeaf() {
+		return txjson.String(n.text.String())
+	}
+	return n.object()
+}
+
+func (n *node) object() txjson.Object {
+	obj := txjson.NewObject(len(n.attrs) + len(n.children) + 1)
+	for _, attr := range n.attrs {
+		obj.Add(AttributePrefix+attr.Name.Local, txjson.String(attr.Value))
+	}
+
+	for _, child := range n.children {
+		key := child.name
+		val := child.structure()
+
+		if !obj.Exists(key) {
+			obj.Add(key, val)
+			continue
+		}
+
+		if array, ok := obj.Value(key).(txjson.Array); ok {
+			obj.Update(key, append(array, val))
+		} else {
+			obj.Update(key, txjson.Array{obj.Value(key), val})
+		}
+	}
+
+	s := n.text.String()
+	if !n.isLeaf() {
+		s = strings.TrimSpace(s)
+	}
+	if 0 < len(s) {
+		obj.Add(TextKey, txjson.String(s))
+	}
+	return obj
+}
+
+// LoadTable loads a table from the XML document read from r.
+// Each element that the row path matches is loaded as a record,
+// whose attributes and child elements are loaded as fields.
+//
+// Attribute names are prefixed with "@". Child elements that have attributes or child elements
+// are loaded as JSON objects, and repeated child elements are loaded as JSON arrays.
+func LoadTable(rowPath string, r io.Reader) ([]string, [][]value.Primary, error) {
+	path, err := ParseRowPath(rowPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := xml.NewDecoder(r)
+
+	var stack []string
+	var nodes []*node
+	hasRoot := false
+	records := make(txjson.Array, 0, 10)
+
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch token.(type) {
+		case xml.StartElement:
+			elem := token.(xml.StartElement)
+			if len(stack) < 1 {
+				if hasRoot {
+					return nil, nil, errors.New("XML document has more than one root element")
+				}
+				hasRoot = true
+			}
+			stack = append(stack, elem.Name.Local)
+
+			if 0 < len(nodes) {
+				n := newNode(elem)
+				parent := nodes[len(nodes)-1]
+				parent.children = append(parent.children, n)
+				nodes = append(nodes, n)
+			} else if path.match(stack) {
+				nodes = append(nodes, newNode(elem))
+			}
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+
+			if 0 < len(nodes) {
+				n := nodes[len(nodes)-1]
+				nodes = nodes[:len(nodes)-1]
+				if len(nodes) < 1 {
+					records = append(records, n.object())
+				}
+			}
+		case xml.CharData:
+			if len(stack) < 1 && 0 < len(strings.TrimSpace(string(token.(xml.CharData)))) {
+				return nil, nil, errors.New("XML document has text outside the root element")
+			}
+			if 0 < len(nodes) {
+				nodes[len(nodes)-1].text.Write(token.(xml.CharData))
+			}
+		}
+	}
+
+	if !hasRoot {
+		return nil, nil, errors.New("XML document has no root element")
+	}
+	return json.ConvertToTableValue(records)
+}
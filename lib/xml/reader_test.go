@@ -0,0 +1,204 @@
+package xml
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var loadTableTests = []struct {
+	Name         string
+	RowPath      string
+	Xml          string
+	ExpectHeader []string
+	ExpectValues [][]value.Primary
+	Error        string
+}{
+	{
+		Name:    "Children of Root Element",
+		RowPath: "",
+		Xml: "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+			"<items>\n" +
+			"  <item id=\"1\"><name>apple</name><price>1.5</price></item>\n" +
+			"  <item id=\"2\"><name>banana &amp; cherry</name></item>\n" +
+			"</items>\n",
+		ExpectHeader: []string{"@id", "name", "price"},
+		ExpectValues: [][]value.Primary{
+			{value.NewString("1"), value.NewString("apple"), value.NewString("1.5")},
+			{value.NewString("2"), value.NewString("banana & cherry"), value.NewNull()},
+		},
+	},
+	{
+		Name:    "Absolute Path",
+		RowPath: "/catalog/books/book",
+		Xml: "<catalog xmlns=\"http://example.com/catalog\">" +
+			"<books><book><title>A</title></book><book><title>B</title></book></books>" +
+			"<magazines><book><title>C</title></book></magazines>" +
+			"</catalog>",
+		ExpectHeader: []string{"title"},
+		ExpectValues: [][]value.Primary{
+			{value.NewString("A")},
+			{value.NewString("B")},
+		},
+	},
+	{
+		Name:    "Descendant Path",
+		RowPath: "//book",
+		Xml: "<catalog>" +
+			"<books><book><title>A</title></book></books>" +
+			"<magazines><book><title>C</title></book></magazines>" +
+			"</catalog>",
+		ExpectHeader: []string{"title"},
+		ExpectValues: [][]value.Primary{
+			{value.NewString("A")},
+			{value.NewString("C")},
+		},
+	},
+	{
+		Name:    "Nested and Repeated Elements",
+		RowPath: "root/*",
+		Xml: "<root><row>" +
+			"<tag>a</tag><tag>b</tag><tag>c</tag>" +
+			"<price currency=\"USD\">10</price>" +
+			"</row></root>",
+		ExpectHeader: []string{"tag", "price"},
+		ExpectValues: [][]value.Primary{
+			{value.NewString("[\"a\",\"b\",\"c\"]"), value.NewString("{\"@currency\":\"USD\",\"#text\":\"10\"}")},
+		},
+	},
+	{
+		Name:         "No Matched Elements",
+		RowPath:      "root/row",
+		Xml:          "<root><item/></root>",
+		ExpectHeader: nil,
+		ExpectValues: [][]value.Primary{},
+	},
+	{
+		Name:    "Invalid Row Path Error",
+		RowPath: "root/1row",
+		Xml:     "<root/>",
+		Error:   "invalid row path \"root/1row\"",
+	},
+	{
+		Name:    "Syntax Error",
+		RowPath: "",
+		Xml:     "<root><row></root>",
+		Error:   "XML syntax error on line 1: element <row> closed by </root>",
+	},
+	{
+		Name:    "Empty Document Error",
+		RowPath: "",
+		Xml:     "",
+		Error:   "XML document has no root element",
+	},
+	{
+		Name:    "Document without Root Element Error",
+		RowPath: "",
+		Xml:     "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<!-- comment -->\n",
+		Error:   "XML document has no root element",
+	},
+	{
+		Name:    "Multiple Root Elements Error",
+		RowPath: "",
+		Xml:     "<root><row/></root>\n<root><row/></root>",
+		Error:   "XML document has more than one root element",
+	},
+	{
+		Name:    "Text Outside Root Element Error",
+		RowPath: "",
+		Xml:     "<root><row/></root>text",
+		Error:   "XML document has text outside the root element",
+	},
+	{
+		Name:         "Comments and White Spaces Outside Root Element",
+		RowPath:      "",
+		Xml:          "<!-- head -->\n<root><row><a>1</a></row></root>\n<!-- tail -->\n",
+		ExpectHeader: []string{"a"},
+		ExpectValues: [][]value.Primary{
+			{value.NewString("1")},
+		},
+	},
+	{
+		Name:    "Unclosed Element Error",
+		RowPath: "",
+		Xml:     "<root><row><a>1</a></row>",
+		Error:   "XML syntax error on line 1: unexpected EOF",
+	},
+	{
+		Name:    "Truncated Attribute Error",
+		RowPath: "",
+		Xml:     "<root><row id=\"1",
+		Error:   "XML syntax error on line 1: unexpected EOF",
+	},
+	{
+		Name:    "Undefined Entity Error",
+		RowPath: "",
+		Xml:     "<root><row>&undefined;</row></root>",
+		Error:   "XML syntax error on line 1: invalid character entity &undefined;",
+	},
+	{
+		Name:    "Illegal Character Error",
+		RowPath: "",
+		Xml:     "<root><row>\x00</row></root>",
+		Error:   "XML syntax error on line 1: illegal character code U+0000",
+	},
+	{
+		Name:    "Unsupported Encoding Error",
+		RowPath: "",
+		Xml:     "<?xml version=\"1.0\" encoding=\"Shift_JIS\"?><root/>",
+		Error:   "xml: encoding \"Shift_JIS\" declared but Decoder.CharsetReader is nil",
+	},
+	{
+		Name:    "Empty Step in Row Path Error",
+		RowPath: "root//row",
+		Xml:     "<root/>",
+		Error:   "invalid row path \"root//row\"",
+	},
+}
+
+func TestLoadTable(t *testing.T) {
+	for _, v := range loadTableTests {
+		header, values, err := LoadTable(v.RowPath, strings.NewReader(v.Xml))
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(header, v.ExpectHeader) {
+			t.Errorf("%s: header = %#v, want %#v", v.Name, header, v.ExpectHeader)
+		}
+		if !reflect.DeepEqual(values, v.ExpectValues) {
+			t.Errorf("%s: values = %#v, want %#v", v.Name, values, v.ExpectValues)
+		}
+	}
+}
+
+func TestLoadTable_TruncatedInput(t *testing.T) {
+	wdir, _ := os.Getwd()
+	src, err := ioutil.ReadFile(filepath.Join(wdir, "..", "..", "testdata", "csv", "table11.xml"))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	doc := strings.TrimSpace(string(src))
+
+	for i := 0; i < len(doc); i++ {
+		if _, _, err := LoadTable("catalog/items/item", strings.NewReader(doc[:i])); err == nil {
+			t.Errorf("no error with %d bytes, want error", i)
+		}
+	}
+	if _, _, err := LoadTable("catalog/items/item", strings.NewReader(doc)); err != nil {
+		t.Errorf("unexpected error %q", err)
+	}
+}
@@ -0,0 +1,164 @@
+package xml
+
+import (
+	"bufio"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/ternary"
+)
+
+const (
+	declaration = `<?xml version="1.0" encoding="UTF-8"?>`
+	indent      = "  "
+)
+
+// IsValidName reports whether the string can be used as an element name or an attribute name.
+// Names that contain colons are not allowed because namespaces are not supported.
+func IsValidName(s string) bool {
+	if len(s) < 1 {
+		return false
+	}
+	for i, r := range s {
+		if !isNameChar(r, i == 0) {
+			return false
+		}
+	}
+	return true
+}
+
+func isNameChar(r rune, first bool) bool {
+	if r == '_' || unicode.IsLetter(r) {
+		return true
+	}
+	return !first && (r == '-' || r == '.' || unicode.IsDigit(r))
+}
+
+// ConvertToName returns the column name as a valid element name or attribute name
+// by replacing the characters that cannot be used with underscores.
+func ConvertToName(s string) string {
+	runes := []rune(s)
+	for i, r := range runes {
+		if !isNameChar(r, false) {
+			runes[i] = '_'
+		}
+	}
+
+	name := string(runes)
+	if !IsValidName(name) {
+		name = "_" + name
+	}
+	return name
+}
+
+// Writer writes records as the child elements of the root element.
+//
+// The fields whose column names start with "@" are written as attributes of the row elements,
+// and the other fields are written as child elements. Null values are omitted.
+type Writer struct {
+	w          *bufio.Writer
+	lineBreak  string
+	root       string
+	row        string
+	names      []string
+	attributes []bool
+}
+
+// NewWriter returns a writer that writes the XML declaration and the start tag of the root element.
+func NewWriter(w io.Writer, root string, row string, header []string, lineBreak text.LineBreak) (*Writer, error) {
+	writer := &Writer{
+		w:          bufio.NewWriter(w),
+		lineBreak:  lineBreak.Value(),
+		root:       root,
+		row:        row,
+		names:      make([]string, len(header)),
+		attributes: make([]bool, len(header)),
+	}
+
+	for i, column := range header {
+		if 1 < len(column) && strings.HasPrefix(column, AttributePrefix) {
+			writer.attributes[i] = true
+			column = column[len(AttributePrefix):]
+		}
+		writer.names[i] = ConvertToName(column)
+	}
+
+	if _, err := writer.w.WriteString(declaration + writer.lineBreak + "<" + root + ">"); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// Write writes a row element that has the values.
+func (w *Writer) Write(values []value.Primary) error {
+	strs := make([]string, len(values))
+	isNull := make([]bool, len(values))
+	hasElements := false
+	for i, p := range values {
+		strs[i], isNull[i] = convertValue(p)
+		if !isNull[i] && !w.attributes[i] {
+			hasElements = true
+		}
+	}
+
+	_, _ = w.w.WriteString(w.lineBreak + indent + "<" + w.row)
+	for i := range values {
+		if w.attributes[i] && !isNull[i] {
+			_, _ = w.w.WriteString(" " + w.names[i] + "=\"")
+			_ = xml.EscapeText(w.w, []byte(strs[i]))
+			_ = w.w.WriteByte('"')
+		}
+	}
+
+	if !hasElements {
+		_, err := w.w.WriteString("/>")
+		return err
+	}
+
+	_ = w.w.WriteByte('>')
+	for i := range values {
+		if !w.attributes[i] && !isNull[i] {
+			_, _ = w.w.WriteString(w.lineBreak + indent + indent + "<" + w.names[i] + ">")
+			_ = xml.EscapeText(w.w, []byte(strs[i]))
+			_, _ = w.w.WriteString("</" + w.names[i] + ">")
+		}
+	}
+	_, err := w.w.WriteString(w.lineBreak + indent + "</" + w.row + ">")
+	return err
+}
+
+// Flush writes the end tag of the root element and flushes the buffered data.
+func (w *Writer) Flush() error {
+	if _, err := w.w.WriteString(w.lineBreak + "</" + w.root + ">"); err != nil {
+		return err
+	}
+	return w.w.Flush()
+}
+
+// convertValue returns the string representation of the value, and reports whether the value is null.
+func convertValue(p value.Primary) (string, bool) {
+	switch p.(type) {
+	case *value.String:
+		return p.(*value.String).Raw(), false
+	case *value.Integer:
+		return p.(*value.Integer).String(), false
+	case *value.Float:
+		return p.(*value.Float).String(), false
+	case *value.Boolean:
+		return p.(*value.Boolean).String(), false
+	case *value.Ternary:
+		if t := p.(*value.Ternary).Ternary(); t != ternary.UNKNOWN {
+			return strconv.FormatBool(t.ParseBool()), false
+		}
+	case *value.Datetime:
+		return p.(*value.Datetime).Format(time.RFC3339Nano), false
+	}
+	return "", true
+}
@@ -0,0 +1,84 @@
+package xml
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/ternary"
+)
+
+func TestWriter(t *testing.T) {
+	header := []string{"@id", "name", "table.price", "date"}
+	rows := [][]value.Primary{
+		{
+			value.NewInteger(1),
+			value.NewString("a < b & \"c\""),
+			value.NewFloat(1.25),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)),
+		},
+		{
+			value.NewInteger(2),
+			value.NewNull(),
+			value.NewNull(),
+			value.NewTernary(ternary.UNKNOWN),
+		},
+	}
+	expect := "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\r\n" +
+		"<items>\r\n" +
+		"  <item id=\"1\">\r\n" +
+		"    <name>a &lt; b &amp; &#34;c&#34;</name>\r\n" +
+		"    <table.price>1.25</table.price>\r\n" +
+		"    <date>2012-02-03T09:18:15Z</date>\r\n" +
+		"  </item>\r\n" +
+		"  <item id=\"2\"/>\r\n" +
+		"</items>"
+
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, "items", "item", header, text.CRLF)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	for _, row := range rows {
+		if err = w.Write(row); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+	}
+	if err = w.Flush(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if buf.String() != expect {
+		t.Errorf("result = %q, want %q", buf.String(), expect)
+	}
+
+	resultHeader, _, err := LoadTable("items/item", bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(resultHeader, header) {
+		t.Errorf("header = %v, want %v", resultHeader, header)
+	}
+}
+
+var convertToNameTests = []struct {
+	Name   string
+	Expect string
+}{
+	{Name: "column1", Expect: "column1"},
+	{Name: "1column", Expect: "_1column"},
+	{Name: "my column:1", Expect: "my_column_1"},
+	{Name: "", Expect: "_"},
+}
+
+func TestConvertToName(t *testing.T) {
+	for _, v := range convertToNameTests {
+		result := ConvertToName(v.Name)
+		if result != v.Expect {
+			t.Errorf("result = %q, want %q for %q", result, v.Expect, v.Name)
+		}
+	}
+}
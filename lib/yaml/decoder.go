@@ -0,0 +1,1083 @@
+// Package yaml decodes YAML documents into JSON structures and encodes JSON structures as YAML.
+package yaml
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/go-text/json"
+)
+
+const eof = -1
+
+const (
+	chompClip = iota
+	chompStrip
+	chompKeep
+)
+
+var (
+	intRe   = regexp.MustCompile("^[-+]?[0-9]+$")
+	octRe   = regexp.MustCompile("^0o[0-7]+$")
+	hexRe   = regexp.MustCompile("^0x[0-9a-fA-F]+$")
+	floatRe = regexp.MustCompile("^[-+]?(\\.[0-9]+|[0-9]+(\\.[0-9]*)?)([eE][-+]?[0-9]+)?$")
+)
+
+// Decode decodes the YAML stream in src.
+//
+// Mappings are decoded into objects, sequences into arrays, and scalars are resolved
+// by the YAML 1.2 core schema. If the stream contains more than one document,
+// the result is an array of the documents.
+func Decode(src string) (json.Structure, error) {
+	src = strings.TrimPrefix(src, "\ufeff")
+	src = strings.Replace(src, "\r\n", "\n", -1)
+	src = strings.Replace(src, "\r", "\n", -1)
+
+	d := &decoder{
+		src:  []rune(src),
+		line: 1,
+	}
+
+	docs := make(json.Array, 0, 1)
+	for {
+		doc, ok, err := d.parseDocument()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		docs = append(docs, doc)
+	}
+
+	switch len(docs) {
+	case 0:
+		return json.Null{}, nil
+	case 1:
+		return docs[0], nil
+	}
+	return docs, nil
+}
+
+type mark struct {
+	pos       int
+	line      int
+	lineStart int
+}
+
+type decoder struct {
+	src       []rune
+	pos       int
+	line      int
+	lineStart int
+	anchors   map[string]json.Structure
+}
+
+func (d *decoder) error(message string) error {
+	return errors.New(fmt.Sprintf("line %d, column %d: %s", d.line, d.column()+1, message))
+}
+
+func (d *decoder) eof() bool {
+	return len(d.src) <= d.pos
+}
+
+func (d *decoder) peek() rune {
+	return d.peekAt(d.pos)
+}
+
+func (d *decoder) peekAt(i int) rune {
+	if len(d.src) <= i {
+		return eof
+	}
+	return d.src[i]
+}
+
+func (d *decoder) next() rune {
+	c := d.src[d.pos]
+	d.pos++
+	if c == '\n' {
+		d.line++
+		d.lineStart = d.pos
+	}
+	return c
+}
+
+func (d *decoder) column() int {
+	return d.pos - d.lineStart
+}
+
+func (d *decoder) mark() mark {
+	return mark{pos: d.pos, line: d.line, lineStart: d.lineStart}
+}
+
+func (d *decoder) reset(m mark) {
+	d.pos = m.pos
+	d.line = m.line
+	d.lineStart = m.lineStart
+}
+
+// isSeparated reports whether the character at i ends a token.
+func (d *decoder) isSeparated(i int, flow bool) bool {
+	switch d.peekAt(i) {
+	case eof, ' ', '\t', '\n':
+		return true
+	case ',', '[', ']', '{', '}':
+		return flow
+	}
+	return false
+}
+
+func (d *decoder) atDocumentMarker() bool {
+	if d.column() != 0 || len(d.src) < d.pos+3 {
+		return false
+	}
+	s := string(d.src[d.pos : d.pos+3])
+	return (s == "---" || s == "...") && d.isSeparated(d.pos+3, false)
+}
+
+func (d *decoder) atSequenceEntry() bool {
+	return d.peek() == '-' && d.isSeparated(d.pos+1, false)
+}
+
+func (d *decoder) skipSpaces() {
+	for c := d.peek(); c == ' ' || c == '\t'; c = d.peek() {
+		d.next()
+	}
+}
+
+func (d *decoder) skipToLineEnd() {
+	for c := d.peek(); c != '\n' && c != eof; c = d.peek() {
+		d.next()
+	}
+}
+
+// skipBlanks skips white spaces, line breaks and comments.
+func (d *decoder) skipBlanks() {
+	for {
+		switch d.peek() {
+		case ' ', '\t', '\n':
+			d.next()
+		case '#':
+			d.skipToLineEnd()
+		default:
+			return
+		}
+	}
+}
+
+func (d *decoder) parseDocument() (json.Structure, bool, error) {
+	d.anchors = make(map[string]json.Structure)
+	for {
+		d.skipBlanks()
+		if d.eof() {
+			return nil, false, nil
+		}
+		if d.column() == 0 && d.peek() == '%' {
+			d.skipToLineEnd()
+			continue
+		}
+		if d.atDocumentMarker() {
+			if d.peek() == '.' {
+				d.pos += 3
+				continue
+			}
+			d.pos += 3
+		}
+		break
+	}
+
+	node, err := d.parseBlockNode(0, 0)
+	if err != nil {
+		return nil, false, err
+	}
+
+	d.skipBlanks()
+	if !d.eof() {
+		if !d.atDocumentMarker() {
+			return nil, false, d.error("unexpected content")
+		}
+		if d.peek() == '.' {
+			d.pos += 3
+		}
+	}
+	return node, true, nil
+}
+
+// parseBlockNode parses a node in the block context whose indentation is at least minIndent.
+// If the node is the value of a mapping entry, keyLine is the line of the key, and a sequence
+// at the indentation of the parent mapping is also parsed as the node. Otherwise keyLine is 0.
+func (d *decoder) parseBlockNode(minIndent int, keyLine int) (json.Structure, error) {
+	var anchor string
+	var tag string
+	for {
+		d.skipBlanks()
+		if d.eof() || d.atDocumentMarker() {
+			return d.setAnchor(anchor, d.resolveTag(tag, "", true))
+		}
+		if d.column() < minIndent {
+			if 0 < keyLine && d.column() == minIndent-1 && d.atSequenceEntry() {
+				seq, err := d.parseBlockSequence(d.column())
+				if err != nil {
+					return nil, err
+				}
+				return d.setAnchor(anchor, seq)
+			}
+			return d.setAnchor(anchor, d.resolveTag(tag, "", true))
+		}
+
+		c := d.peek()
+		if c != '&' && c != '!' {
+			break
+		}
+
+		s := d.readToken()
+		if c == '&' {
+			anchor = s[1:]
+		} else {
+			tag = s
+		}
+	}
+
+	indent := d.column()
+	var node json.Structure
+	var err error
+
+	switch c := d.peek(); {
+	case c == '*':
+		node, err = d.parseAlias()
+	case c == '-' && d.isSeparated(d.pos+1, false):
+		if d.line == keyLine {
+			err = d.error("block sequence is not allowed in this context")
+			break
+		}
+		node, err = d.parseBlockSequence(indent)
+	case c == '?' && d.isSeparated(d.pos+1, false):
+		err = d.error("complex mapping keys are not supported")
+	case c == '|' || c == '>':
+		var s string
+		if s, err = d.parseBlockScalar(minIndent - 1); err == nil {
+			node = d.resolveTag(tag, s, false)
+		}
+	case c == '[' || c == '{':
+		if node, err = d.parseFlowNode(); err == nil {
+			d.skipSpaces()
+			if d.peek() == ':' && d.isSeparated(d.pos+1, false) {
+				err = d.error("mapping keys must be scalars")
+			}
+		}
+	case c == '"' || c == '\'':
+		m := d.mark()
+		var s string
+		if s, err = d.parseQuotedScalar(); err == nil {
+			d.skipSpaces()
+			if m.line == d.line && d.peek() == ':' && d.isSeparated(d.pos+1, false) {
+				if d.line == keyLine {
+					err = d.error("block mapping is not allowed in this context")
+					break
+				}
+				d.reset(m)
+				node, err = d.parseBlockMapping(indent)
+			} else {
+				node = d.resolveTag(tag, s, false)
+			}
+		}
+	default:
+		if d.isMappingKey() {
+			if d.line == keyLine {
+				err = d.error("block mapping is not allowed in this context")
+				break
+			}
+			node, err = d.parseBlockMapping(indent)
+		} else {
+			node = d.resolveTag(tag, d.parsePlainScalar(minIndent, false), true)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return d.setAnchor(anchor, node)
+}
+
+func (d *decoder) setAnchor(anchor string, node json.Structure) (json.Structure, error) {
+	if 0 < len(anchor) {
+		d.anchors[anchor] = node
+	}
+	return node, nil
+}
+
+// readToken reads characters until a white space or a line break.
+func (d *decoder) readToken() string {
+	start := d.pos
+	for !d.isSeparated(d.pos, false) {
+		d.next()
+	}
+	return string(d.src[start:d.pos])
+}
+
+func (d *decoder) parseAlias() (json.Structure, error) {
+	d.next()
+	start := d.pos
+	for !d.isSeparated(d.pos, true) {
+		d.next()
+	}
+	name := string(d.src[start:d.pos])
+	node, ok := d.anchors[name]
+	if !ok {
+		return nil, d.error(fmt.Sprintf("undefined alias %q", name))
+	}
+	return node, nil
+}
+
+// isMappingKey reports whether the rest of the current line starts with an implicit mapping key.
+func (d *decoder) isMappingKey() bool {
+	for i := d.pos; i < len(d.src); i++ {
+		switch d.src[i] {
+		case '\n':
+			return false
+		case ':':
+			if d.isSeparated(i+1, false) {
+				return true
+			}
+		case '#':
+			if d.pos < i && (d.src[i-1] == ' ' || d.src[i-1] == '\t') {
+				return false
+			}
+		}
+	}
+	return false
+}
+
+func (d *decoder) parseBlockMapping(indent int) (json.Structure, error) {
+	m := newMapping()
+
+	for {
+		if d.atSequenceEntry() {
+			return nil, d.error("unexpected sequence entry")
+		}
+
+		keyLine := d.line
+		key, merge, err := d.parseBlockKey()
+		if err != nil {
+			return nil, err
+		}
+		val, err := d.parseBlockNode(indent+1, keyLine)
+		if err != nil {
+			return nil, err
+		}
+		if err = m.add(key, val, merge); err != nil {
+			return nil, d.error(err.Error())
+		}
+
+		d.skipBlanks()
+		if d.eof() || d.atDocumentMarker() || d.column() < indent {
+			break
+		}
+		if indent < d.column() {
+			return nil, d.error("bad indentation of a mapping entry")
+		}
+	}
+	return m.obj, nil
+}
+
+func (d *decoder) parseBlockKey() (string, bool, error) {
+	var key string
+	merge := false
+
+	switch d.peek() {
+	case '"', '\'':
+		s, err := d.parseQuotedScalar()
+		if err != nil {
+			return "", false, err
+		}
+		key = s
+	case '*':
+		node, err := d.parseAlias()
+		if err != nil {
+			return "", false, err
+		}
+		if key, err = d.keyString(node); err != nil {
+			return "", false, err
+		}
+	default:
+		start := d.pos
+		end := start
+		for !(d.peek() == ':' && d.isSeparated(d.pos+1, false)) {
+			if d.peek() == '\n' || d.eof() {
+				break
+			}
+			if c := d.next(); c != ' ' && c != '\t' {
+				end = d.pos
+			}
+		}
+		key = string(d.src[start:end])
+		merge = key == "<<"
+	}
+
+	d.skipSpaces()
+	if d.peek() != ':' {
+		return "", false, d.error("mapping value is not found")
+	}
+	d.next()
+	return key, merge, nil
+}
+
+func (d *decoder) keyString(node json.Structure) (string, error) {
+	switch node.(type) {
+	case json.String:
+		return node.(json.String).Raw(), nil
+	case json.Object, json.Array:
+		return "", d.error("mapping keys must be scalars")
+	}
+	return node.Encode(), nil
+}
+
+func (d *decoder) parseBlockSequence(indent int) (json.Structure, error) {
+	array := make(json.Array, 0, 10)
+
+	for {
+		d.next()
+		item, err := d.parseBlockNode(indent+1, 0)
+		if err != nil {
+			return nil, err
+		}
+		array = append(array, item)
+
+		d.skipBlanks()
+		if d.eof() || d.atDocumentMarker() || d.column() < indent {
+			break
+		}
+		if indent < d.column() {
+			return nil, d.error("bad indentation of a sequence entry")
+		}
+		if !d.atSequenceEntry() {
+			break
+		}
+	}
+	return array, nil
+}
+
+// parsePlainScalar parses a plain scalar that can continue to the following lines
+// whose indentation is at least minIndent.
+func (d *decoder) parsePlainScalar(minIndent int, flow bool) string {
+	var b strings.Builder
+
+	for {
+		start := d.pos
+		end := start
+		for {
+			c := d.peek()
+			if c == eof || c == '\n' {
+				break
+			}
+			if c == ':' && d.isSeparated(d.pos+1, flow) {
+				break
+			}
+			if c == '#' && start < d.pos && (d.src[d.pos-1] == ' ' || d.src[d.pos-1] == '\t') {
+				break
+			}
+			if flow && (c == ',' || c == '[' || c == ']' || c == '{' || c == '}') {
+				break
+			}
+			d.next()
+			if c != ' ' && c != '\t' {
+				end = d.pos
+			}
+		}
+		b.WriteString(string(d.src[start:end]))
+		d.pos = end
+
+		m := d.mark()
+		d.skipSpaces()
+		if d.peek() != '\n' {
+			d.reset(m)
+			break
+		}
+
+		breaks := 0
+		for d.peek() == '\n' {
+			d.next()
+			d.skipSpaces()
+			if d.peek() == '\n' {
+				breaks++
+			}
+		}
+
+		if d.eof() || d.atDocumentMarker() || d.peek() == '#' || (!flow && d.column() < minIndent) ||
+			(!flow && d.isMappingKey()) ||
+			(flow && strings.ContainsRune(",[]{}:", d.peek())) {
+			d.reset(m)
+			break
+		}
+
+		if breaks < 1 {
+			b.WriteByte(' ')
+		} else {
+			b.WriteString(strings.Repeat("\n", breaks))
+		}
+	}
+	return b.String()
+}
+
+func (d *decoder) parseQuotedScalar() (string, error) {
+	quote := d.next()
+	buf := make([]rune, 0, 32)
+	protected := 0
+
+	for {
+		if d.eof() {
+			return "", d.error("quoted scalar is not terminated")
+		}
+
+		c := d.next()
+		switch {
+		case c == quote:
+			if quote == '\'' && d.peek() == '\'' {
+				d.next()
+				buf = append(buf, '\'')
+				continue
+			}
+			return string(buf), nil
+		case c == '\n':
+			for protected < len(buf) && (buf[len(buf)-1] == ' ' || buf[len(buf)-1] == '\t') {
+				buf = buf[:len(buf)-1]
+			}
+			breaks := 0
+			for {
+				d.skipSpaces()
+				if d.peek() != '\n' {
+					break
+				}
+				d.next()
+				breaks++
+			}
+			if d.atDocumentMarker() {
+				return "", d.error("quoted scalar is not terminated")
+			}
+			if breaks < 1 {
+				buf = append(buf, ' ')
+			} else {
+				for i := 0; i < breaks; i++ {
+					buf = append(buf, '\n')
+				}
+			}
+		case c == '\\' && quote == '"':
+			if d.peek() == '\n' {
+				d.next()
+				d.skipSpaces()
+				protected = len(buf)
+				continue
+			}
+			r, err := d.parseEscapeSequence()
+			if err != nil {
+				return "", err
+			}
+			buf = append(buf, r)
+			protected = len(buf)
+		default:
+			buf = append(buf, c)
+		}
+	}
+}
+
+func (d *decoder) parseEscapeSequence() (rune, error) {
+	if d.eof() {
+		return 0, d.error("quoted scalar is not terminated")
+	}
+
+	c := d.next()
+	switch c {
+	case '0':
+		return 0, nil
+	case 'a':
+		return '\a', nil
+	case 'b':
+		return '\b', nil
+	case 't', '\t':
+		return '\t', nil
+	case 'n':
+		return '\n', nil
+	case 'v':
+		return '\v', nil
+	case 'f':
+		return '\f', nil
+	case 'r':
+		return '\r', nil
+	case 'e':
+		return 0x1b, nil
+	case ' ', '"', '/', '\\':
+		return c, nil
+	case 'N':
+		return 0x85, nil
+	case '_':
+		return 0xa0, nil
+	case 'L':
+		return 0x2028, nil
+	case 'P':
+		return 0x2029, nil
+	case 'x', 'u', 'U':
+		size := 2
+		if c == 'u' {
+			size = 4
+		} else if c == 'U' {
+			size = 8
+		}
+		if len(d.src) < d.pos+size {
+			return 0, d.error("invalid escape sequence")
+		}
+		code, err := strconv.ParseUint(string(d.src[d.pos:d.pos+size]), 16, 32)
+		if err != nil {
+			return 0, d.error("invalid escape sequence")
+		}
+		d.pos += size
+		return rune(code), nil
+	}
+	return 0, d.error(fmt.Sprintf("invalid escape sequence \"\\%c\"", c))
+}
+
+// parseBlockScalar parses a literal or folded block scalar whose parent node has the indentation.
+func (d *decoder) parseBlockScalar(parentIndent int) (string, error) {
+	literal := d.next() == '|'
+	chomping := chompClip
+	indent := -1
+
+	for i := 0; i < 2; i++ {
+		c := d.peek()
+		if c == '-' {
+			chomping = chompStrip
+		} else if c == '+' {
+			chomping = chompKeep
+		} else if '1' <= c && c <= '9' {
+			if parentIndent < 0 {
+				parentIndent = 0
+			}
+			indent = parentIndent + int(c-'0')
+		} else {
+			break
+		}
+		d.next()
+	}
+
+	d.skipSpaces()
+	if d.peek() == '#' {
+		d.skipToLineEnd()
+	}
+	if d.peek() != '\n' && !d.eof() {
+		return "", d.error("invalid block scalar header")
+	}
+
+	lines := make([]string, 0, 10)
+	for !d.eof() {
+		d.next()
+		if d.eof() || d.atDocumentMarker() {
+			break
+		}
+
+		m := d.mark()
+		n := 0
+		for d.peek() == ' ' {
+			d.next()
+			n++
+		}
+		if d.peek() == '\n' || d.eof() {
+			if 0 <= indent && indent < n {
+				lines = append(lines, strings.Repeat(" ", n-indent))
+			} else {
+				lines = append(lines, "")
+			}
+			continue
+		}
+
+		if indent < 0 {
+			if n <= parentIndent {
+				d.reset(m)
+				break
+			}
+			indent = n
+		}
+		if n < indent {
+			d.reset(m)
+			break
+		}
+
+		start := d.pos - (n - indent)
+		d.skipToLineEnd()
+		lines = append(lines, string(d.src[start:d.pos]))
+	}
+
+	last := len(lines)
+	for 0 < last && lines[last-1] == "" {
+		last--
+	}
+	trailing := len(lines) - last
+	lines = lines[:last]
+
+	var s string
+	if literal {
+		s = strings.Join(lines, "\n")
+	} else {
+		s = fold(lines)
+	}
+
+	switch chomping {
+	case chompClip:
+		if 0 < last {
+			s = s + "\n"
+		}
+	case chompKeep:
+		if 0 < last {
+			s = s + "\n"
+		}
+		s = s + strings.Repeat("\n", trailing)
+	}
+	return s, nil
+}
+
+func fold(lines []string) string {
+	var b strings.Builder
+	prevNormal := false
+	started := false
+	breaks := 0
+
+	for _, l := range lines {
+		if len(l) < 1 {
+			breaks++
+			continue
+		}
+
+		normal := l[0] != ' ' && l[0] != '\t'
+		if started {
+			if breaks < 1 && prevNormal && normal {
+				b.WriteByte(' ')
+			} else {
+				n := breaks
+				if !prevNormal || !normal {
+					n++
+				}
+				b.WriteString(strings.Repeat("\n", n))
+			}
+		} else {
+			b.WriteString(strings.Repeat("\n", breaks))
+		}
+
+		b.WriteString(l)
+		prevNormal = normal
+		started = true
+		breaks = 0
+	}
+	return b.String()
+}
+
+// skipFlowBlanks skips white spaces, line breaks and comments in the flow context.
+func (d *decoder) skipFlowBlanks() error {
+	d.skipBlanks()
+	if d.atDocumentMarker() {
+		return d.error("flow collection is not terminated")
+	}
+	return nil
+}
+
+func (d *decoder) parseFlowNode() (json.Structure, error) {
+	if err := d.skipFlowBlanks(); err != nil {
+		return nil, err
+	}
+
+	var anchor string
+	var tag string
+	for c := d.peek(); c == '&' || c == '!'; c = d.peek() {
+		d.next()
+		start := d.pos
+		for !d.isSeparated(d.pos, true) {
+			d.next()
+		}
+		if c == '&' {
+			anchor = string(d.src[start:d.pos])
+		} else {
+			tag = "!" + string(d.src[start:d.pos])
+		}
+		if err := d.skipFlowBlanks(); err != nil {
+			return nil, err
+		}
+	}
+
+	var node json.Structure
+	var err error
+
+	switch c := d.peek(); c {
+	case eof:
+		err = d.error("flow collection is not terminated")
+	case '[':
+		node, err = d.parseFlowSequence()
+	case '{':
+		node, err = d.parseFlowMapping()
+	case '*':
+		node, err = d.parseAlias()
+	case '"', '\'':
+		var s string
+		if s, err = d.parseQuotedScalar(); err == nil {
+			node = d.resolveTag(tag, s, false)
+		}
+	case ',', ']', '}':
+		node = d.resolveTag(tag, "", true)
+	default:
+		node = d.resolveTag(tag, d.parsePlainScalar(0, true), true)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return d.setAnchor(anchor, node)
+}
+
+func (d *decoder) parseFlowSequence() (json.Structure, error) {
+	d.next()
+	array := make(json.Array, 0, 10)
+
+	for {
+		if err := d.skipFlowBlanks(); err != nil {
+			return nil, err
+		}
+		if d.peek() == ']' {
+			d.next()
+			break
+		}
+
+		item, err := d.parseFlowNode()
+		if err != nil {
+			return nil, err
+		}
+		if err = d.skipFlowBlanks(); err != nil {
+			return nil, err
+		}
+		if d.peek() == ':' {
+			d.next()
+			key, err := d.keyString(item)
+			if err != nil {
+				return nil, err
+			}
+			val, err := d.parseFlowValue(']')
+			if err != nil {
+				return nil, err
+			}
+			m := newMapping()
+			if err = m.add(key, val, false); err != nil {
+				return nil, d.error(err.Error())
+			}
+			item = m.obj
+		}
+		array = append(array, item)
+
+		if err = d.skipFlowBlanks(); err != nil {
+			return nil, err
+		}
+		switch d.peek() {
+		case ',':
+			d.next()
+			continue
+		case ']':
+			d.next()
+		default:
+			return nil, d.error("flow sequence is not terminated")
+		}
+		break
+	}
+	return array, nil
+}
+
+func (d *decoder) parseFlowMapping() (json.Structure, error) {
+	d.next()
+	m := newMapping()
+
+	for {
+		if err := d.skipFlowBlanks(); err != nil {
+			return nil, err
+		}
+		if d.peek() == '}' {
+			d.next()
+			break
+		}
+
+		keyNode, err := d.parseFlowNode()
+		if err != nil {
+			return nil, err
+		}
+		key, err := d.keyString(keyNode)
+		if err != nil {
+			return nil, err
+		}
+		merge := key == "<<"
+
+		if err = d.skipFlowBlanks(); err != nil {
+			return nil, err
+		}
+		var val json.Structure = json.Null{}
+		if d.peek() == ':' {
+			d.next()
+			if val, err = d.parseFlowValue('}'); err != nil {
+				return nil, err
+			}
+		}
+		if err = m.add(key, val, merge); err != nil {
+			return nil, d.error(err.Error())
+		}
+
+		if err = d.skipFlowBlanks(); err != nil {
+			return nil, err
+		}
+		switch d.peek() {
+		case ',':
+			d.next()
+			continue
+		case '}':
+			d.next()
+		default:
+			return nil, d.error("flow mapping is not terminated")
+		}
+		break
+	}
+	return m.obj, nil
+}
+
+func (d *decoder) parseFlowValue(closing rune) (json.Structure, error) {
+	if err := d.skipFlowBlanks(); err != nil {
+		return nil, err
+	}
+	if c := d.peek(); c == ',' || c == closing {
+		return json.Null{}, nil
+	}
+	return d.parseFlowNode()
+}
+
+// resolveTag returns the value of a scalar.
+// Plain scalars without any tag are resolved by the core schema.
+func (d *decoder) resolveTag(tag string, s string, plain bool) json.Structure {
+	tag = strings.Replace(tag, "!<tag:yaml.org,2002:", "!!", 1)
+	tag = strings.TrimSuffix(tag, ">")
+
+	switch tag {
+	case "!!str", "!":
+		return json.String(s)
+	case "!!null":
+		return json.Null{}
+	case "!!bool", "!!int", "!!float":
+		v := resolve(strings.TrimSpace(s))
+		switch v.(type) {
+		case json.Boolean:
+			if tag == "!!bool" {
+				return v
+			}
+		case json.Integer:
+			if tag == "!!float" {
+				return json.Float(float64(v.(json.Integer)))
+			}
+			if tag == "!!int" {
+				return v
+			}
+		case json.Float:
+			if tag == "!!float" {
+				return v
+			}
+		}
+		return json.String(s)
+	}
+
+	if plain {
+		return resolve(s)
+	}
+	return json.String(s)
+}
+
+func resolve(s string) json.Structure {
+	switch s {
+	case "", "~", "null", "Null", "NULL":
+		return json.Null{}
+	case "true", "True", "TRUE":
+		return json.Boolean(true)
+	case "false", "False", "FALSE":
+		return json.Boolean(false)
+	case ".inf", ".Inf", ".INF", "+.inf", "+.Inf", "+.INF":
+		return json.Float(math.Inf(1))
+	case "-.inf", "-.Inf", "-.INF":
+		return json.Float(math.Inf(-1))
+	case ".nan", ".NaN", ".NAN":
+		return json.Float(math.NaN())
+	}
+
+	switch {
+	case intRe.MatchString(s):
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return json.Integer(i)
+		}
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return json.Float(f)
+		}
+	case octRe.MatchString(s):
+		if i, err := strconv.ParseInt(s[2:], 8, 64); err == nil {
+			return json.Integer(i)
+		}
+	case hexRe.MatchString(s):
+		if i, err := strconv.ParseInt(s[2:], 16, 64); err == nil {
+			return json.Integer(i)
+		}
+	case floatRe.MatchString(s):
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return json.Float(f)
+		}
+	}
+	return json.String(s)
+}
+
+// mapping builds an object from the entries of a mapping, and handles merge keys.
+type mapping struct {
+	obj    json.Object
+	merged map[string]bool
+}
+
+func newMapping() *mapping {
+	return &mapping{
+		obj:    json.NewObject(8),
+		merged: make(map[string]bool),
+	}
+}
+
+func (m *mapping) add(key string, val json.Structure, merge bool) error {
+	if merge {
+		switch val.(type) {
+		case json.Object:
+			m.merge(val.(json.Object))
+			return nil
+		case json.Array:
+			for _, v := range val.(json.Array) {
+				obj, ok := v.(json.Object)
+				if !ok {
+					return errors.New("merge key must refer to a mapping or a sequence of mappings")
+				}
+				m.merge(obj)
+			}
+			return nil
+		}
+		return errors.New("merge key must refer to a mapping or a sequence of mappings")
+	}
+
+	if m.obj.Exists(key) {
+		if !m.merged[key] {
+			return errors.New(fmt.Sprintf("duplicate key %q", key))
+		}
+		m.obj.Update(key, val)
+		delete(m.merged, key)
+		return nil
+	}
+	m.obj.Add(key, val)
+	return nil
+}
+
+func (m *mapping) merge(obj json.Object) {
+	for _, member := range obj.Members {
+		if !m.obj.Exists(member.Key) {
+			m.obj.Add(member.Key, member.Value)
+			m.merged[member.Key] = true
+		}
+	}
+}
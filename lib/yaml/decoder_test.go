@@ -0,0 +1,510 @@
+package yaml
+
+import (
+	"testing"
+)
+
+var decodeTests = []struct {
+	Name   string
+	Input  string
+	Expect string
+	Error  string
+}{
+	{
+		Name:   "Empty",
+		Input:  "",
+		Expect: "null",
+	},
+	{
+		Name: "Block Mapping",
+		Input: "# comment\n" +
+			"str: abc\n" +
+			"int: 123\n" +
+			"float: -1.5e3\n" +
+			"bool: true\n" +
+			"null1: ~\n" +
+			"null2:\n" +
+			"quoted: \"123\"\n",
+		Expect: `{"str":"abc","int":123,"float":-1500,"bool":true,"null1":null,"null2":null,"quoted":"123"}`,
+	},
+	{
+		Name: "Nested Collections",
+		Input: "items:\n" +
+			"  - id: 1\n" +
+			"    tags: [a, b]\n" +
+			"  - id: 2\n" +
+			"    tags:\n" +
+			"    - c\n" +
+			"  - {id: 3, tags: []}\n",
+		Expect: `{"items":[{"id":1,"tags":["a","b"]},{"id":2,"tags":["c"]},{"id":3,"tags":[]}]}`,
+	},
+	{
+		Name: "Anchors and Merge Keys",
+		Input: "base: &base\n" +
+			"  a: 1\n" +
+			"  b: 2\n" +
+			"derived:\n" +
+			"  <<: *base\n" +
+			"  b: 3\n",
+		Expect: `{"base":{"a":1,"b":2},"derived":{"a":1,"b":3}}`,
+	},
+	{
+		Name: "Block Scalars",
+		Input: "literal: |\n" +
+			"  line1\n" +
+			"  line2\n" +
+			"folded: >-\n" +
+			"  line1\n" +
+			"  line2\n" +
+			"plain: line1\n" +
+			"  line2\n",
+		Expect: `{"literal":"line1\nline2\n","folded":"line1 line2","plain":"line1 line2"}`,
+	},
+	{
+		Name:   "Quoted Scalars",
+		Input:  "- 'it''s'\n- \"tab\\tand \\u00e9\"\n",
+		Expect: `["it's","tab\tand é"]`,
+	},
+	{
+		Name:   "Tags",
+		Input:  "- !!str 123\n- !!float 1\n- ! true\n",
+		Expect: `["123",1,"true"]`,
+	},
+	{
+		Name:   "Multiple Documents",
+		Input:  "---\na: 1\n---\na: 2\n",
+		Expect: `[{"a":1},{"a":2}]`,
+	},
+	{
+		Name:   "Anchor to Scalar",
+		Input:  "a: &x 1\nb: *x\n",
+		Expect: `{"a":1,"b":1}`,
+	},
+	{
+		Name:   "Anchor to Flow Sequence",
+		Input:  "a: &x [1, 2]\nb: *x\n",
+		Expect: `{"a":[1,2],"b":[1,2]}`,
+	},
+	{
+		Name:   "Anchor to Block Sequence",
+		Input:  "a: &x\n  - 1\n  - 2\nb: *x\n",
+		Expect: `{"a":[1,2],"b":[1,2]}`,
+	},
+	{
+		Name:   "Anchor in Sequence",
+		Input:  "- &x {a: 1}\n- *x\n",
+		Expect: `[{"a":1},{"a":1}]`,
+	},
+	{
+		Name:   "Anchor in Flow Mapping",
+		Input:  "{a: &x 1, b: *x}\n",
+		Expect: `{"a":1,"b":1}`,
+	},
+	{
+		Name:   "Anchor with Tag",
+		Input:  "a: !!str &x 1\nb: &y !!str 2\nc: [*x, *y]\n",
+		Expect: `{"a":"1","b":"2","c":["1","2"]}`,
+	},
+	{
+		Name:   "Anchor to Empty Node",
+		Input:  "a: &x\nb: *x\n",
+		Expect: `{"a":null,"b":null}`,
+	},
+	{
+		Name:   "Redefined Anchor",
+		Input:  "a: &x 1\nb: &x 2\nc: *x\n",
+		Expect: `{"a":1,"b":2,"c":2}`,
+	},
+	{
+		Name:   "Alias as Mapping Key",
+		Input:  "a: &k key\n*k : 1\n",
+		Expect: `{"a":"key","key":1}`,
+	},
+	{
+		Name:   "Merge Keys with Sequence",
+		Input:  "base: &b {x: 1}\nd:\n  <<: [*b, {y: 2}]\n  x: 3\n",
+		Expect: `{"base":{"x":1},"d":{"x":3,"y":2}}`,
+	},
+	{
+		Name:  "Merge Key with Scalar Error",
+		Input: "d:\n  <<: 1\n",
+		Error: "line 2, column 8: merge key must refer to a mapping or a sequence of mappings",
+	},
+	{
+		Name:  "Merge Key with Sequence of Scalars Error",
+		Input: "d:\n  <<: [1]\n",
+		Error: "line 2, column 10: merge key must refer to a mapping or a sequence of mappings",
+	},
+	{
+		Name:  "Alias to Anchor in Previous Document Error",
+		Input: "---\na: &x 1\n---\nb: *x\n",
+		Error: "line 4, column 6: undefined alias \"x\"",
+	},
+	{
+		Name:  "Undefined Alias in Flow Sequence Error",
+		Input: "a: [*x]\n",
+		Error: "line 1, column 7: undefined alias \"x\"",
+	},
+	{
+		Name:  "Undefined Alias as Mapping Key Error",
+		Input: "*x : 1\n",
+		Error: "line 1, column 3: undefined alias \"x\"",
+	},
+	{
+		Name:   "Multiple Documents without Leading Marker",
+		Input:  "a: 1\n---\nb: 2\n",
+		Expect: `[{"a":1},{"b":2}]`,
+	},
+	{
+		Name:   "Multiple Documents with Scalars on Marker Lines",
+		Input:  "--- 1\n--- 2\n--- 3\n",
+		Expect: `[1,2,3]`,
+	},
+	{
+		Name:   "Multiple Documents with Block Scalars on Marker Lines",
+		Input:  "--- |\n  text\n--- >\n  folded\n",
+		Expect: `["text\n","folded\n"]`,
+	},
+	{
+		Name:   "Multiple Documents with End Markers",
+		Input:  "---\n- 1\n...\n---\n- 2\n",
+		Expect: `[[1],[2]]`,
+	},
+	{
+		Name:   "Multiple Documents without Start Marker after End Marker",
+		Input:  "a: 1\n...\nb: 2\n",
+		Expect: `[{"a":1},{"b":2}]`,
+	},
+	{
+		Name:   "Multiple Empty Documents",
+		Input:  "---\n---\n",
+		Expect: `[null,null]`,
+	},
+	{
+		Name:   "Empty Document with End Marker",
+		Input:  "---\n...\n---\na: 1\n",
+		Expect: `[null,{"a":1}]`,
+	},
+	{
+		Name:   "Single Document with End Marker",
+		Input:  "a: 1\n...\n",
+		Expect: `{"a":1}`,
+	},
+	{
+		Name:   "Document with Directive",
+		Input:  "%YAML 1.2\n---\na: 1\n",
+		Expect: `{"a":1}`,
+	},
+	{
+		Name:   "Document Marker with Comment",
+		Input:  "--- # comment\na: 1\n",
+		Expect: `{"a":1}`,
+	},
+	{
+		Name:   "Document Marker at End of Input",
+		Input:  "a: 1\n--- \nb: 2",
+		Expect: `[{"a":1},{"b":2}]`,
+	},
+	{
+		Name:   "Not Document Markers",
+		Input:  "- '---'\n- ----\n",
+		Expect: `["---","----"]`,
+	},
+	{
+		Name:  "Unterminated Quoted Scalar before Document Marker Error",
+		Input: "a: \"x\n---\n\"\n",
+		Error: "line 2, column 1: quoted scalar is not terminated",
+	},
+	{
+		Name:   "Literal Block Scalar with Empty Lines",
+		Input:  "a: |\n  l1\n\n  l2\n",
+		Expect: `{"a":"l1\n\nl2\n"}`,
+	},
+	{
+		Name:   "Literal Block Scalar with Clip Chomping",
+		Input:  "a: |\n  l1\n\n\n",
+		Expect: `{"a":"l1\n"}`,
+	},
+	{
+		Name:   "Literal Block Scalar with Strip Chomping",
+		Input:  "a: |-\n  l1\n\n",
+		Expect: `{"a":"l1"}`,
+	},
+	{
+		Name:   "Literal Block Scalar with Keep Chomping",
+		Input:  "a: |+\n  l1\n\n\nb: 1\n",
+		Expect: `{"a":"l1\n\n\n","b":1}`,
+	},
+	{
+		Name:   "Folded Block Scalar with Keep Chomping at End of Input",
+		Input:  "a: >+\n  x\n\n",
+		Expect: `{"a":"x\n\n"}`,
+	},
+	{
+		Name:   "Folded Block Scalar with Empty Lines",
+		Input:  "a: >\n  l1\n  l2\n\n  l3\n",
+		Expect: `{"a":"l1 l2\nl3\n"}`,
+	},
+	{
+		Name:   "Folded Block Scalar with More Indented Lines",
+		Input:  "a: >\n  l1\n    indented\n  l2\n",
+		Expect: `{"a":"l1\n  indented\nl2\n"}`,
+	},
+	{
+		Name:   "Folded Block Scalar with Leading Empty Line",
+		Input:  "a: >\n\n  l1\n",
+		Expect: `{"a":"\nl1\n"}`,
+	},
+	{
+		Name:   "Block Scalar with Indentation Indicator",
+		Input:  "a: |2\n   leading\n  l2\nb: |1\n  x\n",
+		Expect: `{"a":" leading\nl2\n","b":" x\n"}`,
+	},
+	{
+		Name:   "Block Scalar with Indentation and Chomping Indicators",
+		Input:  "- |-2\n   x\n- |2-\n   y\n",
+		Expect: `[" x"," y"]`,
+	},
+	{
+		Name:   "Block Scalar with Comment in Header",
+		Input:  "a: | # comment\n  x\n",
+		Expect: `{"a":"x\n"}`,
+	},
+	{
+		Name:   "Block Scalar with Comment Characters in Content",
+		Input:  "a: |\n  # not a comment\n  x\n",
+		Expect: `{"a":"# not a comment\nx\n"}`,
+	},
+	{
+		Name:   "Empty Block Scalars",
+		Input:  "a: |\nb: >\nc: |",
+		Expect: `{"a":"","b":"","c":""}`,
+	},
+	{
+		Name:   "Block Scalars in Sequence",
+		Input:  "- |\n  item\n- >-\n  item2\n",
+		Expect: `["item\n","item2"]`,
+	},
+	{
+		Name:   "Block Scalar in Nested Mapping",
+		Input:  "a:\n  b: |\n    x\n  c: 1\n",
+		Expect: `{"a":{"b":"x\n","c":1}}`,
+	},
+	{
+		Name:   "Block Scalar Terminated by Document Marker",
+		Input:  "a: |\n  x\n...\n",
+		Expect: `{"a":"x\n"}`,
+	},
+	{
+		Name:   "Block Scalar at Top Level",
+		Input:  "|\n literal\n",
+		Expect: `"literal\n"`,
+	},
+	{
+		Name:  "Invalid Block Scalar Header Error",
+		Input: "a: |x\n  x\n",
+		Error: "line 1, column 5: invalid block scalar header",
+	},
+	{
+		Name:  "Less Indented Block Scalar Line Error",
+		Input: "a: |\n    deep\n  shallow\n",
+		Error: "line 3, column 3: bad indentation of a mapping entry",
+	},
+	{
+		Name:   "Multi-Line Quoted Scalars",
+		Input:  "- \"multi\n  line\"\n- 'multi\n\n  line'\n- \"escaped \\\n  break\"\n",
+		Expect: `["multi line","multi\nline","escaped break"]`,
+	},
+	{
+		Name:   "Escape Sequences",
+		Input:  "- \"\\x41\\u00e9\\U0001F600\"\n- \"\\0\\a\\b\\e\\/\\\\\\\"\"\n",
+		Expect: `["Aé😀","\u0000\u0007\b\u001b\/\\\""]`,
+	},
+	{
+		Name:   "Numbers",
+		Input:  "[0x1F, 0o17, 1e3, .5, +1, -1, 99999999999999999999]\n",
+		Expect: `[31,15,1000,0.5,1,-1,100000000000000000000]`,
+	},
+	{
+		Name:   "Infinity",
+		Input:  "[.inf, -.Inf]\n",
+		Expect: `[+Inf,-Inf]`,
+	},
+	{
+		Name:   "Keys",
+		Input:  "key with spaces: 1\n'single quoted': 2\n\"double quoted\": 3\n",
+		Expect: `{"key with spaces":1,"single quoted":2,"double quoted":3}`,
+	},
+	{
+		Name:   "Comments",
+		Input:  "a: x # comment\nb: y#not a comment\n# comment\n",
+		Expect: `{"a":"x","b":"y#not a comment"}`,
+	},
+	{
+		Name:   "Sequence at Indentation of Parent Mapping",
+		Input:  "a:\n- 1\n- 2\nb: 3\n",
+		Expect: `{"a":[1,2],"b":3}`,
+	},
+	{
+		Name:   "Nested Sequences",
+		Input:  "- - 1\n  - 2\n- - 3\n",
+		Expect: `[[1,2],[3]]`,
+	},
+	{
+		Name:   "Compact Mapping in Sequence",
+		Input:  "- a: 1\n  b: 2\n- c\n",
+		Expect: `[{"a":1,"b":2},"c"]`,
+	},
+	{
+		Name:   "Empty Sequence Entries and Mapping Values",
+		Input:  "-\n- a:\n\n  b:\n",
+		Expect: `[null,{"a":null,"b":null}]`,
+	},
+	{
+		Name:   "Plain Scalar Continued by More Indented Line",
+		Input:  "a:\n  - 1\n   - 2\n",
+		Expect: `{"a":["1 - 2"]}`,
+	},
+	{
+		Name:   "Flow Collections",
+		Input:  "a: [1, 2, ]\nb: {x, y: }\nc: [x: 1, y]\n",
+		Expect: `{"a":[1,2],"b":{"x":null,"y":null},"c":[{"x":1},"y"]}`,
+	},
+	{
+		Name:   "Core Schema Tags",
+		Input:  "- !!int 12\n- !!bool yes\n- !<tag:yaml.org,2002:int> 7\n- !!null x\n",
+		Expect: `[12,"yes",7,null]`,
+	},
+	{
+		Name:   "Byte Order Mark and CRLF",
+		Input:  "\ufeffa: 1\r\nb: 2\r\n",
+		Expect: `{"a":1,"b":2}`,
+	},
+	{
+		Name:   "Only Comments",
+		Input:  "# comment\n\t\n",
+		Expect: "null",
+	},
+	{
+		Name:  "Block Mapping in Mapping Value Error",
+		Input: "a: b: c\n",
+		Error: "line 1, column 4: block mapping is not allowed in this context",
+	},
+	{
+		Name:  "Quoted Block Mapping in Mapping Value Error",
+		Input: "a: 'b': c\n",
+		Error: "line 1, column 7: block mapping is not allowed in this context",
+	},
+	{
+		Name:  "Block Sequence in Mapping Value Error",
+		Input: "a: - b\n",
+		Error: "line 1, column 4: block sequence is not allowed in this context",
+	},
+	{
+		Name:  "Bad Indentation of Mapping Entry Error",
+		Input: "a: 1\n b: 2\n",
+		Error: "line 2, column 2: bad indentation of a mapping entry",
+	},
+	{
+		Name:  "Mapping Entry after Sequence Error",
+		Input: "a:\n  - b\n  c: 1\n",
+		Error: "line 3, column 3: bad indentation of a mapping entry",
+	},
+	{
+		Name:  "Less Indented Content Error",
+		Input: "  a: 1\nb: 2\n",
+		Error: "line 2, column 1: unexpected content",
+	},
+	{
+		Name:  "Complex Mapping Key Error",
+		Input: "? a\n: 1\n",
+		Error: "line 1, column 1: complex mapping keys are not supported",
+	},
+	{
+		Name:  "Collection as Mapping Key Error",
+		Input: "[a]: 1\n",
+		Error: "line 1, column 4: mapping keys must be scalars",
+	},
+	{
+		Name:  "Unterminated Quoted Scalar Error",
+		Input: "a: 'unterminated\n",
+		Error: "line 2, column 1: quoted scalar is not terminated",
+	},
+	{
+		Name:  "Invalid Escape Sequence Error",
+		Input: "a: \"bad \\q\"\n",
+		Error: "line 1, column 11: invalid escape sequence \"\\q\"",
+	},
+	{
+		Name:  "Short Escape Sequence Error",
+		Input: "a: \"\\x4\"\n",
+		Error: "line 1, column 7: invalid escape sequence",
+	},
+	{
+		Name:  "Unterminated Flow Mapping Error",
+		Input: "a: [1, {b: 2\n",
+		Error: "line 2, column 1: flow mapping is not terminated",
+	},
+	{
+		Name:  "Unterminated Flow Collection Error",
+		Input: "- [\n",
+		Error: "line 2, column 1: flow collection is not terminated",
+	},
+	{
+		Name:  "Unexpected Flow Indicator Error",
+		Input: "[1, 2]]\n",
+		Error: "line 1, column 7: unexpected content",
+	},
+	{
+		Name:  "Duplicate Key Error",
+		Input: "a: 1\na: 2\n",
+		Error: "line 2, column 5: duplicate key \"a\"",
+	},
+	{
+		Name:  "Unterminated Flow Sequence Error",
+		Input: "a: [1, 2\n",
+		Error: "line 2, column 1: flow sequence is not terminated",
+	},
+	{
+		Name:  "Undefined Alias Error",
+		Input: "a: *undefined\n",
+		Error: "line 1, column 14: undefined alias \"undefined\"",
+	},
+}
+
+func TestDecode(t *testing.T) {
+	for _, v := range decodeTests {
+		result, err := Decode(v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if result.Encode() != v.Expect {
+			t.Errorf("%s: result = %s, want %s", v.Name, result.Encode(), v.Expect)
+		}
+	}
+}
+
+func TestDecode_TruncatedInput(t *testing.T) {
+	for _, v := range decodeTests {
+		src := []rune(v.Input)
+		for i := 0; i < len(src); i++ {
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						t.Errorf("%s: panic with input %q: %v", v.Name, string(src[:i]), r)
+					}
+				}()
+				_, _ = Decode(string(src[:i]))
+			}()
+		}
+	}
+}
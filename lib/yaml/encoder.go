@@ -0,0 +1,230 @@
+package yaml
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/json"
+)
+
+const indentSize = 2
+
+// Encoder encodes JSON structures as YAML documents.
+type Encoder struct {
+	LineBreak text.LineBreak
+
+	decoder   *json.Decoder
+	lineBreak string
+}
+
+// NewEncoder returns an encoder that uses LF as the line break.
+func NewEncoder() *Encoder {
+	d := json.NewDecoder()
+	d.UseInteger = true
+
+	return &Encoder{
+		LineBreak: text.LF,
+		decoder:   d,
+	}
+}
+
+// Encode returns the YAML document that represents the structure.
+//
+// Strings that can be decoded as JSON objects or arrays are encoded as nested mappings
+// or sequences, in the same way as the JSON encoder.
+func (e *Encoder) Encode(structure json.Structure) string {
+	e.lineBreak = e.LineBreak.Value()
+
+	var b strings.Builder
+	structure = e.expand(structure)
+	switch structure.(type) {
+	case json.Object:
+		obj := structure.(json.Object)
+		if 0 < obj.Len() {
+			e.writeMapping(&b, obj, 0, true)
+			return b.String()
+		}
+	case json.Array:
+		array := structure.(json.Array)
+		if 0 < len(array) {
+			e.writeSequence(&b, array, 0, true)
+			return b.String()
+		}
+	}
+	b.WriteString(e.scalar(structure))
+	return b.String()
+}
+
+func (e *Encoder) expand(structure json.Structure) json.Structure {
+	if s, ok := structure.(json.String); ok {
+		str := strings.TrimSpace(s.Raw())
+		if 0 < len(str) && (str[0] == '{' || str[0] == '[') {
+			if decoded, _, err := e.decoder.Decode(str); err == nil {
+				return decoded
+			}
+		}
+	}
+	return structure
+}
+
+func (e *Encoder) newLine(b *strings.Builder, indent int) {
+	b.WriteString(e.lineBreak)
+	b.WriteString(strings.Repeat(" ", indent))
+}
+
+// writeMapping writes the members of the object.
+// If first is true, the first member is written at the current position.
+func (e *Encoder) writeMapping(b *strings.Builder, obj json.Object, indent int, first bool) {
+	for i, m := range obj.Members {
+		if 0 < i || !first {
+			e.newLine(b, indent)
+		}
+		b.WriteString(quote(m.Key))
+		b.WriteByte(':')
+
+		val := e.expand(m.Value)
+		switch val.(type) {
+		case json.Object:
+			if child := val.(json.Object); 0 < child.Len() {
+				e.writeMapping(b, child, indent+indentSize, false)
+				continue
+			}
+		case json.Array:
+			if child := val.(json.Array); 0 < len(child) {
+				e.writeSequence(b, child, indent+indentSize, false)
+				continue
+			}
+		}
+		b.WriteByte(' ')
+		b.WriteString(e.scalar(val))
+	}
+}
+
+// writeSequence writes the items of the array.
+// If first is true, the first item is written at the current position.
+func (e *Encoder) writeSequence(b *strings.Builder, array json.Array, indent int, first bool) {
+	for i, v := range array {
+		if 0 < i || !first {
+			e.newLine(b, indent)
+		}
+		b.WriteString("- ")
+
+		val := e.expand(v)
+		switch val.(type) {
+		case json.Object:
+			if child := val.(json.Object); 0 < child.Len() {
+				e.writeMapping(b, child, indent+indentSize, true)
+				continue
+			}
+		case json.Array:
+			if child := val.(json.Array); 0 < len(child) {
+				e.writeSequence(b, child, indent+indentSize, true)
+				continue
+			}
+		}
+		b.WriteString(e.scalar(val))
+	}
+}
+
+func (e *Encoder) scalar(structure json.Structure) string {
+	switch structure.(type) {
+	case json.Object:
+		return "{}"
+	case json.Array:
+		return "[]"
+	case json.String:
+		return quote(structure.(json.String).Raw())
+	case json.Float:
+		return formatFloat(structure.(json.Float).Raw())
+	case json.Number:
+		return formatFloat(structure.(json.Number).Raw())
+	case nil:
+		return "null"
+	}
+	return structure.Encode()
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return ".nan"
+	case math.IsInf(f, 1):
+		return ".inf"
+	case math.IsInf(f, -1):
+		return "-.inf"
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// quote returns the string as it is if it can be written as a plain scalar,
+// otherwise returns the double-quoted string.
+func quote(s string) string {
+	if isPlain(s) {
+		return s
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString("\\\"")
+		case '\\':
+			b.WriteString("\\\\")
+		case '\n':
+			b.WriteString("\\n")
+		case '\t':
+			b.WriteString("\\t")
+		case '\r':
+			b.WriteString("\\r")
+		case 0:
+			b.WriteString("\\0")
+		case 0x1b:
+			b.WriteString("\\e")
+		case 0x85:
+			b.WriteString("\\N")
+		case 0x2028:
+			b.WriteString("\\L")
+		case 0x2029:
+			b.WriteString("\\P")
+		case 0xfeff:
+			b.WriteString("\\uFEFF")
+		default:
+			if r < 0x20 || r == 0x7f {
+				b.WriteString(fmt.Sprintf("\\x%02X", r))
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func isPlain(s string) bool {
+	if len(s) < 1 || !utf8.ValidString(s) {
+		return false
+	}
+	if _, ok := resolve(s).(json.String); !ok {
+		return false
+	}
+	if strings.ContainsRune("-?:,[]{}#&*!|>'\"%@` \t", rune(s[0])) {
+		return false
+	}
+	if last := s[len(s)-1]; last == ' ' || last == '\t' || last == ':' {
+		return false
+	}
+	if strings.HasPrefix(s, "...") || strings.Contains(s, ": ") || strings.Contains(s, " #") {
+		return false
+	}
+	for _, r := range s {
+		if r < 0x20 || r == 0x7f || r == 0x85 || r == 0x2028 || r == 0x2029 || r == 0xfeff {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,90 @@
+package yaml
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/json"
+)
+
+var encoderEncodeTests = []struct {
+	Name      string
+	Input     json.Structure
+	LineBreak text.LineBreak
+	Expect    string
+}{
+	{
+		Name:   "Scalar",
+		Input:  json.String("abc"),
+		Expect: "abc",
+	},
+	{
+		Name:   "Empty Array",
+		Input:  json.Array{},
+		Expect: "[]",
+	},
+	{
+		Name: "Sequence of Mappings",
+		Input: json.Array{
+			json.Object{Members: []json.ObjectMember{
+				{Key: "a", Value: json.Integer(1)},
+				{Key: "b", Value: json.String("true")},
+			}},
+			json.Object{Members: []json.ObjectMember{
+				{Key: "a", Value: json.Float(math.Inf(-1))},
+				{Key: "b", Value: json.Null{}},
+			}},
+		},
+		Expect: "- a: 1\n" +
+			"  b: \"true\"\n" +
+			"- a: -.inf\n" +
+			"  b: null",
+	},
+	{
+		Name: "Nested Collections",
+		Input: json.Object{Members: []json.ObjectMember{
+			{Key: "list", Value: json.Array{json.Integer(1), json.Array{json.String("a"), json.String("b")}}},
+			{Key: "map", Value: json.Object{Members: []json.ObjectMember{
+				{Key: "key: 1", Value: json.Boolean(false)},
+			}}},
+			{Key: "empty", Value: json.Object{}},
+		}},
+		LineBreak: text.CRLF,
+		Expect: "list:\r\n" +
+			"  - 1\r\n" +
+			"  - - a\r\n" +
+			"    - b\r\n" +
+			"map:\r\n" +
+			"  \"key: 1\": false\r\n" +
+			"empty: {}",
+	},
+	{
+		Name: "Expand JSON String",
+		Input: json.Object{Members: []json.ObjectMember{
+			{Key: "a", Value: json.String("{\"b\": [1, \"c\"]}")},
+		}},
+		Expect: "a:\n" +
+			"  b:\n" +
+			"    - 1\n" +
+			"    - c",
+	},
+	{
+		Name:   "Quoted Strings",
+		Input:  json.Array{json.String(""), json.String("- a"), json.String("123"), json.String("a\tb"), json.String(" a")},
+		Expect: "- \"\"\n- \"- a\"\n- \"123\"\n- \"a\\tb\"\n- \" a\"",
+	},
+}
+
+func TestEncoder_Encode(t *testing.T) {
+	for _, v := range encoderEncodeTests {
+		e := NewEncoder()
+		if v.LineBreak != "" {
+			e.LineBreak = v.LineBreak
+		}
+		result := e.Encode(v.Input)
+		if result != v.Expect {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, v.Expect)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package yaml
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mithrandie/csvq/lib/json"
+	"github.com/mithrandie/csvq/lib/value"
+
+	txjson "github.com/mithrandie/go-text/json"
+)
+
+// LoadTable loads a table from the YAML document in src.
+// The sequence of mappings that is converted to the rows is selected by the query,
+// which is written in the same syntax as json queries.
+func LoadTable(queryString string, src string) ([]string, [][]value.Primary, error) {
+	query, err := json.Query.Parse(queryString)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := Decode(src)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	structure, err := json.Extract(query, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	array, ok := structure.(txjson.Array)
+	if !ok {
+		if query == nil {
+			return nil, nil, errors.New("yaml document root must be a sequence")
+		}
+		return nil, nil, errors.New(fmt.Sprintf("yaml value does not exist for %q", queryString))
+	}
+
+	return json.ConvertToTableValue(array)
+}
@@ -0,0 +1,69 @@
+package yaml
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var loadTableTests = []struct {
+	Query        string
+	Yaml         string
+	ExpectHeader []string
+	ExpectValues [][]value.Primary
+	Error        string
+}{
+	{
+		Query: "key",
+		Yaml: "key:\n" +
+			"  - key2: 2\n" +
+			"    key3: abc\n" +
+			"  - key2: 4\n" +
+			"    key4: true\n",
+		ExpectHeader: []string{"key2", "key3", "key4"},
+		ExpectValues: [][]value.Primary{
+			{value.NewInteger(2), value.NewString("abc"), value.NewNull()},
+			{value.NewInteger(4), value.NewNull(), value.NewBoolean(true)},
+		},
+	},
+	{
+		Query: "",
+		Yaml:  "key: value\n",
+		Error: "yaml document root must be a sequence",
+	},
+	{
+		Query: "key",
+		Yaml:  "key: value\n",
+		Error: "yaml value does not exist for \"key\"",
+	},
+	{
+		Query: "key",
+		Yaml:  "key: [1\n",
+		Error: "line 2, column 1: flow sequence is not terminated",
+	},
+}
+
+func TestLoadTable(t *testing.T) {
+	for _, v := range loadTableTests {
+		header, values, err := LoadTable(v.Query, v.Yaml)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q, %q", err.Error(), v.Query, v.Yaml)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q, %q", err, v.Error, v.Query, v.Yaml)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q, %q", v.Error, v.Query, v.Yaml)
+			continue
+		}
+		if !reflect.DeepEqual(header, v.ExpectHeader) {
+			t.Errorf("header = %#v, want %#v for %q, %q", header, v.ExpectHeader, v.Query, v.Yaml)
+		}
+		if !reflect.DeepEqual(values, v.ExpectValues) {
+			t.Errorf("values = %#v, want %#v for %q, %q", values, v.ExpectValues, v.Query, v.Yaml)
+		}
+	}
+}
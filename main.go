@@ -58,6 +58,15 @@ func main() {
 			Value: 10,
 			Usage: "limit of the waiting time in seconds to wait for locked files to be released",
 		},
+		cli.BoolFlag{
+			Name:  "keep-versions",
+			Usage: "keep the previous contents of updated files as versions",
+		},
+		cli.IntFlag{
+			Name:  "max-versions",
+			Value: 0,
+			Usage: "maximum `NUMBER` of versions kept for each file. 0 means no limit",
+		},
 		cli.StringFlag{
 			Name:  "source, s",
 			Usage: "load query or statements from `FILE`",
@@ -70,7 +79,7 @@ func main() {
 		cli.StringFlag{
 			Name:  "delimiter, d",
 			Value: ",",
-			Usage: "field delimiter for CSV",
+			Usage: "field delimiter for CSV, or AUTO to detect it from files",
 		},
 		cli.StringFlag{
 			Name:  "delimiter-positions, m",
@@ -78,7 +87,7 @@ func main() {
 		},
 		cli.StringFlag{
 			Name:  "json-query, j",
-			Usage: "`QUERY` for JSON",
+			Usage: "`QUERY` for JSON and YAML",
 		},
 		cli.StringFlag{
 			Name:  "encoding, e",
@@ -93,6 +102,42 @@ func main() {
 			Name:  "without-null, a",
 			Usage: "parse empty fields as empty strings",
 		},
+		cli.BoolFlag{
+			Name:  "glob-union-by-name",
+			Usage: "union the columns of files matched by a glob pattern by their names",
+		},
+		cli.StringFlag{
+			Name:  "column-types",
+			Usage: "convert fields to the types specified as `COLUMN:TYPE[,COLUMN:TYPE...]` when loading CSV, TSV, FIXED and LTSV",
+		},
+		cli.StringFlag{
+			Name:  "quote-char",
+			Value: "\"",
+			Usage: "quote `CHARACTER` for CSV",
+		},
+		cli.StringFlag{
+			Name:  "escape-style",
+			Value: "DOUBLE",
+			Usage: "escape style of quote characters in CSV. one of: DOUBLE|BACKSLASH",
+		},
+		cli.StringFlag{
+			Name:  "comment-prefix",
+			Usage: "ignore lines that start with `PREFIX` in CSV",
+		},
+		cli.IntFlag{
+			Name:  "skip-lines",
+			Value: 0,
+			Usage: "skip `NUMBER` lines at the beginning of CSV",
+		},
+		cli.BoolFlag{
+			Name:  "trim-space",
+			Usage: "trim spaces around unquoted fields in CSV",
+		},
+		cli.StringFlag{
+			Name:  "on-parse-error",
+			Value: "FAIL",
+			Usage: "how to handle records that cannot be parsed in CSV, TSV and JSON Lines. one of: FAIL|SKIP|QUARANTINE",
+		},
 		cli.StringFlag{
 			Name:  "out, o",
 			Usage: "export result sets of select queries to `FILE`",
@@ -142,6 +187,30 @@ func main() {
 			Name:  "pretty-print, P",
 			Usage: "make JSON output easier to read in query results",
 		},
+		cli.StringFlag{
+			Name:  "xml-root-element",
+			Value: "root",
+			Usage: "name of the root element in XML query results",
+		},
+		cli.StringFlag{
+			Name:  "xml-row-element",
+			Value: "row",
+			Usage: "name of the record elements in XML query results",
+		},
+		cli.StringFlag{
+			Name:  "sql-table-name",
+			Value: "result",
+			Usage: "table name in SQL query results",
+		},
+		cli.StringFlag{
+			Name:  "sql-dialect",
+			Value: "ANSI",
+			Usage: "SQL dialect of query results",
+		},
+		cli.BoolFlag{
+			Name:  "html-standalone",
+			Usage: "write HTML query results as a complete document",
+		},
 		cli.BoolFlag{
 			Name:  "east-asian-encoding, W",
 			Usage: "count ambiguous characters as fullwidth",
@@ -325,6 +394,11 @@ func commandAction(fn func(ctx context.Context, c *cli.Context, proc *query.Proc
 			return
 		}
 
+		// Finish or roll back the commits interrupted in the repository
+		if err = proc.Tx.RecoverJournals(); err != nil {
+			return
+		}
+
 		err = fn(ctx, c, proc)
 		if signalReceived != nil {
 			err = signalReceived
@@ -357,6 +431,12 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.GlobalIsSet("wait-timeout") {
 		_ = tx.SetFlag(cmd.WaitTimeoutFlag, c.GlobalFloat64("wait-timeout"))
 	}
+	if c.GlobalIsSet("keep-versions") {
+		_ = tx.SetFlag(cmd.KeepVersionsFlag, c.GlobalBool("keep-versions"))
+	}
+	if c.GlobalIsSet("max-versions") {
+		_ = tx.SetFlag(cmd.MaxVersionsFlag, c.GlobalInt64("max-versions"))
+	}
 	if c.GlobalIsSet("color") {
 		_ = tx.SetFlag(cmd.ColorFlag, c.GlobalBool("color"))
 	}
@@ -390,6 +470,38 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.GlobalIsSet("without-null") {
 		_ = tx.SetFlag(cmd.WithoutNullFlag, c.GlobalBool("without-null"))
 	}
+	if c.GlobalIsSet("glob-union-by-name") {
+		_ = tx.SetFlag(cmd.GlobUnionByNameFlag, c.GlobalBool("glob-union-by-name"))
+	}
+	if c.GlobalIsSet("column-types") {
+		if err := tx.SetFlag(cmd.ColumnTypesFlag, c.GlobalString("column-types")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("quote-char") {
+		if err := tx.SetFlag(cmd.QuoteCharFlag, c.GlobalString("quote-char")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("escape-style") {
+		if err := tx.SetFlag(cmd.EscapeStyleFlag, c.GlobalString("escape-style")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("comment-prefix") {
+		_ = tx.SetFlag(cmd.CommentPrefixFlag, c.GlobalString("comment-prefix"))
+	}
+	if c.GlobalIsSet("skip-lines") {
+		_ = tx.SetFlag(cmd.SkipLinesFlag, c.GlobalInt64("skip-lines"))
+	}
+	if c.GlobalIsSet("trim-space") {
+		_ = tx.SetFlag(cmd.TrimSpaceFlag, c.GlobalBool("trim-space"))
+	}
+	if c.GlobalIsSet("on-parse-error") {
+		if err := tx.SetFlag(cmd.OnParseErrorFlag, c.GlobalString("on-parse-error")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
 
 	if c.GlobalIsSet("strip-ending-line-break") {
 		_ = tx.SetFlag(cmd.StripEndingLineBreakFlag, c.GlobalBool("strip-ending-line-break"))
@@ -433,6 +545,29 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.GlobalIsSet("pretty-print") {
 		_ = tx.SetFlag(cmd.PrettyPrintFlag, c.GlobalBool("pretty-print"))
 	}
+	if c.GlobalIsSet("xml-root-element") {
+		if err := tx.SetFlag(cmd.XmlRootElementFlag, c.GlobalString("xml-root-element")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("xml-row-element") {
+		if err := tx.SetFlag(cmd.XmlRowElementFlag, c.GlobalString("xml-row-element")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("sql-table-name") {
+		if err := tx.SetFlag(cmd.SqlTableNameFlag, c.GlobalString("sql-table-name")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("sql-dialect") {
+		if err := tx.SetFlag(cmd.SqlDialectFlag, c.GlobalString("sql-dialect")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.GlobalIsSet("html-standalone") {
+		_ = tx.SetFlag(cmd.HtmlStandaloneFlag, c.GlobalBool("html-standalone"))
+	}
 
 	if c.GlobalIsSet("east-asian-encoding") {
 		_ = tx.SetFlag(cmd.EastAsianEncodingFlag, c.GlobalBool("east-asian-encoding"))